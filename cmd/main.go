@@ -1,18 +1,22 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/rafaelc-rb/geekery-api/internal/config"
 	"github.com/rafaelc-rb/geekery-api/internal/database"
 	"github.com/rafaelc-rb/geekery-api/internal/logger"
 	"github.com/rafaelc-rb/geekery-api/internal/middleware"
+	"github.com/rafaelc-rb/geekery-api/internal/repositories"
 	"github.com/rafaelc-rb/geekery-api/internal/routes"
+	"github.com/rafaelc-rb/geekery-api/internal/services"
 
 	_ "github.com/rafaelc-rb/geekery-api/docs" // Swagger docs
 )
@@ -81,8 +85,56 @@ func main() {
 	router.Use(middleware.Logger()) // Logger estruturado
 	router.Use(corsMiddleware())    // CORS
 
+	// Cache dos itens similares compartilhado entre rotas e jobs: qualquer escrita no catálogo
+	// (inclusive dos workers de import) invalida as entradas servidas pela API
+	similarItemsCache := services.NewSimilarItemsCache(services.SimilarItemsCacheTTL)
+
 	// Configurar rotas
-	routes.SetupRoutes(router, db)
+	routes.SetupRoutes(router, db, similarItemsCache)
+
+	// Jobs em segundo plano
+	// Recálculo periódico dos agregados da comunidade (score bayesiano depende da média global)
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	if cfg.StatsRefreshInterval > 0 {
+		itemStatsService := services.NewItemStatsService(repositories.NewItemStatsRepository(db))
+		go itemStatsService.RunPeriodicRefresh(jobsCtx, cfg.StatsRefreshInterval)
+	}
+	// Reconstrução do modelo de similaridade usado nas recomendações
+	if cfg.RecommendationsRefreshInterval > 0 {
+		recommendationService := services.NewRecommendationService(repositories.NewRecommendationRepository(db), repositories.NewItemRepository(db), similarItemsCache)
+		go recommendationService.RunPeriodicRebuild(jobsCtx, cfg.RecommendationsRefreshInterval)
+	}
+	// Workers da fila de imports (jobs interrompidos voltam para a fila no desligamento)
+	importWorkersDone := make(chan struct{})
+	if cfg.ImportWorkers > 0 {
+		itemService := services.NewItemService(repositories.NewItemRepository(db), repositories.NewTagRepository(db), repositories.NewUnitOfWork(db), similarItemsCache)
+		importJobService := services.NewImportJobService(repositories.NewImportJobRepository(db), itemService, cfg.ImportStorageDir)
+		go func() {
+			defer close(importWorkersDone)
+			importJobService.RunWorkers(jobsCtx, cfg.ImportWorkers, cfg.ImportPollInterval)
+		}()
+	} else {
+		close(importWorkersDone)
+	}
+	// Workers dos exports da conta e limpeza dos ZIPs expirados
+	dataExportWorkersDone := make(chan struct{})
+	accountService := services.NewAccountService(
+		repositories.NewUserRepository(db), repositories.NewUserItemRepository(db), repositories.NewDataExportRepository(db),
+		repositories.NewAccountRepository(db), repositories.NewItemStatsRepository(db),
+		cfg.DataExportStorageDir, cfg.DataExportTTL, cfg.JWTSecret,
+	)
+	if cfg.DataExportWorkers > 0 {
+		go func() {
+			defer close(dataExportWorkersDone)
+			accountService.RunWorkers(jobsCtx, cfg.DataExportWorkers, cfg.DataExportPollInterval)
+		}()
+	} else {
+		close(dataExportWorkersDone)
+	}
+	if cfg.DataExportCleanupInterval > 0 {
+		go accountService.RunPeriodicCleanup(jobsCtx, cfg.DataExportCleanupInterval)
+	}
 
 	// Iniciar servidor
 	serverAddr := fmt.Sprintf(":%s", cfg.ServerPort)
@@ -104,6 +156,17 @@ func main() {
 	<-quit
 
 	logger.Info().Msg("Shutting down server...")
+	stopJobs()
+	select {
+	case <-importWorkersDone:
+	case <-time.After(10 * time.Second):
+		logger.Warn().Msg("Import workers did not stop in time")
+	}
+	select {
+	case <-dataExportWorkersDone:
+	case <-time.After(10 * time.Second):
+		logger.Warn().Msg("Data export workers did not stop in time")
+	}
 	logger.Info().Msg("Server stopped gracefully")
 }
 
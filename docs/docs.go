@@ -23,9 +23,9 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/auth/login": {
-            "post": {
-                "description": "Authenticate user with username or email and return JWT token",
+        "/account": {
+            "delete": {
+                "description": "Permanently erase the account and every row tied to it (list, reviews and their votes, collections, personal tags, goals, follows, activity, list imports and data exports). Soft-deleted rows are erased too. Requires the current password",
                 "consumes": [
                     "application/json"
                 ],
@@ -33,25 +33,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "account"
                 ],
-                "summary": "Login",
+                "summary": "Delete my account",
                 "parameters": [
                     {
-                        "description": "Login credentials (username or email)",
+                        "description": "Password confirmation",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.LoginRequest"
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.DeleteAccountRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Login successful",
+                        "description": "Account erased - rows deleted per table",
                         "schema": {
-                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.AuthResponse"
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.AccountErasureResult"
                         }
                     },
                     "400": {
@@ -64,50 +64,7 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "Unauthorized - invalid credentials",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    }
-                }
-            }
-        },
-        "/auth/register": {
-            "post": {
-                "description": "Create a new user account",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "auth"
-                ],
-                "summary": "Register a new user",
-                "parameters": [
-                    {
-                        "description": "Registration data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.RegisterRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "User registered successfully",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.AuthResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad request - validation error",
+                        "description": "Wrong password",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -115,8 +72,8 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "409": {
-                        "description": "Conflict - email or username already exists",
+                    "404": {
+                        "description": "User not found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -127,68 +84,50 @@ const docTemplate = `{
                 }
             }
         },
-        "/items": {
+        "/account/exports": {
             "get": {
-                "description": "Get paginated list of all items from the global catalog",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "List the authenticated user's data exports (newest first); available ones include a signed download_url",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "items"
+                    "account"
                 ],
-                "summary": "Get all items",
+                "summary": "List account data exports",
                 "parameters": [
                     {
-                        "minimum": 1,
                         "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
+                        "description": "Page number (default: 1)",
                         "name": "page",
                         "in": "query"
                     },
                     {
-                        "maximum": 100,
-                        "minimum": 1,
                         "type": "integer",
-                        "default": 20,
-                        "description": "Items per page",
+                        "description": "Items per page (default: 20, max: 100)",
                         "name": "limit",
                         "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "anime",
-                            "movie",
-                            "series",
-                            "game",
-                            "manga",
-                            "light_novel",
-                            "music",
-                            "book"
-                        ],
-                        "type": "string",
-                        "description": "Filter by media type",
-                        "name": "type",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success - returns paginated items",
+                        "description": "Success - returns data exports",
                         "schema": {
-                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.PaginatedResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad request - invalid parameters",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.DataExportDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "500": {
@@ -203,37 +142,32 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Create a new item in the global catalog (admin only in the future)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Queue a ZIP of JSON files with every piece of data tied to the account: profile, list (with the full progress_data and view history), reviews with revisions, helpful votes, collections, personal tags, goals, follows, activity, list imports and tokens metadata.\nThe ZIP is generated in the background; poll /account/exports/{id} until status is completed to get a signed download_url, valid until expires_at",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "items"
+                    "account"
                 ],
-                "summary": "Create item",
-                "parameters": [
-                    {
-                        "description": "Item to create",
-                        "name": "item",
-                        "in": "body",
-                        "required": true,
+                "summary": "Request account data export",
+                "responses": {
+                    "202": {
+                        "description": "Export queued",
                         "schema": {
-                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.Item"
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.DataExportDTO"
                         }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Item created successfully",
+                    },
+                    "409": {
+                        "description": "An export is already queued or running",
                         "schema": {
-                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.Item"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
-                    "400": {
-                        "description": "Bad request - validation error",
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -244,37 +178,43 @@ const docTemplate = `{
                 }
             }
         },
-        "/items/import/anime": {
-            "post": {
-                "description": "Import multiple anime items from CSV file",
-                "consumes": [
-                    "multipart/form-data"
-                ],
+        "/account/exports/{id}": {
+            "get": {
+                "description": "Get a data export with its status; once completed, download_url is a signed link valid until expires_at",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "items"
+                    "account"
                 ],
-                "summary": "Import anime items",
+                "summary": "Get account data export",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "CSV file with anime data",
-                        "name": "file",
-                        "in": "formData",
+                        "type": "integer",
+                        "description": "Data export ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Import completed",
+                        "description": "Data export",
                         "schema": {
-                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ImportResult"
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.DataExportDTO"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Data export not found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -285,37 +225,66 @@ const docTemplate = `{
                 }
             }
         },
-        "/items/import/book": {
-            "post": {
-                "description": "Import multiple book items from CSV file",
-                "consumes": [
-                    "multipart/form-data"
-                ],
+        "/account/exports/{id}/download": {
+            "get": {
+                "description": "Download the export ZIP. No authentication header is needed: the link from download_url is signed and expires with the export",
                 "produces": [
-                    "application/json"
+                    "application/zip"
                 ],
                 "tags": [
-                    "items"
+                    "account"
                 ],
-                "summary": "Import book items",
+                "summary": "Download account data export",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "CSV file with book data",
-                        "name": "file",
-                        "in": "formData",
+                        "type": "integer",
+                        "description": "Data export ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Link expiration (Unix timestamp)",
+                        "name": "expires",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Link signature",
+                        "name": "signature",
+                        "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Import completed",
+                        "description": "Export ZIP",
                         "schema": {
-                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ImportResult"
+                            "type": "file"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad request - invalid ID or missing signature",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Invalid or expired link",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Data export not found or no longer available",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -326,37 +295,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/items/import/comic": {
+        "/auth/login": {
             "post": {
-                "description": "Import multiple comic items from CSV file",
+                "description": "Authenticate user with username or email and return JWT token",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "items"
+                    "auth"
                 ],
-                "summary": "Import comic items",
+                "summary": "Login",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "CSV file with comic data",
-                        "name": "file",
-                        "in": "formData",
-                        "required": true
+                        "description": "Login credentials (username or email)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.LoginRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Import completed",
+                        "description": "Login successful",
                         "schema": {
-                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ImportResult"
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.AuthResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - invalid credentials",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -367,37 +347,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/items/import/game": {
+        "/auth/register": {
             "post": {
-                "description": "Import multiple game items from CSV file",
+                "description": "Create a new user account",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "items"
+                    "auth"
                 ],
-                "summary": "Import game items",
+                "summary": "Register a new user",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "CSV file with game data",
-                        "name": "file",
-                        "in": "formData",
-                        "required": true
+                        "description": "Registration data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.RegisterRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Import completed",
+                    "201": {
+                        "description": "User registered successfully",
                         "schema": {
-                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ImportResult"
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.AuthResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - email or username already exists",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -408,37 +399,42 @@ const docTemplate = `{
                 }
             }
         },
-        "/items/import/movie": {
-            "post": {
-                "description": "Import multiple movie items from CSV file",
+        "/collections": {
+            "get": {
+                "description": "List the authenticated user's collections (without entries) with pagination",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "items"
+                    "collections"
                 ],
-                "summary": "Import movie items",
+                "summary": "List my collections",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "CSV file with movie data",
-                        "name": "file",
-                        "in": "formData",
-                        "required": true
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page (default: 20, max: 100)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Import completed",
+                        "description": "Success - returns collections",
                         "schema": {
-                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ImportResult"
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.PaginatedResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad request",
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -447,39 +443,48 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/items/import/novel": {
+            },
             "post": {
-                "description": "Import multiple novel items from CSV file",
+                "description": "Create a named, ordered collection that can mix media types (private by default)",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "items"
+                    "collections"
                 ],
-                "summary": "Import novel items",
+                "summary": "Create collection",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "CSV file with novel data",
-                        "name": "file",
-                        "in": "formData",
-                        "required": true
+                        "description": "Collection data",
+                        "name": "collection",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.CollectionRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Import completed",
+                    "201": {
+                        "description": "Collection created",
                         "schema": {
-                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ImportResult"
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.CollectionDTO"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -490,37 +495,42 @@ const docTemplate = `{
                 }
             }
         },
-        "/items/import/series": {
-            "post": {
-                "description": "Import multiple series items from CSV file",
+        "/collections/public": {
+            "get": {
+                "description": "Browse public collections from all users (without entries)",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "items"
+                    "collections"
                 ],
-                "summary": "Import series items",
+                "summary": "List public collections",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "CSV file with series data",
-                        "name": "file",
-                        "in": "formData",
-                        "required": true
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page (default: 20, max: 100)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Import completed",
+                        "description": "Success - returns collections",
                         "schema": {
-                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ImportResult"
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.PaginatedResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad request",
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -531,9 +541,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/items/search": {
+        "/collections/shared/{token}": {
             "get": {
-                "description": "Search items by title (case-insensitive) with pagination",
+                "description": "Get an unlisted or public collection through its share link",
                 "consumes": [
                     "application/json"
                 ],
@@ -541,40 +551,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "items"
+                    "collections"
                 ],
-                "summary": "Search items",
+                "summary": "Get shared collection",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Search query",
-                        "name": "q",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 20,
-                        "description": "Items per page",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Share token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success - returns matching items",
+                        "description": "Success - returns collection",
                         "schema": {
-                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.PaginatedResponse"
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.CollectionDTO"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "404": {
+                        "description": "Collection not found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -585,9 +582,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/items/{id}": {
+        "/collections/{id}": {
             "get": {
-                "description": "Get a specific item from the catalog by its ID",
+                "description": "Get a collection with its ordered entries. Public collections are visible to everyone; private and unlisted ones only to the owner (unlisted ones are also reachable through their share link)",
                 "consumes": [
                     "application/json"
                 ],
@@ -595,13 +592,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "items"
+                    "collections"
                 ],
-                "summary": "Get item by ID",
+                "summary": "Get collection",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Item ID",
+                        "description": "Collection ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -609,9 +606,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success - returns item",
+                        "description": "Success - returns collection",
                         "schema": {
-                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.Item"
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.CollectionDTO"
                         }
                     },
                     "400": {
@@ -624,7 +621,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Item not found",
+                        "description": "Collection not found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -635,7 +632,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Update an existing item in the catalog (admin only in the future)",
+                "description": "Update a collection's title, description and visibility",
                 "consumes": [
                     "application/json"
                 ],
@@ -643,35 +640,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "items"
+                    "collections"
                 ],
-                "summary": "Update item",
+                "summary": "Update collection",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Item ID",
+                        "description": "Collection ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Item data to update",
-                        "name": "item",
+                        "description": "Collection data",
+                        "name": "collection",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.Item"
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.CollectionRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Item updated successfully",
+                        "description": "Collection updated",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.CollectionDTO"
                         }
                     },
                     "400": {
@@ -684,7 +678,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Item not found",
+                        "description": "Collection not found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -695,7 +689,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Delete an item from the catalog (admin only in the future)",
+                "description": "Delete one of the user's collections and its entries",
                 "consumes": [
                     "application/json"
                 ],
@@ -703,13 +697,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "items"
+                    "collections"
                 ],
-                "summary": "Delete item",
+                "summary": "Delete collection",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Item ID",
+                        "description": "Collection ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -717,7 +711,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "204": {
-                        "description": "Item deleted successfully"
+                        "description": "Collection deleted"
                     },
                     "400": {
                         "description": "Bad request - invalid ID",
@@ -729,7 +723,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Item not found",
+                        "description": "Collection not found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -740,9 +734,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/my-list": {
-            "get": {
-                "description": "Get user's personal tracking list with pagination and filters",
+        "/collections/{id}/items": {
+            "post": {
+                "description": "Add a catalog item to a collection at the given position (default: end). The item does not need to be in the user's list",
                 "consumes": [
                     "application/json"
                 ],
@@ -750,53 +744,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "my-list"
+                    "collections"
                 ],
-                "summary": "Get my list",
+                "summary": "Add item to collection",
                 "parameters": [
                     {
                         "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 20,
-                        "description": "Items per page",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "planned",
-                            "in_progress",
-                            "completed",
-                            "paused",
-                            "dropped"
-                        ],
-                        "type": "string",
-                        "description": "Filter by status",
-                        "name": "status",
-                        "in": "query"
+                        "description": "Collection ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "boolean",
-                        "description": "Filter favorites only",
-                        "name": "favorite",
-                        "in": "query"
+                        "description": "Entry data",
+                        "name": "item",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.AddCollectionItemRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Success - returns user's items",
+                    "201": {
+                        "description": "Item added - returns the collection",
                         "schema": {
-                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.PaginatedResponse"
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.CollectionDTO"
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid parameters",
+                        "description": "Bad request - validation error",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -804,8 +781,17 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "404": {
+                        "description": "Collection or item not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Item already in collection",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -814,9 +800,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Add a catalog item to user's personal tracking list",
+            }
+        },
+        "/collections/{id}/items/{itemId}": {
+            "put": {
+                "description": "Update the note of a collection entry",
                 "consumes": [
                     "application/json"
                 ],
@@ -824,33 +812,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "my-list"
+                    "collections"
                 ],
-                "summary": "Add item to my list",
+                "summary": "Update collection entry",
                 "parameters": [
                     {
-                        "description": "Item to add (item_id required, status optional)",
-                        "name": "item",
+                        "type": "integer",
+                        "description": "Collection ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Catalog item ID",
+                        "name": "itemId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Entry data",
+                        "name": "entry",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "object",
-                            "properties": {
-                                "item_id": {
-                                    "type": "integer"
-                                },
-                                "status": {
-                                    "type": "string"
-                                }
-                            }
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.UpdateCollectionItemRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Item added successfully",
+                    "200": {
+                        "description": "Entry updated - returns the collection",
                         "schema": {
-                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.UserItem"
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.CollectionDTO"
                         }
                     },
                     "400": {
@@ -862,8 +856,8 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "409": {
-                        "description": "Conflict - item already in list",
+                    "404": {
+                        "description": "Collection or entry not found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -872,11 +866,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/my-list/stats": {
-            "get": {
-                "description": "Get statistics about user's tracking list (totals by status, favorites count)",
+            },
+            "delete": {
+                "description": "Remove an entry from a collection (following entries move up)",
                 "consumes": [
                     "application/json"
                 ],
@@ -884,60 +876,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "my-list"
+                    "collections"
                 ],
-                "summary": "Get list statistics",
-                "responses": {
-                    "200": {
-                        "description": "Success - returns statistics",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "integer",
-                                "format": "int64"
-                            }
-                        }
+                "summary": "Remove item from collection",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Collection ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
-                    "500": {
-                        "description": "Internal server error",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    }
-                }
-            }
-        },
-        "/my-list/{id}": {
-            "get": {
-                "description": "Get a specific item from user's list by ID",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "my-list"
-                ],
-                "summary": "Get list item",
-                "parameters": [
                     {
                         "type": "integer",
-                        "description": "User Item ID",
-                        "name": "id",
+                        "description": "Catalog item ID",
+                        "name": "itemId",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Success - returns user item",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.UserItem"
-                        }
+                    "204": {
+                        "description": "Entry removed"
                     },
                     "400": {
                         "description": "Bad request - invalid ID",
@@ -949,7 +909,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Item not found",
+                        "description": "Collection or entry not found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -958,9 +918,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/collections/{id}/reorder": {
             "put": {
-                "description": "Update a user's list item (status, rating, progress, etc)",
+                "description": "Set the full order of a collection. item_ids must contain every item of the collection exactly once",
                 "consumes": [
                     "application/json"
                 ],
@@ -968,36 +930,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "my-list"
+                    "collections"
                 ],
-                "summary": "Update list item",
+                "summary": "Reorder collection",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "User Item ID",
+                        "description": "Collection ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated item data",
-                        "name": "item",
+                        "description": "New order",
+                        "name": "order",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.UserItem"
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ReorderCollectionRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Item updated successfully",
+                        "description": "Collection reordered",
                         "schema": {
-                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.UserItem"
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.CollectionDTO"
                         }
                     },
                     "400": {
-                        "description": "Bad request - validation error",
+                        "description": "Bad request - invalid order",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1006,7 +968,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Item not found",
+                        "description": "Collection not found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1015,9 +977,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Remove an item from user's tracking list",
+            }
+        },
+        "/feed": {
+            "get": {
+                "description": "List events from the lists of followed users (added, started, completed, rated, reviewed), most recent first. Private entries and private profiles are excluded",
                 "consumes": [
                     "application/json"
                 ],
@@ -1025,24 +989,34 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "my-list"
+                    "users"
                 ],
-                "summary": "Remove from list",
+                "summary": "Get activity feed",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "User Item ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "Item removed successfully"
+                    "200": {
+                        "description": "Success - returns feed events",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.PaginatedResponse"
+                        }
                     },
                     "400": {
-                        "description": "Bad request - invalid ID",
+                        "description": "Bad request - invalid parameters",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1050,8 +1024,8 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "404": {
-                        "description": "Item not found",
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1062,31 +1036,52 @@ const docTemplate = `{
                 }
             }
         },
-        "/tags": {
+        "/imports/{id}": {
             "get": {
-                "description": "Get a list of all available tags",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get status, progress and (once finished) the result of a queued CSV import",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "tags"
+                    "items"
+                ],
+                "summary": "Get import job",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Import job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get all tags",
                 "responses": {
                     "200": {
-                        "description": "Success - returns all tags",
+                        "description": "Import job",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.Tag"
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ImportJobDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Import job not found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1096,38 +1091,33 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
-                "description": "Create a new tag for categorizing items",
-                "consumes": [
-                    "application/json"
-                ],
+            "delete": {
+                "description": "Cancel a queued or running import. A running import stops at its next batch; with transaction=row, batches already written are kept",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "tags"
+                    "items"
                 ],
-                "summary": "Create tag",
+                "summary": "Cancel import job",
                 "parameters": [
                     {
-                        "description": "Tag data",
-                        "name": "tag",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_handlers.CreateTagRequest"
-                        }
+                        "type": "integer",
+                        "description": "Import job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Tag created successfully",
+                    "200": {
+                        "description": "Import job canceled",
                         "schema": {
-                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.Tag"
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ImportJobDTO"
                         }
                     },
                     "400": {
-                        "description": "Bad request - validation error",
+                        "description": "Bad request - invalid ID",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1135,8 +1125,26 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Import job not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Import job has already finished",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1147,23 +1155,20 @@ const docTemplate = `{
                 }
             }
         },
-        "/tags/{id}": {
+        "/imports/{id}/errors": {
             "get": {
-                "description": "Get a specific tag by its ID",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Download a CSV (line, title, error) with every failed line of an import, including those beyond the error list in the result",
                 "produces": [
-                    "application/json"
+                    "text/csv"
                 ],
                 "tags": [
-                    "tags"
+                    "items"
                 ],
-                "summary": "Get tag by ID",
+                "summary": "Download import errors",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Tag ID",
+                        "description": "Import job ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1171,9 +1176,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success - returns tag",
+                        "description": "Error report",
                         "schema": {
-                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.Tag"
+                            "type": "file"
                         }
                     },
                     "400": {
@@ -1185,8 +1190,17 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
                     "404": {
-                        "description": "Tag not found",
+                        "description": "Import job not found or without failed lines",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1195,9 +1209,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Update an existing tag's data",
+            }
+        },
+        "/items": {
+            "get": {
+                "description": "Get paginated list of all items from the global catalog",
                 "consumes": [
                     "application/json"
                 ],
@@ -1205,36 +1221,63 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tags"
+                    "items"
                 ],
-                "summary": "Update tag",
+                "summary": "Get all items",
                 "parameters": [
                     {
+                        "minimum": 1,
                         "type": "integer",
-                        "description": "Tag ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
                     },
                     {
-                        "description": "Updated tag data",
-                        "name": "tag",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_handlers.UpdateTagRequest"
-                        }
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "anime",
+                            "movie",
+                            "series",
+                            "game",
+                            "manga",
+                            "light_novel",
+                            "music",
+                            "book"
+                        ],
+                        "type": "string",
+                        "description": "Filter by media type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "score",
+                            "popularity"
+                        ],
+                        "type": "string",
+                        "description": "Sort by community stats",
+                        "name": "sort",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Tag updated successfully",
+                        "description": "Success - returns paginated items",
                         "schema": {
-                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.Tag"
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.PaginatedResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request - validation error",
+                        "description": "Bad request - invalid parameters",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1242,8 +1285,8 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "404": {
-                        "description": "Tag not found",
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1253,8 +1296,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
-                "description": "Delete a tag from the database",
+            "post": {
+                "description": "Create a new item in the global catalog (admin only in the future)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1262,30 +1305,178 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tags"
+                    "items"
                 ],
-                "summary": "Delete tag",
+                "summary": "Create item",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Tag ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Item to create",
+                        "name": "item",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.Item"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Item created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.Item"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/items/export": {
+            "get": {
+                "description": "Stream the catalog (or a single type) with type-specific data, tags and external metadata. The output can be imported unchanged into another instance: a typed CSV has the columns of /items/import/{type}; without type, the CSV has a type column and JSON/NDJSON use the /items/import shape",
+                "produces": [
+                    "text/csv",
+                    "application/json",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "items"
+                ],
+                "summary": "Export catalog",
+                "parameters": [
+                    {
+                        "enum": [
+                            "anime",
+                            "movie",
+                            "series",
+                            "game",
+                            "comic",
+                            "novel",
+                            "book"
+                        ],
+                        "type": "string",
+                        "description": "Media type (default: all types)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "csv",
+                            "json",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "default": "csv",
+                        "description": "File format",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Tag deleted successfully",
+                        "description": "Exported items",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid type or format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
                                 "type": "string"
                             }
                         }
+                    }
+                }
+            }
+        },
+        "/items/import": {
+            "post": {
+                "description": "Import items of any type from a CSV with a type column, a JSON array or NDJSON (one item per line). JSON items use the item payload shape: type, title, description, release_date (YYYY-MM-DD), cover_url, tags (names), external_metadata (object) and specific_data (the type-specific CSV columns, e.g. episodes, studio). Every format goes through the same validation as the per-type CSV imports. The file is stored and processed in the background",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "items"
+                ],
+                "summary": "Import items of mixed types",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "CSV (with a type column), JSON array or NDJSON file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "csv",
+                            "json",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "File format (default: from the file extension .csv, .json, .ndjson or .jsonl)",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "create",
+                            "upsert"
+                        ],
+                        "type": "string",
+                        "default": "create",
+                        "description": "Import mode: create (existing titles fail) or upsert (update items matched by external IDs, then by title and release year)",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "row",
+                            "file"
+                        ],
+                        "type": "string",
+                        "default": "row",
+                        "description": "Transaction mode: row (failed lines are skipped) or file (any failure rolls back the whole file)",
+                        "name": "transaction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate and report what would be created or updated without writing anything",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Import queued - poll /imports/{id} for progress and result",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ImportJobDTO"
+                        }
                     },
                     "400": {
-                        "description": "Bad request - invalid ID",
+                        "description": "Bad request - missing file, unknown format or invalid headers",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1293,144 +1484,6132 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "404": {
-                        "description": "Tag not found",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/items/import/anime": {
+            "post": {
+                "description": "Import multiple anime items from CSV file. The file is stored and processed in the background",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "items"
+                ],
+                "summary": "Import anime items",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "CSV file with anime data",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "create",
+                            "upsert"
+                        ],
+                        "type": "string",
+                        "default": "create",
+                        "description": "Import mode: create (existing titles fail) or upsert (update items matched by external IDs, then by title and release year)",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "row",
+                            "file"
+                        ],
+                        "type": "string",
+                        "default": "row",
+                        "description": "Transaction mode: row (failed lines are skipped) or file (any failure rolls back the whole file)",
+                        "name": "transaction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate and report what would be created or updated without writing anything",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Import queued - poll /imports/{id} for progress and result",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ImportJobDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - missing file or invalid headers",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
                                 "type": "string"
                             }
                         }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/items/import/book": {
+            "post": {
+                "description": "Import multiple book items from CSV file. The file is stored and processed in the background",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "items"
+                ],
+                "summary": "Import book items",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "CSV file with book data",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "create",
+                            "upsert"
+                        ],
+                        "type": "string",
+                        "default": "create",
+                        "description": "Import mode: create (existing titles fail) or upsert (update items matched by external IDs, then by title and release year)",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "row",
+                            "file"
+                        ],
+                        "type": "string",
+                        "default": "row",
+                        "description": "Transaction mode: row (failed lines are skipped) or file (any failure rolls back the whole file)",
+                        "name": "transaction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate and report what would be created or updated without writing anything",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Import queued - poll /imports/{id} for progress and result",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ImportJobDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - missing file or invalid headers",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/items/import/comic": {
+            "post": {
+                "description": "Import multiple comic items from CSV file. The file is stored and processed in the background",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "items"
+                ],
+                "summary": "Import comic items",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "CSV file with comic data",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "create",
+                            "upsert"
+                        ],
+                        "type": "string",
+                        "default": "create",
+                        "description": "Import mode: create (existing titles fail) or upsert (update items matched by external IDs, then by title and release year)",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "row",
+                            "file"
+                        ],
+                        "type": "string",
+                        "default": "row",
+                        "description": "Transaction mode: row (failed lines are skipped) or file (any failure rolls back the whole file)",
+                        "name": "transaction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate and report what would be created or updated without writing anything",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Import queued - poll /imports/{id} for progress and result",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ImportJobDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - missing file or invalid headers",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/items/import/game": {
+            "post": {
+                "description": "Import multiple game items from CSV file. The file is stored and processed in the background",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "items"
+                ],
+                "summary": "Import game items",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "CSV file with game data",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "create",
+                            "upsert"
+                        ],
+                        "type": "string",
+                        "default": "create",
+                        "description": "Import mode: create (existing titles fail) or upsert (update items matched by external IDs, then by title and release year)",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "row",
+                            "file"
+                        ],
+                        "type": "string",
+                        "default": "row",
+                        "description": "Transaction mode: row (failed lines are skipped) or file (any failure rolls back the whole file)",
+                        "name": "transaction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate and report what would be created or updated without writing anything",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Import queued - poll /imports/{id} for progress and result",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ImportJobDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - missing file or invalid headers",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/items/import/movie": {
+            "post": {
+                "description": "Import multiple movie items from CSV file. The file is stored and processed in the background",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "items"
+                ],
+                "summary": "Import movie items",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "CSV file with movie data",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "create",
+                            "upsert"
+                        ],
+                        "type": "string",
+                        "default": "create",
+                        "description": "Import mode: create (existing titles fail) or upsert (update items matched by external IDs, then by title and release year)",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "row",
+                            "file"
+                        ],
+                        "type": "string",
+                        "default": "row",
+                        "description": "Transaction mode: row (failed lines are skipped) or file (any failure rolls back the whole file)",
+                        "name": "transaction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate and report what would be created or updated without writing anything",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Import queued - poll /imports/{id} for progress and result",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ImportJobDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - missing file or invalid headers",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/items/import/novel": {
+            "post": {
+                "description": "Import multiple novel items from CSV file. The file is stored and processed in the background",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "items"
+                ],
+                "summary": "Import novel items",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "CSV file with novel data",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "create",
+                            "upsert"
+                        ],
+                        "type": "string",
+                        "default": "create",
+                        "description": "Import mode: create (existing titles fail) or upsert (update items matched by external IDs, then by title and release year)",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "row",
+                            "file"
+                        ],
+                        "type": "string",
+                        "default": "row",
+                        "description": "Transaction mode: row (failed lines are skipped) or file (any failure rolls back the whole file)",
+                        "name": "transaction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate and report what would be created or updated without writing anything",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Import queued - poll /imports/{id} for progress and result",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ImportJobDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - missing file or invalid headers",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/items/import/series": {
+            "post": {
+                "description": "Import multiple series items from CSV file. The file is stored and processed in the background",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "items"
+                ],
+                "summary": "Import series items",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "CSV file with series data",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "create",
+                            "upsert"
+                        ],
+                        "type": "string",
+                        "default": "create",
+                        "description": "Import mode: create (existing titles fail) or upsert (update items matched by external IDs, then by title and release year)",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "row",
+                            "file"
+                        ],
+                        "type": "string",
+                        "default": "row",
+                        "description": "Transaction mode: row (failed lines are skipped) or file (any failure rolls back the whole file)",
+                        "name": "transaction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate and report what would be created or updated without writing anything",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Import queued - poll /imports/{id} for progress and result",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ImportJobDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - missing file or invalid headers",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/items/rankings": {
+            "get": {
+                "description": "Rank items by Bayesian-weighted score or popularity (members), optionally by media type and release season (e.g. top anime, most popular games this season)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "items"
+                ],
+                "summary": "Get item rankings",
+                "parameters": [
+                    {
+                        "enum": [
+                            "anime",
+                            "movie",
+                            "series",
+                            "game",
+                            "manga",
+                            "light_novel",
+                            "music",
+                            "book"
+                        ],
+                        "type": "string",
+                        "description": "Filter by media type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "score",
+                            "popularity"
+                        ],
+                        "type": "string",
+                        "default": "score",
+                        "description": "Ranking criteria",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "winter",
+                            "spring",
+                            "summer",
+                            "fall",
+                            "current"
+                        ],
+                        "type": "string",
+                        "description": "Release season (quarter)",
+                        "name": "season",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Season year (defaults to current year)",
+                        "name": "year",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success - returns ranked items",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.RankedItemDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parameters",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/items/search": {
+            "get": {
+                "description": "Search items by title (case-insensitive) with pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "items"
+                ],
+                "summary": "Search items",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success - returns matching items",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.PaginatedResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/items/{id}": {
+            "get": {
+                "description": "Get a specific item from the catalog by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "items"
+                ],
+                "summary": "Get item by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success - returns item",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.Item"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Item not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an existing item in the catalog (admin only in the future)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "items"
+                ],
+                "summary": "Update item",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Item data to update",
+                        "name": "item",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.Item"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Item updated successfully",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Item not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete an item from the catalog (admin only in the future)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "items"
+                ],
+                "summary": "Delete item",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Item deleted successfully"
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Item not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/items/{id}/enrich": {
+            "post": {
+                "description": "Fetch the item from external providers (AniList/MAL for anime, comics and novels; TMDB for movies and series; IGDB for games; Open Library for books and novels) using the IDs in external_metadata (anilist, mal, tmdb, imdb, igdb, steam, isbn, openlibrary) or, without them, the title and release year.\nSynopsis, release date, cover, tags, external IDs and type-specific fields are merged into the item. By default only empty fields are filled; overwrite=true replaces existing values (including the title). Tags are always added, never removed",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "items"
+                ],
+                "summary": "Enrich item from external metadata",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "anilist",
+                            "tmdb",
+                            "igdb",
+                            "openlibrary"
+                        ],
+                        "type": "string",
+                        "description": "Only query this provider (default: every provider for the item type, in order)",
+                        "name": "provider",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Replace fields that already have a value",
+                        "name": "overwrite",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Item enriched - lists the updated fields",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.EnrichItemResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID, unknown provider or unsupported media type",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Item not found or no provider found metadata for it",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "502": {
+                        "description": "Metadata provider request failed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/items/{id}/reviews": {
+            "get": {
+                "description": "List public reviews of a catalog item with sorting, filters and pagination. When authenticated, voted_helpful tells whether the user voted on each review",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reviews"
+                ],
+                "summary": "List item reviews",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "helpful",
+                            "recent",
+                            "rating"
+                        ],
+                        "type": "string",
+                        "description": "Sort order (default: helpful)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only reviews that recommend (true) or not (false)",
+                        "name": "recommended",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Exclude reviews flagged as spoilers",
+                        "name": "hide_spoilers",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page (default: 20, max: 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success - returns reviews",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ReviewDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parameters",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Item not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Publish a review of a catalog item (one per user and item). The rating is a snapshot of the item's rating in the user's list; it is shown as 0 while the author hides ratings or keeps the list entry private. Private list notes are not affected",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reviews"
+                ],
+                "summary": "Write a review",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Review data",
+                        "name": "review",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ReviewRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Review created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ReviewDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Item not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Item already reviewed by the user",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/items/{id}/similar": {
+            "get": {
+                "description": "Rank other catalog items by tag overlap (IDF-weighted Jaccard over item tags), same creator (studio, director, developer or author) and co-occurrence in user lists. Results are cached and invalidated when tags change",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "items"
+                ],
+                "summary": "Get similar items",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only items of the same media type (default: cross-media)",
+                        "name": "same_type",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "anime",
+                            "movie",
+                            "series",
+                            "game",
+                            "manga",
+                            "light_novel",
+                            "music",
+                            "book"
+                        ],
+                        "type": "string",
+                        "description": "Only items of this media type (ignored with same_type)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 50,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of similar items",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success - returns similar items",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.SimilarItemDTO"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parameters",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Item not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me/activity": {
+            "get": {
+                "description": "Daily activity counts (GitHub-style heatmap) from list status transitions and progress updates, plus current and longest streak. Defaults to the last 365 days",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "me"
+                ],
+                "summary": "Get activity heatmap",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date, inclusive (YYYY-MM-DD, default: today)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success - returns activity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ActivityDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid date range",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me/follow-requests": {
+            "get": {
+                "description": "List the users waiting for approval to follow the authenticated user, most recent first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get follow requests",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success - returns the requesting users",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.PaginatedResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parameters",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me/follow-requests/{username}": {
+            "delete": {
+                "description": "Reject a pending follow request. The requester can ask again later",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Reject follow request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username of the requester",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Follow request rejected"
+                    },
+                    "404": {
+                        "description": "User or follow request not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me/follow-requests/{username}/accept": {
+            "post": {
+                "description": "Approve a pending follow request. The requester becomes a follower and can see a followers-only profile and its activity in their feed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Accept follow request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username of the requester",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Follow request accepted"
+                    },
+                    "404": {
+                        "description": "User or follow request not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me/goals": {
+            "get": {
+                "description": "List user's goals with progress computed from list activity",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "me"
+                ],
+                "summary": "List goals",
+                "responses": {
+                    "200": {
+                        "description": "Success - returns goals",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.GoalDTO"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a goal (e.g. read 24 books in 2026). period=year uses year, period=month uses year+month, period=custom uses starts_at/ends_at (inclusive)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "me"
+                ],
+                "summary": "Create goal",
+                "parameters": [
+                    {
+                        "description": "Goal data",
+                        "name": "goal",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.GoalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Goal created with current progress",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.GoalDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me/goals/{id}": {
+            "get": {
+                "description": "Get a specific goal with its progress",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "me"
+                ],
+                "summary": "Get goal",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Goal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success - returns goal",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.GoalDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Goal not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replace a goal's target, period and filters",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "me"
+                ],
+                "summary": "Update goal",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Goal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Goal data",
+                        "name": "goal",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.GoalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Goal updated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.GoalDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Goal not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete one of the user's goals",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "me"
+                ],
+                "summary": "Delete goal",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Goal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Goal deleted"
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Goal not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me/privacy": {
+            "get": {
+                "description": "Get who can see the user's profile, list and activity, which statuses are hidden from the public list and whether ratings and notes are hidden",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get privacy settings",
+                "responses": {
+                    "200": {
+                        "description": "Success - returns privacy settings",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.PrivacySettingsDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replace the privacy settings: profile visibility (public, followers or private; switching to public accepts pending follow requests), statuses hidden from the public list and feed, and whether ratings and notes are hidden. Individual list entries can also be marked private via PUT /my-list/{id}. Settings are enforced on every public and social endpoint",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Update privacy settings",
+                "parameters": [
+                    {
+                        "description": "Privacy settings",
+                        "name": "settings",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.UpdatePrivacySettingsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Privacy settings updated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.PrivacySettingsDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me/tags": {
+            "get": {
+                "description": "List the user's private labels with how many list entries use each one",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "personal-tags"
+                ],
+                "summary": "List personal tags",
+                "responses": {
+                    "200": {
+                        "description": "Success - returns personal tags",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.PersonalTag"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a private label for entries of the user's list (does not affect catalog tags)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "personal-tags"
+                ],
+                "summary": "Create personal tag",
+                "parameters": [
+                    {
+                        "description": "Personal tag data",
+                        "name": "tag",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.PersonalTagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Personal tag created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.PersonalTag"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Personal tag with this name already exists",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me/tags/{id}": {
+            "put": {
+                "description": "Rename or recolor one of the user's private labels",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "personal-tags"
+                ],
+                "summary": "Update personal tag",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Personal tag ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Personal tag data",
+                        "name": "tag",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.PersonalTagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Personal tag updated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.PersonalTag"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Personal tag not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Personal tag with this name already exists",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete one of the user's private labels and remove it from all list entries",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "personal-tags"
+                ],
+                "summary": "Delete personal tag",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Personal tag ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Personal tag deleted"
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Personal tag not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/my-list": {
+            "get": {
+                "description": "Get user's personal tracking list with combined filters, title search, sorting and pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "my-list"
+                ],
+                "summary": "Get my list",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "in_progress,paused",
+                        "description": "Filter by status (comma-separated)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "anime,comic",
+                        "description": "Filter by media type (comma-separated)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by tag names or aliases (comma-separated, any match, includes descendant tags)",
+                        "name": "tags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by personal tag names (comma-separated, any match)",
+                        "name": "personal_tags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum rating (0-10)",
+                        "name": "min_rating",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum rating (0-10)",
+                        "name": "max_rating",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter by favorite flag",
+                        "name": "favorite",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter by rewatching/rereading",
+                        "name": "rewatching",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only entries updated since (YYYY-MM-DD or RFC3339)",
+                        "name": "updated_since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search by title within the list",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "rating",
+                            "updated_at",
+                            "added",
+                            "title",
+                            "progress"
+                        ],
+                        "type": "string",
+                        "default": "updated_at",
+                        "description": "Sort field",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success - returns user's items",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.PaginatedResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parameters",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Add a catalog item to user's personal tracking list",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "my-list"
+                ],
+                "summary": "Add item to my list",
+                "parameters": [
+                    {
+                        "description": "Item to add (item_id required, status optional)",
+                        "name": "item",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "item_id": {
+                                    "type": "integer"
+                                },
+                                "status": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Item added successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.UserItem"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - item already in list",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/my-list/bulk": {
+            "post": {
+                "description": "Run a batch of operations (add, update_status, set_favorite, delete, set_personal_tags) on user's list in a single transaction",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "my-list"
+                ],
+                "summary": "Bulk list operations",
+                "parameters": [
+                    {
+                        "description": "Batch of operations",
+                        "name": "operations",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.BulkOperationsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Batch processed - returns per-operation results",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.BulkResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/my-list/export": {
+            "get": {
+                "description": "Stream every list entry with status, rating, progress data, view history, personal tags and notes.\njson and csv are portable and complete. mal-xml is the MyAnimeList import format (anime and manga with a MAL ID in external_metadata; use type=anime or type=comic for MAL's separate anime and manga imports).\nanilist is an AniList MediaListCollection (anime and manga). Both can be imported back with /my-list/import/{source}",
+                "produces": [
+                    "application/json",
+                    "text/csv",
+                    "application/xml"
+                ],
+                "tags": [
+                    "my-list"
+                ],
+                "summary": "Export my list",
+                "parameters": [
+                    {
+                        "enum": [
+                            "anime",
+                            "movie",
+                            "series",
+                            "game",
+                            "comic",
+                            "novel",
+                            "book"
+                        ],
+                        "type": "string",
+                        "description": "Media type (default: all types)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv",
+                            "mal-xml",
+                            "anilist"
+                        ],
+                        "type": "string",
+                        "default": "json",
+                        "description": "File format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Exported list",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid type or format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/my-list/import/{source}": {
+            "post": {
+                "description": "Import a personal list export: MyAnimeList XML (mal), AniList JSON (anilist), Letterboxd CSV (letterboxd), Goodreads CSV (goodreads) or Steam library JSON (steam).\nEntries are matched to catalog items by external IDs (external_metadata), then by title and release year, and added with status, rating (0-10), progress and dates.\nItems already in the list are skipped. Unmatched entries are kept for review at /my-list/imports/{id}/entries?status=unmatched",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "my-list"
+                ],
+                "summary": "Import my list from another service",
+                "parameters": [
+                    {
+                        "enum": [
+                            "mal",
+                            "anilist",
+                            "letterboxd",
+                            "goodreads",
+                            "steam"
+                        ],
+                        "type": "string",
+                        "description": "Export source",
+                        "name": "source",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Exported list file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "List imported - totals per entry status",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ListImportDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid source, missing or unreadable file",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/my-list/imports": {
+            "get": {
+                "description": "List the authenticated user's list imports (newest first) with totals per entry status",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "my-list"
+                ],
+                "summary": "List my list imports",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page (default: 20, max: 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success - returns list imports",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ListImportDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/my-list/imports/{id}": {
+            "get": {
+                "description": "Get a list import with totals per entry status",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "my-list"
+                ],
+                "summary": "Get list import",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "List import ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List import",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ListImportDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "List import not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/my-list/imports/{id}/entries": {
+            "get": {
+                "description": "List the entries of a list import in file order. Use status=unmatched for the entries waiting for review",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "my-list"
+                ],
+                "summary": "List list import entries",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "List import ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "imported",
+                            "skipped",
+                            "unmatched",
+                            "resolved",
+                            "dismissed",
+                            "failed"
+                        ],
+                        "type": "string",
+                        "description": "Filter by entry status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page (default: 20, max: 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success - returns entries",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.ListImportEntry"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parameters",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "List import not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/my-list/imports/{id}/entries/{entryId}/dismiss": {
+            "post": {
+                "description": "Remove an unmatched entry from review without adding it to the list",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "my-list"
+                ],
+                "summary": "Dismiss unmatched entry",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "List import ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Entry ID",
+                        "name": "entryId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Entry dismissed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.ListImportEntry"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "List import or entry not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Entry already reviewed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/my-list/imports/{id}/entries/{entryId}/resolve": {
+            "post": {
+                "description": "Add an unmatched entry to the list using the catalog item chosen by the user (status, rating, progress and dates come from the export)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "my-list"
+                ],
+                "summary": "Resolve unmatched entry",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "List import ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Entry ID",
+                        "name": "entryId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Catalog item",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ResolveListImportEntryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Entry resolved",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.ListImportEntry"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "List import, entry or item not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Entry already reviewed or item already in list",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/my-list/recap": {
+            "get": {
+                "description": "Year-in-review computed from view history: completions, top-rated items and tags, time per media type, longest activity streak, most rewatched item and first/last completion. Use format=svg or format=png for a shareable card (png for apps that do not display SVG)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "image/svg+xml",
+                    "image/png"
+                ],
+                "tags": [
+                    "my-list"
+                ],
+                "summary": "Get yearly recap",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Year (default: current year)",
+                        "name": "year",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Response format: json (default), svg or png",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success - returns the recap",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.RecapDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid year or format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/my-list/recommendations": {
+            "get": {
+                "description": "Suggest catalog items the user hasn't added, blending content similarity (shared tags, creator, media type) with item-item collaborative filtering over all users' ratings and statuses. Each suggestion explains which list entries it came from. Users without history get community favorites",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "my-list"
+                ],
+                "summary": "Get recommendations",
+                "parameters": [
+                    {
+                        "enum": [
+                            "anime",
+                            "movie",
+                            "series",
+                            "game",
+                            "manga",
+                            "light_novel",
+                            "music",
+                            "book"
+                        ],
+                        "type": "string",
+                        "description": "Filter by media type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 50,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Number of recommendations",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success - returns recommendations",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.RecommendationDTO"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parameters",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/my-list/stats": {
+            "get": {
+                "description": "Get statistics about user's tracking list: status totals, per-media-type status matrix, rating distribution, estimated time spent and completions per month/year",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "my-list"
+                ],
+                "summary": "Get list statistics",
+                "responses": {
+                    "200": {
+                        "description": "Success - returns statistics",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.UserListStatsDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/my-list/{id}": {
+            "get": {
+                "description": "Get a specific item from user's list by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "my-list"
+                ],
+                "summary": "Get list item",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success - returns user item",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.UserItem"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Item not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a user's list item (status, rating, progress, etc)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "my-list"
+                ],
+                "summary": "Update list item",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated item data",
+                        "name": "item",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.UserItem"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Item updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.UserItem"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Item not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove an item from user's tracking list",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "my-list"
+                ],
+                "summary": "Remove from list",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Item removed successfully"
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Item not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/my-list/{id}/tags": {
+            "put": {
+                "description": "Replace the personal tags of an entry in the user's list (empty tag_ids removes all)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "my-list"
+                ],
+                "summary": "Set personal tags of a list entry",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Personal tag IDs",
+                        "name": "tags",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.SetPersonalTagsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success - returns the updated entry",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.UserItem"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "User item or personal tag not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/my-list/{id}/tags/{tagId}": {
+            "delete": {
+                "description": "Remove one personal tag from an entry in the user's list",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "my-list"
+                ],
+                "summary": "Remove personal tag from a list entry",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User Item ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Personal tag ID",
+                        "name": "tagId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Personal tag removed"
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "User item or personal tag not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/reviews/{id}": {
+            "get": {
+                "description": "Get a single review",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reviews"
+                ],
+                "summary": "Get review",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Review ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success - returns review",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ReviewDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Review not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Edit the authenticated user's review. The previous version is kept in the edit history and the rating snapshot is refreshed from the list",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reviews"
+                ],
+                "summary": "Edit review",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Review ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Review data",
+                        "name": "review",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ReviewRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Review updated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ReviewDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Not the author",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Review not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete the authenticated user's review",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reviews"
+                ],
+                "summary": "Delete review",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Review ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Review deleted"
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Not the author",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Review not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/reviews/{id}/helpful": {
+            "post": {
+                "description": "Mark another user's review as helpful (voting again has no effect)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reviews"
+                ],
+                "summary": "Vote review as helpful",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Review ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated review",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ReviewDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - own review",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Review not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove the authenticated user's helpful vote from a review",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reviews"
+                ],
+                "summary": "Remove helpful vote",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Review ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated review",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ReviewDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Review not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/reviews/{id}/history": {
+            "get": {
+                "description": "List the previous versions of a review, most recent first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reviews"
+                ],
+                "summary": "Review edit history",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Review ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success - returns previous versions",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ReviewRevisionDTO"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Review not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tags": {
+            "get": {
+                "description": "Paginated list of tags with item counts (total and per media type). Supports search by name/alias, category filter and sorting by name, usage or recency",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "List tags",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search in tag names and aliases",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "genre",
+                            "theme",
+                            "demographic",
+                            "setting",
+                            "content_warning"
+                        ],
+                        "type": "string",
+                        "description": "Filter by category",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "name",
+                            "usage",
+                            "recent"
+                        ],
+                        "type": "string",
+                        "description": "Sort order (default: name)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page (default: 20, max: 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success - returns tags",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.TagUsageDTO"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parameters",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new tag for categorizing items",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Create tag",
+                "parameters": [
+                    {
+                        "description": "Tag data",
+                        "name": "tag",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.CreateTagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Tag created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.Tag"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/autocomplete": {
+            "get": {
+                "description": "Suggest tags whose name or alias starts with the given prefix, most used first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Autocomplete tags",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Prefix",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Max suggestions (default: 10, max: 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success - returns suggestions",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.TagSuggestionDTO"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid limit",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/cleanup": {
+            "post": {
+                "description": "Permanently delete tags not used by any item (tags with child tags are kept). Use dry_run=true to only list them",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Clean up orphan tags",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Only list the tags that would be deleted",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Cleanup result",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.TagCleanupResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid dry_run",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/{id}": {
+            "get": {
+                "description": "Get a specific tag by its ID, including its children and aliases",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Get tag by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Tag ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success - returns tag",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.Tag"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an existing tag's data",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Update tag",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Tag ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated tag data",
+                        "name": "tag",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.UpdateTagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tag updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.Tag"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a tag from the database",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Delete tag",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Tag ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tag deleted successfully",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/{id}/aliases": {
+            "post": {
+                "description": "Add an alternative name that resolves to this tag (e.g. \"scifi\" for \"sci-fi\"). Imports and filters use the canonical tag",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Add tag alias",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Tag ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Alias data",
+                        "name": "alias",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.AddTagAliasRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Alias created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.TagAlias"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Name already used by a tag or alias",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/{id}/aliases/{aliasId}": {
+            "delete": {
+                "description": "Remove an alternative name from a tag",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Remove tag alias",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Tag ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Alias ID",
+                        "name": "aliasId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Alias removed"
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Alias not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/{id}/items": {
+            "get": {
+                "description": "Paginated list of catalog items with this tag or any of its descendant tags",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "List items of a tag",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Tag ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "anime",
+                            "movie",
+                            "series",
+                            "game",
+                            "manga",
+                            "light_novel",
+                            "music",
+                            "book"
+                        ],
+                        "type": "string",
+                        "description": "Filter by media type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number (default: 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page (default: 20, max: 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success - returns items",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.PaginatedResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.Item"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parameters",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/{id}/merge": {
+            "post": {
+                "description": "Merge the source tags into this tag: item associations, children and aliases move to it, source names become aliases and the source tags are removed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Merge tags",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Target tag ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Source tag IDs",
+                        "name": "merge",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.MergeTagsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Merged tag",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.Tag"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - validation error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{username}": {
+            "get": {
+                "description": "Get a user's profile with follower counts and statistics over their public list entries. Profiles not visible to the caller (private, or followers-only when not following) return only basic data with restricted=true",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get user profile",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success - returns the profile",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ProfileDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{username}/follow": {
+            "post": {
+                "description": "Follow a user. Public profiles are followed immediately (status accepted); followers-only and private profiles get a follow request (status pending) that only counts once the user approves it via /me/follow-requests. Following again has no effect and returns the current status",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Follow user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Following or follow request sent",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.FollowResultDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Cannot follow yourself",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Stop following a user, or cancel a pending follow request",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Unfollow user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Unfollowed"
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{username}/followers": {
+            "get": {
+                "description": "List the users following a user, most recent first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get followers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success - returns followers",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.PaginatedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Profile not visible",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{username}/following": {
+            "get": {
+                "description": "List the users a user follows, most recent first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get following",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success - returns followed users",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.PaginatedResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Profile not visible",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{username}/list": {
+            "get": {
+                "description": "Get the entries of a user's list that the owner allows others to see, with filters, title search, sorting and pagination. Private entries and hidden statuses are never included; ratings and notes are omitted when the owner hides them (rating filters and sorting are then ignored)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get user list",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "in_progress,paused",
+                        "description": "Filter by status (comma-separated)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "anime,comic",
+                        "description": "Filter by media type (comma-separated)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by tag names or aliases (comma-separated, any match, includes descendant tags)",
+                        "name": "tags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum rating (0-10)",
+                        "name": "min_rating",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum rating (0-10)",
+                        "name": "max_rating",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter by favorite flag",
+                        "name": "favorite",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search by title within the list",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "rating",
+                            "updated_at",
+                            "added",
+                            "title",
+                            "progress"
+                        ],
+                        "type": "string",
+                        "default": "updated_at",
+                        "description": "Sort field",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success - returns the user's public entries",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.PaginatedResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid parameters",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Profile not visible",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "github_com_rafaelc-rb_geekery-api_internal_dto.AccountErasureResult": {
+            "type": "object",
+            "properties": {
+                "deleted": {
+                    "description": "Linhas removidas por tabela",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer",
+                        "format": "int64"
+                    }
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.ActivityDTO": {
+            "type": "object",
+            "properties": {
+                "active_days": {
+                    "type": "integer"
+                },
+                "current_streak": {
+                    "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.StreakDTO"
+                },
+                "days": {
+                    "description": "Um registro por dia do período (dias sem atividade com count 0)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.DayCount"
+                    }
+                },
+                "from": {
+                    "description": "YYYY-MM-DD",
+                    "type": "string"
+                },
+                "longest_streak": {
+                    "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.StreakDTO"
+                },
+                "to": {
+                    "description": "YYYY-MM-DD (inclusivo)",
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.AddCollectionItemRequest": {
+            "type": "object",
+            "required": [
+                "item_id"
+            ],
+            "properties": {
+                "item_id": {
+                    "type": "integer"
+                },
+                "note": {
+                    "type": "string",
+                    "maxLength": 2000
+                },
+                "position": {
+                    "description": "Opcional: padrão é o final da coleção",
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.AuthResponse": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.UserInfo"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.BulkOperation": {
+            "type": "object",
+            "required": [
+                "op"
+            ],
+            "properties": {
+                "favorite": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "item_id": {
+                    "type": "integer"
+                },
+                "op": {
+                    "type": "string",
+                    "enum": [
+                        "add",
+                        "update_status",
+                        "set_favorite",
+                        "delete",
+                        "set_personal_tags"
+                    ]
+                },
+                "personal_tag_ids": {
+                    "description": "set_personal_tags: rótulos pessoais (vazio remove todos)",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.BulkOperationResult": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "index": {
+                    "type": "integer"
+                },
+                "item_id": {
+                    "type": "integer"
+                },
+                "op": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "user_item_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.BulkOperationsRequest": {
+            "type": "object",
+            "required": [
+                "operations"
+            ],
+            "properties": {
+                "all_or_nothing": {
+                    "description": "Se true, qualquer falha desfaz o lote inteiro",
+                    "type": "boolean"
+                },
+                "operations": {
+                    "type": "array",
+                    "maxItems": 500,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.BulkOperation"
+                    }
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.BulkResult": {
+            "type": "object",
+            "properties": {
+                "all_or_nothing": {
+                    "type": "boolean"
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.BulkOperationResult"
+                    }
+                },
+                "rolled_back": {
+                    "type": "boolean"
+                },
+                "succeeded": {
+                    "type": "integer"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.CollectionDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "item_count": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.CollectionItemDTO"
+                    }
+                },
+                "share_url": {
+                    "description": "Apenas para o dono",
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                },
+                "visibility": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.CollectionItemDTO": {
+            "type": "object",
+            "properties": {
+                "added_at": {
+                    "type": "string"
+                },
+                "item": {
+                    "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ItemDTO"
+                },
+                "item_id": {
+                    "type": "integer"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.CollectionRequest": {
+            "type": "object",
+            "required": [
+                "title"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 5000
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "minLength": 1
+                },
+                "visibility": {
+                    "description": "Padrão: private",
+                    "type": "string",
+                    "enum": [
+                        "public",
+                        "unlisted",
+                        "private"
+                    ]
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.CompletionStatsDTO": {
+            "type": "object",
+            "properties": {
+                "by_month": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.PeriodCount"
+                    }
+                },
+                "by_year": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.PeriodCount"
+                    }
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.DataExportDTO": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "download_url": {
+                    "description": "Link assinado, presente enquanto o ZIP estiver disponível",
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "finished_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "size": {
+                    "description": "Tamanho do ZIP em bytes",
+                    "type": "integer"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "queued, running, completed, failed ou expired",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.DayCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "date": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.DeleteAccountRequest": {
+            "type": "object",
+            "required": [
+                "password"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.EnrichItemResult": {
+            "type": "object",
+            "properties": {
+                "external_id": {
+                    "description": "ID do item no provider",
+                    "type": "string"
+                },
+                "item": {
+                    "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ItemDTO"
+                },
+                "provider": {
+                    "description": "Provider que encontrou o item",
+                    "type": "string"
+                },
+                "updated": {
+                    "description": "Campos alterados; vazio quando o item já estava completo",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.FollowResultDTO": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "description": "accepted ou pending (aguardando aprovação)",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.GoalDTO": {
+            "type": "object",
+            "properties": {
+                "achieved": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "current": {
+                    "type": "number"
+                },
+                "ends_at": {
+                    "type": "string"
+                },
+                "expected": {
+                    "description": "Progresso esperado até agora num ritmo linear",
+                    "type": "number"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "media_type": {
+                    "type": "string"
+                },
+                "metric": {
+                    "type": "string"
+                },
+                "percent": {
+                    "description": "Limitado a 100",
+                    "type": "number"
+                },
+                "period": {
+                    "type": "string"
+                },
+                "starts_at": {
+                    "type": "string"
+                },
+                "target": {
+                    "type": "number"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.GoalRequest": {
+            "type": "object",
+            "required": [
+                "metric",
+                "period",
+                "target"
+            ],
+            "properties": {
+                "ends_at": {
+                    "type": "string"
+                },
+                "media_type": {
+                    "description": "Opcional: vazio = todos os tipos",
+                    "type": "string"
+                },
+                "metric": {
+                    "type": "string",
+                    "enum": [
+                        "completions",
+                        "episodes",
+                        "chapters",
+                        "pages",
+                        "minutes",
+                        "hours"
+                    ]
+                },
+                "month": {
+                    "type": "integer",
+                    "maximum": 12,
+                    "minimum": 1
+                },
+                "period": {
+                    "type": "string",
+                    "enum": [
+                        "year",
+                        "month",
+                        "custom"
+                    ]
+                },
+                "starts_at": {
+                    "type": "string"
+                },
+                "target": {
+                    "type": "number"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 200
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.ImportError": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "line": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.ImportJobDTO": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "error": {
+                    "description": "Erro que interrompeu o job",
+                    "type": "string"
+                },
+                "errors_url": {
+                    "description": "CSV com todas as linhas que falharam",
+                    "type": "string"
+                },
+                "file_name": {
+                    "type": "string"
+                },
+                "finished_at": {
+                    "type": "string"
+                },
+                "format": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "media_type": {
+                    "description": "Ausente em imports com tipos mistos",
+                    "type": "string"
+                },
+                "mode": {
+                    "type": "string"
+                },
+                "progress": {
+                    "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ImportProgress"
+                },
+                "result": {
+                    "description": "Presente quando o import termina",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ImportResult"
+                        }
+                    ]
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "queued, running, completed, failed ou canceled",
+                    "type": "string"
+                },
+                "transaction": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.ImportProgress": {
+            "type": "object",
+            "properties": {
+                "failed": {
+                    "type": "integer"
+                },
+                "imported": {
+                    "type": "integer"
+                },
+                "lines": {
+                    "description": "Linhas de dados lidas até agora",
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.ImportResult": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer"
+                },
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "errors": {
+                    "description": "Limitado aos primeiros erros; ver ErrorsTruncated",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ImportError"
+                    }
+                },
+                "errors_truncated": {
+                    "type": "boolean"
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "format": {
+                    "type": "string"
+                },
+                "imported": {
+                    "description": "Linhas processadas com sucesso (created + updated + unchanged)",
+                    "type": "integer"
+                },
+                "media_type": {
+                    "description": "Ausente em imports com tipos mistos",
+                    "type": "string"
+                },
+                "mode": {
+                    "type": "string"
+                },
+                "resumed_from_line": {
+                    "description": "ResumedFromLine indica que o import foi retomado após essa linha; created, updated, unchanged,\nrows e errors cobrem apenas as linhas seguintes (total_lines, imported e failed cobrem o arquivo todo)",
+                    "type": "integer"
+                },
+                "rolled_back": {
+                    "description": "true quando nenhuma linha foi (ou seria) gravada por causa de falhas no modo file",
+                    "type": "boolean"
+                },
+                "rows": {
+                    "description": "Limitado às primeiras linhas; ver RowsTruncated",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ImportRowResult"
+                    }
+                },
+                "rows_truncated": {
+                    "type": "boolean"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "total_lines": {
+                    "type": "integer"
+                },
+                "transaction": {
+                    "type": "string"
+                },
+                "unchanged": {
+                    "type": "integer"
+                },
+                "updated": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.ImportRowResult": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "item_id": {
+                    "description": "Ausente para items criados no dry run ou em rollback",
+                    "type": "integer"
+                },
+                "line": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.ItemDTO": {
+            "type": "object",
+            "properties": {
+                "cover_url": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "external_metadata": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "release_date": {
+                    "type": "string"
+                },
+                "specific_data": {},
+                "stats": {
+                    "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ItemStatsDTO"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.TagDTO"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.ItemStatsDTO": {
+            "type": "object",
+            "properties": {
+                "completed_count": {
+                    "type": "integer"
+                },
+                "dropped_count": {
+                    "type": "integer"
+                },
+                "favorite_count": {
+                    "type": "integer"
+                },
+                "mean_score": {
+                    "type": "number"
+                },
+                "members": {
+                    "type": "integer"
+                },
+                "rating_count": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "weighted_score": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.ListImportDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "dismissed": {
+                    "type": "integer"
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "file_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "imported": {
+                    "type": "integer"
+                },
+                "resolved": {
+                    "type": "integer"
+                },
+                "review_url": {
+                    "description": "Entradas não encontradas, presente enquanto houver alguma",
+                    "type": "string"
+                },
+                "skipped": {
+                    "description": "Já estavam na lista",
+                    "type": "integer"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "unmatched": {
+                    "description": "Aguardando revisão",
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.LoginRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "username"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string"
+                },
+                "username": {
+                    "description": "Aceita username ou email",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.MediaTypeStatsDTO": {
+            "type": "object",
+            "properties": {
+                "by_status": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer",
+                        "format": "int64"
+                    }
+                },
+                "favorites": {
+                    "type": "integer"
+                },
+                "ratings": {
+                    "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.RatingStatsDTO"
+                },
+                "time_spent": {
+                    "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.TimeSpentDTO"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.PaginatedResponse": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "pagination": {
+                    "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.PaginationMeta"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.PaginationMeta": {
+            "type": "object",
+            "properties": {
+                "current_page": {
+                    "type": "integer"
+                },
+                "has_next": {
+                    "type": "boolean"
+                },
+                "has_previous": {
+                    "type": "boolean"
+                },
+                "items_per_page": {
+                    "type": "integer"
+                },
+                "total_items": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.PeriodCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "period": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.PersonalTagRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "color": {
+                    "description": "Ex: #ff8800",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 1
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.PrivacySettingsDTO": {
+            "type": "object",
+            "properties": {
+                "hidden_statuses": {
+                    "description": "Status ocultos da lista pública e do feed",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "hide_notes": {
+                    "type": "boolean"
+                },
+                "hide_ratings": {
+                    "type": "boolean"
+                },
+                "profile_visibility": {
+                    "description": "public, followers ou private",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.ProfileDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "follow_requested": {
+                    "description": "Se o usuário autenticado tem uma solicitação pendente para seguir este perfil",
+                    "type": "boolean"
+                },
+                "followers_count": {
+                    "type": "integer"
+                },
+                "following_count": {
+                    "type": "integer"
+                },
+                "follows_you": {
+                    "description": "Se este perfil segue o usuário autenticado",
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_following": {
+                    "description": "Se o usuário autenticado segue este perfil",
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "profile_visibility": {
+                    "type": "string"
+                },
+                "restricted": {
+                    "type": "boolean"
+                },
+                "stats": {
+                    "description": "Calculadas apenas sobre as entradas públicas",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.UserListStatsDTO"
+                        }
+                    ]
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.RankedItemDTO": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ItemDTO"
+                },
+                "rank": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.RatingStatsDTO": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "histogram": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer",
+                        "format": "int64"
+                    }
+                },
+                "mean": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.RecapDTO": {
+            "type": "object",
+            "properties": {
+                "completed": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.RecapItemDTO"
+                    }
+                },
+                "first_completion": {
+                    "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.RecapItemDTO"
+                },
+                "items_completed": {
+                    "description": "Items distintos concluídos no ano",
+                    "type": "integer"
+                },
+                "items_started": {
+                    "type": "integer"
+                },
+                "last_completion": {
+                    "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.RecapItemDTO"
+                },
+                "longest_streak": {
+                    "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.StreakDTO"
+                },
+                "most_rewatched": {
+                    "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.RecapItemDTO"
+                },
+                "time_by_media_type": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.TimeSpentDTO"
+                    }
+                },
+                "top_rated": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.RecapItemDTO"
+                    }
+                },
+                "top_tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.TagCountDTO"
+                    }
+                },
+                "total_completions": {
+                    "description": "Conclusões no ano (inclui re-watches)",
+                    "type": "integer"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.RecapItemDTO": {
+            "type": "object",
+            "properties": {
+                "completed_at": {
+                    "description": "Última conclusão dentro do ano",
+                    "type": "string"
+                },
+                "completion_count": {
+                    "type": "integer"
+                },
+                "cover_url": {
+                    "type": "string"
+                },
+                "item_id": {
+                    "type": "integer"
+                },
+                "rating": {
+                    "type": "number"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "user_item_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.RecommendationDTO": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ItemDTO"
+                },
+                "reasons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.RecommendationReasonDTO"
+                    }
+                },
+                "score": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.RecommendationReasonDTO": {
+            "type": "object",
+            "properties": {
+                "item_id": {
+                    "description": "Item da lista do usuário que originou a recomendação",
+                    "type": "integer"
+                },
+                "message": {
+                    "description": "Ex: \"because you rated Steins;Gate 9/10\"",
+                    "type": "string"
+                },
+                "rating": {
+                    "type": "number"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.RegisterRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "name",
+                "password",
+                "username"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 8
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 30,
+                    "minLength": 3
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.ReorderCollectionRequest": {
+            "type": "object",
+            "required": [
+                "item_ids"
+            ],
+            "properties": {
+                "item_ids": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "github_com_rafaelc-rb_geekery-api_internal_dto.AuthResponse": {
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.ResolveListImportEntryRequest": {
             "type": "object",
+            "required": [
+                "item_id"
+            ],
             "properties": {
-                "token": {
+                "item_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.ReviewAuthorDTO": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
                     "type": "string"
                 },
-                "user": {
-                    "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.UserInfo"
+                "username": {
+                    "type": "string"
                 }
             }
         },
-        "github_com_rafaelc-rb_geekery-api_internal_dto.ImportError": {
+        "github_com_rafaelc-rb_geekery-api_internal_dto.ReviewDTO": {
             "type": "object",
             "properties": {
-                "error": {
+                "author": {
+                    "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ReviewAuthorDTO"
+                },
+                "body": {
                     "type": "string"
                 },
-                "line": {
+                "created_at": {
+                    "type": "string"
+                },
+                "edit_count": {
+                    "description": "Número de edições (versões em /history)",
+                    "type": "integer"
+                },
+                "helpful_count": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "item_id": {
                     "type": "integer"
                 },
+                "rating": {
+                    "type": "number"
+                },
+                "recommended": {
+                    "type": "boolean"
+                },
+                "spoiler": {
+                    "type": "boolean"
+                },
                 "title": {
                     "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "voted_helpful": {
+                    "description": "Se o usuário autenticado votou como útil",
+                    "type": "boolean"
                 }
             }
         },
-        "github_com_rafaelc-rb_geekery-api_internal_dto.ImportResult": {
+        "github_com_rafaelc-rb_geekery-api_internal_dto.ReviewRequest": {
             "type": "object",
+            "required": [
+                "body",
+                "recommended",
+                "title"
+            ],
             "properties": {
-                "errors": {
+                "body": {
+                    "description": "Markdown",
+                    "type": "string",
+                    "maxLength": 20000,
+                    "minLength": 1
+                },
+                "recommended": {
+                    "type": "boolean"
+                },
+                "spoiler": {
+                    "type": "boolean"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "minLength": 1
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.ReviewRevisionDTO": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string"
+                },
+                "rating": {
+                    "type": "number"
+                },
+                "recommended": {
+                    "type": "boolean"
+                },
+                "replaced_at": {
+                    "type": "string"
+                },
+                "spoiler": {
+                    "type": "boolean"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.SetPersonalTagsRequest": {
+            "type": "object",
+            "properties": {
+                "tag_ids": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ImportError"
+                        "type": "integer"
                     }
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.SimilarItemDTO": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.ItemDTO"
                 },
-                "failed": {
+                "same_creator": {
+                    "description": "Mesmo estúdio, diretor, desenvolvedora ou autor",
+                    "type": "boolean"
+                },
+                "score": {
+                    "description": "Similaridade combinada (0-1)",
+                    "type": "number"
+                },
+                "shared_members": {
+                    "description": "Usuários que têm os dois items na lista",
                     "type": "integer"
                 },
-                "imported": {
+                "shared_tags": {
+                    "description": "Tags em comum",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.StreakDTO": {
+            "type": "object",
+            "properties": {
+                "days": {
                     "type": "integer"
                 },
-                "media_type": {
+                "end_date": {
+                    "description": "YYYY-MM-DD",
                     "type": "string"
                 },
-                "success": {
+                "start_date": {
+                    "description": "YYYY-MM-DD",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.TagCleanupResult": {
+            "type": "object",
+            "properties": {
+                "deleted": {
+                    "description": "Em dry_run: quantas seriam removidas",
+                    "type": "integer"
+                },
+                "dry_run": {
                     "type": "boolean"
                 },
-                "total_lines": {
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.TagCountDTO": {
+            "type": "object",
+            "properties": {
+                "count": {
                     "type": "integer"
+                },
+                "name": {
+                    "type": "string"
                 }
             }
         },
-        "github_com_rafaelc-rb_geekery-api_internal_dto.LoginRequest": {
+        "github_com_rafaelc-rb_geekery-api_internal_dto.TagDTO": {
             "type": "object",
-            "required": [
-                "password",
-                "username"
-            ],
             "properties": {
-                "password": {
+                "created_at": {
                     "type": "string"
                 },
-                "username": {
-                    "description": "Aceita username ou email",
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "github_com_rafaelc-rb_geekery-api_internal_dto.PaginatedResponse": {
+        "github_com_rafaelc-rb_geekery-api_internal_dto.TagSuggestionDTO": {
             "type": "object",
             "properties": {
-                "data": {},
-                "pagination": {
-                    "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.PaginationMeta"
+                "category": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "item_count": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
                 }
             }
         },
-        "github_com_rafaelc-rb_geekery-api_internal_dto.PaginationMeta": {
+        "github_com_rafaelc-rb_geekery-api_internal_dto.TagUsageDTO": {
             "type": "object",
             "properties": {
-                "current_page": {
+                "category": {
+                    "type": "string"
+                },
+                "counts_by_media_type": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer",
+                        "format": "int64"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
                     "type": "integer"
                 },
-                "has_next": {
-                    "type": "boolean"
+                "item_count": {
+                    "type": "integer"
                 },
-                "has_previous": {
-                    "type": "boolean"
+                "name": {
+                    "type": "string"
                 },
-                "items_per_page": {
+                "parent_id": {
                     "type": "integer"
                 },
-                "total_items": {
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.TimeSpentDTO": {
+            "type": "object",
+            "properties": {
+                "chapters_read": {
                     "type": "integer"
                 },
-                "total_pages": {
+                "episodes_watched": {
+                    "type": "integer"
+                },
+                "game_hours": {
+                    "type": "number"
+                },
+                "minutes_watched": {
+                    "description": "Filmes (runtime do catálogo)",
+                    "type": "integer"
+                },
+                "pages_read": {
                     "type": "integer"
                 }
             }
         },
-        "github_com_rafaelc-rb_geekery-api_internal_dto.RegisterRequest": {
+        "github_com_rafaelc-rb_geekery-api_internal_dto.UpdateCollectionItemRequest": {
             "type": "object",
-            "required": [
-                "email",
-                "name",
-                "password",
-                "username"
+            "properties": {
+                "note": {
+                    "type": "string",
+                    "maxLength": 2000
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.UpdatePrivacySettingsRequest": {
+            "type": "object",
+            "required": [
+                "profile_visibility"
             ],
             "properties": {
-                "email": {
-                    "type": "string"
+                "hidden_statuses": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 100,
-                    "minLength": 1
+                "hide_notes": {
+                    "type": "boolean"
                 },
-                "password": {
-                    "type": "string",
-                    "minLength": 8
+                "hide_ratings": {
+                    "type": "boolean"
                 },
-                "username": {
+                "profile_visibility": {
                     "type": "string",
-                    "maxLength": 30,
-                    "minLength": 3
+                    "enum": [
+                        "public",
+                        "followers",
+                        "private"
+                    ]
                 }
             }
         },
@@ -1454,6 +7633,47 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_rafaelc-rb_geekery-api_internal_dto.UserListStatsDTO": {
+            "type": "object",
+            "properties": {
+                "by_media_type": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.MediaTypeStatsDTO"
+                    }
+                },
+                "completed": {
+                    "type": "integer"
+                },
+                "completions": {
+                    "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.CompletionStatsDTO"
+                },
+                "dropped": {
+                    "type": "integer"
+                },
+                "favorites": {
+                    "type": "integer"
+                },
+                "in_progress": {
+                    "type": "integer"
+                },
+                "paused": {
+                    "type": "integer"
+                },
+                "planned": {
+                    "type": "integer"
+                },
+                "ratings": {
+                    "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.RatingStatsDTO"
+                },
+                "time_spent": {
+                    "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_dto.TimeSpentDTO"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
         "github_com_rafaelc-rb_geekery-api_internal_models.AnimeData": {
             "type": "object",
             "properties": {
@@ -1607,6 +7827,14 @@ const docTemplate = `{
                 "series_data": {
                     "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.SeriesData"
                 },
+                "stats": {
+                    "description": "Agregados da comunidade (nil enquanto ninguém adicionou o item à lista)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.ItemStats"
+                        }
+                    ]
+                },
                 "tags": {
                     "type": "array",
                     "items": {
@@ -1624,10 +7852,162 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_rafaelc-rb_geekery-api_internal_models.ItemStats": {
+            "type": "object",
+            "properties": {
+                "completed_count": {
+                    "type": "integer"
+                },
+                "dropped_count": {
+                    "type": "integer"
+                },
+                "favorite_count": {
+                    "type": "integer"
+                },
+                "mean_score": {
+                    "description": "Média simples das notas (\u003e 0)",
+                    "type": "number"
+                },
+                "members": {
+                    "description": "Entradas na lista (qualquer status)",
+                    "type": "integer"
+                },
+                "rating_count": {
+                    "description": "Entradas com nota",
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "weighted_score": {
+                    "description": "Média bayesiana (ver BayesianScore)",
+                    "type": "number"
+                }
+            }
+        },
         "github_com_rafaelc-rb_geekery-api_internal_models.JSONB": {
             "type": "object",
             "additionalProperties": true
         },
+        "github_com_rafaelc-rb_geekery-api_internal_models.ListImportEntry": {
+            "type": "object",
+            "properties": {
+                "completion_count": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "external_ids": {
+                    "description": "source:id|source:id, como no import do catálogo",
+                    "type": "string"
+                },
+                "finished_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "item_id": {
+                    "description": "Resultado",
+                    "type": "integer"
+                },
+                "line": {
+                    "description": "Identificação na origem",
+                    "type": "integer"
+                },
+                "list_import_id": {
+                    "type": "integer"
+                },
+                "list_status": {
+                    "description": "Dados da lista convertidos",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.MediaStatus"
+                        }
+                    ]
+                },
+                "media_type": {
+                    "description": "Tipo provável (mangá do MAL pode ser comic ou novel)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.MediaType"
+                        }
+                    ]
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "progress": {
+                    "description": "Episódios vistos, capítulos lidos ou minutos jogados",
+                    "type": "integer"
+                },
+                "progress_volumes": {
+                    "type": "integer"
+                },
+                "rating": {
+                    "description": "0-10",
+                    "type": "number"
+                },
+                "reason": {
+                    "description": "Por que a entrada não foi encontrada ou falhou",
+                    "type": "string"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.ListImportEntryStatus"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_item_id": {
+                    "type": "integer"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_models.ListImportEntryStatus": {
+            "type": "string",
+            "enum": [
+                "imported",
+                "skipped",
+                "unmatched",
+                "resolved",
+                "dismissed",
+                "failed"
+            ],
+            "x-enum-comments": {
+                "ListImportEntryDismissed": "Revisada: o usuário descartou a entrada",
+                "ListImportEntryFailed": "Encontrada, mas não foi possível adicioná-la à lista",
+                "ListImportEntryImported": "Encontrada no catálogo e adicionada à lista",
+                "ListImportEntryResolved": "Revisada: o usuário escolheu o item do catálogo",
+                "ListImportEntrySkipped": "O item já estava na lista (a entrada existente não é alterada)",
+                "ListImportEntryUnmatched": "Aguarda revisão: nenhum (ou mais de um) item do catálogo corresponde"
+            },
+            "x-enum-descriptions": [
+                "Encontrada no catálogo e adicionada à lista",
+                "O item já estava na lista (a entrada existente não é alterada)",
+                "Aguarda revisão: nenhum (ou mais de um) item do catálogo corresponde",
+                "Revisada: o usuário escolheu o item do catálogo",
+                "Revisada: o usuário descartou a entrada",
+                "Encontrada, mas não foi possível adicioná-la à lista"
+            ],
+            "x-enum-varnames": [
+                "ListImportEntryImported",
+                "ListImportEntrySkipped",
+                "ListImportEntryUnmatched",
+                "ListImportEntryResolved",
+                "ListImportEntryDismissed",
+                "ListImportEntryFailed"
+            ]
+        },
         "github_com_rafaelc-rb_geekery-api_internal_models.MediaStatus": {
             "type": "string",
             "enum": [
@@ -1708,6 +8088,57 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_rafaelc-rb_geekery-api_internal_models.PersonalTag": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "description": "Hex opcional (#rrggbb)",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "usage_count": {
+                    "description": "Número de entradas da lista com o rótulo (preenchido nas listagens)",
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_models.ProfileVisibility": {
+            "type": "string",
+            "enum": [
+                "public",
+                "followers",
+                "private"
+            ],
+            "x-enum-comments": {
+                "ProfileFollowers": "Visível apenas para quem segue o usuário",
+                "ProfilePrivate": "Visível apenas para o dono",
+                "ProfilePublic": "Visível para todos"
+            },
+            "x-enum-descriptions": [
+                "Visível para todos",
+                "Visível apenas para quem segue o usuário",
+                "Visível apenas para o dono"
+            ],
+            "x-enum-varnames": [
+                "ProfilePublic",
+                "ProfileFollowers",
+                "ProfilePrivate"
+            ]
+        },
         "github_com_rafaelc-rb_geekery-api_internal_models.ProgressType": {
             "type": "string",
             "enum": [
@@ -1770,6 +8201,21 @@ const docTemplate = `{
         "github_com_rafaelc-rb_geekery-api_internal_models.Tag": {
             "type": "object",
             "properties": {
+                "aliases": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.TagAlias"
+                    }
+                },
+                "category": {
+                    "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.TagCategory"
+                },
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.Tag"
+                    }
+                },
                 "created_at": {
                     "type": "string"
                 },
@@ -1779,6 +8225,10 @@ const docTemplate = `{
                 "id": {
                     "type": "integer"
                 },
+                "item_count": {
+                    "description": "Número de items do catálogo com a tag (preenchido nas listagens)",
+                    "type": "integer"
+                },
                 "items": {
                     "type": "array",
                     "items": {
@@ -1788,11 +8238,48 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "parent_id": {
+                    "type": "integer"
+                },
                 "updated_at": {
                     "type": "string"
                 }
             }
         },
+        "github_com_rafaelc-rb_geekery-api_internal_models.TagAlias": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "tag_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_rafaelc-rb_geekery-api_internal_models.TagCategory": {
+            "type": "string",
+            "enum": [
+                "genre",
+                "theme",
+                "demographic",
+                "setting",
+                "content_warning"
+            ],
+            "x-enum-varnames": [
+                "TagCategoryGenre",
+                "TagCategoryTheme",
+                "TagCategoryDemographic",
+                "TagCategorySetting",
+                "TagCategoryContentWarning"
+            ]
+        },
         "github_com_rafaelc-rb_geekery-api_internal_models.User": {
             "type": "object",
             "properties": {
@@ -1805,12 +8292,33 @@ const docTemplate = `{
                 "email": {
                     "type": "string"
                 },
+                "hidden_statuses": {
+                    "description": "Status ocultos da lista pública",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.MediaStatus"
+                    }
+                },
+                "hide_notes": {
+                    "type": "boolean"
+                },
+                "hide_ratings": {
+                    "type": "boolean"
+                },
                 "id": {
                     "type": "integer"
                 },
                 "name": {
                     "type": "string"
                 },
+                "profile_visibility": {
+                    "description": "Privacidade (aplicada pelos repositórios em todas as consultas públicas e sociais)",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.ProfileVisibility"
+                        }
+                    ]
+                },
                 "updated_at": {
                     "type": "string"
                 },
@@ -1852,6 +8360,16 @@ const docTemplate = `{
                 "notes": {
                     "type": "string"
                 },
+                "personal_tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_rafaelc-rb_geekery-api_internal_models.PersonalTag"
+                    }
+                },
+                "private": {
+                    "description": "Entrada oculta do perfil público e do feed",
+                    "type": "boolean"
+                },
                 "progress_data": {
                     "description": "Dados flexíveis de progresso + history",
                     "allOf": [
@@ -1897,14 +8415,50 @@ const docTemplate = `{
                 }
             }
         },
+        "internal_handlers.AddTagAliasRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 2
+                }
+            }
+        },
         "internal_handlers.CreateTagRequest": {
             "type": "object",
             "required": [
                 "name"
             ],
             "properties": {
+                "category": {
+                    "description": "genre, theme, demographic, setting, content_warning",
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
+                },
+                "parent_id": {
+                    "description": "Tag pai na hierarquia (opcional)",
+                    "type": "integer"
+                }
+            }
+        },
+        "internal_handlers.MergeTagsRequest": {
+            "type": "object",
+            "required": [
+                "source_ids"
+            ],
+            "properties": {
+                "source_ids": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    }
                 }
             }
         },
@@ -1914,8 +8468,16 @@ const docTemplate = `{
                 "name"
             ],
             "properties": {
+                "category": {
+                    "description": "genre, theme, demographic, setting, content_warning",
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
+                },
+                "parent_id": {
+                    "description": "Tag pai na hierarquia (opcional)",
+                    "type": "integer"
                 }
             }
         }
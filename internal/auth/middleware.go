@@ -48,3 +48,17 @@ func AuthMiddleware(jwtManager *JWTManager) gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// OptionalAuthMiddleware identifica o usuário quando um token válido é enviado
+// Requisições sem token (ou com token inválido) seguem como anônimas, sem userID no contexto
+func OptionalAuthMiddleware(jwtManager *JWTManager) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		parts := strings.SplitN(c.GetHeader("Authorization"), " ", 2)
+		if len(parts) == 2 && parts[0] == "Bearer" {
+			if claims, err := jwtManager.ValidateToken(parts[1]); err == nil {
+				c.Set("userID", claims.UserID)
+			}
+		}
+		c.Next()
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -18,6 +20,40 @@ type Config struct {
 	Environment string
 	LogLevel    string
 	JWTSecret   string
+
+	// Intervalo do recálculo periódico dos agregados da comunidade (0 desativa)
+	StatsRefreshInterval time.Duration
+
+	// Intervalo da reconstrução do modelo de recomendações (0 desativa)
+	RecommendationsRefreshInterval time.Duration
+
+	// Workers que processam a fila de imports (0 desativa nesta instância)
+	ImportWorkers int
+	// Intervalo de consulta à fila quando ela está vazia
+	ImportPollInterval time.Duration
+	// Diretório dos arquivos enviados e dos relatórios de erros dos imports
+	// Deve ser compartilhado entre as instâncias (volume comum) quando houver mais de uma
+	ImportStorageDir string
+
+	// Workers que geram os exports dos dados da conta (0 desativa nesta instância)
+	DataExportWorkers int
+	// Intervalo de consulta à fila de exports quando ela está vazia
+	DataExportPollInterval time.Duration
+	// Diretório dos ZIPs gerados
+	// Deve ser compartilhado entre as instâncias (volume comum) quando houver mais de uma
+	DataExportStorageDir string
+	// Validade do link de download; depois dela o ZIP é removido
+	DataExportTTL time.Duration
+	// Intervalo da remoção dos ZIPs expirados (0 desativa)
+	DataExportCleanupInterval time.Duration
+
+	// Token de leitura da API do TMDB (vazio desativa o provider)
+	TMDBAPIToken string
+	// Credenciais de app da Twitch para o IGDB (vazias desativam o provider)
+	IGDBClientID    string
+	IGDBAccessToken string
+	// Timeout de cada requisição às APIs de metadados
+	MetadataTimeout time.Duration
 }
 
 var AppConfig *Config
@@ -41,6 +77,66 @@ func LoadConfig() (*Config, error) {
 		JWTSecret:   getEnv("JWT_SECRET", ""),
 	}
 
+	statsRefreshInterval, err := time.ParseDuration(getEnv("STATS_REFRESH_INTERVAL", "1h"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid STATS_REFRESH_INTERVAL: %w", err)
+	}
+	config.StatsRefreshInterval = statsRefreshInterval
+
+	recommendationsRefreshInterval, err := time.ParseDuration(getEnv("RECOMMENDATIONS_REFRESH_INTERVAL", "6h"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid RECOMMENDATIONS_REFRESH_INTERVAL: %w", err)
+	}
+	config.RecommendationsRefreshInterval = recommendationsRefreshInterval
+
+	importWorkers, err := strconv.Atoi(getEnv("IMPORT_WORKERS", "2"))
+	if err != nil || importWorkers < 0 {
+		return nil, fmt.Errorf("invalid IMPORT_WORKERS: must be a non-negative integer")
+	}
+	config.ImportWorkers = importWorkers
+
+	importPollInterval, err := time.ParseDuration(getEnv("IMPORT_POLL_INTERVAL", "2s"))
+	if err != nil || importPollInterval <= 0 {
+		return nil, fmt.Errorf("invalid IMPORT_POLL_INTERVAL: must be a positive duration")
+	}
+	config.ImportPollInterval = importPollInterval
+	config.ImportStorageDir = getEnv("IMPORT_STORAGE_DIR", "data/imports")
+
+	dataExportWorkers, err := strconv.Atoi(getEnv("DATA_EXPORT_WORKERS", "1"))
+	if err != nil || dataExportWorkers < 0 {
+		return nil, fmt.Errorf("invalid DATA_EXPORT_WORKERS: must be a non-negative integer")
+	}
+	config.DataExportWorkers = dataExportWorkers
+
+	dataExportPollInterval, err := time.ParseDuration(getEnv("DATA_EXPORT_POLL_INTERVAL", "5s"))
+	if err != nil || dataExportPollInterval <= 0 {
+		return nil, fmt.Errorf("invalid DATA_EXPORT_POLL_INTERVAL: must be a positive duration")
+	}
+	config.DataExportPollInterval = dataExportPollInterval
+	config.DataExportStorageDir = getEnv("DATA_EXPORT_STORAGE_DIR", "data/exports")
+
+	dataExportTTL, err := time.ParseDuration(getEnv("DATA_EXPORT_TTL", "24h"))
+	if err != nil || dataExportTTL <= 0 {
+		return nil, fmt.Errorf("invalid DATA_EXPORT_TTL: must be a positive duration")
+	}
+	config.DataExportTTL = dataExportTTL
+
+	dataExportCleanupInterval, err := time.ParseDuration(getEnv("DATA_EXPORT_CLEANUP_INTERVAL", "1h"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid DATA_EXPORT_CLEANUP_INTERVAL: %w", err)
+	}
+	config.DataExportCleanupInterval = dataExportCleanupInterval
+
+	config.TMDBAPIToken = getEnv("TMDB_API_TOKEN", "")
+	config.IGDBClientID = getEnv("IGDB_CLIENT_ID", "")
+	config.IGDBAccessToken = getEnv("IGDB_ACCESS_TOKEN", "")
+
+	metadataTimeout, err := time.ParseDuration(getEnv("METADATA_TIMEOUT", "10s"))
+	if err != nil || metadataTimeout <= 0 {
+		return nil, fmt.Errorf("invalid METADATA_TIMEOUT: must be a positive duration")
+	}
+	config.MetadataTimeout = metadataTimeout
+
 	// Validar campos obrigatórios
 	if err := config.validate(); err != nil {
 		return nil, err
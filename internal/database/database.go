@@ -59,14 +59,38 @@ func runMigrations(db *gorm.DB) error {
 	err := db.AutoMigrate(
 		&models.User{},
 		&models.Tag{},
-		&models.Item{},     // Catálogo global (sem user_id)
-		&models.UserItem{}, // Lista pessoal dos usuários
+		&models.TagAlias{},
+		&models.PersonalTag{},    // Rótulos privados das entradas da lista
+		&models.Item{},           // Catálogo global (sem user_id)
+		&models.UserItem{},       // Lista pessoal dos usuários
+		&models.ItemStats{},      // Agregados da comunidade por item
+		&models.MediaTypeStats{}, // Média das notas por tipo de mídia (score bayesiano)
 		// Dados específicos por tipo de mídia
 		&models.AnimeData{},
 		&models.MovieData{},
 		&models.GameData{},
 		&models.BookData{},
 		&models.SeriesData{},
+		// Atividade e metas dos usuários
+		&models.ActivityEvent{},
+		&models.Goal{},
+		// Coleções personalizadas
+		&models.Collection{},
+		&models.CollectionItem{},
+		// Resenhas públicas
+		&models.Review{},
+		&models.ReviewRevision{},
+		&models.ReviewVote{},
+		// Modelo de recomendações (similaridade entre items)
+		&models.ItemSimilarity{},
+		// Grafo social (seguidores)
+		&models.Follow{},
+		&models.ImportJob{},
+		// Imports de listas pessoais (MAL, AniList, Letterboxd...)
+		&models.ListImport{},
+		&models.ListImportEntry{},
+		// Exports dos dados da conta (ZIP com link assinado)
+		&models.DataExport{},
 	)
 	if err != nil {
 		return err
@@ -0,0 +1,158 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+)
+
+// DataExportDTO representa um export dos dados da conta e seu andamento
+type DataExportDTO struct {
+	ID          uint       `json:"id"`
+	Status      string     `json:"status"` // queued, running, completed, failed ou expired
+	Attempts    int        `json:"attempts"`
+	Size        int64      `json:"size,omitempty"` // Tamanho do ZIP em bytes
+	Error       string     `json:"error,omitempty"`
+	DownloadURL string     `json:"download_url,omitempty"` // Link assinado, presente enquanto o ZIP estiver disponível
+	CreatedAt   time.Time  `json:"created_at"`
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	FinishedAt  *time.Time `json:"finished_at,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+}
+
+// DownloadSignatureParams representa os parâmetros do link assinado de download (query params)
+type DownloadSignatureParams struct {
+	Expires   int64  `form:"expires" binding:"required"` // Unix timestamp
+	Signature string `form:"signature" binding:"required"`
+}
+
+// DeleteAccountRequest representa a confirmação da exclusão definitiva da conta
+type DeleteAccountRequest struct {
+	Password string `json:"password" binding:"required"`
+}
+
+// AccountErasureResult resume o que foi removido na exclusão definitiva da conta
+type AccountErasureResult struct {
+	Deleted map[string]int64 `json:"deleted"` // Linhas removidas por tabela
+
+	ItemIDs     []uint   `json:"-"` // Items cujos agregados da comunidade precisam ser recalculados
+	ExportFiles []string `json:"-"` // ZIPs de exports da conta a remover do disco
+}
+
+// AccountData agrupa os dados do usuário lidos para o export da conta (exceto a lista, lida em lotes)
+type AccountData struct {
+	Reviews         []models.Review
+	ReviewRevisions []models.ReviewRevision
+	ReviewVotes     []models.ReviewVote
+	Collections     []models.Collection // Com os items
+	PersonalTags    []models.PersonalTag
+	Goals           []models.Goal
+	Follows         []models.Follow // Nas duas direções
+	Activity        []models.ActivityEvent
+	ListImports     []models.ListImport // Com as entradas
+	DataExports     []models.DataExport
+}
+
+// ========================================
+// Arquivos do ZIP do export da conta
+// ========================================
+
+// AccountProfileRecord é o conteúdo de profile.json
+type AccountProfileRecord struct {
+	ID                uint      `json:"id"`
+	Email             string    `json:"email"`
+	Username          string    `json:"username"`
+	Name              string    `json:"name"`
+	ProfileVisibility string    `json:"profile_visibility"`
+	HiddenStatuses    []string  `json:"hidden_statuses"`
+	HideRatings       bool      `json:"hide_ratings"`
+	HideNotes         bool      `json:"hide_notes"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// AccountListRecord é uma entrada de list.json: o registro portátil da lista
+// mais o ProgressData completo, como gravado (inclui o history de visualizações)
+type AccountListRecord struct {
+	ID     uint `json:"id"`
+	ItemID uint `json:"item_id"`
+	UserItemFileRecord
+	ProgressData models.JSONB `json:"progress_data"`
+}
+
+// AccountReviewRecord é uma entrada de reviews.json, com as versões anteriores
+type AccountReviewRecord struct {
+	ID          uint                    `json:"id"`
+	ItemID      uint                    `json:"item_id"`
+	Title       string                  `json:"title"`
+	Body        string                  `json:"body"`
+	Spoiler     bool                    `json:"spoiler"`
+	Recommended bool                    `json:"recommended"`
+	Rating      float64                 `json:"rating"`
+	CreatedAt   time.Time               `json:"created_at"`
+	UpdatedAt   time.Time               `json:"updated_at"`
+	Revisions   []models.ReviewRevision `json:"revisions"`
+}
+
+// AccountCollectionRecord é uma entrada de collections.json
+type AccountCollectionRecord struct {
+	ID          uint                          `json:"id"`
+	Title       string                        `json:"title"`
+	Description string                        `json:"description,omitempty"`
+	Visibility  string                        `json:"visibility"`
+	CreatedAt   time.Time                     `json:"created_at"`
+	UpdatedAt   time.Time                     `json:"updated_at"`
+	Items       []AccountCollectionItemRecord `json:"items"`
+}
+
+// AccountCollectionItemRecord é um item de uma coleção, na ordem definida pelo usuário
+type AccountCollectionItemRecord struct {
+	ItemID   uint      `json:"item_id"`
+	Type     string    `json:"type"`
+	Title    string    `json:"title"`
+	Position int       `json:"position"`
+	Note     string    `json:"note,omitempty"`
+	AddedAt  time.Time `json:"added_at"`
+}
+
+// AccountVoteRecord é uma entrada de review_votes.json (votos "útil" dados pelo usuário)
+type AccountVoteRecord struct {
+	ReviewID  uint      `json:"review_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// AccountFollowsRecord é o conteúdo de follows.json
+type AccountFollowsRecord struct {
+	Following []AccountFollowRecord `json:"following"`
+	Followers []AccountFollowRecord `json:"followers"`
+}
+
+// AccountFollowRecord identifica o outro usuário de uma relação de seguir
+type AccountFollowRecord struct {
+	UserID    uint      `json:"user_id"`
+	Status    string    `json:"status"` // accepted ou pending
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// AccountTokensRecord é o conteúdo de tokens.json: metadados (nunca os segredos) das credenciais da conta
+type AccountTokensRecord struct {
+	AccessTokens string                    `json:"access_tokens"`
+	ShareLinks   []AccountShareLinkRecord  `json:"share_links"`  // Links de compartilhamento das coleções
+	DataExports  []AccountDataExportRecord `json:"data_exports"` // Links de download de exports
+}
+
+// AccountShareLinkRecord descreve o link de compartilhamento de uma coleção
+type AccountShareLinkRecord struct {
+	CollectionID uint      `json:"collection_id"`
+	Title        string    `json:"title"`
+	Visibility   string    `json:"visibility"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// AccountDataExportRecord descreve um export da conta
+type AccountDataExportRecord struct {
+	ID        uint       `json:"id"`
+	Status    string     `json:"status"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
@@ -0,0 +1,52 @@
+package dto
+
+import "time"
+
+// DayCount representa o número de eventos de atividade em um dia (YYYY-MM-DD)
+type DayCount struct {
+	Date  string `json:"date"`
+	Count int64  `json:"count"`
+}
+
+// ActivityDTO representa o heatmap diário de atividade e as streaks do usuário
+type ActivityDTO struct {
+	From          string     `json:"from"` // YYYY-MM-DD
+	To            string     `json:"to"`   // YYYY-MM-DD (inclusivo)
+	Total         int64      `json:"total"`
+	ActiveDays    int        `json:"active_days"`
+	Days          []DayCount `json:"days"` // Um registro por dia do período (dias sem atividade com count 0)
+	CurrentStreak StreakDTO  `json:"current_streak"`
+	LongestStreak StreakDTO  `json:"longest_streak"`
+}
+
+// GoalRequest representa o payload de criação/atualização de meta
+// Para period=year informe year; para period=month informe year e month;
+// para period=custom informe starts_at e ends_at (ends_at inclusivo)
+type GoalRequest struct {
+	Title     string     `json:"title" binding:"max=200"`
+	Metric    string     `json:"metric" binding:"required,oneof=completions episodes chapters pages minutes hours"`
+	Target    float64    `json:"target" binding:"required,gt=0"`
+	Period    string     `json:"period" binding:"required,oneof=year month custom"`
+	Year      int        `json:"year"`
+	Month     int        `json:"month" binding:"omitempty,min=1,max=12"`
+	StartsAt  *time.Time `json:"starts_at"`
+	EndsAt    *time.Time `json:"ends_at"`
+	MediaType string     `json:"media_type"` // Opcional: vazio = todos os tipos
+}
+
+// GoalDTO representa uma meta com o progresso calculado
+type GoalDTO struct {
+	ID        uint      `json:"id"`
+	Title     string    `json:"title"`
+	Metric    string    `json:"metric"`
+	Target    float64   `json:"target"`
+	Period    string    `json:"period"`
+	MediaType string    `json:"media_type,omitempty"`
+	StartsAt  time.Time `json:"starts_at"`
+	EndsAt    time.Time `json:"ends_at"`
+	Current   float64   `json:"current"`
+	Percent   float64   `json:"percent"`  // Limitado a 100
+	Expected  float64   `json:"expected"` // Progresso esperado até agora num ritmo linear
+	Achieved  bool      `json:"achieved"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,50 @@
+package dto
+
+import "time"
+
+// CollectionDTO representa uma coleção para resposta da API
+type CollectionDTO struct {
+	ID          uint                `json:"id"`
+	UserID      uint                `json:"user_id"`
+	Title       string              `json:"title"`
+	Description string              `json:"description"`
+	Visibility  string              `json:"visibility"`
+	ShareURL    string              `json:"share_url,omitempty"` // Apenas para o dono
+	ItemCount   int64               `json:"item_count"`
+	Items       []CollectionItemDTO `json:"items,omitempty"`
+	CreatedAt   time.Time           `json:"created_at"`
+	UpdatedAt   time.Time           `json:"updated_at"`
+}
+
+// CollectionItemDTO representa uma entrada de coleção
+type CollectionItemDTO struct {
+	ItemID   uint      `json:"item_id"`
+	Position int       `json:"position"`
+	Note     string    `json:"note"`
+	AddedAt  time.Time `json:"added_at"`
+	Item     *ItemDTO  `json:"item,omitempty"`
+}
+
+// CollectionRequest representa o payload de criação/atualização de coleção
+type CollectionRequest struct {
+	Title       string `json:"title" binding:"required,min=1,max=200"`
+	Description string `json:"description" binding:"max=5000"`
+	Visibility  string `json:"visibility" binding:"omitempty,oneof=public unlisted private"` // Padrão: private
+}
+
+// AddCollectionItemRequest representa o payload para adicionar um item a uma coleção
+type AddCollectionItemRequest struct {
+	ItemID   uint   `json:"item_id" binding:"required"`
+	Note     string `json:"note" binding:"max=2000"`
+	Position int    `json:"position" binding:"omitempty,min=1"` // Opcional: padrão é o final da coleção
+}
+
+// UpdateCollectionItemRequest representa o payload para atualizar a nota de uma entrada
+type UpdateCollectionItemRequest struct {
+	Note string `json:"note" binding:"max=2000"`
+}
+
+// ReorderCollectionRequest representa a nova ordem completa dos items da coleção
+type ReorderCollectionRequest struct {
+	ItemIDs []uint `json:"item_ids" binding:"required,min=1"`
+}
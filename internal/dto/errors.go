@@ -16,6 +16,7 @@ const (
 	ErrCodeInvalidCredentials = "INVALID_CREDENTIALS"
 	ErrCodeUserExists         = "USER_EXISTS"
 	ErrCodeForbidden          = "FORBIDDEN"
+	ErrCodeUpstream           = "UPSTREAM_ERROR"
 )
 
 // NewErrorResponse cria uma resposta de erro padronizada
@@ -0,0 +1,63 @@
+package dto
+
+import "time"
+
+// Formatos do export da lista pessoal
+const (
+	ListExportFormatJSON    = "json"
+	ListExportFormatCSV     = "csv"
+	ListExportFormatMALXML  = "mal-xml" // XML aceito pelo import do MyAnimeList (apenas anime e mangá com ID do MAL)
+	ListExportFormatAniList = "anilist" // JSON no formato MediaListCollection do AniList (apenas anime e mangá)
+)
+
+// ItemExportParams representa os filtros do export do catálogo (query params)
+type ItemExportParams struct {
+	Type   string `form:"type"`                                             // Vazio exporta todos os tipos
+	Format string `form:"format" binding:"omitempty,oneof=csv json ndjson"` // Padrão: csv
+}
+
+// ItemFileRecord representa um item nos arquivos JSON e NDJSON
+// É o formato gerado pelo export e aceito pelo import genérico (POST /items/import)
+type ItemFileRecord struct {
+	Type             string                 `json:"type"`
+	Title            string                 `json:"title"`
+	Description      string                 `json:"description,omitempty"`
+	ReleaseDate      string                 `json:"release_date,omitempty"` // YYYY-MM-DD
+	CoverURL         string                 `json:"cover_url,omitempty"`
+	Tags             []string               `json:"tags,omitempty"`
+	ExternalMetadata map[string]interface{} `json:"external_metadata,omitempty"`
+	SpecificData     map[string]interface{} `json:"specific_data,omitempty"` // Mesmos campos das colunas específicas do CSV
+}
+
+// UserItemExportParams representa os filtros do export da lista pessoal (query params)
+type UserItemExportParams struct {
+	Type   string `form:"type"`                                                      // Vazio exporta todos os tipos
+	Format string `form:"format" binding:"omitempty,oneof=json csv mal-xml anilist"` // Padrão: json
+}
+
+// UserItemFileRecord representa uma entrada da lista no export portátil (JSON)
+// O item é identificado pelo título, ano e IDs externos, para ser encontrado em outro catálogo
+type UserItemFileRecord struct {
+	Type             string                 `json:"type"`
+	Title            string                 `json:"title"`
+	ReleaseDate      string                 `json:"release_date,omitempty"` // YYYY-MM-DD
+	ExternalMetadata map[string]interface{} `json:"external_metadata,omitempty"`
+	Status           string                 `json:"status"`
+	Rating           float64                `json:"rating"`
+	Favorite         bool                   `json:"favorite"`
+	Private          bool                   `json:"private"`
+	ProgressType     string                 `json:"progress_type"`
+	Progress         map[string]interface{} `json:"progress,omitempty"` // ProgressData sem o history
+	CompletionCount  int                    `json:"completion_count"`
+	Views            []UserItemViewRecord   `json:"views,omitempty"` // History de visualizações/leituras
+	PersonalTags     []string               `json:"personal_tags,omitempty"`
+	Notes            string                 `json:"notes,omitempty"`
+	AddedAt          time.Time              `json:"added_at"`
+	UpdatedAt        time.Time              `json:"updated_at"`
+}
+
+// UserItemViewRecord representa uma visualização do history (finished_at vazio = em andamento)
+type UserItemViewRecord struct {
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+}
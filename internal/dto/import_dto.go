@@ -1,13 +1,84 @@
 package dto
 
+import "time"
+
+// Formatos de arquivo aceitos pelo import
+const (
+	ImportFormatCSV    = "csv"
+	ImportFormatJSON   = "json"   // Array de items
+	ImportFormatNDJSON = "ndjson" // Um item por linha
+)
+
+// Modos de transação do import
+const (
+	ImportTransactionRow  = "row"  // Cada linha é gravada de forma independente (falhas são puladas)
+	ImportTransactionFile = "file" // O arquivo inteiro é gravado ou nenhuma linha é
+)
+
+// Modos de import
+const (
+	ImportModeCreate = "create" // Apenas cria; linhas que já existem no catálogo falham
+	ImportModeUpsert = "upsert" // Atualiza items existentes (por IDs externos ou título + ano) e cria os demais
+)
+
+// Ações reportadas por linha importada
+const (
+	ImportActionCreate    = "create"
+	ImportActionUpdate    = "update"
+	ImportActionUnchanged = "unchanged"
+)
+
+// ImportOptions representa as opções de um import (query params)
+type ImportOptions struct {
+	Mode        string `form:"mode" binding:"omitempty,oneof=create upsert"`     // Padrão: create
+	Transaction string `form:"transaction" binding:"omitempty,oneof=row file"`   // Padrão: row
+	DryRun      bool   `form:"dry_run"`                                          // Valida e reporta sem gravar nada
+	Format      string `form:"format" binding:"omitempty,oneof=csv json ndjson"` // Import genérico; padrão: pela extensão do arquivo
+
+	// ResumeFrom retoma um import interrompido (jobs em background, não vem da query): as linhas já
+	// processadas são lidas e puladas, e os contadores partem do progresso gravado
+	ResumeFrom *ImportProgress `form:"-"`
+}
+
 // ImportResult representa o resultado de uma importação CSV
 type ImportResult struct {
-	Success    bool          `json:"success"`
-	MediaType  string        `json:"media_type"`
-	TotalLines int           `json:"total_lines"`
-	Imported   int           `json:"imported"`
-	Failed     int           `json:"failed"`
-	Errors     []ImportError `json:"errors,omitempty"`
+	Success     bool              `json:"success"`
+	Format      string            `json:"format"`
+	MediaType   string            `json:"media_type,omitempty"` // Ausente em imports com tipos mistos
+	Mode        string            `json:"mode"`
+	Transaction string            `json:"transaction"`
+	DryRun      bool              `json:"dry_run"`
+	RolledBack  bool              `json:"rolled_back"` // true quando nenhuma linha foi (ou seria) gravada por causa de falhas no modo file
+	TotalLines  int               `json:"total_lines"`
+	Imported    int               `json:"imported"` // Linhas processadas com sucesso (created + updated + unchanged)
+	Created     int               `json:"created"`
+	Updated     int               `json:"updated"`
+	Unchanged   int               `json:"unchanged"`
+	Failed      int               `json:"failed"`
+	Rows        []ImportRowResult `json:"rows,omitempty"`   // Limitado às primeiras linhas; ver RowsTruncated
+	Errors      []ImportError     `json:"errors,omitempty"` // Limitado aos primeiros erros; ver ErrorsTruncated
+
+	RowsTruncated   bool `json:"rows_truncated,omitempty"`
+	ErrorsTruncated bool `json:"errors_truncated,omitempty"`
+
+	// ResumedFromLine indica que o import foi retomado após essa linha; created, updated, unchanged,
+	// rows e errors cobrem apenas as linhas seguintes (total_lines, imported e failed cobrem o arquivo todo)
+	ResumedFromLine int `json:"resumed_from_line,omitempty"`
+}
+
+// ImportProgress representa o andamento de um import, reportado a cada lote processado
+type ImportProgress struct {
+	Lines    int `json:"lines"` // Linhas de dados lidas até agora
+	Imported int `json:"imported"`
+	Failed   int `json:"failed"`
+}
+
+// ImportRowResult representa o que foi (ou seria, no dry run) feito com uma linha válida
+type ImportRowResult struct {
+	Line   int    `json:"line"`
+	Title  string `json:"title"`
+	Action string `json:"action"`
+	ItemID uint   `json:"item_id,omitempty"` // Ausente para items criados no dry run ou em rollback
 }
 
 // ImportError representa um erro específico durante a importação
@@ -16,3 +87,23 @@ type ImportError struct {
 	Title string `json:"title,omitempty"`
 	Error string `json:"error"`
 }
+
+// ImportJobDTO representa um import enfileirado e seu andamento
+type ImportJobDTO struct {
+	ID          uint           `json:"id"`
+	Status      string         `json:"status"` // queued, running, completed, failed ou canceled
+	Format      string         `json:"format"`
+	MediaType   string         `json:"media_type,omitempty"` // Ausente em imports com tipos mistos
+	Mode        string         `json:"mode"`
+	Transaction string         `json:"transaction"`
+	DryRun      bool           `json:"dry_run"`
+	FileName    string         `json:"file_name"`
+	Attempts    int            `json:"attempts"`
+	Progress    ImportProgress `json:"progress"`
+	Result      *ImportResult  `json:"result,omitempty"`     // Presente quando o import termina
+	Error       string         `json:"error,omitempty"`      // Erro que interrompeu o job
+	ErrorsURL   string         `json:"errors_url,omitempty"` // CSV com todas as linhas que falharam
+	CreatedAt   time.Time      `json:"created_at"`
+	StartedAt   *time.Time     `json:"started_at,omitempty"`
+	FinishedAt  *time.Time     `json:"finished_at,omitempty"`
+}
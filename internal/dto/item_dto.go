@@ -13,6 +13,7 @@ type ItemDTO struct {
 	ExternalMetadata map[string]interface{} `json:"external_metadata,omitempty"`
 	Tags             []TagDTO               `json:"tags,omitempty"`
 	SpecificData     interface{}            `json:"specific_data,omitempty"`
+	Stats            *ItemStatsDTO          `json:"stats,omitempty"`
 	CreatedAt        time.Time              `json:"created_at"`
 	UpdatedAt        time.Time              `json:"updated_at"`
 }
@@ -74,3 +75,66 @@ type BookDataDTO struct {
 	Format    string `json:"format,omitempty"`
 	Publisher string `json:"publisher,omitempty"`
 }
+
+// ItemStatsDTO representa os agregados da comunidade de um item
+type ItemStatsDTO struct {
+	MeanScore      float64   `json:"mean_score"`
+	WeightedScore  float64   `json:"weighted_score"`
+	RatingCount    int64     `json:"rating_count"`
+	Members        int64     `json:"members"`
+	CompletedCount int64     `json:"completed_count"`
+	DroppedCount   int64     `json:"dropped_count"`
+	FavoriteCount  int64     `json:"favorite_count"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// Campos de ordenação por agregados da comunidade
+const (
+	ItemSortScore      = "score"      // Score bayesiano
+	ItemSortPopularity = "popularity" // Número de membros
+)
+
+// Temporadas aceitas nos rankings (trimestres do ano, como nas temporadas de anime)
+const (
+	SeasonWinter  = "winter"
+	SeasonSpring  = "spring"
+	SeasonSummer  = "summer"
+	SeasonFall    = "fall"
+	SeasonCurrent = "current"
+)
+
+// ItemRankingFilter representa os filtros de listagem ordenada por agregados
+type ItemRankingFilter struct {
+	Type   string `form:"type"`
+	SortBy string `form:"sort" binding:"omitempty,oneof=score popularity"`
+	Season string `form:"season" binding:"omitempty,oneof=winter spring summer fall current"` // Filtra pela data de lançamento
+	Year   int    `form:"year" binding:"omitempty,min=1900,max=3000"`                         // Ano da temporada (padrão: ano atual)
+
+	// Intervalo [ReleasedFrom, ReleasedTo) resolvido a partir de season/year
+	ReleasedFrom *time.Time `form:"-"`
+	ReleasedTo   *time.Time `form:"-"`
+}
+
+// RankedItemDTO representa um item em um ranking
+type RankedItemDTO struct {
+	Rank int      `json:"rank"`
+	Item *ItemDTO `json:"item"`
+}
+
+// ItemStatsAggregate representa uma linha agregada das listas dos usuários por item
+type ItemStatsAggregate struct {
+	ItemID         uint
+	MediaType      string
+	MeanScore      float64
+	RatingCount    int64
+	Members        int64
+	CompletedCount int64
+	DroppedCount   int64
+	FavoriteCount  int64
+}
+
+// MediaTypeMean representa a média das notas de um tipo de mídia
+type MediaTypeMean struct {
+	MediaType string
+	Mean      float64
+}
@@ -0,0 +1,36 @@
+package dto
+
+import "time"
+
+// ListImportStatusCount representa o total de entradas de um import em um status (agregado do repositório)
+type ListImportStatusCount struct {
+	ListImportID uint
+	Status       string
+	Count        int
+}
+
+// ListImportDTO representa o resumo de um import de lista pessoal
+type ListImportDTO struct {
+	ID        uint      `json:"id"`
+	Source    string    `json:"source"`
+	FileName  string    `json:"file_name"`
+	Total     int       `json:"total"`
+	Imported  int       `json:"imported"`
+	Skipped   int       `json:"skipped"`   // Já estavam na lista
+	Unmatched int       `json:"unmatched"` // Aguardando revisão
+	Resolved  int       `json:"resolved"`
+	Dismissed int       `json:"dismissed"`
+	Failed    int       `json:"failed"`
+	ReviewURL string    `json:"review_url,omitempty"` // Entradas não encontradas, presente enquanto houver alguma
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ListImportEntryFilter representa o filtro das entradas de um import (query params)
+type ListImportEntryFilter struct {
+	Status string `form:"status" binding:"omitempty,oneof=imported skipped unmatched resolved dismissed failed"`
+}
+
+// ResolveListImportEntryRequest representa a escolha manual do item do catálogo para uma entrada não encontrada
+type ResolveListImportEntryRequest struct {
+	ItemID uint `json:"item_id" binding:"required"`
+}
@@ -1,6 +1,11 @@
 package dto
 
 import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+
 	"github.com/rafaelc-rb/geekery-api/internal/models"
 )
 
@@ -30,6 +35,19 @@ func ItemToDTO(item *models.Item) *ItemDTO {
 		}
 	}
 
+	if item.Stats != nil {
+		dto.Stats = &ItemStatsDTO{
+			MeanScore:      item.Stats.MeanScore,
+			WeightedScore:  item.Stats.WeightedScore,
+			RatingCount:    item.Stats.RatingCount,
+			Members:        item.Stats.Members,
+			CompletedCount: item.Stats.CompletedCount,
+			DroppedCount:   item.Stats.DroppedCount,
+			FavoriteCount:  item.Stats.FavoriteCount,
+			UpdatedAt:      item.Stats.UpdatedAt,
+		}
+	}
+
 	// Adicionar dados específicos baseado no tipo
 	switch item.Type {
 	case models.MediaTypeAnime:
@@ -105,6 +123,7 @@ func UserItemToDTO(userItem *models.UserItem) *UserItemDTO {
 		ProgressType:    string(userItem.ProgressType),
 		ProgressData:    userItem.ProgressData,
 		CompletionCount: userItem.CompletionCount,
+		Private:         userItem.Private,
 		CreatedAt:       userItem.CreatedAt,
 		UpdatedAt:       userItem.UpdatedAt,
 	}
@@ -153,19 +172,293 @@ func TagsToDTOs(tags []models.Tag) []TagDTO {
 	return dtos
 }
 
-// StatsToDTO converte um mapa de estatísticas para UserListStatsDTO
-func StatsToDTO(stats map[string]int64) *UserListStatsDTO {
-	if stats == nil {
+// CollectionToDTO converte uma Collection para CollectionDTO (incluindo as entradas carregadas)
+func CollectionToDTO(collection *models.Collection) *CollectionDTO {
+	if collection == nil {
+		return nil
+	}
+
+	dto := &CollectionDTO{
+		ID:          collection.ID,
+		UserID:      collection.UserID,
+		Title:       collection.Title,
+		Description: collection.Description,
+		Visibility:  string(collection.Visibility),
+		ItemCount:   collection.ItemCount,
+		CreatedAt:   collection.CreatedAt,
+		UpdatedAt:   collection.UpdatedAt,
+	}
+
+	for i := range collection.Items {
+		entry := &collection.Items[i]
+		entryDTO := CollectionItemDTO{
+			ItemID:   entry.ItemID,
+			Position: entry.Position,
+			Note:     entry.Note,
+			AddedAt:  entry.CreatedAt,
+		}
+		if entry.Item.ID != 0 {
+			entryDTO.Item = ItemToDTO(&entry.Item)
+		}
+		dto.Items = append(dto.Items, entryDTO)
+	}
+
+	return dto
+}
+
+// CollectionsToDTOs converte uma slice de Collections para slice de CollectionDTOs
+func CollectionsToDTOs(collections []models.Collection) []CollectionDTO {
+	dtos := make([]CollectionDTO, len(collections))
+	for i, collection := range collections {
+		if dto := CollectionToDTO(&collection); dto != nil {
+			dtos[i] = *dto
+		}
+	}
+	return dtos
+}
+
+// ReviewToDTO converte uma Review para ReviewDTO (sem o voto do usuário autenticado)
+func ReviewToDTO(review *models.Review) *ReviewDTO {
+	if review == nil {
+		return nil
+	}
+
+	rating := review.Rating
+	if review.RatingHidden {
+		rating = 0
+	}
+
+	return &ReviewDTO{
+		ID:     review.ID,
+		ItemID: review.ItemID,
+		Author: ReviewAuthorDTO{
+			ID:       review.UserID,
+			Username: review.User.Username,
+			Name:     review.User.Name,
+		},
+		Title:        review.Title,
+		Body:         review.Body,
+		Spoiler:      review.Spoiler,
+		Recommended:  review.Recommended,
+		Rating:       rating,
+		HelpfulCount: review.HelpfulCount,
+		EditCount:    review.EditCount,
+		CreatedAt:    review.CreatedAt,
+		UpdatedAt:    review.UpdatedAt,
+	}
+}
+
+// ReviewRevisionsToDTOs converte o histórico de edições de uma resenha
+// hideRating zera as notas quando o autor as oculta (ver Review.RatingHidden)
+func ReviewRevisionsToDTOs(revisions []models.ReviewRevision, hideRating bool) []ReviewRevisionDTO {
+	dtos := make([]ReviewRevisionDTO, len(revisions))
+	for i, revision := range revisions {
+		if hideRating {
+			revision.Rating = 0
+		}
+		dtos[i] = ReviewRevisionDTO{
+			Title:       revision.Title,
+			Body:        revision.Body,
+			Spoiler:     revision.Spoiler,
+			Recommended: revision.Recommended,
+			Rating:      revision.Rating,
+			ReplacedAt:  revision.CreatedAt,
+		}
+	}
+	return dtos
+}
+
+// StatsToDTO converte as linhas agregadas e a linha do tempo de conclusões para UserListStatsDTO
+func StatsToDTO(aggregates []StatsAggregate, completionsByMonth []PeriodCount) *UserListStatsDTO {
+	stats := &UserListStatsDTO{
+		Ratings:     newRatingStats(),
+		ByMediaType: make(map[string]*MediaTypeStatsDTO),
+		Completions: CompletionStatsDTO{
+			ByMonth: []PeriodCount{},
+			ByYear:  []PeriodCount{},
+		},
+	}
+
+	for _, agg := range aggregates {
+		typeStats, ok := stats.ByMediaType[agg.MediaType]
+		if !ok {
+			typeStats = &MediaTypeStatsDTO{
+				ByStatus: make(map[string]int64),
+				Ratings:  newRatingStats(),
+			}
+			stats.ByMediaType[agg.MediaType] = typeStats
+		}
+
+		stats.Total += agg.Count
+		stats.Favorites += agg.Favorites
+		typeStats.Total += agg.Count
+		typeStats.Favorites += agg.Favorites
+		typeStats.ByStatus[agg.Status] += agg.Count
+
+		switch models.MediaStatus(agg.Status) {
+		case models.StatusInProgress:
+			stats.InProgress += agg.Count
+		case models.StatusCompleted:
+			stats.Completed += agg.Count
+		case models.StatusPlanned:
+			stats.Planned += agg.Count
+		case models.StatusPaused:
+			stats.Paused += agg.Count
+		case models.StatusDropped:
+			stats.Dropped += agg.Count
+		}
+
+		addRating(&stats.Ratings, agg)
+		addRating(&typeStats.Ratings, agg)
+		addTimeSpent(&stats.TimeSpent, agg)
+		addTimeSpent(&typeStats.TimeSpent, agg)
+	}
+
+	finishRating(&stats.Ratings)
+	for _, typeStats := range stats.ByMediaType {
+		finishRating(&typeStats.Ratings)
+	}
+
+	// Conclusões por ano derivadas das mensais (já ordenadas)
+	for _, month := range completionsByMonth {
+		stats.Completions.ByMonth = append(stats.Completions.ByMonth, month)
+
+		year := month.Period
+		if len(year) >= 4 {
+			year = year[:4]
+		}
+		last := len(stats.Completions.ByYear) - 1
+		if last >= 0 && stats.Completions.ByYear[last].Period == year {
+			stats.Completions.ByYear[last].Count += month.Count
+		} else {
+			stats.Completions.ByYear = append(stats.Completions.ByYear, PeriodCount{Period: year, Count: month.Count})
+		}
+	}
+
+	return stats
+}
+
+// newRatingStats cria um RatingStatsDTO com histograma vazio (1 a 10)
+func newRatingStats() RatingStatsDTO {
+	histogram := make(map[string]int64, 10)
+	for i := 1; i <= 10; i++ {
+		histogram[strconv.Itoa(i)] = 0
+	}
+	return RatingStatsDTO{Histogram: histogram}
+}
+
+// addRating acumula a linha agregada no histograma (a média é calculada em finishRating)
+func addRating(ratings *RatingStatsDTO, agg StatsAggregate) {
+	if agg.RatingBucket <= 0 {
+		return
+	}
+	ratings.Count += agg.Count
+	ratings.sum += agg.RatingSum
+	ratings.Histogram[strconv.Itoa(agg.RatingBucket)] += agg.Count
+}
+
+// finishRating calcula a média a partir da soma acumulada (2 casas decimais)
+func finishRating(ratings *RatingStatsDTO) {
+	if ratings.Count == 0 {
+		return
+	}
+	ratings.Mean = math.Round(ratings.sum/float64(ratings.Count)*100) / 100
+}
+
+// addTimeSpent acumula o consumo estimado da linha agregada
+func addTimeSpent(timeSpent *TimeSpentDTO, agg StatsAggregate) {
+	timeSpent.EpisodesWatched += int64(math.Round(agg.EpisodesWatched))
+	timeSpent.ChaptersRead += int64(math.Round(agg.ChaptersRead))
+	timeSpent.PagesRead += int64(math.Round(agg.PagesRead))
+	timeSpent.MinutesWatched += int64(math.Round(agg.MinutesWatched))
+	timeSpent.GameHours = math.Round((timeSpent.GameHours+agg.GameHours)*10) / 10
+}
+
+// ImportJobToDTO converte um ImportJob model para ImportJobDTO
+func ImportJobToDTO(job *models.ImportJob) *ImportJobDTO {
+	if job == nil {
+		return nil
+	}
+
+	jobDTO := &ImportJobDTO{
+		ID:          job.ID,
+		Status:      string(job.Status),
+		Format:      job.Format,
+		MediaType:   string(job.MediaType),
+		Mode:        job.Mode,
+		Transaction: job.Transaction,
+		DryRun:      job.DryRun,
+		FileName:    job.FileName,
+		Attempts:    job.Attempts,
+		Progress: ImportProgress{
+			Lines:    job.Lines,
+			Imported: job.Imported,
+			Failed:   job.Failed,
+		},
+		Error:      job.Error,
+		CreatedAt:  job.CreatedAt,
+		StartedAt:  job.StartedAt,
+		FinishedAt: job.FinishedAt,
+	}
+
+	// O resultado é gravado como JSONB; um valor que não decodifica é simplesmente omitido
+	if len(job.Result) > 0 {
+		if data, err := json.Marshal(job.Result); err == nil {
+			var result ImportResult
+			if json.Unmarshal(data, &result) == nil {
+				jobDTO.Result = &result
+			}
+		}
+	}
+
+	if job.Failed > 0 {
+		jobDTO.ErrorsURL = fmt.Sprintf("/api/imports/%d/errors", job.ID)
+	}
+
+	return jobDTO
+}
+
+// ListImportToDTO converte um ListImport model para ListImportDTO, com os totais por status das entradas
+func ListImportToDTO(listImport *models.ListImport, counts map[string]int) *ListImportDTO {
+	if listImport == nil {
+		return nil
+	}
+
+	importDTO := &ListImportDTO{
+		ID:        listImport.ID,
+		Source:    string(listImport.Source),
+		FileName:  listImport.FileName,
+		Total:     listImport.Total,
+		Imported:  counts[string(models.ListImportEntryImported)],
+		Skipped:   counts[string(models.ListImportEntrySkipped)],
+		Unmatched: counts[string(models.ListImportEntryUnmatched)],
+		Resolved:  counts[string(models.ListImportEntryResolved)],
+		Dismissed: counts[string(models.ListImportEntryDismissed)],
+		Failed:    counts[string(models.ListImportEntryFailed)],
+		CreatedAt: listImport.CreatedAt,
+	}
+	if importDTO.Unmatched > 0 {
+		importDTO.ReviewURL = fmt.Sprintf("/api/my-list/imports/%d/entries?status=unmatched", listImport.ID)
+	}
+
+	return importDTO
+}
+
+// DataExportToDTO converte um DataExport model para DataExportDTO (sem o link de download, que é assinado pelo service)
+func DataExportToDTO(export *models.DataExport) *DataExportDTO {
+	if export == nil {
 		return nil
 	}
 
-	return &UserListStatsDTO{
-		Total:      stats["total"],
-		InProgress: stats["in_progress"],
-		Completed:  stats["completed"],
-		Planned:    stats["planned"],
-		Paused:     stats["paused"],
-		Dropped:    stats["dropped"],
-		Favorites:  stats["favorites"],
+	return &DataExportDTO{
+		ID:         export.ID,
+		Status:     string(export.Status),
+		Attempts:   export.Attempts,
+		Size:       export.Size,
+		Error:      export.Error,
+		CreatedAt:  export.CreatedAt,
+		StartedAt:  export.StartedAt,
+		FinishedAt: export.FinishedAt,
+		ExpiresAt:  export.ExpiresAt,
 	}
 }
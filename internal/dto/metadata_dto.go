@@ -0,0 +1,15 @@
+package dto
+
+// EnrichItemParams representa as opções do enriquecimento de um item com metadados externos
+type EnrichItemParams struct {
+	Provider  string `form:"provider" binding:"omitempty,oneof=anilist tmdb igdb openlibrary"` // Vazio tenta os providers do tipo, em ordem
+	Overwrite bool   `form:"overwrite"`                                                        // Substitui campos já preenchidos (padrão: só preenche os vazios)
+}
+
+// EnrichItemResult representa o item após o enriquecimento
+type EnrichItemResult struct {
+	Item       *ItemDTO `json:"item"`
+	Provider   string   `json:"provider"`    // Provider que encontrou o item
+	ExternalID string   `json:"external_id"` // ID do item no provider
+	Updated    []string `json:"updated"`     // Campos alterados; vazio quando o item já estava completo
+}
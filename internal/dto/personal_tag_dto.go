@@ -0,0 +1,13 @@
+package dto
+
+// PersonalTagRequest representa o payload de criação/atualização de rótulo pessoal
+type PersonalTagRequest struct {
+	Name  string `json:"name" binding:"required,min=1,max=50"`
+	Color string `json:"color" binding:"omitempty,hexcolor"` // Ex: #ff8800
+}
+
+// SetPersonalTagsRequest representa os rótulos pessoais de uma entrada da lista
+// Substitui os rótulos atuais; lista vazia remove todos
+type SetPersonalTagsRequest struct {
+	TagIDs []uint `json:"tag_ids"`
+}
@@ -0,0 +1,53 @@
+package dto
+
+import "time"
+
+// Formatos aceitos pelo recap anual
+const (
+	RecapFormatJSON = "json"
+	RecapFormatSVG  = "svg"
+	RecapFormatPNG  = "png"
+)
+
+// RecapDTO representa o resumo anual ("wrapped") da lista do usuário
+// Calculado a partir das datas do history (started_at/finished_at) e do CompletionCount
+type RecapDTO struct {
+	Year             int                     `json:"year"`
+	TotalCompletions int                     `json:"total_completions"` // Conclusões no ano (inclui re-watches)
+	ItemsCompleted   int                     `json:"items_completed"`   // Items distintos concluídos no ano
+	ItemsStarted     int                     `json:"items_started"`
+	Completed        []RecapItemDTO          `json:"completed"`
+	TopRated         []RecapItemDTO          `json:"top_rated"`
+	TopTags          []TagCountDTO           `json:"top_tags"`
+	TimeByMediaType  map[string]TimeSpentDTO `json:"time_by_media_type"`
+	LongestStreak    StreakDTO               `json:"longest_streak"`
+	MostRewatched    *RecapItemDTO           `json:"most_rewatched,omitempty"`
+	FirstCompletion  *RecapItemDTO           `json:"first_completion,omitempty"`
+	LastCompletion   *RecapItemDTO           `json:"last_completion,omitempty"`
+}
+
+// RecapItemDTO representa um item da lista no recap
+type RecapItemDTO struct {
+	UserItemID      uint       `json:"user_item_id"`
+	ItemID          uint       `json:"item_id"`
+	Title           string     `json:"title"`
+	Type            string     `json:"type"`
+	CoverURL        string     `json:"cover_url,omitempty"`
+	Rating          float64    `json:"rating"`
+	CompletionCount int        `json:"completion_count"`
+	CompletedAt     *time.Time `json:"completed_at,omitempty"` // Última conclusão dentro do ano
+}
+
+// TagCountDTO representa uma tag e quantas vezes ela aparece
+type TagCountDTO struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// StreakDTO representa a maior sequência de dias consecutivos com atividade
+// Um dia tem atividade quando alguma visualização começou ou terminou nele
+type StreakDTO struct {
+	Days      int    `json:"days"`
+	StartDate string `json:"start_date,omitempty"` // YYYY-MM-DD
+	EndDate   string `json:"end_date,omitempty"`   // YYYY-MM-DD
+}
@@ -0,0 +1,69 @@
+package dto
+
+// RecommendationFilter representa os filtros das recomendações do usuário
+type RecommendationFilter struct {
+	Type  string `form:"type"`                                   // Tipo de mídia (opcional)
+	Limit int    `form:"limit" binding:"omitempty,min=1,max=50"` // Padrão: 20
+}
+
+// RecommendationReasonDTO explica por que um item foi recomendado
+type RecommendationReasonDTO struct {
+	ItemID  uint    `json:"item_id,omitempty"` // Item da lista do usuário que originou a recomendação
+	Title   string  `json:"title,omitempty"`
+	Rating  float64 `json:"rating,omitempty"`
+	Status  string  `json:"status,omitempty"`
+	Message string  `json:"message"` // Ex: "because you rated Steins;Gate 9/10"
+}
+
+// RecommendationDTO representa um item recomendado para o usuário
+type RecommendationDTO struct {
+	Item    *ItemDTO                  `json:"item"`
+	Score   float64                   `json:"score"`
+	Reasons []RecommendationReasonDTO `json:"reasons"`
+}
+
+// SimilarItemsFilter representa os filtros da listagem de items similares
+type SimilarItemsFilter struct {
+	SameType bool   `form:"same_type"`                              // Apenas items do mesmo tipo de mídia
+	Type     string `form:"type"`                                   // Tipo de mídia específico (ignorado com same_type)
+	Limit    int    `form:"limit" binding:"omitempty,min=1,max=50"` // Padrão: 10
+}
+
+// SimilarItemDTO representa um item similar a outro ("mais como este")
+type SimilarItemDTO struct {
+	Item          *ItemDTO `json:"item"`
+	Score         float64  `json:"score"`          // Similaridade combinada (0-1)
+	SharedTags    []string `json:"shared_tags"`    // Tags em comum
+	SameCreator   bool     `json:"same_creator"`   // Mesmo estúdio, diretor, desenvolvedora ou autor
+	SharedMembers int64    `json:"shared_members"` // Usuários que têm os dois items na lista
+}
+
+// ItemFeatures representa os atributos de conteúdo de um item usados na similaridade
+type ItemFeatures struct {
+	ItemID    uint
+	MediaType string
+	Creator   string // Estúdio, diretor, desenvolvedora ou autor, conforme o tipo
+	TagIDs    []uint
+}
+
+// UserItemSignal representa a interação de um usuário com um item (nota, status e favorito)
+type UserItemSignal struct {
+	UserID   uint
+	ItemID   uint
+	Rating   float64
+	Status   string
+	Favorite bool
+}
+
+// TagFrequency representa em quantos items uma tag aparece
+type TagFrequency struct {
+	TagID     uint
+	ItemCount int64
+}
+
+// ItemCoOccurrence representa quantas listas contêm um item junto com outro
+type ItemCoOccurrence struct {
+	ItemID  uint
+	Shared  int64 // Listas que contêm os dois items
+	Members int64 // Listas que contêm este item
+}
@@ -0,0 +1,60 @@
+package dto
+
+import "time"
+
+// Campos de ordenação aceitos na listagem de resenhas
+const (
+	ReviewSortHelpful = "helpful"
+	ReviewSortRecent  = "recent"
+	ReviewSortRating  = "rating"
+)
+
+// ReviewRequest representa o payload de criação/edição de resenha
+// A nota não é enviada: é copiada da lista do usuário no momento da escrita
+type ReviewRequest struct {
+	Title       string `json:"title" binding:"required,min=1,max=200"`
+	Body        string `json:"body" binding:"required,min=1,max=20000"` // Markdown
+	Spoiler     bool   `json:"spoiler"`
+	Recommended *bool  `json:"recommended" binding:"required"`
+}
+
+// ReviewFilter representa filtros e ordenação da listagem de resenhas de um item
+type ReviewFilter struct {
+	SortBy       string `form:"sort" binding:"omitempty,oneof=helpful recent rating"` // Padrão: helpful
+	Recommended  *bool  `form:"recommended"`
+	HideSpoilers bool   `form:"hide_spoilers"`
+}
+
+// ReviewAuthorDTO representa o autor de uma resenha
+type ReviewAuthorDTO struct {
+	ID       uint   `json:"id"`
+	Username string `json:"username"`
+	Name     string `json:"name"`
+}
+
+// ReviewDTO representa uma resenha para resposta da API
+type ReviewDTO struct {
+	ID           uint            `json:"id"`
+	ItemID       uint            `json:"item_id"`
+	Author       ReviewAuthorDTO `json:"author"`
+	Title        string          `json:"title"`
+	Body         string          `json:"body"`
+	Spoiler      bool            `json:"spoiler"`
+	Recommended  bool            `json:"recommended"`
+	Rating       float64         `json:"rating"`
+	HelpfulCount int64           `json:"helpful_count"`
+	VotedHelpful bool            `json:"voted_helpful"` // Se o usuário autenticado votou como útil
+	EditCount    int64           `json:"edit_count"`    // Número de edições (versões em /history)
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+}
+
+// ReviewRevisionDTO representa uma versão anterior de uma resenha
+type ReviewRevisionDTO struct {
+	Title       string    `json:"title"`
+	Body        string    `json:"body"`
+	Spoiler     bool      `json:"spoiler"`
+	Recommended bool      `json:"recommended"`
+	Rating      float64   `json:"rating"`
+	ReplacedAt  time.Time `json:"replaced_at"`
+}
@@ -0,0 +1,96 @@
+package dto
+
+import "time"
+
+// Tipos de evento exibidos no feed
+// "started" corresponde a uma transição de status para in_progress
+const (
+	FeedEventAdded     = "added"
+	FeedEventStarted   = "started"
+	FeedEventCompleted = "completed"
+	FeedEventRated     = "rated"
+	FeedEventReviewed  = "reviewed"
+)
+
+// UserSummaryDTO representa um usuário em listagens sociais (seguidores, feed)
+type UserSummaryDTO struct {
+	ID       uint   `json:"id"`
+	Username string `json:"username"`
+	Name     string `json:"name"`
+}
+
+// ProfileDTO representa o perfil público de um usuário
+// Quando o perfil não é visível para quem consulta, Restricted é true e Stats é omitido
+type ProfileDTO struct {
+	ID                uint              `json:"id"`
+	Username          string            `json:"username"`
+	Name              string            `json:"name"`
+	ProfileVisibility string            `json:"profile_visibility"`
+	FollowersCount    int64             `json:"followers_count"`
+	FollowingCount    int64             `json:"following_count"`
+	IsFollowing       bool              `json:"is_following"`     // Se o usuário autenticado segue este perfil
+	FollowsYou        bool              `json:"follows_you"`      // Se este perfil segue o usuário autenticado
+	FollowRequested   bool              `json:"follow_requested"` // Se o usuário autenticado tem uma solicitação pendente para seguir este perfil
+	Restricted        bool              `json:"restricted"`
+	Stats             *UserListStatsDTO `json:"stats,omitempty"` // Calculadas apenas sobre as entradas públicas
+	CreatedAt         time.Time         `json:"created_at"`
+}
+
+// FollowResultDTO representa o resultado de seguir um usuário
+type FollowResultDTO struct {
+	Status string `json:"status"` // accepted ou pending (aguardando aprovação)
+}
+
+// FeedItemDTO representa o item do catálogo citado em um evento do feed
+type FeedItemDTO struct {
+	ID       uint   `json:"id"`
+	Title    string `json:"title"`
+	Type     string `json:"type"`
+	CoverURL string `json:"cover_url,omitempty"`
+}
+
+// FeedEventDTO representa um evento da lista de um usuário seguido
+type FeedEventDTO struct {
+	ID          uint           `json:"id"`
+	Kind        string         `json:"kind"` // added, started, completed, rated, reviewed
+	User        UserSummaryDTO `json:"user"`
+	Item        FeedItemDTO    `json:"item"`
+	Status      string         `json:"status,omitempty"`
+	Rating      float64        `json:"rating,omitempty"`
+	Completions int            `json:"completions,omitempty"`
+	OccurredAt  time.Time      `json:"occurred_at"`
+}
+
+// FeedEventRow representa uma linha da query do feed (evento + usuário + item)
+type FeedEventRow struct {
+	ID          uint
+	Kind        string
+	UserID      uint
+	Username    string
+	Name        string
+	ItemID      uint
+	Title       string
+	MediaType   string
+	CoverURL    string
+	Status      string
+	Rating      float64
+	Completions int
+	OccurredAt  time.Time
+}
+
+// PrivacySettingsDTO representa as configurações de privacidade do usuário
+type PrivacySettingsDTO struct {
+	ProfileVisibility string   `json:"profile_visibility"` // public, followers ou private
+	HiddenStatuses    []string `json:"hidden_statuses"`    // Status ocultos da lista pública e do feed
+	HideRatings       bool     `json:"hide_ratings"`
+	HideNotes         bool     `json:"hide_notes"`
+}
+
+// UpdatePrivacySettingsRequest representa o payload de atualização das configurações de privacidade
+// Substitui todas as configurações; campos omitidos voltam ao padrão (nada oculto)
+type UpdatePrivacySettingsRequest struct {
+	ProfileVisibility string   `json:"profile_visibility" binding:"required,oneof=public followers private"`
+	HiddenStatuses    []string `json:"hidden_statuses" binding:"omitempty,dive,oneof=planned in_progress completed paused dropped"`
+	HideRatings       bool     `json:"hide_ratings"`
+	HideNotes         bool     `json:"hide_notes"`
+}
@@ -25,3 +25,51 @@ type TagListResponse struct {
 	Tags  []TagDTO `json:"tags"`
 	Total int      `json:"total"`
 }
+
+// Campos de ordenação aceitos na listagem de tags
+const (
+	TagSortName   = "name"
+	TagSortUsage  = "usage"
+	TagSortRecent = "recent"
+)
+
+// TagFilter representa busca, filtro e ordenação da listagem de tags
+type TagFilter struct {
+	Query    string `form:"q"` // Busca no nome e nos aliases
+	Category string `form:"category" binding:"omitempty,oneof=genre theme demographic setting content_warning"`
+	SortBy   string `form:"sort" binding:"omitempty,oneof=name usage recent"`
+}
+
+// TagUsageDTO representa uma tag com suas contagens de uso
+type TagUsageDTO struct {
+	ID                uint             `json:"id"`
+	Name              string           `json:"name"`
+	Category          string           `json:"category,omitempty"`
+	ParentID          *uint            `json:"parent_id,omitempty"`
+	ItemCount         int64            `json:"item_count"`
+	CountsByMediaType map[string]int64 `json:"counts_by_media_type"`
+	CreatedAt         time.Time        `json:"created_at"`
+	UpdatedAt         time.Time        `json:"updated_at"`
+}
+
+// TagMediaTypeCount representa uma linha agregada de uso de tag por tipo de mídia
+type TagMediaTypeCount struct {
+	TagID     uint
+	MediaType string
+	Count     int64
+}
+
+// TagSuggestionDTO representa uma sugestão de autocomplete de tag
+type TagSuggestionDTO struct {
+	ID        uint   `json:"id"`
+	Name      string `json:"name"`
+	Category  string `json:"category,omitempty"`
+	ItemCount int64  `json:"item_count"`
+}
+
+// TagCleanupResult representa o resultado da limpeza de tags órfãs
+type TagCleanupResult struct {
+	DryRun  bool     `json:"dry_run"`
+	Deleted int      `json:"deleted"` // Em dry_run: quantas seriam removidas
+	Tags    []string `json:"tags"`
+}
@@ -14,11 +14,38 @@ type UserItemDTO struct {
 	ProgressType    string                 `json:"progress_type"`
 	ProgressData    map[string]interface{} `json:"progress_data,omitempty"`
 	CompletionCount int                    `json:"completion_count"`
+	Private         bool                   `json:"private"`
 	Item            *ItemDTO               `json:"item,omitempty"`
 	CreatedAt       time.Time              `json:"created_at"`
 	UpdatedAt       time.Time              `json:"updated_at"`
 }
 
+// Campos de ordenação aceitos pela lista pessoal
+const (
+	SortByRating    = "rating"
+	SortByUpdatedAt = "updated_at"
+	SortByAdded     = "added"
+	SortByTitle     = "title"
+	SortByProgress  = "progress"
+)
+
+// UserItemFilter representa filtros, busca e ordenação da lista pessoal
+// Campos vazios/nil não filtram; listas aceitam valores separados por vírgula
+type UserItemFilter struct {
+	Statuses     []string   `form:"status" collection_format:"csv"`
+	MediaTypes   []string   `form:"type" collection_format:"csv"`
+	Tags         []string   `form:"tags" collection_format:"csv"`          // Nomes de tags (qualquer uma)
+	PersonalTags []string   `form:"personal_tags" collection_format:"csv"` // Nomes de rótulos pessoais (qualquer um)
+	MinRating    *float64   `form:"min_rating" binding:"omitempty,min=0,max=10"`
+	MaxRating    *float64   `form:"max_rating" binding:"omitempty,min=0,max=10"`
+	Favorite     *bool      `form:"favorite"`
+	Rewatching   *bool      `form:"rewatching"`
+	UpdatedSince *time.Time `form:"-"` // Parseado pelo handler (YYYY-MM-DD ou RFC3339)
+	Query        string     `form:"q"`
+	SortBy       string     `form:"sort" binding:"omitempty,oneof=rating updated_at added title progress"`
+	SortOrder    string     `form:"order" binding:"omitempty,oneof=asc desc"`
+}
+
 // AddToListRequest representa o payload para adicionar item à lista
 type AddToListRequest struct {
 	ItemID uint   `json:"item_id" binding:"required"`
@@ -44,12 +71,118 @@ type UpdateProgressRequest struct {
 
 // UserListStatsDTO representa estatísticas da lista do usuário
 type UserListStatsDTO struct {
-	Total       int64            `json:"total"`
-	InProgress  int64            `json:"in_progress"`
-	Completed   int64            `json:"completed"`
-	Planned     int64            `json:"planned"`
-	Paused      int64            `json:"paused"`
-	Dropped     int64            `json:"dropped"`
-	Favorites   int64            `json:"favorites"`
-	ByMediaType map[string]int64 `json:"by_media_type,omitempty"`
+	Total       int64                         `json:"total"`
+	InProgress  int64                         `json:"in_progress"`
+	Completed   int64                         `json:"completed"`
+	Planned     int64                         `json:"planned"`
+	Paused      int64                         `json:"paused"`
+	Dropped     int64                         `json:"dropped"`
+	Favorites   int64                         `json:"favorites"`
+	Ratings     RatingStatsDTO                `json:"ratings"`
+	TimeSpent   TimeSpentDTO                  `json:"time_spent"`
+	Completions CompletionStatsDTO            `json:"completions"`
+	ByMediaType map[string]*MediaTypeStatsDTO `json:"by_media_type,omitempty"`
+}
+
+// MediaTypeStatsDTO representa as estatísticas de um tipo de mídia (matriz de status)
+type MediaTypeStatsDTO struct {
+	Total     int64            `json:"total"`
+	ByStatus  map[string]int64 `json:"by_status"`
+	Favorites int64            `json:"favorites"`
+	Ratings   RatingStatsDTO   `json:"ratings"`
+	TimeSpent TimeSpentDTO     `json:"time_spent"`
+}
+
+// RatingStatsDTO representa a distribuição de notas (histograma de 1 a 10) e a média
+// Items sem nota (rating 0) não entram na distribuição
+type RatingStatsDTO struct {
+	Count     int64            `json:"count"`
+	Mean      float64          `json:"mean"`
+	Histogram map[string]int64 `json:"histogram"`
+	sum       float64          // Soma das notas, usada para calcular Mean
+}
+
+// TimeSpentDTO representa o consumo estimado a partir do ProgressData e dos dados do catálogo
+// Completions anteriores contam o total do item (episódios, capítulos, runtime, tempo médio de jogo)
+type TimeSpentDTO struct {
+	EpisodesWatched int64   `json:"episodes_watched"`
+	ChaptersRead    int64   `json:"chapters_read"`
+	PagesRead       int64   `json:"pages_read"`
+	MinutesWatched  int64   `json:"minutes_watched"` // Filmes (runtime do catálogo)
+	GameHours       float64 `json:"game_hours"`
+}
+
+// CompletionStatsDTO representa conclusões agrupadas por período (a partir do history)
+type CompletionStatsDTO struct {
+	ByMonth []PeriodCount `json:"by_month"`
+	ByYear  []PeriodCount `json:"by_year"`
+}
+
+// PeriodCount representa uma contagem em um período (YYYY ou YYYY-MM)
+type PeriodCount struct {
+	Period string `json:"period"`
+	Count  int64  `json:"count"`
+}
+
+// StatsAggregate representa uma linha da query agregada de estatísticas
+// Agrupada por tipo de mídia, status e nota arredondada (0 = sem nota)
+type StatsAggregate struct {
+	MediaType       string
+	Status          string
+	RatingBucket    int
+	Count           int64
+	Favorites       int64
+	RatingSum       float64
+	EpisodesWatched float64
+	ChaptersRead    float64
+	PagesRead       float64
+	MinutesWatched  float64
+	GameHours       float64
+}
+
+// Operações aceitas pelo endpoint de bulk da lista
+const (
+	BulkOpAdd          = "add"
+	BulkOpUpdateStatus = "update_status"
+	BulkOpSetFavorite  = "set_favorite"
+	BulkOpDelete       = "delete"
+	BulkOpSetPersonal  = "set_personal_tags"
+)
+
+// BulkOperation representa uma operação individual de um lote
+// Entradas existentes podem ser referenciadas por id (user item) ou item_id
+type BulkOperation struct {
+	Op             string `json:"op" binding:"required,oneof=add update_status set_favorite delete set_personal_tags"`
+	ID             uint   `json:"id,omitempty"`
+	ItemID         uint   `json:"item_id,omitempty"`
+	Status         string `json:"status,omitempty"`
+	Favorite       *bool  `json:"favorite,omitempty"`
+	PersonalTagIDs []uint `json:"personal_tag_ids,omitempty"` // set_personal_tags: rótulos pessoais (vazio remove todos)
+}
+
+// BulkOperationsRequest representa o payload do endpoint de bulk
+type BulkOperationsRequest struct {
+	Operations   []BulkOperation `json:"operations" binding:"required,min=1,max=500,dive"`
+	AllOrNothing bool            `json:"all_or_nothing"` // Se true, qualquer falha desfaz o lote inteiro
+}
+
+// BulkOperationResult representa o resultado de uma operação do lote
+type BulkOperationResult struct {
+	Index      int    `json:"index"`
+	Op         string `json:"op"`
+	ItemID     uint   `json:"item_id,omitempty"`
+	UserItemID uint   `json:"user_item_id,omitempty"`
+	Success    bool   `json:"success"`
+	Error      string `json:"error,omitempty"`
+}
+
+// BulkResult representa o resultado de um lote de operações (similar a ImportResult)
+type BulkResult struct {
+	Success      bool                  `json:"success"`
+	AllOrNothing bool                  `json:"all_or_nothing"`
+	RolledBack   bool                  `json:"rolled_back"`
+	Total        int                   `json:"total"`
+	Succeeded    int                   `json:"succeeded"`
+	Failed       int                   `json:"failed"`
+	Results      []BulkOperationResult `json:"results"`
 }
@@ -0,0 +1,192 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/services"
+)
+
+type AccountHandler struct {
+	service *services.AccountService
+}
+
+// NewAccountHandler cria uma nova instância do handler da conta
+func NewAccountHandler(service *services.AccountService) *AccountHandler {
+	return &AccountHandler{service: service}
+}
+
+// RequestDataExport enfileira o export de todos os dados da conta
+// @Summary      Request account data export
+// @Description  Queue a ZIP of JSON files with every piece of data tied to the account: profile, list (with the full progress_data and view history), reviews with revisions, helpful votes, collections, personal tags, goals, follows, activity, list imports and tokens metadata.
+// @Description  The ZIP is generated in the background; poll /account/exports/{id} until status is completed to get a signed download_url, valid until expires_at
+// @Tags         account
+// @Produce      json
+// @Success      202  {object}  dto.DataExportDTO  "Export queued"
+// @Failure      409  {object}  map[string]string  "An export is already queued or running"
+// @Failure      500  {object}  map[string]string  "Internal server error"
+// @Router       /account/exports [post]
+func (h *AccountHandler) RequestDataExport(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	export, err := h.service.RequestExport(ctx, userID)
+	if err != nil {
+		respondAccountError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusAccepted, export)
+}
+
+// GetDataExports retorna os exports da conta
+// @Summary      List account data exports
+// @Description  List the authenticated user's data exports (newest first); available ones include a signed download_url
+// @Tags         account
+// @Produce      json
+// @Param        page   query  int  false  "Page number (default: 1)"
+// @Param        limit  query  int  false  "Items per page (default: 20, max: 100)"
+// @Success      200  {object}  dto.PaginatedResponse{data=[]dto.DataExportDTO}  "Success - returns data exports"
+// @Failure      500  {object}  map[string]string  "Internal server error"
+// @Router       /account/exports [get]
+func (h *AccountHandler) GetDataExports(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	var params dto.PaginationParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+	params.Normalize()
+
+	exports, total, err := h.service.GetExports(ctx, userID, params)
+	if err != nil {
+		respondAccountError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, dto.NewPaginatedResponse(exports, params.Page, params.Limit, total))
+}
+
+// GetDataExport retorna um export da conta
+// @Summary      Get account data export
+// @Description  Get a data export with its status; once completed, download_url is a signed link valid until expires_at
+// @Tags         account
+// @Produce      json
+// @Param        id  path  int  true  "Data export ID"
+// @Success      200  {object}  dto.DataExportDTO  "Data export"
+// @Failure      400  {object}  map[string]string  "Bad request - invalid ID"
+// @Failure      404  {object}  map[string]string  "Data export not found"
+// @Router       /account/exports/{id} [get]
+func (h *AccountHandler) GetDataExport(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	id, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	export, err := h.service.GetExport(ctx, userID, id)
+	if err != nil {
+		respondAccountError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, export)
+}
+
+// DownloadDataExport baixa o ZIP de um export pelo link assinado
+// @Summary      Download account data export
+// @Description  Download the export ZIP. No authentication header is needed: the link from download_url is signed and expires with the export
+// @Tags         account
+// @Produce      application/zip
+// @Param        id         path   int     true  "Data export ID"
+// @Param        expires    query  int     true  "Link expiration (Unix timestamp)"
+// @Param        signature  query  string  true  "Link signature"
+// @Success      200  {file}    file               "Export ZIP"
+// @Failure      400  {object}  map[string]string  "Bad request - invalid ID or missing signature"
+// @Failure      403  {object}  map[string]string  "Invalid or expired link"
+// @Failure      404  {object}  map[string]string  "Data export not found or no longer available"
+// @Router       /account/exports/{id}/download [get]
+func (h *AccountHandler) DownloadDataExport(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	var params dto.DownloadSignatureParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+
+	path, err := h.service.DownloadPath(ctx, id, params.Expires, params.Signature)
+	if err != nil {
+		respondAccountError(c, err)
+		return
+	}
+
+	c.Header("Content-Type", "application/zip")
+	c.FileAttachment(path, fmt.Sprintf("geekery-export-%d.zip", id))
+}
+
+// DeleteAccount apaga definitivamente a conta
+// @Summary      Delete my account
+// @Description  Permanently erase the account and every row tied to it (list, reviews and their votes, collections, personal tags, goals, follows, activity, list imports and data exports). Soft-deleted rows are erased too. Requires the current password
+// @Tags         account
+// @Accept       json
+// @Produce      json
+// @Param        request  body      dto.DeleteAccountRequest   true  "Password confirmation"
+// @Success      200      {object}  dto.AccountErasureResult   "Account erased - rows deleted per table"
+// @Failure      400      {object}  map[string]string          "Bad request - validation error"
+// @Failure      401      {object}  map[string]string          "Wrong password"
+// @Failure      404      {object}  map[string]string          "User not found"
+// @Router       /account [delete]
+func (h *AccountHandler) DeleteAccount(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	var req dto.DeleteAccountRequest
+	if err := validateAndBind(c, &req); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+
+	result, err := h.service.DeleteAccount(ctx, userID, req.Password)
+	if err != nil {
+		respondAccountError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, result)
+}
+
+// respondAccountError mapeia os erros da conta e dos exports para respostas HTTP
+func respondAccountError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, models.ErrDataExportNotFound):
+		respondNotFound(c, "Data export")
+	case errors.Is(err, models.ErrUserNotFound):
+		respondNotFound(c, "User")
+	case errors.Is(err, models.ErrDataExportNotReady):
+		respondError(c, http.StatusNotFound, dto.ErrCodeNotFound, err.Error())
+	case errors.Is(err, models.ErrDataExportInProgress):
+		respondError(c, http.StatusConflict, dto.ErrCodeValidation, err.Error())
+	case errors.Is(err, models.ErrInvalidDownloadSignature):
+		respondError(c, http.StatusForbidden, dto.ErrCodeForbidden, err.Error())
+	case errors.Is(err, models.ErrInvalidPassword):
+		respondError(c, http.StatusUnauthorized, dto.ErrCodeInvalidCredentials, err.Error())
+	default:
+		respondInternalError(c, err)
+	}
+}
@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/services"
+	"github.com/rafaelc-rb/geekery-api/internal/testutil"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func setupAccountHandler(t *testing.T) (*AccountHandler, *testutil.MockDataExportRepository) {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+	userRepo := &testutil.MockUserRepository{
+		GetByIDFunc: func(ctx context.Context, id uint) (*models.User, error) {
+			return &models.User{ID: id, Username: "alice", PasswordHash: string(hash)}, nil
+		},
+	}
+	exportRepo := &testutil.MockDataExportRepository{}
+	service := services.NewAccountService(userRepo, &testutil.MockUserItemRepository{}, exportRepo, &testutil.MockAccountRepository{}, nil,
+		t.TempDir(), time.Hour, "test-secret-key-for-account-exports")
+	gin.SetMode(gin.TestMode)
+	return NewAccountHandler(service), exportRepo
+}
+
+func TestAccountHandler_RequestDataExport(t *testing.T) {
+	handler, exportRepo := setupAccountHandler(t)
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(1))
+	router.POST("/account/exports", handler.RequestDataExport)
+
+	req, _ := http.NewRequest("POST", "/account/exports", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusAccepted {
+		t.Errorf("Expected status 202, got %d: %s", w.Code, w.Body.String())
+	}
+
+	exportRepo.HasPendingFunc = func(ctx context.Context, userID uint) (bool, error) {
+		return true, nil
+	}
+	req, _ = http.NewRequest("POST", "/account/exports", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusConflict {
+		t.Errorf("Expected status 409, got %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestAccountHandler_DownloadDataExportInvalidLink(t *testing.T) {
+	handler, _ := setupAccountHandler(t)
+
+	router := gin.New()
+	router.GET("/account/exports/:id/download", handler.DownloadDataExport)
+
+	tests := []struct {
+		name string
+		url  string
+		want int
+	}{
+		{"missing signature", "/account/exports/1/download", http.StatusBadRequest},
+		{"invalid ID", "/account/exports/abc/download?expires=1&signature=x", http.StatusBadRequest},
+		{"forged signature", "/account/exports/1/download?expires=4102444800&signature=abc", http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("GET", tt.url, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			if w.Code != tt.want {
+				t.Errorf("Expected status %d, got %d: %s", tt.want, w.Code, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestAccountHandler_DeleteAccount(t *testing.T) {
+	handler, _ := setupAccountHandler(t)
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(1))
+	router.DELETE("/account", handler.DeleteAccount)
+
+	tests := []struct {
+		name string
+		body string
+		want int
+	}{
+		{"missing password", `{}`, http.StatusBadRequest},
+		{"wrong password", `{"password":"nope"}`, http.StatusUnauthorized},
+		{"confirmed", `{"password":"password123"}`, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("DELETE", "/account", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			if w.Code != tt.want {
+				t.Errorf("Expected status %d, got %d: %s", tt.want, w.Code, w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/services"
+)
+
+type ActivityHandler struct {
+	activityService *services.ActivityService
+}
+
+// NewActivityHandler cria uma nova instância do handler de atividade
+func NewActivityHandler(activityService *services.ActivityService) *ActivityHandler {
+	return &ActivityHandler{activityService: activityService}
+}
+
+// GetActivity retorna o heatmap diário de atividade e as streaks do usuário
+// @Summary      Get activity heatmap
+// @Description  Daily activity counts (GitHub-style heatmap) from list status transitions and progress updates, plus current and longest streak. Defaults to the last 365 days
+// @Tags         me
+// @Accept       json
+// @Produce      json
+// @Param        from  query     string  false  "Start date (YYYY-MM-DD)"
+// @Param        to    query     string  false  "End date, inclusive (YYYY-MM-DD, default: today)"
+// @Success      200  {object}  dto.ActivityDTO     "Success - returns activity"
+// @Failure      400  {object}  map[string]string   "Bad request - invalid date range"
+// @Failure      500  {object}  map[string]string   "Internal server error"
+// @Router       /me/activity [get]
+func (h *ActivityHandler) GetActivity(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	from, err := parseOptionalTimeQuery(c, "from")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, err.Error())
+		return
+	}
+
+	to, err := parseOptionalTimeQuery(c, "to")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, err.Error())
+		return
+	}
+
+	activity, err := h.activityService.GetActivity(ctx, userID, from, to)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidDateRange) {
+			respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, err.Error())
+			return
+		}
+		respondInternalError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, activity)
+}
@@ -0,0 +1,406 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/services"
+	"gorm.io/gorm"
+)
+
+type CollectionHandler struct {
+	collectionService *services.CollectionService
+}
+
+// NewCollectionHandler cria uma nova instância do handler de coleções
+func NewCollectionHandler(collectionService *services.CollectionService) *CollectionHandler {
+	return &CollectionHandler{collectionService: collectionService}
+}
+
+// CreateCollection cria uma coleção para o usuário
+// @Summary      Create collection
+// @Description  Create a named, ordered collection that can mix media types (private by default)
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        collection  body  dto.CollectionRequest  true  "Collection data"
+// @Success      201  {object}  dto.CollectionDTO   "Collection created"
+// @Failure      400  {object}  map[string]string   "Bad request - validation error"
+// @Failure      500  {object}  map[string]string   "Internal server error"
+// @Router       /collections [post]
+func (h *CollectionHandler) CreateCollection(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	var req dto.CollectionRequest
+	if err := validateAndBind(c, &req); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+
+	collection, err := h.collectionService.CreateCollection(ctx, userID, req)
+	if err != nil {
+		h.respondCollectionError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusCreated, collection)
+}
+
+// GetMyCollections retorna as coleções do usuário
+// @Summary      List my collections
+// @Description  List the authenticated user's collections (without entries) with pagination
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        page   query     int  false  "Page number (default: 1)"
+// @Param        limit  query     int  false  "Items per page (default: 20, max: 100)"
+// @Success      200  {object}  dto.PaginatedResponse  "Success - returns collections"
+// @Failure      500  {object}  map[string]string      "Internal server error"
+// @Router       /collections [get]
+func (h *CollectionHandler) GetMyCollections(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	var params dto.PaginationParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+	params.Normalize()
+
+	collections, total, err := h.collectionService.GetMyCollections(ctx, userID, params)
+	if err != nil {
+		respondInternalError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, dto.NewPaginatedResponse(collections, params.Page, params.Limit, total))
+}
+
+// GetPublicCollections retorna as coleções públicas
+// @Summary      List public collections
+// @Description  Browse public collections from all users (without entries)
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        page   query     int  false  "Page number (default: 1)"
+// @Param        limit  query     int  false  "Items per page (default: 20, max: 100)"
+// @Success      200  {object}  dto.PaginatedResponse  "Success - returns collections"
+// @Failure      500  {object}  map[string]string      "Internal server error"
+// @Router       /collections/public [get]
+func (h *CollectionHandler) GetPublicCollections(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var params dto.PaginationParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+	params.Normalize()
+
+	collections, total, err := h.collectionService.GetPublicCollections(ctx, params)
+	if err != nil {
+		respondInternalError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, dto.NewPaginatedResponse(collections, params.Page, params.Limit, total))
+}
+
+// GetCollection retorna uma coleção com as entradas
+// @Summary      Get collection
+// @Description  Get a collection with its ordered entries. Public collections are visible to everyone; private and unlisted ones only to the owner (unlisted ones are also reachable through their share link)
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        id  path  int  true  "Collection ID"
+// @Success      200  {object}  dto.CollectionDTO   "Success - returns collection"
+// @Failure      400  {object}  map[string]string   "Bad request - invalid ID"
+// @Failure      404  {object}  map[string]string   "Collection not found"
+// @Router       /collections/{id} [get]
+func (h *CollectionHandler) GetCollection(c *gin.Context) {
+	ctx := c.Request.Context()
+	viewerID := getUserID(c)
+
+	id, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	collection, err := h.collectionService.GetCollection(ctx, id, viewerID)
+	if err != nil {
+		h.respondCollectionError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, collection)
+}
+
+// GetSharedCollection retorna uma coleção pelo link de compartilhamento
+// @Summary      Get shared collection
+// @Description  Get an unlisted or public collection through its share link
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        token  path  string  true  "Share token"
+// @Success      200  {object}  dto.CollectionDTO   "Success - returns collection"
+// @Failure      404  {object}  map[string]string   "Collection not found"
+// @Router       /collections/shared/{token} [get]
+func (h *CollectionHandler) GetSharedCollection(c *gin.Context) {
+	ctx := c.Request.Context()
+	viewerID := getUserID(c)
+
+	collection, err := h.collectionService.GetSharedCollection(ctx, c.Param("token"), viewerID)
+	if err != nil {
+		h.respondCollectionError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, collection)
+}
+
+// UpdateCollection atualiza uma coleção do usuário
+// @Summary      Update collection
+// @Description  Update a collection's title, description and visibility
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        id          path  int                    true  "Collection ID"
+// @Param        collection  body  dto.CollectionRequest  true  "Collection data"
+// @Success      200  {object}  dto.CollectionDTO   "Collection updated"
+// @Failure      400  {object}  map[string]string   "Bad request - validation error"
+// @Failure      404  {object}  map[string]string   "Collection not found"
+// @Router       /collections/{id} [put]
+func (h *CollectionHandler) UpdateCollection(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	id, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	var req dto.CollectionRequest
+	if err := validateAndBind(c, &req); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+
+	collection, err := h.collectionService.UpdateCollection(ctx, id, userID, req)
+	if err != nil {
+		h.respondCollectionError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, collection)
+}
+
+// DeleteCollection remove uma coleção do usuário
+// @Summary      Delete collection
+// @Description  Delete one of the user's collections and its entries
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        id  path  int  true  "Collection ID"
+// @Success      204  "Collection deleted"
+// @Failure      400  {object}  map[string]string   "Bad request - invalid ID"
+// @Failure      404  {object}  map[string]string   "Collection not found"
+// @Router       /collections/{id} [delete]
+func (h *CollectionHandler) DeleteCollection(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	id, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	if err := h.collectionService.DeleteCollection(ctx, id, userID); err != nil {
+		h.respondCollectionError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// AddItem adiciona um item à coleção
+// @Summary      Add item to collection
+// @Description  Add a catalog item to a collection at the given position (default: end). The item does not need to be in the user's list
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int                           true  "Collection ID"
+// @Param        item  body  dto.AddCollectionItemRequest  true  "Entry data"
+// @Success      201  {object}  dto.CollectionDTO   "Item added - returns the collection"
+// @Failure      400  {object}  map[string]string   "Bad request - validation error"
+// @Failure      404  {object}  map[string]string   "Collection or item not found"
+// @Failure      409  {object}  map[string]string   "Item already in collection"
+// @Router       /collections/{id}/items [post]
+func (h *CollectionHandler) AddItem(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	id, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	var req dto.AddCollectionItemRequest
+	if err := validateAndBind(c, &req); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+
+	collection, err := h.collectionService.AddItem(ctx, id, userID, req)
+	if err != nil {
+		h.respondCollectionError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusCreated, collection)
+}
+
+// UpdateItem atualiza a nota de uma entrada da coleção
+// @Summary      Update collection entry
+// @Description  Update the note of a collection entry
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        id      path  int                              true  "Collection ID"
+// @Param        itemId  path  int                              true  "Catalog item ID"
+// @Param        entry   body  dto.UpdateCollectionItemRequest  true  "Entry data"
+// @Success      200  {object}  dto.CollectionDTO   "Entry updated - returns the collection"
+// @Failure      400  {object}  map[string]string   "Bad request - validation error"
+// @Failure      404  {object}  map[string]string   "Collection or entry not found"
+// @Router       /collections/{id}/items/{itemId} [put]
+func (h *CollectionHandler) UpdateItem(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	id, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	itemID, err := validateID(c, "itemId")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	var req dto.UpdateCollectionItemRequest
+	if err := validateAndBind(c, &req); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+
+	collection, err := h.collectionService.UpdateItemNote(ctx, id, userID, itemID, req)
+	if err != nil {
+		h.respondCollectionError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, collection)
+}
+
+// RemoveItem remove um item da coleção
+// @Summary      Remove item from collection
+// @Description  Remove an entry from a collection (following entries move up)
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        id      path  int  true  "Collection ID"
+// @Param        itemId  path  int  true  "Catalog item ID"
+// @Success      204  "Entry removed"
+// @Failure      400  {object}  map[string]string   "Bad request - invalid ID"
+// @Failure      404  {object}  map[string]string   "Collection or entry not found"
+// @Router       /collections/{id}/items/{itemId} [delete]
+func (h *CollectionHandler) RemoveItem(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	id, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	itemID, err := validateID(c, "itemId")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	if err := h.collectionService.RemoveItem(ctx, id, userID, itemID); err != nil {
+		h.respondCollectionError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Reorder redefine a ordem dos items da coleção
+// @Summary      Reorder collection
+// @Description  Set the full order of a collection. item_ids must contain every item of the collection exactly once
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        id     path  int                           true  "Collection ID"
+// @Param        order  body  dto.ReorderCollectionRequest  true  "New order"
+// @Success      200  {object}  dto.CollectionDTO   "Collection reordered"
+// @Failure      400  {object}  map[string]string   "Bad request - invalid order"
+// @Failure      404  {object}  map[string]string   "Collection not found"
+// @Router       /collections/{id}/reorder [put]
+func (h *CollectionHandler) Reorder(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	id, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	var req dto.ReorderCollectionRequest
+	if err := validateAndBind(c, &req); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+
+	collection, err := h.collectionService.Reorder(ctx, id, userID, req)
+	if err != nil {
+		h.respondCollectionError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, collection)
+}
+
+// respondCollectionError mapeia os erros do serviço de coleções para respostas HTTP
+func (h *CollectionHandler) respondCollectionError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		respondNotFound(c, "Collection")
+	case errors.Is(err, models.ErrItemNotFound):
+		respondNotFound(c, "Item")
+	case errors.Is(err, models.ErrItemNotInCollection):
+		respondNotFound(c, "Collection entry")
+	case errors.Is(err, models.ErrItemAlreadyInCollection):
+		respondError(c, http.StatusConflict, dto.ErrCodeDuplicate, err.Error())
+	case errors.Is(err, models.ErrInvalidVisibility),
+		errors.Is(err, models.ErrTitleRequired),
+		errors.Is(err, models.ErrInvalidReorder):
+		respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, err.Error())
+	default:
+		respondInternalError(c, err)
+	}
+}
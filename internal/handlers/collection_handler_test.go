@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/services"
+	"github.com/rafaelc-rb/geekery-api/internal/testutil"
+)
+
+func setupCollectionHandler() (*CollectionHandler, *testutil.MockCollectionRepository) {
+	mockCollectionRepo := &testutil.MockCollectionRepository{}
+	service := services.NewCollectionService(mockCollectionRepo, &testutil.MockItemRepository{})
+	handler := NewCollectionHandler(service)
+	gin.SetMode(gin.TestMode)
+	return handler, mockCollectionRepo
+}
+
+func TestCollectionHandler_CreateCollection_InvalidVisibility(t *testing.T) {
+	handler, _ := setupCollectionHandler()
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(1)) // Mock authenticated user with ID 1
+	router.POST("/collections", handler.CreateCollection)
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"title":      "Comfort shows",
+		"visibility": "friends",
+	})
+	req, _ := http.NewRequest("POST", "/collections", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", w.Code)
+	}
+}
+
+func TestCollectionHandler_GetCollection_PrivateHidden(t *testing.T) {
+	handler, mockCollectionRepo := setupCollectionHandler()
+	mockCollectionRepo.GetByIDFunc = func(ctx context.Context, id uint) (*models.Collection, error) {
+		return &models.Collection{ID: id, UserID: 2, Title: "Secret", Visibility: models.VisibilityPrivate}, nil
+	}
+
+	router := gin.New()
+	router.GET("/collections/:id", handler.GetCollection)
+
+	req, _ := http.NewRequest("GET", "/collections/1", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", w.Code)
+	}
+}
+
+func TestCollectionHandler_AddItem_Duplicate(t *testing.T) {
+	handler, mockCollectionRepo := setupCollectionHandler()
+	mockCollectionRepo.GetByIDFunc = func(ctx context.Context, id uint) (*models.Collection, error) {
+		return &models.Collection{ID: id, UserID: 1, Title: "List", Visibility: models.VisibilityPrivate}, nil
+	}
+	mockCollectionRepo.AddItemFunc = func(ctx context.Context, entry *models.CollectionItem) error {
+		return models.ErrItemAlreadyInCollection
+	}
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(1)) // Mock authenticated user with ID 1
+	router.POST("/collections/:id/items", handler.AddItem)
+
+	body, _ := json.Marshal(map[string]interface{}{"item_id": 10})
+	req, _ := http.NewRequest("POST", "/collections/1/items", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusConflict {
+		t.Errorf("Expected status 409, got %d", w.Code)
+	}
+}
+
+func TestCollectionHandler_Reorder_Invalid(t *testing.T) {
+	handler, mockCollectionRepo := setupCollectionHandler()
+	mockCollectionRepo.GetByIDFunc = func(ctx context.Context, id uint) (*models.Collection, error) {
+		return &models.Collection{ID: id, UserID: 1, Title: "List", Visibility: models.VisibilityPublic, ShareToken: "abc"}, nil
+	}
+	mockCollectionRepo.ReorderFunc = func(ctx context.Context, collectionID uint, itemIDs []uint) error {
+		return models.ErrInvalidReorder
+	}
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(1)) // Mock authenticated user with ID 1
+	router.PUT("/collections/:id/reorder", handler.Reorder)
+
+	body, _ := json.Marshal(dto.ReorderCollectionRequest{ItemIDs: []uint{3, 1}})
+	req, _ := http.NewRequest("PUT", "/collections/1/reorder", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", w.Code)
+	}
+}
@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/services"
+	"gorm.io/gorm"
+)
+
+type GoalHandler struct {
+	goalService *services.GoalService
+}
+
+// NewGoalHandler cria uma nova instância do handler de metas
+func NewGoalHandler(goalService *services.GoalService) *GoalHandler {
+	return &GoalHandler{goalService: goalService}
+}
+
+// CreateGoal cria uma meta para o usuário
+// @Summary      Create goal
+// @Description  Create a goal (e.g. read 24 books in 2026). period=year uses year, period=month uses year+month, period=custom uses starts_at/ends_at (inclusive)
+// @Tags         me
+// @Accept       json
+// @Produce      json
+// @Param        goal  body  dto.GoalRequest  true  "Goal data"
+// @Success      201  {object}  dto.GoalDTO         "Goal created with current progress"
+// @Failure      400  {object}  map[string]string   "Bad request - validation error"
+// @Failure      500  {object}  map[string]string   "Internal server error"
+// @Router       /me/goals [post]
+func (h *GoalHandler) CreateGoal(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	var req dto.GoalRequest
+	if err := validateAndBind(c, &req); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+
+	goal, err := h.goalService.CreateGoal(ctx, userID, req)
+	if err != nil {
+		h.respondGoalError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusCreated, goal)
+}
+
+// GetGoals retorna as metas do usuário com o progresso
+// @Summary      List goals
+// @Description  List user's goals with progress computed from list activity
+// @Tags         me
+// @Accept       json
+// @Produce      json
+// @Success      200  {array}   dto.GoalDTO         "Success - returns goals"
+// @Failure      500  {object}  map[string]string   "Internal server error"
+// @Router       /me/goals [get]
+func (h *GoalHandler) GetGoals(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	goals, err := h.goalService.GetGoals(ctx, userID)
+	if err != nil {
+		respondInternalError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, goals)
+}
+
+// GetGoal retorna uma meta do usuário com o progresso
+// @Summary      Get goal
+// @Description  Get a specific goal with its progress
+// @Tags         me
+// @Accept       json
+// @Produce      json
+// @Param        id  path  int  true  "Goal ID"
+// @Success      200  {object}  dto.GoalDTO         "Success - returns goal"
+// @Failure      400  {object}  map[string]string   "Bad request - invalid ID"
+// @Failure      404  {object}  map[string]string   "Goal not found"
+// @Router       /me/goals/{id} [get]
+func (h *GoalHandler) GetGoal(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	id, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	goal, err := h.goalService.GetGoal(ctx, id, userID)
+	if err != nil {
+		h.respondGoalError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, goal)
+}
+
+// UpdateGoal atualiza uma meta do usuário
+// @Summary      Update goal
+// @Description  Replace a goal's target, period and filters
+// @Tags         me
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int              true  "Goal ID"
+// @Param        goal  body  dto.GoalRequest  true  "Goal data"
+// @Success      200  {object}  dto.GoalDTO         "Goal updated"
+// @Failure      400  {object}  map[string]string   "Bad request - validation error"
+// @Failure      404  {object}  map[string]string   "Goal not found"
+// @Router       /me/goals/{id} [put]
+func (h *GoalHandler) UpdateGoal(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	id, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	var req dto.GoalRequest
+	if err := validateAndBind(c, &req); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+
+	goal, err := h.goalService.UpdateGoal(ctx, id, userID, req)
+	if err != nil {
+		h.respondGoalError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, goal)
+}
+
+// DeleteGoal remove uma meta do usuário
+// @Summary      Delete goal
+// @Description  Delete one of the user's goals
+// @Tags         me
+// @Accept       json
+// @Produce      json
+// @Param        id  path  int  true  "Goal ID"
+// @Success      204  "Goal deleted"
+// @Failure      400  {object}  map[string]string   "Bad request - invalid ID"
+// @Failure      404  {object}  map[string]string   "Goal not found"
+// @Router       /me/goals/{id} [delete]
+func (h *GoalHandler) DeleteGoal(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	id, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	if err := h.goalService.DeleteGoal(ctx, id, userID); err != nil {
+		h.respondGoalError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// respondGoalError mapeia os erros do serviço de metas para respostas HTTP
+func (h *GoalHandler) respondGoalError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		respondNotFound(c, "Goal")
+	case errors.Is(err, models.ErrInvalidGoalMetric),
+		errors.Is(err, models.ErrInvalidGoalPeriod),
+		errors.Is(err, models.ErrInvalidGoalTarget),
+		errors.Is(err, models.ErrInvalidGoalRange),
+		errors.Is(err, models.ErrInvalidMediaType):
+		respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, err.Error())
+	default:
+		respondInternalError(c, err)
+	}
+}
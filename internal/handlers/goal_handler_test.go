@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/services"
+	"github.com/rafaelc-rb/geekery-api/internal/testutil"
+	"gorm.io/gorm"
+)
+
+func setupGoalHandler() (*GoalHandler, *testutil.MockGoalRepository, *testutil.MockActivityRepository) {
+	mockGoalRepo := &testutil.MockGoalRepository{}
+	mockActivityRepo := &testutil.MockActivityRepository{}
+	service := services.NewGoalService(mockGoalRepo, mockActivityRepo)
+	handler := NewGoalHandler(service)
+	gin.SetMode(gin.TestMode)
+	return handler, mockGoalRepo, mockActivityRepo
+}
+
+func TestGoalHandler_CreateGoal(t *testing.T) {
+	handler, _, _ := setupGoalHandler()
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(1)) // Mock authenticated user with ID 1
+	router.POST("/me/goals", handler.CreateGoal)
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"metric":     "episodes",
+		"target":     300,
+		"period":     "year",
+		"year":       2026,
+		"media_type": "anime",
+	})
+	req, _ := http.NewRequest("POST", "/me/goals", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("Expected status 201, got %d: %s", w.Code, w.Body.String())
+	}
+
+	var goal dto.GoalDTO
+	if err := json.Unmarshal(w.Body.Bytes(), &goal); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if goal.MediaType != "anime" || goal.StartsAt.Year() != 2026 {
+		t.Errorf("Unexpected goal: %+v", goal)
+	}
+}
+
+func TestGoalHandler_CreateGoal_InvalidMediaType(t *testing.T) {
+	handler, _, _ := setupGoalHandler()
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(1)) // Mock authenticated user with ID 1
+	router.POST("/me/goals", handler.CreateGoal)
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"metric":     "completions",
+		"target":     10,
+		"period":     "year",
+		"media_type": "podcast",
+	})
+	req, _ := http.NewRequest("POST", "/me/goals", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", w.Code)
+	}
+}
+
+func TestGoalHandler_GetGoal_NotFound(t *testing.T) {
+	handler, mockGoalRepo, _ := setupGoalHandler()
+
+	mockGoalRepo.GetByIDAndUserFunc = func(ctx context.Context, id, userID uint) (*models.Goal, error) {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(1)) // Mock authenticated user with ID 1
+	router.GET("/me/goals/:id", handler.GetGoal)
+
+	req, _ := http.NewRequest("GET", "/me/goals/99", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", w.Code)
+	}
+}
@@ -2,9 +2,11 @@ package handlers
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
@@ -37,6 +39,29 @@ func validateID(c *gin.Context, param string) (uint, error) {
 	return uint(id), nil
 }
 
+// parseTimeParam parseia um parâmetro de data (YYYY-MM-DD ou RFC3339)
+func parseTimeParam(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02", value)
+}
+
+// parseOptionalTimeQuery parseia um parâmetro de data opcional da query string
+// Retorna nil quando o parâmetro não foi informado
+func parseOptionalTimeQuery(c *gin.Context, param string) (*time.Time, error) {
+	value := c.Query(param)
+	if value == "" {
+		return nil, nil
+	}
+
+	parsed, err := parseTimeParam(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s date", param)
+	}
+	return &parsed, nil
+}
+
 // respondError envia uma resposta de erro padronizada
 func respondError(c *gin.Context, status int, code, message string) {
 	response := dto.NewErrorResponse(code, message)
@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/services"
+)
+
+type ImportJobHandler struct {
+	service *services.ImportJobService
+}
+
+// NewImportJobHandler cria uma nova instância do handler de jobs de import
+func NewImportJobHandler(service *services.ImportJobService) *ImportJobHandler {
+	return &ImportJobHandler{service: service}
+}
+
+// GetImportJob retorna o andamento de um import
+// @Summary      Get import job
+// @Description  Get status, progress and (once finished) the result of a queued CSV import
+// @Tags         items
+// @Produce      json
+// @Param        id  path  int  true  "Import job ID"
+// @Success      200  {object}  dto.ImportJobDTO   "Import job"
+// @Failure      400  {object}  map[string]string  "Bad request - invalid ID"
+// @Failure      401  {object}  map[string]string  "Unauthorized"
+// @Failure      404  {object}  map[string]string  "Import job not found"
+// @Router       /imports/{id} [get]
+func (h *ImportJobHandler) GetImportJob(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	job, err := h.service.GetJob(ctx, id, getUserID(c))
+	if err != nil {
+		respondImportJobError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, job)
+}
+
+// CancelImportJob cancela um import na fila ou em execução
+// @Summary      Cancel import job
+// @Description  Cancel a queued or running import. A running import stops at its next batch; with transaction=row, batches already written are kept
+// @Tags         items
+// @Produce      json
+// @Param        id  path  int  true  "Import job ID"
+// @Success      200  {object}  dto.ImportJobDTO   "Import job canceled"
+// @Failure      400  {object}  map[string]string  "Bad request - invalid ID"
+// @Failure      401  {object}  map[string]string  "Unauthorized"
+// @Failure      404  {object}  map[string]string  "Import job not found"
+// @Failure      409  {object}  map[string]string  "Import job has already finished"
+// @Router       /imports/{id} [delete]
+func (h *ImportJobHandler) CancelImportJob(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	job, err := h.service.CancelJob(ctx, id, getUserID(c))
+	if err != nil {
+		respondImportJobError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, job)
+}
+
+// GetImportJobErrors baixa o CSV com todas as linhas que falharam
+// @Summary      Download import errors
+// @Description  Download a CSV (line, title, error) with every failed line of an import, including those beyond the error list in the result
+// @Tags         items
+// @Produce      text/csv
+// @Param        id  path  int  true  "Import job ID"
+// @Success      200  {file}    file               "Error report"
+// @Failure      400  {object}  map[string]string  "Bad request - invalid ID"
+// @Failure      401  {object}  map[string]string  "Unauthorized"
+// @Failure      404  {object}  map[string]string  "Import job not found or without failed lines"
+// @Router       /imports/{id}/errors [get]
+func (h *ImportJobHandler) GetImportJobErrors(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	path, err := h.service.ErrorsFilePath(ctx, id, getUserID(c))
+	if err != nil {
+		respondImportJobError(c, err)
+		return
+	}
+
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.FileAttachment(path, fmt.Sprintf("import-%d-errors.csv", id))
+}
+
+// respondImportJobError mapeia os erros dos jobs de import para respostas HTTP
+func respondImportJobError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, models.ErrImportJobNotFound):
+		respondNotFound(c, "Import job")
+	case errors.Is(err, models.ErrImportErrorReportNotFound):
+		respondError(c, http.StatusNotFound, dto.ErrCodeNotFound, err.Error())
+	case errors.Is(err, models.ErrImportJobFinished):
+		respondError(c, http.StatusConflict, dto.ErrCodeValidation, err.Error())
+	default:
+		respondInternalError(c, err)
+	}
+}
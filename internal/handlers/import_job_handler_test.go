@@ -0,0 +1,217 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/services"
+	"github.com/rafaelc-rb/geekery-api/internal/testutil"
+)
+
+func setupImportJobHandlers(t *testing.T) (*ItemHandler, *ImportJobHandler, *testutil.MockImportJobRepository) {
+	t.Helper()
+	mockJobRepo := &testutil.MockImportJobRepository{}
+	itemService := services.NewItemService(&testutil.MockItemRepository{}, &testutil.MockTagRepository{}, nil, nil)
+	importJobService := services.NewImportJobService(mockJobRepo, itemService, t.TempDir())
+	gin.SetMode(gin.TestMode)
+	return NewItemHandler(itemService, importJobService), NewImportJobHandler(importJobService), mockJobRepo
+}
+
+// newImportUpload monta um multipart com o arquivo no campo "file"
+func newImportUpload(t *testing.T, url, fileName, content string) *http.Request {
+	t.Helper()
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, _ := writer.CreateFormFile("file", fileName)
+	_, _ = part.Write([]byte(content))
+	_ = writer.Close()
+
+	req, _ := http.NewRequest("POST", url, &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+func TestItemHandler_ImportQueuesJob(t *testing.T) {
+	itemHandler, _, mockJobRepo := setupImportJobHandlers(t)
+	var queued *models.ImportJob
+	mockJobRepo.CreateFunc = func(ctx context.Context, job *models.ImportJob) error {
+		job.ID = 42
+		queued = job
+		return nil
+	}
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(1))
+	router.POST("/items/import/anime", itemHandler.ImportAnime)
+
+	req := newImportUpload(t, "/items/import/anime?mode=upsert&dry_run=true", "anime.csv", "title,episodes,studio\nCowboy Bebop,26,Sunrise\n")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusAccepted {
+		t.Fatalf("Expected status 202, got %d: %s", w.Code, w.Body.String())
+	}
+	if w.Header().Get("Location") != "/api/imports/42" {
+		t.Errorf("Expected Location header, got %q", w.Header().Get("Location"))
+	}
+
+	var job dto.ImportJobDTO
+	_ = json.Unmarshal(w.Body.Bytes(), &job)
+	if job.ID != 42 || job.Status != string(models.ImportJobQueued) || job.FileName != "anime.csv" {
+		t.Errorf("Unexpected job: %+v", job)
+	}
+	if queued == nil || queued.UserID != 1 || queued.Mode != dto.ImportModeUpsert || !queued.DryRun {
+		t.Errorf("Expected options and uploader stored on the job, got %+v", queued)
+	}
+}
+
+func TestItemHandler_ImportRejectsInvalidHeaders(t *testing.T) {
+	itemHandler, _, mockJobRepo := setupImportJobHandlers(t)
+	mockJobRepo.CreateFunc = func(ctx context.Context, job *models.ImportJob) error {
+		t.Error("Expected no job to be queued")
+		return nil
+	}
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(1))
+	router.POST("/items/import/anime", itemHandler.ImportAnime)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, newImportUpload(t, "/items/import/anime", "anime.csv", "title,runtime\nAlien,117\n"))
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", w.Code)
+	}
+}
+
+func TestItemHandler_ImportItemsMixed(t *testing.T) {
+	itemHandler, _, mockJobRepo := setupImportJobHandlers(t)
+	var queued *models.ImportJob
+	mockJobRepo.CreateFunc = func(ctx context.Context, job *models.ImportJob) error {
+		queued = job
+		return nil
+	}
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(1))
+	router.POST("/items/import", itemHandler.ImportItems)
+
+	tests := []struct {
+		name           string
+		url            string
+		fileName       string
+		expectedStatus int
+		expectedFormat string
+	}{
+		{"json by extension", "/items/import", "catalog.json", http.StatusAccepted, dto.ImportFormatJSON},
+		{"ndjson by format param", "/items/import?format=ndjson", "catalog.txt", http.StatusAccepted, dto.ImportFormatNDJSON},
+		{"unknown extension", "/items/import", "catalog.txt", http.StatusBadRequest, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queued = nil
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, newImportUpload(t, tt.url, tt.fileName, `[{"type": "anime", "title": "Frieren"}]`))
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("Expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+			if tt.expectedFormat != "" && (queued == nil || queued.Format != tt.expectedFormat || queued.MediaType != "") {
+				t.Errorf("Expected mixed %s job, got %+v", tt.expectedFormat, queued)
+			}
+		})
+	}
+}
+
+func TestImportJobHandler_GetImportJob(t *testing.T) {
+	_, handler, mockJobRepo := setupImportJobHandlers(t)
+	mockJobRepo.GetByIDFunc = func(ctx context.Context, id uint) (*models.ImportJob, error) {
+		if id == 3 {
+			return nil, models.ErrImportJobNotFound
+		}
+		return &models.ImportJob{
+			ID:        id,
+			UserID:    id,
+			Status:    models.ImportJobCompleted,
+			MediaType: models.MediaTypeAnime,
+			Lines:     3,
+			Imported:  2,
+			Failed:    1,
+			Result:    models.JSONB{"success": true, "created": 2, "failed": 1},
+		}, nil
+	}
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(1))
+	router.GET("/imports/:id", handler.GetImportJob)
+
+	req, _ := http.NewRequest("GET", "/imports/1", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", w.Code)
+	}
+	var job dto.ImportJobDTO
+	_ = json.Unmarshal(w.Body.Bytes(), &job)
+	if job.Progress.Imported != 2 || job.Result == nil || job.Result.Created != 2 || job.ErrorsURL != "/api/imports/1/errors" {
+		t.Errorf("Unexpected job: %+v", job)
+	}
+
+	// Job inexistente e job de outro usuário respondem igual
+	for _, url := range []string{"/imports/3", "/imports/2"} {
+		req, _ = http.NewRequest("GET", url, nil)
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		if w.Code != http.StatusNotFound {
+			t.Errorf("Expected status 404 for %s, got %d", url, w.Code)
+		}
+	}
+}
+
+func TestImportJobHandler_CancelFinishedJob(t *testing.T) {
+	_, handler, mockJobRepo := setupImportJobHandlers(t)
+	mockJobRepo.GetByIDFunc = func(ctx context.Context, id uint) (*models.ImportJob, error) {
+		return &models.ImportJob{ID: id, UserID: 1, Status: models.ImportJobCompleted}, nil
+	}
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(1))
+	router.DELETE("/imports/:id", handler.CancelImportJob)
+
+	req, _ := http.NewRequest("DELETE", "/imports/1", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusConflict {
+		t.Errorf("Expected status 409, got %d", w.Code)
+	}
+}
+
+func TestImportJobHandler_GetImportJobErrors_NoFailures(t *testing.T) {
+	_, handler, mockJobRepo := setupImportJobHandlers(t)
+	mockJobRepo.GetByIDFunc = func(ctx context.Context, id uint) (*models.ImportJob, error) {
+		return &models.ImportJob{ID: id, UserID: 1, Status: models.ImportJobCompleted, FilePath: "/tmp/none.csv"}, nil
+	}
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(1))
+	router.GET("/imports/:id/errors", handler.GetImportJobErrors)
+
+	req, _ := http.NewRequest("GET", "/imports/1/errors", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", w.Code)
+	}
+}
@@ -1,7 +1,10 @@
 package handlers
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
+	"path/filepath"
 	"strings"
 
 	"github.com/gin-gonic/gin"
@@ -10,13 +13,18 @@ import (
 	"github.com/rafaelc-rb/geekery-api/internal/services"
 )
 
+// maxImportFileSize é o tamanho máximo de um arquivo de import (comporta dumps com ~1M de linhas)
+const maxImportFileSize = 512 << 20
+
 type ItemHandler struct {
-	service *services.ItemService
+	service    *services.ItemService
+	importJobs *services.ImportJobService
 }
 
 // NewItemHandler cria uma nova instância do handler de items (catálogo global)
-func NewItemHandler(service *services.ItemService) *ItemHandler {
-	return &ItemHandler{service: service}
+// Os imports de CSV são enfileirados em importJobs e processados pelos workers
+func NewItemHandler(service *services.ItemService, importJobs *services.ImportJobService) *ItemHandler {
+	return &ItemHandler{service: service, importJobs: importJobs}
 }
 
 // GetAllItems retorna todos os items do catálogo com paginação
@@ -28,6 +36,7 @@ func NewItemHandler(service *services.ItemService) *ItemHandler {
 // @Param        page   query  int     false  "Page number" default(1) minimum(1)
 // @Param        limit  query  int     false  "Items per page" default(20) minimum(1) maximum(100)
 // @Param        type   query  string  false  "Filter by media type" Enums(anime, movie, series, game, manga, light_novel, music, book)
+// @Param        sort   query  string  false  "Sort by community stats" Enums(score, popularity)
 // @Success      200  {object}  dto.PaginatedResponse  "Success - returns paginated items"
 // @Failure      400  {object}  map[string]string      "Bad request - invalid parameters"
 // @Failure      500  {object}  map[string]string      "Internal server error"
@@ -43,6 +52,24 @@ func (h *ItemHandler) GetAllItems(c *gin.Context) {
 	}
 	params.Normalize()
 
+	// Ordenação por agregados da comunidade (score bayesiano ou popularidade)
+	if c.Query("sort") != "" {
+		var filter dto.ItemRankingFilter
+		if err := c.ShouldBindQuery(&filter); err != nil {
+			respondValidationError(c, err)
+			return
+		}
+
+		items, total, err := h.service.GetRankedItems(ctx, filter, params)
+		if err != nil {
+			respondRankingError(c, err)
+			return
+		}
+
+		respondSuccess(c, http.StatusOK, dto.NewPaginatedResponse(items, params.Page, params.Limit, total))
+		return
+	}
+
 	// Parâmetro de filtro opcional por tipo
 	typeParam := c.Query("type")
 
@@ -70,6 +97,59 @@ func (h *ItemHandler) GetAllItems(c *gin.Context) {
 	respondSuccess(c, http.StatusOK, response)
 }
 
+// GetRankings retorna o ranking de items pelos agregados da comunidade
+// @Summary      Get item rankings
+// @Description  Rank items by Bayesian-weighted score or popularity (members), optionally by media type and release season (e.g. top anime, most popular games this season)
+// @Tags         items
+// @Accept       json
+// @Produce      json
+// @Param        type    query  string  false  "Filter by media type" Enums(anime, movie, series, game, manga, light_novel, music, book)
+// @Param        sort    query  string  false  "Ranking criteria" Enums(score, popularity) default(score)
+// @Param        season  query  string  false  "Release season (quarter)" Enums(winter, spring, summer, fall, current)
+// @Param        year    query  int     false  "Season year (defaults to current year)"
+// @Param        page    query  int     false  "Page number" default(1) minimum(1)
+// @Param        limit   query  int     false  "Items per page" default(20) minimum(1) maximum(100)
+// @Success      200  {object}  dto.PaginatedResponse{data=[]dto.RankedItemDTO}  "Success - returns ranked items"
+// @Failure      400  {object}  map[string]string                                "Bad request - invalid parameters"
+// @Failure      500  {object}  map[string]string                                "Internal server error"
+// @Router       /items/rankings [get]
+func (h *ItemHandler) GetRankings(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var params dto.PaginationParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+	params.Normalize()
+
+	var filter dto.ItemRankingFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+	if filter.SortBy == "" {
+		filter.SortBy = dto.ItemSortScore
+	}
+
+	rankings, total, err := h.service.GetRankings(ctx, filter, params)
+	if err != nil {
+		respondRankingError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, dto.NewPaginatedResponse(rankings, params.Page, params.Limit, total))
+}
+
+// respondRankingError converte erros de listagem por agregados em respostas HTTP
+func respondRankingError(c *gin.Context, err error) {
+	if errors.Is(err, models.ErrInvalidMediaType) {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, err.Error())
+		return
+	}
+	respondInternalError(c, err)
+}
+
 // GetItemByID retorna um item específico do catálogo
 // @Summary      Get item by ID
 // @Description  Get a specific item from the catalog by its ID
@@ -236,13 +316,18 @@ func (h *ItemHandler) DeleteItem(c *gin.Context) {
 
 // ImportAnime importa múltiplos animes de um arquivo CSV
 // @Summary      Import anime items
-// @Description  Import multiple anime items from CSV file
+// @Description  Import multiple anime items from CSV file. The file is stored and processed in the background
 // @Tags         items
 // @Accept       multipart/form-data
 // @Produce      json
-// @Param        file  formData  file  true  "CSV file with anime data"
-// @Success      200  {object}  dto.ImportResult  "Import completed"
-// @Failure      400  {object}  map[string]string  "Bad request"
+// @Param        file         formData  file    true   "CSV file with anime data"
+// @Param        mode         query     string  false  "Import mode: create (existing titles fail) or upsert (update items matched by external IDs, then by title and release year)" Enums(create, upsert) default(create)
+// @Param        transaction  query     string  false  "Transaction mode: row (failed lines are skipped) or file (any failure rolls back the whole file)" Enums(row, file) default(row)
+// @Param        dry_run      query     bool    false  "Validate and report what would be created or updated without writing anything"
+// @Success      202  {object}  dto.ImportJobDTO   "Import queued - poll /imports/{id} for progress and result"
+// @Failure      400  {object}  map[string]string  "Bad request - missing file or invalid headers"
+// @Failure      401  {object}  map[string]string  "Unauthorized"
+// @Failure      500  {object}  map[string]string  "Internal server error"
 // @Router       /items/import/anime [post]
 func (h *ItemHandler) ImportAnime(c *gin.Context) {
 	h.importByType(c, models.MediaTypeAnime)
@@ -250,13 +335,18 @@ func (h *ItemHandler) ImportAnime(c *gin.Context) {
 
 // ImportComic importa múltiplos comics (manga, manhwa) de um arquivo CSV
 // @Summary      Import comic items
-// @Description  Import multiple comic items from CSV file
+// @Description  Import multiple comic items from CSV file. The file is stored and processed in the background
 // @Tags         items
 // @Accept       multipart/form-data
 // @Produce      json
-// @Param        file  formData  file  true  "CSV file with comic data"
-// @Success      200  {object}  dto.ImportResult  "Import completed"
-// @Failure      400  {object}  map[string]string  "Bad request"
+// @Param        file         formData  file    true   "CSV file with comic data"
+// @Param        mode         query     string  false  "Import mode: create (existing titles fail) or upsert (update items matched by external IDs, then by title and release year)" Enums(create, upsert) default(create)
+// @Param        transaction  query     string  false  "Transaction mode: row (failed lines are skipped) or file (any failure rolls back the whole file)" Enums(row, file) default(row)
+// @Param        dry_run      query     bool    false  "Validate and report what would be created or updated without writing anything"
+// @Success      202  {object}  dto.ImportJobDTO   "Import queued - poll /imports/{id} for progress and result"
+// @Failure      400  {object}  map[string]string  "Bad request - missing file or invalid headers"
+// @Failure      401  {object}  map[string]string  "Unauthorized"
+// @Failure      500  {object}  map[string]string  "Internal server error"
 // @Router       /items/import/comic [post]
 func (h *ItemHandler) ImportComic(c *gin.Context) {
 	h.importByType(c, models.MediaTypeComic)
@@ -264,13 +354,18 @@ func (h *ItemHandler) ImportComic(c *gin.Context) {
 
 // ImportNovel importa múltiplos novels (light novel, web novel) de um arquivo CSV
 // @Summary      Import novel items
-// @Description  Import multiple novel items from CSV file
+// @Description  Import multiple novel items from CSV file. The file is stored and processed in the background
 // @Tags         items
 // @Accept       multipart/form-data
 // @Produce      json
-// @Param        file  formData  file  true  "CSV file with novel data"
-// @Success      200  {object}  dto.ImportResult  "Import completed"
-// @Failure      400  {object}  map[string]string  "Bad request"
+// @Param        file         formData  file    true   "CSV file with novel data"
+// @Param        mode         query     string  false  "Import mode: create (existing titles fail) or upsert (update items matched by external IDs, then by title and release year)" Enums(create, upsert) default(create)
+// @Param        transaction  query     string  false  "Transaction mode: row (failed lines are skipped) or file (any failure rolls back the whole file)" Enums(row, file) default(row)
+// @Param        dry_run      query     bool    false  "Validate and report what would be created or updated without writing anything"
+// @Success      202  {object}  dto.ImportJobDTO   "Import queued - poll /imports/{id} for progress and result"
+// @Failure      400  {object}  map[string]string  "Bad request - missing file or invalid headers"
+// @Failure      401  {object}  map[string]string  "Unauthorized"
+// @Failure      500  {object}  map[string]string  "Internal server error"
 // @Router       /items/import/novel [post]
 func (h *ItemHandler) ImportNovel(c *gin.Context) {
 	h.importByType(c, models.MediaTypeNovel)
@@ -278,13 +373,18 @@ func (h *ItemHandler) ImportNovel(c *gin.Context) {
 
 // ImportMovie importa múltiplos filmes de um arquivo CSV
 // @Summary      Import movie items
-// @Description  Import multiple movie items from CSV file
+// @Description  Import multiple movie items from CSV file. The file is stored and processed in the background
 // @Tags         items
 // @Accept       multipart/form-data
 // @Produce      json
-// @Param        file  formData  file  true  "CSV file with movie data"
-// @Success      200  {object}  dto.ImportResult  "Import completed"
-// @Failure      400  {object}  map[string]string  "Bad request"
+// @Param        file         formData  file    true   "CSV file with movie data"
+// @Param        mode         query     string  false  "Import mode: create (existing titles fail) or upsert (update items matched by external IDs, then by title and release year)" Enums(create, upsert) default(create)
+// @Param        transaction  query     string  false  "Transaction mode: row (failed lines are skipped) or file (any failure rolls back the whole file)" Enums(row, file) default(row)
+// @Param        dry_run      query     bool    false  "Validate and report what would be created or updated without writing anything"
+// @Success      202  {object}  dto.ImportJobDTO   "Import queued - poll /imports/{id} for progress and result"
+// @Failure      400  {object}  map[string]string  "Bad request - missing file or invalid headers"
+// @Failure      401  {object}  map[string]string  "Unauthorized"
+// @Failure      500  {object}  map[string]string  "Internal server error"
 // @Router       /items/import/movie [post]
 func (h *ItemHandler) ImportMovie(c *gin.Context) {
 	h.importByType(c, models.MediaTypeMovie)
@@ -292,13 +392,18 @@ func (h *ItemHandler) ImportMovie(c *gin.Context) {
 
 // ImportSeries importa múltiplas séries de um arquivo CSV
 // @Summary      Import series items
-// @Description  Import multiple series items from CSV file
+// @Description  Import multiple series items from CSV file. The file is stored and processed in the background
 // @Tags         items
 // @Accept       multipart/form-data
 // @Produce      json
-// @Param        file  formData  file  true  "CSV file with series data"
-// @Success      200  {object}  dto.ImportResult  "Import completed"
-// @Failure      400  {object}  map[string]string  "Bad request"
+// @Param        file         formData  file    true   "CSV file with series data"
+// @Param        mode         query     string  false  "Import mode: create (existing titles fail) or upsert (update items matched by external IDs, then by title and release year)" Enums(create, upsert) default(create)
+// @Param        transaction  query     string  false  "Transaction mode: row (failed lines are skipped) or file (any failure rolls back the whole file)" Enums(row, file) default(row)
+// @Param        dry_run      query     bool    false  "Validate and report what would be created or updated without writing anything"
+// @Success      202  {object}  dto.ImportJobDTO   "Import queued - poll /imports/{id} for progress and result"
+// @Failure      400  {object}  map[string]string  "Bad request - missing file or invalid headers"
+// @Failure      401  {object}  map[string]string  "Unauthorized"
+// @Failure      500  {object}  map[string]string  "Internal server error"
 // @Router       /items/import/series [post]
 func (h *ItemHandler) ImportSeries(c *gin.Context) {
 	h.importByType(c, models.MediaTypeSeries)
@@ -306,13 +411,18 @@ func (h *ItemHandler) ImportSeries(c *gin.Context) {
 
 // ImportGame importa múltiplos games de um arquivo CSV
 // @Summary      Import game items
-// @Description  Import multiple game items from CSV file
+// @Description  Import multiple game items from CSV file. The file is stored and processed in the background
 // @Tags         items
 // @Accept       multipart/form-data
 // @Produce      json
-// @Param        file  formData  file  true  "CSV file with game data"
-// @Success      200  {object}  dto.ImportResult  "Import completed"
-// @Failure      400  {object}  map[string]string  "Bad request"
+// @Param        file         formData  file    true   "CSV file with game data"
+// @Param        mode         query     string  false  "Import mode: create (existing titles fail) or upsert (update items matched by external IDs, then by title and release year)" Enums(create, upsert) default(create)
+// @Param        transaction  query     string  false  "Transaction mode: row (failed lines are skipped) or file (any failure rolls back the whole file)" Enums(row, file) default(row)
+// @Param        dry_run      query     bool    false  "Validate and report what would be created or updated without writing anything"
+// @Success      202  {object}  dto.ImportJobDTO   "Import queued - poll /imports/{id} for progress and result"
+// @Failure      400  {object}  map[string]string  "Bad request - missing file or invalid headers"
+// @Failure      401  {object}  map[string]string  "Unauthorized"
+// @Failure      500  {object}  map[string]string  "Internal server error"
 // @Router       /items/import/game [post]
 func (h *ItemHandler) ImportGame(c *gin.Context) {
 	h.importByType(c, models.MediaTypeGame)
@@ -320,38 +430,79 @@ func (h *ItemHandler) ImportGame(c *gin.Context) {
 
 // ImportBook importa múltiplos livros de um arquivo CSV
 // @Summary      Import book items
-// @Description  Import multiple book items from CSV file
+// @Description  Import multiple book items from CSV file. The file is stored and processed in the background
 // @Tags         items
 // @Accept       multipart/form-data
 // @Produce      json
-// @Param        file  formData  file  true  "CSV file with book data"
-// @Success      200  {object}  dto.ImportResult  "Import completed"
-// @Failure      400  {object}  map[string]string  "Bad request"
+// @Param        file         formData  file    true   "CSV file with book data"
+// @Param        mode         query     string  false  "Import mode: create (existing titles fail) or upsert (update items matched by external IDs, then by title and release year)" Enums(create, upsert) default(create)
+// @Param        transaction  query     string  false  "Transaction mode: row (failed lines are skipped) or file (any failure rolls back the whole file)" Enums(row, file) default(row)
+// @Param        dry_run      query     bool    false  "Validate and report what would be created or updated without writing anything"
+// @Success      202  {object}  dto.ImportJobDTO   "Import queued - poll /imports/{id} for progress and result"
+// @Failure      400  {object}  map[string]string  "Bad request - missing file or invalid headers"
+// @Failure      401  {object}  map[string]string  "Unauthorized"
+// @Failure      500  {object}  map[string]string  "Internal server error"
 // @Router       /items/import/book [post]
 func (h *ItemHandler) ImportBook(c *gin.Context) {
 	h.importByType(c, models.MediaTypeBook)
 }
 
+// ImportItems importa items de tipos variados de um arquivo CSV, JSON ou NDJSON
+// @Summary      Import items of mixed types
+// @Description  Import items of any type from a CSV with a type column, a JSON array or NDJSON (one item per line). JSON items use the item payload shape: type, title, description, release_date (YYYY-MM-DD), cover_url, tags (names), external_metadata (object) and specific_data (the type-specific CSV columns, e.g. episodes, studio). Every format goes through the same validation as the per-type CSV imports. The file is stored and processed in the background
+// @Tags         items
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        file         formData  file    true   "CSV (with a type column), JSON array or NDJSON file"
+// @Param        format       query     string  false  "File format (default: from the file extension .csv, .json, .ndjson or .jsonl)" Enums(csv, json, ndjson)
+// @Param        mode         query     string  false  "Import mode: create (existing titles fail) or upsert (update items matched by external IDs, then by title and release year)" Enums(create, upsert) default(create)
+// @Param        transaction  query     string  false  "Transaction mode: row (failed lines are skipped) or file (any failure rolls back the whole file)" Enums(row, file) default(row)
+// @Param        dry_run      query     bool    false  "Validate and report what would be created or updated without writing anything"
+// @Success      202  {object}  dto.ImportJobDTO   "Import queued - poll /imports/{id} for progress and result"
+// @Failure      400  {object}  map[string]string  "Bad request - missing file, unknown format or invalid headers"
+// @Failure      401  {object}  map[string]string  "Unauthorized"
+// @Failure      500  {object}  map[string]string  "Internal server error"
+// @Router       /items/import [post]
+func (h *ItemHandler) ImportItems(c *gin.Context) {
+	h.importByType(c, "")
+}
+
 // importByType é o handler genérico de import por tipo
+// Com mediaType vazio, o import é misto e aceita CSV, JSON e NDJSON; os imports por tipo aceitam apenas CSV
 func (h *ItemHandler) importByType(c *gin.Context, mediaType models.MediaType) {
 	ctx := c.Request.Context()
 
+	var opts dto.ImportOptions
+	if err := c.ShouldBindQuery(&opts); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+
 	// Receber arquivo
 	file, err := c.FormFile("file")
 	if err != nil {
-		respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, "CSV file is required")
+		respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, "Import file is required")
 		return
 	}
 
-	// Validar extensão
-	if !strings.HasSuffix(strings.ToLower(file.Filename), ".csv") {
-		respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, "File must be a .csv file")
-		return
+	// Validar formato (extensão, ou format no import misto)
+	if mediaType != "" {
+		if !strings.HasSuffix(strings.ToLower(file.Filename), ".csv") {
+			respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, "File must be a .csv file")
+			return
+		}
+		opts.Format = dto.ImportFormatCSV
+	} else if opts.Format == "" {
+		opts.Format = importFormatFromFileName(file.Filename)
+		if opts.Format == "" {
+			respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, "File must be a .csv, .json, .ndjson or .jsonl file (or set format)")
+			return
+		}
 	}
 
-	// Validar tamanho (max 10MB)
-	if file.Size > 10*1024*1024 {
-		respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, "File size must be less than 10MB")
+	// Validar tamanho (o arquivo é processado em streaming, então o limite protege apenas o disco)
+	if file.Size > maxImportFileSize {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, "File size must be less than 512MB")
 		return
 	}
 
@@ -368,12 +519,96 @@ func (h *ItemHandler) importByType(c *gin.Context, mediaType models.MediaType) {
 		}
 	}()
 
-	// Processar no service
-	result, err := h.service.ImportItemsFromCSV(ctx, src, mediaType)
+	// Enfileirar (o processamento acontece nos workers)
+	job, err := h.importJobs.Enqueue(ctx, getUserID(c), mediaType, opts, file.Filename, src)
 	if err != nil {
-		respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, err.Error())
+		if errors.Is(err, models.ErrInvalidImportFile) {
+			respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, err.Error())
+			return
+		}
+		respondInternalError(c, err)
 		return
 	}
 
-	respondSuccess(c, http.StatusOK, result)
+	c.Header("Location", fmt.Sprintf("/api/imports/%d", job.ID))
+	respondSuccess(c, http.StatusAccepted, job)
+}
+
+// ExportItems exporta o catálogo em CSV, JSON ou NDJSON
+// @Summary      Export catalog
+// @Description  Stream the catalog (or a single type) with type-specific data, tags and external metadata. The output can be imported unchanged into another instance: a typed CSV has the columns of /items/import/{type}; without type, the CSV has a type column and JSON/NDJSON use the /items/import shape
+// @Tags         items
+// @Produce      text/csv
+// @Produce      json
+// @Produce      application/x-ndjson
+// @Param        type    query     string  false  "Media type (default: all types)" Enums(anime, movie, series, game, comic, novel, book)
+// @Param        format  query     string  false  "File format" Enums(csv, json, ndjson) default(csv)
+// @Success      200  {file}    file               "Exported items"
+// @Failure      400  {object}  map[string]string  "Bad request - invalid type or format"
+// @Failure      500  {object}  map[string]string  "Internal server error"
+// @Router       /items/export [get]
+func (h *ItemHandler) ExportItems(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var params dto.ItemExportParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+
+	mediaType := models.MediaType(params.Type)
+	if mediaType != "" && !mediaType.IsValid() {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, models.ErrInvalidMediaType.Error())
+		return
+	}
+
+	format := params.Format
+	if format == "" {
+		format = dto.ImportFormatCSV
+	}
+	fileName := "items"
+	if mediaType != "" {
+		fileName += "-" + string(mediaType)
+	}
+
+	c.Header("Content-Type", exportContentType(format))
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName+"."+format))
+	c.Status(http.StatusOK)
+
+	if err := h.service.ExportItems(ctx, c.Writer, mediaType, format); err != nil {
+		// Antes do primeiro lote ainda dá para responder com erro; depois, o download é interrompido
+		if !c.Writer.Written() {
+			c.Header("Content-Disposition", "")
+			respondInternalError(c, err)
+			return
+		}
+		_ = c.Error(err)
+		c.Abort()
+	}
+}
+
+// exportContentType retorna o Content-Type do formato de export
+func exportContentType(format string) string {
+	switch format {
+	case dto.ImportFormatJSON:
+		return "application/json; charset=utf-8"
+	case dto.ImportFormatNDJSON:
+		return "application/x-ndjson; charset=utf-8"
+	default:
+		return "text/csv; charset=utf-8"
+	}
+}
+
+// importFormatFromFileName deduz o formato do import pela extensão do arquivo (vazio se desconhecida)
+func importFormatFromFileName(fileName string) string {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".csv":
+		return dto.ImportFormatCSV
+	case ".json":
+		return dto.ImportFormatJSON
+	case ".ndjson", ".jsonl":
+		return dto.ImportFormatNDJSON
+	default:
+		return ""
+	}
 }
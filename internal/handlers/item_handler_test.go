@@ -18,8 +18,8 @@ import (
 func setupItemHandler() (*ItemHandler, *testutil.MockItemRepository) {
 	mockRepo := &testutil.MockItemRepository{}
 	mockTagRepo := &testutil.MockTagRepository{}
-	service := services.NewItemService(mockRepo, mockTagRepo)
-	handler := NewItemHandler(service)
+	service := services.NewItemService(mockRepo, mockTagRepo, nil, nil)
+	handler := NewItemHandler(service, nil)
 	gin.SetMode(gin.TestMode)
 	return handler, mockRepo
 }
@@ -296,3 +296,126 @@ func TestItemHandler_ErrorResponseFormat(t *testing.T) {
 		t.Errorf("Expected error code '%s', got '%s'", dto.ErrCodeInvalidID, errorResponse.Code)
 	}
 }
+
+func TestItemHandler_GetRankings(t *testing.T) {
+	handler, mockRepo := setupItemHandler()
+
+	var receivedFilter dto.ItemRankingFilter
+	mockRepo.GetRankedFunc = func(ctx context.Context, filter dto.ItemRankingFilter, params dto.PaginationParams) ([]models.Item, int64, error) {
+		receivedFilter = filter
+		item := models.Item{Title: "Top Anime", Type: models.MediaTypeAnime, Stats: &models.ItemStats{WeightedScore: 8.7, Members: 120}}
+		return []models.Item{item}, 1, nil
+	}
+
+	router := gin.New()
+	router.GET("/items/rankings", handler.GetRankings)
+
+	req, _ := http.NewRequest("GET", "/items/rankings?type=anime&season=current", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if receivedFilter.SortBy != dto.ItemSortScore {
+		t.Errorf("Expected default sort 'score', got '%s'", receivedFilter.SortBy)
+	}
+	if receivedFilter.ReleasedFrom == nil || receivedFilter.ReleasedTo == nil {
+		t.Error("Expected season to be resolved into a release date range")
+	}
+
+	var response dto.PaginatedResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	rankingsData, _ := json.Marshal(response.Data)
+	var rankings []dto.RankedItemDTO
+	if err := json.Unmarshal(rankingsData, &rankings); err != nil {
+		t.Fatalf("Failed to unmarshal rankings: %v", err)
+	}
+	if len(rankings) != 1 || rankings[0].Rank != 1 {
+		t.Fatalf("Expected one ranking at position 1, got %+v", rankings)
+	}
+	if rankings[0].Item.Stats == nil || rankings[0].Item.Stats.Members != 120 {
+		t.Errorf("Expected item stats in ranking, got %+v", rankings[0].Item.Stats)
+	}
+}
+
+func TestItemHandler_GetRankings_InvalidSort(t *testing.T) {
+	handler, _ := setupItemHandler()
+
+	router := gin.New()
+	router.GET("/items/rankings", handler.GetRankings)
+
+	req, _ := http.NewRequest("GET", "/items/rankings?sort=invalid", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", w.Code)
+	}
+}
+
+func TestItemHandler_GetAllItems_SortByPopularity(t *testing.T) {
+	handler, mockRepo := setupItemHandler()
+
+	rankedCalled := false
+	mockRepo.GetRankedFunc = func(ctx context.Context, filter dto.ItemRankingFilter, params dto.PaginationParams) ([]models.Item, int64, error) {
+		rankedCalled = true
+		if filter.SortBy != dto.ItemSortPopularity || filter.Type != "game" {
+			t.Errorf("Expected popularity sort for games, got %+v", filter)
+		}
+		return []models.Item{}, 0, nil
+	}
+
+	router := gin.New()
+	router.GET("/items", handler.GetAllItems)
+
+	req, _ := http.NewRequest("GET", "/items?type=game&sort=popularity", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status 200, got %d", w.Code)
+	}
+	if !rankedCalled {
+		t.Error("Expected ranked query to be used when sort is set")
+	}
+}
+
+func TestItemHandler_ExportItems(t *testing.T) {
+	handler, mockRepo := setupItemHandler()
+	mockRepo.ListAfterIDFunc = func(ctx context.Context, mediaType models.MediaType, afterID uint, limit int) ([]models.Item, error) {
+		if mediaType != models.MediaTypeMovie {
+			t.Errorf("Expected movie filter, got %q", mediaType)
+		}
+		return []models.Item{{ID: 1, Type: models.MediaTypeMovie, Title: "Alien", MovieData: &models.MovieData{Runtime: 117, Director: "Ridley Scott"}}}, nil
+	}
+
+	router := gin.New()
+	router.GET("/items/export", handler.ExportItems)
+
+	req, _ := http.NewRequest("GET", "/items/export?type=movie&format=ndjson", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if w.Header().Get("Content-Disposition") != `attachment; filename="items-movie.ndjson"` {
+		t.Errorf("Unexpected Content-Disposition %q", w.Header().Get("Content-Disposition"))
+	}
+	expected := `{"type":"movie","title":"Alien","specific_data":{"director":"Ridley Scott","runtime":117}}` + "\n"
+	if w.Body.String() != expected {
+		t.Errorf("Expected %s, got %s", expected, w.Body.String())
+	}
+
+	for _, query := range []string{"type=podcast", "format=xml"} {
+		req, _ = http.NewRequest("GET", "/items/export?"+query, nil)
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("Expected status 400 for %s, got %d", query, w.Code)
+		}
+	}
+}
@@ -0,0 +1,286 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/services"
+)
+
+// maxListImportFileSize é o tamanho máximo de um export de lista (o arquivo é lido de uma vez)
+const maxListImportFileSize = 32 << 20
+
+type ListImportHandler struct {
+	service *services.ListImportService
+}
+
+// NewListImportHandler cria uma nova instância do handler de imports de lista pessoal
+func NewListImportHandler(service *services.ListImportService) *ListImportHandler {
+	return &ListImportHandler{service: service}
+}
+
+// ImportList importa a lista pessoal exportada de outro serviço
+// @Summary      Import my list from another service
+// @Description  Import a personal list export: MyAnimeList XML (mal), AniList JSON (anilist), Letterboxd CSV (letterboxd), Goodreads CSV (goodreads) or Steam library JSON (steam).
+// @Description  Entries are matched to catalog items by external IDs (external_metadata), then by title and release year, and added with status, rating (0-10), progress and dates.
+// @Description  Items already in the list are skipped. Unmatched entries are kept for review at /my-list/imports/{id}/entries?status=unmatched
+// @Tags         my-list
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        source  path      string  true  "Export source" Enums(mal, anilist, letterboxd, goodreads, steam)
+// @Param        file    formData  file    true  "Exported list file"
+// @Success      201  {object}  dto.ListImportDTO  "List imported - totals per entry status"
+// @Failure      400  {object}  map[string]string  "Bad request - invalid source, missing or unreadable file"
+// @Failure      500  {object}  map[string]string  "Internal server error"
+// @Router       /my-list/import/{source} [post]
+func (h *ListImportHandler) ImportList(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	source := models.ListImportSource(c.Param("source"))
+	if !source.IsValid() {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, models.ErrInvalidListImportSource.Error())
+		return
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, "Import file is required")
+		return
+	}
+	if file.Size > maxListImportFileSize {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, "File size must be less than 32MB")
+		return
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		respondInternalError(c, err)
+		return
+	}
+	defer func() {
+		if closeErr := src.Close(); closeErr != nil {
+			// Log error but don't fail the request
+			_ = closeErr
+		}
+	}()
+
+	result, err := h.service.Import(ctx, userID, source, file.Filename, src)
+	if err != nil {
+		respondListImportError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusCreated, result)
+}
+
+// GetListImports retorna os imports de lista do usuário
+// @Summary      List my list imports
+// @Description  List the authenticated user's list imports (newest first) with totals per entry status
+// @Tags         my-list
+// @Produce      json
+// @Param        page   query  int  false  "Page number (default: 1)"
+// @Param        limit  query  int  false  "Items per page (default: 20, max: 100)"
+// @Success      200  {object}  dto.PaginatedResponse{data=[]dto.ListImportDTO}  "Success - returns list imports"
+// @Failure      500  {object}  map[string]string  "Internal server error"
+// @Router       /my-list/imports [get]
+func (h *ListImportHandler) GetListImports(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	var params dto.PaginationParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+	params.Normalize()
+
+	imports, total, err := h.service.GetImports(ctx, userID, params)
+	if err != nil {
+		respondListImportError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, dto.NewPaginatedResponse(imports, params.Page, params.Limit, total))
+}
+
+// GetListImport retorna um import de lista do usuário
+// @Summary      Get list import
+// @Description  Get a list import with totals per entry status
+// @Tags         my-list
+// @Produce      json
+// @Param        id  path  int  true  "List import ID"
+// @Success      200  {object}  dto.ListImportDTO  "List import"
+// @Failure      400  {object}  map[string]string  "Bad request - invalid ID"
+// @Failure      404  {object}  map[string]string  "List import not found"
+// @Router       /my-list/imports/{id} [get]
+func (h *ListImportHandler) GetListImport(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	id, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	result, err := h.service.GetImport(ctx, userID, id)
+	if err != nil {
+		respondListImportError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, result)
+}
+
+// GetListImportEntries retorna as entradas de um import de lista
+// @Summary      List list import entries
+// @Description  List the entries of a list import in file order. Use status=unmatched for the entries waiting for review
+// @Tags         my-list
+// @Produce      json
+// @Param        id      path   int     true   "List import ID"
+// @Param        status  query  string  false  "Filter by entry status" Enums(imported, skipped, unmatched, resolved, dismissed, failed)
+// @Param        page    query  int     false  "Page number (default: 1)"
+// @Param        limit   query  int     false  "Items per page (default: 20, max: 100)"
+// @Success      200  {object}  dto.PaginatedResponse{data=[]models.ListImportEntry}  "Success - returns entries"
+// @Failure      400  {object}  map[string]string  "Bad request - invalid parameters"
+// @Failure      404  {object}  map[string]string  "List import not found"
+// @Router       /my-list/imports/{id}/entries [get]
+func (h *ListImportHandler) GetListImportEntries(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	id, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	var params dto.PaginationParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+	params.Normalize()
+
+	var filter dto.ListImportEntryFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+
+	entries, total, err := h.service.GetEntries(ctx, userID, id, filter, params)
+	if err != nil {
+		respondListImportError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, dto.NewPaginatedResponse(entries, params.Page, params.Limit, total))
+}
+
+// ResolveListImportEntry adiciona à lista uma entrada não encontrada, com o item escolhido pelo usuário
+// @Summary      Resolve unmatched entry
+// @Description  Add an unmatched entry to the list using the catalog item chosen by the user (status, rating, progress and dates come from the export)
+// @Tags         my-list
+// @Accept       json
+// @Produce      json
+// @Param        id       path  int                                true  "List import ID"
+// @Param        entryId  path  int                                true  "Entry ID"
+// @Param        request  body  dto.ResolveListImportEntryRequest  true  "Catalog item"
+// @Success      200  {object}  models.ListImportEntry  "Entry resolved"
+// @Failure      400  {object}  map[string]string       "Bad request - validation error"
+// @Failure      404  {object}  map[string]string       "List import, entry or item not found"
+// @Failure      409  {object}  map[string]string       "Entry already reviewed or item already in list"
+// @Router       /my-list/imports/{id}/entries/{entryId}/resolve [post]
+func (h *ListImportHandler) ResolveListImportEntry(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	id, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+	entryID, err := validateID(c, "entryId")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	var req dto.ResolveListImportEntryRequest
+	if err := validateAndBind(c, &req); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+
+	entry, err := h.service.ResolveEntry(ctx, userID, id, entryID, req.ItemID)
+	if err != nil {
+		respondListImportError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, entry)
+}
+
+// DismissListImportEntry descarta uma entrada não encontrada
+// @Summary      Dismiss unmatched entry
+// @Description  Remove an unmatched entry from review without adding it to the list
+// @Tags         my-list
+// @Produce      json
+// @Param        id       path  int  true  "List import ID"
+// @Param        entryId  path  int  true  "Entry ID"
+// @Success      200  {object}  models.ListImportEntry  "Entry dismissed"
+// @Failure      400  {object}  map[string]string       "Bad request - invalid ID"
+// @Failure      404  {object}  map[string]string       "List import or entry not found"
+// @Failure      409  {object}  map[string]string       "Entry already reviewed"
+// @Router       /my-list/imports/{id}/entries/{entryId}/dismiss [post]
+func (h *ListImportHandler) DismissListImportEntry(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	id, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+	entryID, err := validateID(c, "entryId")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	entry, err := h.service.DismissEntry(ctx, userID, id, entryID)
+	if err != nil {
+		respondListImportError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, entry)
+}
+
+// respondListImportError mapeia os erros dos imports de lista para respostas HTTP
+func respondListImportError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, models.ErrListImportNotFound):
+		respondNotFound(c, "List import")
+	case errors.Is(err, models.ErrListImportEntryNotFound):
+		respondNotFound(c, "List import entry")
+	case errors.Is(err, models.ErrItemNotFound):
+		respondNotFound(c, "Item")
+	case errors.Is(err, models.ErrDuplicateEntry):
+		respondDuplicate(c, "Item")
+	case errors.Is(err, models.ErrListImportEntryReviewed):
+		respondError(c, http.StatusConflict, dto.ErrCodeValidation, err.Error())
+	case errors.Is(err, models.ErrInvalidListImportSource),
+		errors.Is(err, models.ErrInvalidListImportFile),
+		errors.Is(err, models.ErrInvalidStatus),
+		errors.Is(err, models.ErrInvalidRating),
+		errors.Is(err, models.ErrInvalidCompletionCount):
+		respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, err.Error())
+	default:
+		respondInternalError(c, err)
+	}
+}
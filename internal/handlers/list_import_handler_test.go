@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/services"
+	"github.com/rafaelc-rb/geekery-api/internal/testutil"
+)
+
+func setupListImportHandler() (*ListImportHandler, *testutil.MockListImportRepository, *testutil.MockItemRepository) {
+	mockListImportRepo := &testutil.MockListImportRepository{}
+	mockItemRepo := &testutil.MockItemRepository{}
+	uow := &testutil.MockUnitOfWork{UserItems: &testutil.MockUserItemRepository{}, ListImports: mockListImportRepo}
+	service := services.NewListImportService(mockListImportRepo, mockItemRepo, uow, nil)
+	gin.SetMode(gin.TestMode)
+	return NewListImportHandler(service), mockListImportRepo, mockItemRepo
+}
+
+func TestListImportHandler_ImportList(t *testing.T) {
+	handler, mockListImportRepo, _ := setupListImportHandler()
+	mockListImportRepo.CreateFunc = func(ctx context.Context, listImport *models.ListImport) error {
+		listImport.ID = 3
+		return nil
+	}
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(1))
+	router.POST("/my-list/import/:source", handler.ImportList)
+
+	req := newImportUpload(t, "/my-list/import/steam", "games.json", `{"games":[{"appid":620,"name":"Portal 2","playtime_forever":750}]}`)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("Expected status 201, got %d: %s", w.Code, w.Body.String())
+	}
+
+	var result dto.ListImportDTO
+	_ = json.Unmarshal(w.Body.Bytes(), &result)
+	if result.ID != 3 || result.Total != 1 || result.Unmatched != 1 {
+		t.Errorf("Expected one unmatched entry, got %+v", result)
+	}
+	if result.ReviewURL != "/api/my-list/imports/3/entries?status=unmatched" {
+		t.Errorf("Expected review URL, got %q", result.ReviewURL)
+	}
+}
+
+func TestListImportHandler_ImportListInvalidInput(t *testing.T) {
+	handler, _, _ := setupListImportHandler()
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(1))
+	router.POST("/my-list/import/:source", handler.ImportList)
+
+	tests := []struct {
+		name string
+		req  *http.Request
+	}{
+		{"unknown source", newImportUpload(t, "/my-list/import/trakt", "list.json", "{}")},
+		{"unreadable file", newImportUpload(t, "/my-list/import/mal", "animelist.xml", "not xml")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, tt.req)
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("Expected status 400, got %d: %s", w.Code, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestListImportHandler_ResolveEntry(t *testing.T) {
+	handler, mockListImportRepo, mockItemRepo := setupListImportHandler()
+	mockListImportRepo.GetByIDAndUserFunc = func(ctx context.Context, id, userID uint) (*models.ListImport, error) {
+		if userID != 1 {
+			return nil, models.ErrListImportNotFound
+		}
+		return &models.ListImport{ID: id, UserID: userID}, nil
+	}
+	mockListImportRepo.GetEntryFunc = func(ctx context.Context, listImportID, entryID uint) (*models.ListImportEntry, error) {
+		return &models.ListImportEntry{ID: entryID, ListImportID: listImportID, Status: models.ListImportEntryDismissed}, nil
+	}
+	mockItemRepo.GetByIDFunc = func(ctx context.Context, id uint) (*models.Item, error) {
+		return &models.Item{ID: id, Type: models.MediaTypeMovie, Title: "Heat"}, nil
+	}
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(1))
+	router.POST("/my-list/imports/:id/entries/:entryId/resolve", handler.ResolveListImportEntry)
+
+	tests := []struct {
+		name string
+		url  string
+		body string
+		want int
+	}{
+		{"missing item", "/my-list/imports/1/entries/5/resolve", `{}`, http.StatusBadRequest},
+		{"invalid entry ID", "/my-list/imports/1/entries/abc/resolve", `{"item_id":2}`, http.StatusBadRequest},
+		{"already reviewed", "/my-list/imports/1/entries/5/resolve", `{"item_id":2}`, http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("POST", tt.url, bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			if w.Code != tt.want {
+				t.Errorf("Expected status %d, got %d: %s", tt.want, w.Code, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestListImportHandler_GetListImportNotFound(t *testing.T) {
+	handler, _, _ := setupListImportHandler()
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(1))
+	router.GET("/my-list/imports/:id", handler.GetListImport)
+
+	req, _ := http.NewRequest("GET", "/my-list/imports/9", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d: %s", w.Code, w.Body.String())
+	}
+}
@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/services"
+)
+
+type MetadataHandler struct {
+	service *services.MetadataService
+}
+
+// NewMetadataHandler cria uma nova instância do handler de metadados externos
+func NewMetadataHandler(service *services.MetadataService) *MetadataHandler {
+	return &MetadataHandler{service: service}
+}
+
+// EnrichItem completa um item do catálogo com metadados de APIs externas
+// @Summary      Enrich item from external metadata
+// @Description  Fetch the item from external providers (AniList/MAL for anime, comics and novels; TMDB for movies and series; IGDB for games; Open Library for books and novels) using the IDs in external_metadata (anilist, mal, tmdb, imdb, igdb, steam, isbn, openlibrary) or, without them, the title and release year.
+// @Description  Synopsis, release date, cover, tags, external IDs and type-specific fields are merged into the item. By default only empty fields are filled; overwrite=true replaces existing values (including the title). Tags are always added, never removed
+// @Tags         items
+// @Produce      json
+// @Param        id         path   int     true   "Item ID"
+// @Param        provider   query  string  false  "Only query this provider (default: every provider for the item type, in order)" Enums(anilist, tmdb, igdb, openlibrary)
+// @Param        overwrite  query  bool    false  "Replace fields that already have a value"
+// @Success      200  {object}  dto.EnrichItemResult  "Item enriched - lists the updated fields"
+// @Failure      400  {object}  map[string]string     "Bad request - invalid ID, unknown provider or unsupported media type"
+// @Failure      401  {object}  map[string]string     "Unauthorized"
+// @Failure      404  {object}  map[string]string     "Item not found or no provider found metadata for it"
+// @Failure      502  {object}  map[string]string     "Metadata provider request failed"
+// @Router       /items/{id}/enrich [post]
+func (h *MetadataHandler) EnrichItem(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	itemID, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	var params dto.EnrichItemParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+
+	result, err := h.service.EnrichItem(ctx, itemID, params)
+	if err != nil {
+		respondMetadataError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, result)
+}
+
+// respondMetadataError mapeia os erros do enriquecimento para respostas HTTP
+func respondMetadataError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, models.ErrItemNotFound):
+		respondNotFound(c, "Item")
+	case errors.Is(err, models.ErrMetadataNotFound):
+		respondError(c, http.StatusNotFound, dto.ErrCodeNotFound, err.Error())
+	case errors.Is(err, models.ErrUnknownMetadataProvider), errors.Is(err, models.ErrMetadataNotSupported):
+		respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, err.Error())
+	case errors.Is(err, models.ErrMetadataProviderFailed):
+		respondError(c, http.StatusBadGateway, dto.ErrCodeUpstream, err.Error())
+	default:
+		respondInternalError(c, err)
+	}
+}
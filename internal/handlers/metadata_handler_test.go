@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/services"
+	"github.com/rafaelc-rb/geekery-api/internal/testutil"
+	"gorm.io/gorm"
+)
+
+// setupMetadataHandler usa o provider do Open Library contra o servidor httptest informado
+func setupMetadataHandler(apiURL string) (*gin.Engine, *testutil.MockItemRepository) {
+	itemRepo := &testutil.MockItemRepository{
+		GetByIDFunc: func(ctx context.Context, id uint) (*models.Item, error) {
+			if id != 1 {
+				return nil, gorm.ErrRecordNotFound
+			}
+			return &models.Item{ID: 1, Title: "Dune", Type: models.MediaTypeBook, ExternalMetadata: models.JSONB{"openlibrary": "OL893415W"}}, nil
+		},
+	}
+	uow := &testutil.MockUnitOfWork{Items: itemRepo, Tags: &testutil.MockTagRepository{}}
+	service := services.NewMetadataService(itemRepo, uow, nil, services.NewOpenLibraryProvider(http.DefaultClient, apiURL))
+	handler := NewMetadataHandler(service)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/items/:id/enrich", handler.EnrichItem)
+	return router, itemRepo
+}
+
+func TestMetadataHandler_EnrichItem(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/works/OL893415W.json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		io.WriteString(w, `{"key":"/works/OL893415W","title":"Dune","description":"Set on the desert planet Arrakis.","first_publish_date":"1965"}`)
+	}))
+	defer server.Close()
+
+	router, itemRepo := setupMetadataHandler(server.URL)
+	var saved *models.Item
+	itemRepo.UpdateFunc = func(ctx context.Context, item *models.Item) error {
+		saved = item
+		return nil
+	}
+
+	req, _ := http.NewRequest("POST", "/items/1/enrich?provider=openlibrary", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	var result dto.EnrichItemResult
+	if err := json.Unmarshal(w.Body.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if result.Provider != "openlibrary" || result.ExternalID != "OL893415W" || fmt.Sprint(result.Updated) != "[description release_date]" {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+	if saved == nil || saved.Description != "Set on the desert planet Arrakis." {
+		t.Errorf("Expected description saved, got %+v", saved)
+	}
+}
+
+func TestMetadataHandler_EnrichItemErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	router, _ := setupMetadataHandler(server.URL)
+
+	tests := []struct {
+		name       string
+		url        string
+		wantStatus int
+	}{
+		{"invalid ID", "/items/abc/enrich", http.StatusBadRequest},
+		{"invalid provider", "/items/1/enrich?provider=wikipedia", http.StatusBadRequest},
+		{"provider not configured", "/items/1/enrich?provider=tmdb", http.StatusBadRequest},
+		{"item not found", "/items/2/enrich", http.StatusNotFound},
+		{"provider failure", "/items/1/enrich", http.StatusBadGateway},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest("POST", tt.url, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("Expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+		})
+	}
+}
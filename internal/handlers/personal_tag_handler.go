@@ -0,0 +1,228 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/services"
+)
+
+type PersonalTagHandler struct {
+	personalTagService *services.PersonalTagService
+}
+
+// NewPersonalTagHandler cria uma nova instância do handler de rótulos pessoais
+func NewPersonalTagHandler(personalTagService *services.PersonalTagService) *PersonalTagHandler {
+	return &PersonalTagHandler{personalTagService: personalTagService}
+}
+
+// CreateTag cria um rótulo pessoal
+// @Summary      Create personal tag
+// @Description  Create a private label for entries of the user's list (does not affect catalog tags)
+// @Tags         personal-tags
+// @Accept       json
+// @Produce      json
+// @Param        tag  body  dto.PersonalTagRequest  true  "Personal tag data"
+// @Success      201  {object}  models.PersonalTag  "Personal tag created"
+// @Failure      400  {object}  map[string]string   "Bad request - validation error"
+// @Failure      409  {object}  map[string]string   "Personal tag with this name already exists"
+// @Router       /me/tags [post]
+func (h *PersonalTagHandler) CreateTag(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	var req dto.PersonalTagRequest
+	if err := validateAndBind(c, &req); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+
+	tag, err := h.personalTagService.CreateTag(ctx, userID, req)
+	if err != nil {
+		h.respondPersonalTagError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusCreated, tag)
+}
+
+// GetTags retorna os rótulos pessoais do usuário
+// @Summary      List personal tags
+// @Description  List the user's private labels with how many list entries use each one
+// @Tags         personal-tags
+// @Accept       json
+// @Produce      json
+// @Success      200  {array}   models.PersonalTag  "Success - returns personal tags"
+// @Failure      500  {object}  map[string]string   "Internal server error"
+// @Router       /me/tags [get]
+func (h *PersonalTagHandler) GetTags(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	tags, err := h.personalTagService.GetTags(ctx, userID)
+	if err != nil {
+		respondInternalError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, tags)
+}
+
+// UpdateTag atualiza um rótulo pessoal
+// @Summary      Update personal tag
+// @Description  Rename or recolor one of the user's private labels
+// @Tags         personal-tags
+// @Accept       json
+// @Produce      json
+// @Param        id   path  int                     true  "Personal tag ID"
+// @Param        tag  body  dto.PersonalTagRequest  true  "Personal tag data"
+// @Success      200  {object}  models.PersonalTag  "Personal tag updated"
+// @Failure      400  {object}  map[string]string   "Bad request - validation error"
+// @Failure      404  {object}  map[string]string   "Personal tag not found"
+// @Failure      409  {object}  map[string]string   "Personal tag with this name already exists"
+// @Router       /me/tags/{id} [put]
+func (h *PersonalTagHandler) UpdateTag(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	id, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	var req dto.PersonalTagRequest
+	if err := validateAndBind(c, &req); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+
+	tag, err := h.personalTagService.UpdateTag(ctx, id, userID, req)
+	if err != nil {
+		h.respondPersonalTagError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, tag)
+}
+
+// DeleteTag remove um rótulo pessoal
+// @Summary      Delete personal tag
+// @Description  Delete one of the user's private labels and remove it from all list entries
+// @Tags         personal-tags
+// @Accept       json
+// @Produce      json
+// @Param        id  path  int  true  "Personal tag ID"
+// @Success      204  "Personal tag deleted"
+// @Failure      400  {object}  map[string]string   "Bad request - invalid ID"
+// @Failure      404  {object}  map[string]string   "Personal tag not found"
+// @Router       /me/tags/{id} [delete]
+func (h *PersonalTagHandler) DeleteTag(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	id, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	if err := h.personalTagService.DeleteTag(ctx, id, userID); err != nil {
+		h.respondPersonalTagError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// SetListItemTags substitui os rótulos pessoais de uma entrada da lista
+// @Summary      Set personal tags of a list entry
+// @Description  Replace the personal tags of an entry in the user's list (empty tag_ids removes all)
+// @Tags         my-list
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int                         true  "User Item ID"
+// @Param        tags  body  dto.SetPersonalTagsRequest  true  "Personal tag IDs"
+// @Success      200  {object}  models.UserItem     "Success - returns the updated entry"
+// @Failure      400  {object}  map[string]string   "Bad request - invalid ID"
+// @Failure      404  {object}  map[string]string   "User item or personal tag not found"
+// @Router       /my-list/{id}/tags [put]
+func (h *PersonalTagHandler) SetListItemTags(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	id, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	var req dto.SetPersonalTagsRequest
+	if err := validateAndBind(c, &req); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+
+	userItem, err := h.personalTagService.SetUserItemTags(ctx, id, userID, req.TagIDs)
+	if err != nil {
+		h.respondPersonalTagError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, userItem)
+}
+
+// RemoveListItemTag remove um rótulo pessoal de uma entrada da lista
+// @Summary      Remove personal tag from a list entry
+// @Description  Remove one personal tag from an entry in the user's list
+// @Tags         my-list
+// @Accept       json
+// @Produce      json
+// @Param        id     path  int  true  "User Item ID"
+// @Param        tagId  path  int  true  "Personal tag ID"
+// @Success      204  "Personal tag removed"
+// @Failure      400  {object}  map[string]string   "Bad request - invalid ID"
+// @Failure      404  {object}  map[string]string   "User item or personal tag not found"
+// @Router       /my-list/{id}/tags/{tagId} [delete]
+func (h *PersonalTagHandler) RemoveListItemTag(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	id, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	tagID, err := validateID(c, "tagId")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	if err := h.personalTagService.RemoveUserItemTag(ctx, id, userID, tagID); err != nil {
+		h.respondPersonalTagError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// respondPersonalTagError mapeia os erros do serviço de rótulos pessoais para respostas HTTP
+func (h *PersonalTagHandler) respondPersonalTagError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, models.ErrPersonalTagNotFound):
+		respondNotFound(c, "Personal tag")
+	case errors.Is(err, models.ErrDuplicatePersonalTag):
+		respondError(c, http.StatusConflict, dto.ErrCodeDuplicate, err.Error())
+	case errors.Is(err, models.ErrTagNameEmpty):
+		respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, err.Error())
+	case errors.Is(err, models.ErrUserItemNotFound):
+		respondNotFound(c, "User item")
+	default:
+		respondInternalError(c, err)
+	}
+}
@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/services"
+	"github.com/rafaelc-rb/geekery-api/internal/testutil"
+)
+
+func setupPersonalTagHandler() (*PersonalTagHandler, *testutil.MockPersonalTagRepository, *testutil.MockUserItemRepository) {
+	mockPersonalTagRepo := &testutil.MockPersonalTagRepository{}
+	mockUserItemRepo := &testutil.MockUserItemRepository{}
+	service := services.NewPersonalTagService(mockPersonalTagRepo, mockUserItemRepo)
+	handler := NewPersonalTagHandler(service)
+	gin.SetMode(gin.TestMode)
+	return handler, mockPersonalTagRepo, mockUserItemRepo
+}
+
+func TestPersonalTagHandler_CreateTag_Duplicate(t *testing.T) {
+	handler, mockPersonalTagRepo, _ := setupPersonalTagHandler()
+	mockPersonalTagRepo.GetByNameFunc = func(ctx context.Context, userID uint, name string) (*models.PersonalTag, error) {
+		return &models.PersonalTag{ID: 1, UserID: userID, Name: name}, nil
+	}
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(1)) // Mock authenticated user with ID 1
+	router.POST("/me/tags", handler.CreateTag)
+
+	body, _ := json.Marshal(map[string]interface{}{"name": "comfort rewatch"})
+	req, _ := http.NewRequest("POST", "/me/tags", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusConflict {
+		t.Errorf("Expected status 409, got %d", w.Code)
+	}
+}
+
+func TestPersonalTagHandler_CreateTag_InvalidColor(t *testing.T) {
+	handler, _, _ := setupPersonalTagHandler()
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(1)) // Mock authenticated user with ID 1
+	router.POST("/me/tags", handler.CreateTag)
+
+	body, _ := json.Marshal(map[string]interface{}{"name": "favorites", "color": "orange"})
+	req, _ := http.NewRequest("POST", "/me/tags", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", w.Code)
+	}
+}
+
+func TestPersonalTagHandler_SetListItemTags_UserItemNotFound(t *testing.T) {
+	handler, _, mockUserItemRepo := setupPersonalTagHandler()
+	mockUserItemRepo.GetByIDAndUserFunc = func(ctx context.Context, id, userID uint) (*models.UserItem, error) {
+		return nil, models.ErrUserItemNotFound
+	}
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(1)) // Mock authenticated user with ID 1
+	router.PUT("/my-list/:id/tags", handler.SetListItemTags)
+
+	body, _ := json.Marshal(map[string]interface{}{"tag_ids": []uint{1}})
+	req, _ := http.NewRequest("PUT", "/my-list/99/tags", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", w.Code)
+	}
+}
@@ -0,0 +1,205 @@
+package handlers
+
+import (
+	"fmt"
+	"html"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+)
+
+// Dimensões do card de compartilhamento do recap
+// recapCardHeight é a altura mínima: o card cresce se o conteúdo não couber
+const (
+	recapCardWidth  = 600
+	recapCardHeight = 800
+	recapCardMargin = 40 // Espaço abaixo da última linha e do rodapé
+	recapFooterGap  = 50 // Distância entre a última linha e o rodapé (most rewatched)
+)
+
+// recapMaxTimeLines limita as linhas de tempo por tipo de mídia no card (as demais viram "+N more")
+const recapMaxTimeLines = 3
+
+// Cores do card
+const (
+	recapColorAccent = "#a5b4fc" // Títulos das seções
+	recapColorText   = "#ffffff"
+	recapColorMuted  = "#cbd5e1" // Legendas e rodapé
+	recapColorTop    = "#1e1b4b" // Gradiente do fundo
+	recapColorBottom = "#0f172a"
+)
+
+// recapCardRadius é o raio dos cantos arredondados do card
+const recapCardRadius = 24
+
+// recapText é uma linha de texto posicionada no card (y é a linha de base)
+type recapText struct {
+	x, y  int
+	size  int
+	color string
+	bold  bool
+	text  string
+}
+
+// recapCard é o layout do card, desenhado em SVG ou PNG
+type recapCard struct {
+	height int
+	texts  []recapText
+}
+
+// layoutRecapCard posiciona o conteúdo do recap no card
+// A altura é calculada depois de posicionar as linhas, pois o card cresce para caber o conteúdo
+func layoutRecapCard(recap *dto.RecapDTO) recapCard {
+	var card recapCard
+
+	card.add(40, 70, 22, recapColorAccent, false, "Geekery Recap")
+	card.add(40, 130, 56, recapColorText, true, strconv.Itoa(recap.Year))
+
+	// Números principais
+	card.addStat(40, 210, recap.TotalCompletions, "completions")
+	card.addStat(230, 210, recap.ItemsStarted, "started")
+	card.addStat(420, 210, recap.LongestStreak.Days, "day streak")
+
+	y := 290
+	card.addHeading(y, "Top rated")
+	if len(recap.TopRated) == 0 {
+		y += 34
+		card.addLine(y, "—")
+	}
+	for i, item := range recap.TopRated {
+		y += 34
+		card.addLine(y, fmt.Sprintf("%d. %s  ★ %.1f", i+1, truncateRecapText(item.Title, 38), item.Rating))
+	}
+
+	y += 60
+	card.addHeading(y, "Top tags")
+	tags := make([]string, 0, len(recap.TopTags))
+	for _, tag := range recap.TopTags {
+		tags = append(tags, fmt.Sprintf("#%s", tag.Name))
+	}
+	if len(tags) == 0 {
+		tags = append(tags, "—")
+	}
+	y += 34
+	card.addLine(y, truncateRecapText(strings.Join(tags, "  "), 48))
+
+	y += 60
+	card.addHeading(y, "Time spent")
+	for _, line := range limitRecapLines(recapTimeLines(recap.TimeByMediaType), recapMaxTimeLines) {
+		y += 34
+		card.addLine(y, truncateRecapText(line, 48))
+	}
+
+	bottom := y + recapCardMargin
+	if recap.MostRewatched != nil {
+		bottom += recapFooterGap
+	}
+	card.height = max(recapCardHeight, bottom)
+
+	if recap.MostRewatched != nil {
+		card.add(40, card.height-recapCardMargin, 16, recapColorMuted, false,
+			fmt.Sprintf("Most rewatched: %s (%dx)", truncateRecapText(recap.MostRewatched.Title, 40), recap.MostRewatched.CompletionCount))
+	}
+	return card
+}
+
+// add posiciona uma linha de texto no card
+func (c *recapCard) add(x, y, size int, color string, bold bool, text string) {
+	c.texts = append(c.texts, recapText{x: x, y: y, size: size, color: color, bold: bold, text: text})
+}
+
+// addStat posiciona um número em destaque com sua legenda
+func (c *recapCard) addStat(x, y, value int, label string) {
+	c.add(x, y, 44, recapColorText, true, strconv.Itoa(value))
+	c.add(x, y+26, 16, recapColorMuted, false, label)
+}
+
+// addHeading posiciona o título de uma seção
+func (c *recapCard) addHeading(y int, text string) {
+	c.add(40, y, 20, recapColorAccent, false, text)
+}
+
+// addLine posiciona uma linha de conteúdo de uma seção
+func (c *recapCard) addLine(y int, text string) {
+	c.add(40, y, 20, recapColorText, false, text)
+}
+
+// renderRecapCard renderiza o recap como um card SVG para compartilhamento
+func renderRecapCard(recap *dto.RecapDTO) []byte {
+	card := layoutRecapCard(recap)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="Helvetica, Arial, sans-serif">`,
+		recapCardWidth, card.height, recapCardWidth, card.height)
+	fmt.Fprintf(&b, `<defs><linearGradient id="bg" x1="0" y1="0" x2="0" y2="1"><stop offset="0" stop-color="%s"/><stop offset="1" stop-color="%s"/></linearGradient></defs>`,
+		recapColorTop, recapColorBottom)
+	fmt.Fprintf(&b, `<rect width="%d" height="%d" rx="%d" fill="url(#bg)"/>`, recapCardWidth, card.height, recapCardRadius)
+	for _, text := range card.texts {
+		weight := ""
+		if text.bold {
+			weight = ` font-weight="bold"`
+		}
+		fmt.Fprintf(&b, `<text x="%d" y="%d" fill="%s" font-size="%d"%s>%s</text>`,
+			text.x, text.y, text.color, text.size, weight, html.EscapeString(text.text))
+	}
+	b.WriteString(`</svg>`)
+	return []byte(b.String())
+}
+
+// recapTimeLines formata o consumo por tipo de mídia (ordenado pelo nome do tipo)
+func recapTimeLines(times map[string]dto.TimeSpentDTO) []string {
+	mediaTypes := make([]string, 0, len(times))
+	for mediaType := range times {
+		mediaTypes = append(mediaTypes, mediaType)
+	}
+	sort.Strings(mediaTypes)
+
+	lines := make([]string, 0, len(mediaTypes))
+	for _, mediaType := range mediaTypes {
+		spent := times[mediaType]
+		var parts []string
+		if spent.EpisodesWatched > 0 {
+			parts = append(parts, fmt.Sprintf("%d episodes", spent.EpisodesWatched))
+		}
+		if spent.MinutesWatched > 0 {
+			parts = append(parts, fmt.Sprintf("%dh %dmin", spent.MinutesWatched/60, spent.MinutesWatched%60))
+		}
+		if spent.ChaptersRead > 0 {
+			parts = append(parts, fmt.Sprintf("%d chapters", spent.ChaptersRead))
+		}
+		if spent.PagesRead > 0 {
+			parts = append(parts, fmt.Sprintf("%d pages", spent.PagesRead))
+		}
+		if spent.GameHours > 0 {
+			parts = append(parts, fmt.Sprintf("%.0f hours", spent.GameHours))
+		}
+		if len(parts) == 0 {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%s: %s", mediaType, strings.Join(parts, ", ")))
+	}
+
+	if len(lines) == 0 {
+		lines = append(lines, "—")
+	}
+	return lines
+}
+
+// limitRecapLines mantém as primeiras limit linhas e resume as demais em "+N more"
+func limitRecapLines(lines []string, limit int) []string {
+	if len(lines) <= limit {
+		return lines
+	}
+	return append(lines[:limit:limit], fmt.Sprintf("+%d more", len(lines)-limit))
+}
+
+// truncateRecapText limita o texto ao número de caracteres (runes) informado
+func truncateRecapText(text string, limit int) string {
+	runes := []rune(text)
+	if len(runes) <= limit {
+		return text
+	}
+	return string(runes[:limit-1]) + "…"
+}
@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+	"golang.org/x/image/vector"
+)
+
+// recapStar é desenhado como polígono: as fontes Go não têm o glifo
+const recapStar = "★"
+
+var (
+	recapFontsOnce sync.Once
+	recapRegular   *opentype.Font
+	recapBold      *opentype.Font
+	recapFontsErr  error
+)
+
+// loadRecapFonts carrega (uma única vez) as fontes Go embutidas usadas no PNG
+func loadRecapFonts() error {
+	recapFontsOnce.Do(func() {
+		if recapRegular, recapFontsErr = opentype.Parse(goregular.TTF); recapFontsErr != nil {
+			return
+		}
+		recapBold, recapFontsErr = opentype.Parse(gobold.TTF)
+	})
+	return recapFontsErr
+}
+
+// renderRecapCardPNG renderiza o recap como um card PNG, com o mesmo layout do SVG
+// Útil para redes sociais e apps de mensagem que não exibem SVG
+func renderRecapCardPNG(recap *dto.RecapDTO) ([]byte, error) {
+	if err := loadRecapFonts(); err != nil {
+		return nil, fmt.Errorf("failed to load recap card fonts: %w", err)
+	}
+
+	card := layoutRecapCard(recap)
+	img := image.NewRGBA(image.Rect(0, 0, recapCardWidth, card.height))
+	drawRecapBackground(img)
+
+	type faceKey struct {
+		size int
+		bold bool
+	}
+	faces := make(map[faceKey]font.Face)
+	defer func() {
+		for _, face := range faces {
+			_ = face.Close()
+		}
+	}()
+
+	for _, text := range card.texts {
+		key := faceKey{size: text.size, bold: text.bold}
+		face, ok := faces[key]
+		if !ok {
+			source := recapRegular
+			if text.bold {
+				source = recapBold
+			}
+			var err error
+			face, err = opentype.NewFace(source, &opentype.FaceOptions{Size: float64(text.size), DPI: 72, Hinting: font.HintingFull})
+			if err != nil {
+				return nil, fmt.Errorf("failed to load recap card font: %w", err)
+			}
+			faces[key] = face
+		}
+		drawRecapText(img, face, text)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("failed to encode recap card: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// drawRecapBackground pinta o gradiente vertical do fundo, deixando transparentes os cantos arredondados
+func drawRecapBackground(img *image.RGBA) {
+	top, bottom := parseRecapColor(recapColorTop), parseRecapColor(recapColorBottom)
+	bounds := img.Bounds()
+	height := bounds.Dy()
+
+	for y := 0; y < height; y++ {
+		t := float64(y) / float64(max(height-1, 1))
+		row := color.RGBA{
+			R: uint8(float64(top.R) + t*(float64(bottom.R)-float64(top.R))),
+			G: uint8(float64(top.G) + t*(float64(bottom.G)-float64(top.G))),
+			B: uint8(float64(top.B) + t*(float64(bottom.B)-float64(top.B))),
+			A: 255,
+		}
+		for x := 0; x < bounds.Dx(); x++ {
+			if outsideRecapCorner(x, y, bounds.Dx(), height) {
+				continue
+			}
+			img.SetRGBA(x, y, row)
+		}
+	}
+}
+
+// outsideRecapCorner indica se o pixel fica fora dos cantos arredondados do card
+func outsideRecapCorner(x, y, width, height int) bool {
+	cx, cy := float64(recapCardRadius), float64(recapCardRadius)
+	switch {
+	case x < recapCardRadius:
+	case x >= width-recapCardRadius:
+		cx = float64(width - recapCardRadius)
+	default:
+		return false
+	}
+	switch {
+	case y < recapCardRadius:
+	case y >= height-recapCardRadius:
+		cy = float64(height - recapCardRadius)
+	default:
+		return false
+	}
+	return math.Hypot(float64(x)+0.5-cx, float64(y)+0.5-cy) > recapCardRadius
+}
+
+// drawRecapText escreve uma linha do card a partir da linha de base, desenhando a estrela à parte
+func drawRecapText(img *image.RGBA, face font.Face, text recapText) {
+	ink := image.NewUniform(parseRecapColor(text.color))
+	drawer := &font.Drawer{Dst: img, Src: ink, Face: face, Dot: fixed.P(text.x, text.y)}
+
+	for i, segment := range strings.Split(text.text, recapStar) {
+		if i > 0 {
+			size := float32(text.size)
+			x := float32(drawer.Dot.X.Round())
+			drawRecapStar(img, ink, x+size*0.45, float32(text.y)-size*0.35, size*0.45)
+			drawer.Dot.X += fixed.I(int(size * 0.9))
+		}
+		drawer.DrawString(segment)
+	}
+}
+
+// drawRecapStar desenha uma estrela de cinco pontas centrada em (cx, cy)
+// O rasterizador cobre apenas o quadrado da estrela
+func drawRecapStar(img *image.RGBA, ink image.Image, cx, cy, radius float32) {
+	side := int(math.Ceil(float64(radius)*2)) + 2
+	origin := image.Pt(int(cx-radius)-1, int(cy-radius)-1)
+	rasterizer := vector.NewRasterizer(side, side)
+	for i := 0; i < 10; i++ {
+		r := radius
+		if i%2 == 1 {
+			r = radius * 0.4
+		}
+		angle := float64(i)*math.Pi/5 - math.Pi/2
+		x := cx - float32(origin.X) + r*float32(math.Cos(angle))
+		y := cy - float32(origin.Y) + r*float32(math.Sin(angle))
+		if i == 0 {
+			rasterizer.MoveTo(x, y)
+		} else {
+			rasterizer.LineTo(x, y)
+		}
+	}
+	rasterizer.ClosePath()
+	rasterizer.Draw(img, image.Rectangle{Min: origin, Max: origin.Add(image.Pt(side, side))}, ink, image.Point{})
+}
+
+// parseRecapColor converte uma cor #rrggbb do layout
+func parseRecapColor(hex string) color.RGBA {
+	value, _ := strconv.ParseUint(hex[1:], 16, 32)
+	return color.RGBA{R: uint8(value >> 16), G: uint8(value >> 8), B: uint8(value), A: 255}
+}
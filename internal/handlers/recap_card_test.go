@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	"image/png"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+)
+
+func TestRenderRecapCard_AllMediaTypesFitInCard(t *testing.T) {
+	recap := &dto.RecapDTO{
+		Year:            2025,
+		TimeByMediaType: map[string]dto.TimeSpentDTO{},
+		MostRewatched:   &dto.RecapItemDTO{Title: "Your Name", CompletionCount: 4},
+	}
+	for i := 1; i <= 5; i++ {
+		recap.TopRated = append(recap.TopRated, dto.RecapItemDTO{Title: strings.Repeat("Long title ", 6), Rating: 9})
+		recap.TopTags = append(recap.TopTags, dto.TagCountDTO{Name: fmt.Sprintf("tag%d", i), Count: 1})
+	}
+	for _, mediaType := range models.ValidMediaTypes {
+		recap.TimeByMediaType[string(mediaType)] = dto.TimeSpentDTO{EpisodesWatched: 120, MinutesWatched: 3000, ChaptersRead: 300, PagesRead: 5000, GameHours: 80}
+	}
+
+	card := string(renderRecapCard(recap))
+
+	height, _ := strconv.Atoi(regexp.MustCompile(`<svg [^>]*height="(\d+)"`).FindStringSubmatch(card)[1])
+	if height < recapCardHeight {
+		t.Fatalf("Expected height of at least %d, got %d", recapCardHeight, height)
+	}
+
+	extra := len(recap.TimeByMediaType) - recapMaxTimeLines
+	if !strings.Contains(card, fmt.Sprintf(">+%d more<", extra)) {
+		t.Errorf("Expected time lines capped with +%d more, got %s", extra, card)
+	}
+
+	// Todas as linhas dentro do card e acima do rodapé, sem sobreposição
+	var ys []int
+	for _, match := range regexp.MustCompile(`<text x="\d+" y="(\d+)"[^>]*>([^<]*)</text>`).FindAllStringSubmatch(card, -1) {
+		y, _ := strconv.Atoi(match[1])
+		if y > height-recapCardMargin {
+			t.Errorf("Line %q at y=%d overflows card of height %d", match[2], y, height)
+		}
+		ys = append(ys, y)
+	}
+	footer := ys[len(ys)-1]
+	if footer != height-recapCardMargin || footer-ys[len(ys)-2] < 30 {
+		t.Errorf("Expected footer below the last line, got y=%d after y=%d", footer, ys[len(ys)-2])
+	}
+}
+
+func TestRenderRecapCardPNG_MatchesLayout(t *testing.T) {
+	recap := &dto.RecapDTO{
+		Year:          2025,
+		TopRated:      []dto.RecapItemDTO{{Title: "Frieren", Rating: 10}},
+		MostRewatched: &dto.RecapItemDTO{Title: "Your Name", CompletionCount: 4},
+		TimeByMediaType: map[string]dto.TimeSpentDTO{
+			"anime": {EpisodesWatched: 28},
+		},
+	}
+	for _, mediaType := range models.ValidMediaTypes {
+		recap.TimeByMediaType[string(mediaType)] = dto.TimeSpentDTO{EpisodesWatched: 12}
+	}
+
+	data, err := renderRecapCardPNG(recap)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Expected a valid PNG, got %v", err)
+	}
+
+	bounds := img.Bounds()
+	if bounds.Dx() != recapCardWidth || bounds.Dy() != layoutRecapCard(recap).height {
+		t.Errorf("Expected %dx%d card, got %v", recapCardWidth, layoutRecapCard(recap).height, bounds)
+	}
+	if _, _, _, a := img.At(0, 0).RGBA(); a != 0 {
+		t.Error("Expected transparent rounded corner")
+	}
+
+	// O ano (texto branco em negrito) precisa ter sido desenhado sobre o fundo escuro
+	var white int
+	for y := 80; y < 130; y++ {
+		for x := 40; x < 200; x++ {
+			if r, g, b, _ := img.At(x, y).RGBA(); r > 0xf000 && g > 0xf000 && b > 0xf000 {
+				white++
+			}
+		}
+	}
+	if white == 0 {
+		t.Error("Expected the year drawn on the card")
+	}
+}
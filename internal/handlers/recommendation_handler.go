@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/services"
+)
+
+type RecommendationHandler struct {
+	service *services.RecommendationService
+}
+
+// NewRecommendationHandler cria uma nova instância do handler de recomendações
+func NewRecommendationHandler(service *services.RecommendationService) *RecommendationHandler {
+	return &RecommendationHandler{service: service}
+}
+
+// GetRecommendations retorna items recomendados com base na lista do usuário
+// @Summary      Get recommendations
+// @Description  Suggest catalog items the user hasn't added, blending content similarity (shared tags, creator, media type) with item-item collaborative filtering over all users' ratings and statuses. Each suggestion explains which list entries it came from. Users without history get community favorites
+// @Tags         my-list
+// @Accept       json
+// @Produce      json
+// @Param        type   query  string  false  "Filter by media type" Enums(anime, movie, series, game, manga, light_novel, music, book)
+// @Param        limit  query  int     false  "Number of recommendations" default(20) minimum(1) maximum(50)
+// @Success      200  {array}   dto.RecommendationDTO  "Success - returns recommendations"
+// @Failure      400  {object}  map[string]string      "Bad request - invalid parameters"
+// @Failure      500  {object}  map[string]string      "Internal server error"
+// @Router       /my-list/recommendations [get]
+func (h *RecommendationHandler) GetRecommendations(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	var filter dto.RecommendationFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+
+	recommendations, err := h.service.GetRecommendations(ctx, userID, filter)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidMediaType) {
+			respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, err.Error())
+			return
+		}
+		respondInternalError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, recommendations)
+}
+
+// GetSimilarItems retorna items parecidos com um item do catálogo ("mais como este")
+// @Summary      Get similar items
+// @Description  Rank other catalog items by tag overlap (IDF-weighted Jaccard over item tags), same creator (studio, director, developer or author) and co-occurrence in user lists. Results are cached and invalidated when tags change
+// @Tags         items
+// @Accept       json
+// @Produce      json
+// @Param        id         path   int     true   "Item ID"
+// @Param        same_type  query  bool    false  "Only items of the same media type (default: cross-media)"
+// @Param        type       query  string  false  "Only items of this media type (ignored with same_type)" Enums(anime, movie, series, game, manga, light_novel, music, book)
+// @Param        limit      query  int     false  "Number of similar items" default(10) minimum(1) maximum(50)
+// @Success      200  {array}   dto.SimilarItemDTO  "Success - returns similar items"
+// @Failure      400  {object}  map[string]string   "Bad request - invalid parameters"
+// @Failure      404  {object}  map[string]string   "Item not found"
+// @Failure      500  {object}  map[string]string   "Internal server error"
+// @Router       /items/{id}/similar [get]
+func (h *RecommendationHandler) GetSimilarItems(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	itemID, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	var filter dto.SimilarItemsFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+
+	similar, err := h.service.GetSimilarItems(ctx, itemID, filter)
+	if err != nil {
+		switch {
+		case errors.Is(err, models.ErrItemNotFound):
+			respondNotFound(c, "Item")
+		case errors.Is(err, models.ErrInvalidMediaType):
+			respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, err.Error())
+		default:
+			respondInternalError(c, err)
+		}
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, similar)
+}
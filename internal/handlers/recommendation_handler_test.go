@@ -0,0 +1,157 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/services"
+	"github.com/rafaelc-rb/geekery-api/internal/testutil"
+)
+
+func setupRecommendationHandler() (*RecommendationHandler, *testutil.MockRecommendationRepository) {
+	mockRepo := &testutil.MockRecommendationRepository{}
+	service := services.NewRecommendationService(mockRepo, &testutil.MockItemRepository{}, nil)
+	handler := NewRecommendationHandler(service)
+	gin.SetMode(gin.TestMode)
+	return handler, mockRepo
+}
+
+func TestRecommendationHandler_GetRecommendations(t *testing.T) {
+	handler, mockRepo := setupRecommendationHandler()
+
+	var receivedUserID uint
+	var receivedType models.MediaType
+	mockRepo.GetSignalsFunc = func(ctx context.Context, userID uint) ([]dto.UserItemSignal, error) {
+		receivedUserID = userID
+		return []dto.UserItemSignal{{UserID: userID, ItemID: 1, Rating: 9}}, nil
+	}
+	mockRepo.GetSimilaritiesFunc = func(ctx context.Context, itemIDs []uint, mediaType models.MediaType) ([]models.ItemSimilarity, error) {
+		receivedType = mediaType
+		return []models.ItemSimilarity{{ItemID: 1, SimilarItemID: 2, Score: 0.7}}, nil
+	}
+	mockRepo.GetItemsByIDsFunc = func(ctx context.Context, ids []uint) ([]models.Item, error) {
+		return []models.Item{
+			{ID: 1, Title: "Mob Psycho 100", Type: models.MediaTypeAnime},
+			{ID: 2, Title: "One Punch Man", Type: models.MediaTypeAnime},
+		}, nil
+	}
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(4))
+	router.GET("/my-list/recommendations", handler.GetRecommendations)
+
+	req, _ := http.NewRequest("GET", "/my-list/recommendations?type=anime&limit=5", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if receivedUserID != 4 {
+		t.Errorf("Expected user 4, got %d", receivedUserID)
+	}
+	if receivedType != models.MediaTypeAnime {
+		t.Errorf("Expected type filter 'anime', got '%s'", receivedType)
+	}
+
+	var recommendations []dto.RecommendationDTO
+	if err := json.Unmarshal(w.Body.Bytes(), &recommendations); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if len(recommendations) != 1 || recommendations[0].Item.ID != 2 {
+		t.Fatalf("Expected item 2 to be recommended, got %+v", recommendations)
+	}
+	if len(recommendations[0].Reasons) == 0 || recommendations[0].Reasons[0].ItemID != 1 {
+		t.Errorf("Expected explanation referencing item 1, got %+v", recommendations[0].Reasons)
+	}
+}
+
+func TestRecommendationHandler_GetRecommendations_InvalidLimit(t *testing.T) {
+	handler, _ := setupRecommendationHandler()
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(1))
+	router.GET("/my-list/recommendations", handler.GetRecommendations)
+
+	req, _ := http.NewRequest("GET", "/my-list/recommendations?limit=500", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", w.Code)
+	}
+}
+
+func TestRecommendationHandler_GetRecommendations_InvalidType(t *testing.T) {
+	handler, _ := setupRecommendationHandler()
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(1))
+	router.GET("/my-list/recommendations", handler.GetRecommendations)
+
+	req, _ := http.NewRequest("GET", "/my-list/recommendations?type=podcast", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", w.Code)
+	}
+}
+
+func TestRecommendationHandler_GetSimilarItems(t *testing.T) {
+	handler, mockRepo := setupRecommendationHandler()
+
+	mockRepo.GetItemFeaturesFunc = func(ctx context.Context, itemIDs []uint) ([]dto.ItemFeatures, error) {
+		features := []dto.ItemFeatures{}
+		for _, id := range itemIDs {
+			features = append(features, dto.ItemFeatures{ItemID: id, MediaType: "anime", TagIDs: []uint{1}})
+		}
+		return features, nil
+	}
+	mockRepo.GetItemIDsSharingTagsFunc = func(ctx context.Context, itemID uint, limit int) ([]uint, error) {
+		return []uint{2}, nil
+	}
+	mockRepo.GetItemsByIDsFunc = func(ctx context.Context, ids []uint) ([]models.Item, error) {
+		return []models.Item{{ID: 2, Title: "Similar", Type: models.MediaTypeAnime, Tags: []models.Tag{{ID: 1, Name: "mecha"}}}}, nil
+	}
+
+	router := gin.New()
+	router.GET("/items/:id/similar", handler.GetSimilarItems)
+
+	req, _ := http.NewRequest("GET", "/items/1/similar?same_type=true", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	var similar []dto.SimilarItemDTO
+	if err := json.Unmarshal(w.Body.Bytes(), &similar); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if len(similar) != 1 || similar[0].Item.ID != 2 || len(similar[0].SharedTags) != 1 {
+		t.Errorf("Expected item 2 with shared tag, got %+v", similar)
+	}
+}
+
+func TestRecommendationHandler_GetSimilarItems_NotFound(t *testing.T) {
+	handler, _ := setupRecommendationHandler()
+
+	router := gin.New()
+	router.GET("/items/:id/similar", handler.GetSimilarItems)
+
+	req, _ := http.NewRequest("GET", "/items/99/similar", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", w.Code)
+	}
+}
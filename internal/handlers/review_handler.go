@@ -0,0 +1,316 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/services"
+)
+
+type ReviewHandler struct {
+	reviewService *services.ReviewService
+}
+
+// NewReviewHandler cria uma nova instância do handler de resenhas
+func NewReviewHandler(reviewService *services.ReviewService) *ReviewHandler {
+	return &ReviewHandler{reviewService: reviewService}
+}
+
+// GetItemReviews lista as resenhas de um item
+// @Summary      List item reviews
+// @Description  List public reviews of a catalog item with sorting, filters and pagination. When authenticated, voted_helpful tells whether the user voted on each review
+// @Tags         reviews
+// @Accept       json
+// @Produce      json
+// @Param        id             path   int     true   "Item ID"
+// @Param        sort           query  string  false  "Sort order (default: helpful)" Enums(helpful, recent, rating)
+// @Param        recommended    query  bool    false  "Only reviews that recommend (true) or not (false)"
+// @Param        hide_spoilers  query  bool    false  "Exclude reviews flagged as spoilers"
+// @Param        page           query  int     false  "Page number (default: 1)"
+// @Param        limit          query  int     false  "Items per page (default: 20, max: 100)"
+// @Success      200  {object}  dto.PaginatedResponse{data=[]dto.ReviewDTO}  "Success - returns reviews"
+// @Failure      400  {object}  map[string]string   "Bad request - invalid parameters"
+// @Failure      404  {object}  map[string]string   "Item not found"
+// @Failure      500  {object}  map[string]string   "Internal server error"
+// @Router       /items/{id}/reviews [get]
+func (h *ReviewHandler) GetItemReviews(c *gin.Context) {
+	ctx := c.Request.Context()
+	viewerID := getUserID(c)
+
+	itemID, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	var params dto.PaginationParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+	params.Normalize()
+
+	var filter dto.ReviewFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+
+	reviews, total, err := h.reviewService.GetItemReviews(ctx, itemID, viewerID, filter, params)
+	if err != nil {
+		h.respondReviewError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, dto.NewPaginatedResponse(reviews, params.Page, params.Limit, total))
+}
+
+// CreateReview publica uma resenha sobre um item
+// @Summary      Write a review
+// @Description  Publish a review of a catalog item (one per user and item). The rating is a snapshot of the item's rating in the user's list; it is shown as 0 while the author hides ratings or keeps the list entry private. Private list notes are not affected
+// @Tags         reviews
+// @Accept       json
+// @Produce      json
+// @Param        id      path  int                true  "Item ID"
+// @Param        review  body  dto.ReviewRequest  true  "Review data"
+// @Success      201  {object}  dto.ReviewDTO       "Review created"
+// @Failure      400  {object}  map[string]string   "Bad request - validation error"
+// @Failure      401  {object}  map[string]string   "Unauthorized"
+// @Failure      404  {object}  map[string]string   "Item not found"
+// @Failure      409  {object}  map[string]string   "Item already reviewed by the user"
+// @Router       /items/{id}/reviews [post]
+func (h *ReviewHandler) CreateReview(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	itemID, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	var req dto.ReviewRequest
+	if err := validateAndBind(c, &req); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+
+	review, err := h.reviewService.CreateReview(ctx, userID, itemID, req)
+	if err != nil {
+		h.respondReviewError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusCreated, review)
+}
+
+// GetReview retorna uma resenha
+// @Summary      Get review
+// @Description  Get a single review
+// @Tags         reviews
+// @Accept       json
+// @Produce      json
+// @Param        id  path  int  true  "Review ID"
+// @Success      200  {object}  dto.ReviewDTO       "Success - returns review"
+// @Failure      400  {object}  map[string]string   "Bad request - invalid ID"
+// @Failure      404  {object}  map[string]string   "Review not found"
+// @Router       /reviews/{id} [get]
+func (h *ReviewHandler) GetReview(c *gin.Context) {
+	ctx := c.Request.Context()
+	viewerID := getUserID(c)
+
+	id, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	review, err := h.reviewService.GetReview(ctx, id, viewerID)
+	if err != nil {
+		h.respondReviewError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, review)
+}
+
+// UpdateReview edita uma resenha
+// @Summary      Edit review
+// @Description  Edit the authenticated user's review. The previous version is kept in the edit history and the rating snapshot is refreshed from the list
+// @Tags         reviews
+// @Accept       json
+// @Produce      json
+// @Param        id      path  int                true  "Review ID"
+// @Param        review  body  dto.ReviewRequest  true  "Review data"
+// @Success      200  {object}  dto.ReviewDTO       "Review updated"
+// @Failure      400  {object}  map[string]string   "Bad request - validation error"
+// @Failure      403  {object}  map[string]string   "Not the author"
+// @Failure      404  {object}  map[string]string   "Review not found"
+// @Router       /reviews/{id} [put]
+func (h *ReviewHandler) UpdateReview(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	id, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	var req dto.ReviewRequest
+	if err := validateAndBind(c, &req); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+
+	review, err := h.reviewService.UpdateReview(ctx, id, userID, req)
+	if err != nil {
+		h.respondReviewError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, review)
+}
+
+// DeleteReview remove uma resenha
+// @Summary      Delete review
+// @Description  Delete the authenticated user's review
+// @Tags         reviews
+// @Accept       json
+// @Produce      json
+// @Param        id  path  int  true  "Review ID"
+// @Success      204  "Review deleted"
+// @Failure      400  {object}  map[string]string   "Bad request - invalid ID"
+// @Failure      403  {object}  map[string]string   "Not the author"
+// @Failure      404  {object}  map[string]string   "Review not found"
+// @Router       /reviews/{id} [delete]
+func (h *ReviewHandler) DeleteReview(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	id, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	if err := h.reviewService.DeleteReview(ctx, id, userID); err != nil {
+		h.respondReviewError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetReviewHistory retorna o histórico de edições de uma resenha
+// @Summary      Review edit history
+// @Description  List the previous versions of a review, most recent first
+// @Tags         reviews
+// @Accept       json
+// @Produce      json
+// @Param        id  path  int  true  "Review ID"
+// @Success      200  {array}   dto.ReviewRevisionDTO  "Success - returns previous versions"
+// @Failure      400  {object}  map[string]string      "Bad request - invalid ID"
+// @Failure      404  {object}  map[string]string      "Review not found"
+// @Router       /reviews/{id}/history [get]
+func (h *ReviewHandler) GetReviewHistory(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	history, err := h.reviewService.GetReviewHistory(ctx, id)
+	if err != nil {
+		h.respondReviewError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, history)
+}
+
+// VoteHelpful marca uma resenha como útil
+// @Summary      Vote review as helpful
+// @Description  Mark another user's review as helpful (voting again has no effect)
+// @Tags         reviews
+// @Accept       json
+// @Produce      json
+// @Param        id  path  int  true  "Review ID"
+// @Success      200  {object}  dto.ReviewDTO       "Updated review"
+// @Failure      400  {object}  map[string]string   "Bad request - own review"
+// @Failure      404  {object}  map[string]string   "Review not found"
+// @Router       /reviews/{id}/helpful [post]
+func (h *ReviewHandler) VoteHelpful(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	id, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	review, err := h.reviewService.VoteHelpful(ctx, id, userID)
+	if err != nil {
+		h.respondReviewError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, review)
+}
+
+// RemoveHelpfulVote remove o voto útil
+// @Summary      Remove helpful vote
+// @Description  Remove the authenticated user's helpful vote from a review
+// @Tags         reviews
+// @Accept       json
+// @Produce      json
+// @Param        id  path  int  true  "Review ID"
+// @Success      200  {object}  dto.ReviewDTO       "Updated review"
+// @Failure      400  {object}  map[string]string   "Bad request - invalid ID"
+// @Failure      404  {object}  map[string]string   "Review not found"
+// @Router       /reviews/{id}/helpful [delete]
+func (h *ReviewHandler) RemoveHelpfulVote(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	id, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	review, err := h.reviewService.RemoveHelpfulVote(ctx, id, userID)
+	if err != nil {
+		h.respondReviewError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, review)
+}
+
+// respondReviewError mapeia os erros do serviço de resenhas para respostas HTTP
+func (h *ReviewHandler) respondReviewError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, models.ErrReviewNotFound):
+		respondNotFound(c, "Review")
+	case errors.Is(err, models.ErrItemNotFound):
+		respondNotFound(c, "Item")
+	case errors.Is(err, models.ErrDuplicateReview):
+		respondError(c, http.StatusConflict, dto.ErrCodeDuplicate, err.Error())
+	case errors.Is(err, models.ErrNotReviewAuthor):
+		respondError(c, http.StatusForbidden, dto.ErrCodeForbidden, err.Error())
+	case errors.Is(err, models.ErrCannotVoteOwnReview),
+		errors.Is(err, models.ErrTitleRequired),
+		errors.Is(err, models.ErrReviewBodyRequired),
+		errors.Is(err, models.ErrInvalidRating):
+		respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, err.Error())
+	default:
+		respondInternalError(c, err)
+	}
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/services"
+	"github.com/rafaelc-rb/geekery-api/internal/testutil"
+	"gorm.io/gorm"
+)
+
+func setupReviewHandler() (*ReviewHandler, *testutil.MockReviewRepository, *testutil.MockItemRepository) {
+	mockReviewRepo := &testutil.MockReviewRepository{}
+	mockItemRepo := &testutil.MockItemRepository{}
+	service := services.NewReviewService(mockReviewRepo, mockItemRepo, &testutil.MockUserItemRepository{}, nil)
+	handler := NewReviewHandler(service)
+	gin.SetMode(gin.TestMode)
+	return handler, mockReviewRepo, mockItemRepo
+}
+
+func TestReviewHandler_CreateReview_RequiresRecommended(t *testing.T) {
+	handler, _, _ := setupReviewHandler()
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(1))
+	router.POST("/items/:id/reviews", handler.CreateReview)
+
+	body, _ := json.Marshal(map[string]interface{}{"title": "Great", "body": "Loved it"})
+	req, _ := http.NewRequest("POST", "/items/5/reviews", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", w.Code)
+	}
+}
+
+func TestReviewHandler_GetItemReviews_ItemNotFound(t *testing.T) {
+	handler, _, mockItemRepo := setupReviewHandler()
+	mockItemRepo.GetByIDFunc = func(ctx context.Context, id uint) (*models.Item, error) {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	router := gin.New()
+	router.GET("/items/:id/reviews", handler.GetItemReviews)
+
+	req, _ := http.NewRequest("GET", "/items/99/reviews", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", w.Code)
+	}
+}
+
+func TestReviewHandler_GetItemReviews_Paginated(t *testing.T) {
+	handler, mockReviewRepo, _ := setupReviewHandler()
+	var gotFilter dto.ReviewFilter
+	mockReviewRepo.GetByItemIDFunc = func(ctx context.Context, itemID uint, filter dto.ReviewFilter, params dto.PaginationParams) ([]models.Review, int64, error) {
+		gotFilter = filter
+		return []models.Review{{ID: 1, ItemID: itemID, UserID: 2, Title: "Great", User: models.User{Username: "ana"}}}, 1, nil
+	}
+
+	router := gin.New()
+	router.GET("/items/:id/reviews", handler.GetItemReviews)
+
+	req, _ := http.NewRequest("GET", "/items/5/reviews?sort=recent&hide_spoilers=true", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", w.Code)
+	}
+
+	var response struct {
+		Data       []dto.ReviewDTO    `json:"data"`
+		Pagination dto.PaginationMeta `json:"pagination"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if len(response.Data) != 1 || response.Data[0].Author.Username != "ana" {
+		t.Errorf("Unexpected reviews: %+v", response.Data)
+	}
+	if gotFilter.SortBy != dto.ReviewSortRecent || !gotFilter.HideSpoilers {
+		t.Errorf("Unexpected filter: %+v", gotFilter)
+	}
+}
+
+func TestReviewHandler_DeleteReview_NotAuthor(t *testing.T) {
+	handler, mockReviewRepo, _ := setupReviewHandler()
+	mockReviewRepo.GetByIDFunc = func(ctx context.Context, id uint) (*models.Review, error) {
+		return &models.Review{ID: id, UserID: 2}, nil
+	}
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(1))
+	router.DELETE("/reviews/:id", handler.DeleteReview)
+
+	req, _ := http.NewRequest("DELETE", "/reviews/3", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusForbidden {
+		t.Errorf("Expected status 403, got %d", w.Code)
+	}
+}
@@ -0,0 +1,381 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/services"
+)
+
+type SocialHandler struct {
+	service *services.SocialService
+}
+
+// NewSocialHandler cria uma nova instância do handler social
+func NewSocialHandler(service *services.SocialService) *SocialHandler {
+	return &SocialHandler{service: service}
+}
+
+// GetProfile retorna o perfil público de um usuário
+// @Summary      Get user profile
+// @Description  Get a user's profile with follower counts and statistics over their public list entries. Profiles not visible to the caller (private, or followers-only when not following) return only basic data with restricted=true
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Param        username  path  string  true  "Username"
+// @Success      200  {object}  dto.ProfileDTO     "Success - returns the profile"
+// @Failure      404  {object}  map[string]string  "User not found"
+// @Failure      500  {object}  map[string]string  "Internal server error"
+// @Router       /users/{username} [get]
+func (h *SocialHandler) GetProfile(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	profile, err := h.service.GetProfile(ctx, getUserID(c), c.Param("username"))
+	if err != nil {
+		respondSocialError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, profile)
+}
+
+// GetUserList retorna as entradas públicas da lista de um usuário
+// @Summary      Get user list
+// @Description  Get the entries of a user's list that the owner allows others to see, with filters, title search, sorting and pagination. Private entries and hidden statuses are never included; ratings and notes are omitted when the owner hides them (rating filters and sorting are then ignored)
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Param        username    path   string  true   "Username"
+// @Param        page        query  int     false  "Page number" default(1)
+// @Param        limit       query  int     false  "Items per page" default(20)
+// @Param        status      query  string  false  "Filter by status (comma-separated)" example(in_progress,paused)
+// @Param        type        query  string  false  "Filter by media type (comma-separated)" example(anime,comic)
+// @Param        tags        query  string  false  "Filter by tag names or aliases (comma-separated, any match, includes descendant tags)"
+// @Param        min_rating  query  number  false  "Minimum rating (0-10)"
+// @Param        max_rating  query  number  false  "Maximum rating (0-10)"
+// @Param        favorite    query  bool    false  "Filter by favorite flag"
+// @Param        q           query  string  false  "Search by title within the list"
+// @Param        sort        query  string  false  "Sort field" Enums(rating, updated_at, added, title, progress) default(updated_at)
+// @Param        order       query  string  false  "Sort order" Enums(asc, desc)
+// @Success      200  {object}  dto.PaginatedResponse  "Success - returns the user's public entries"
+// @Failure      400  {object}  map[string]string      "Bad request - invalid parameters"
+// @Failure      403  {object}  map[string]string      "Profile not visible"
+// @Failure      404  {object}  map[string]string      "User not found"
+// @Failure      500  {object}  map[string]string      "Internal server error"
+// @Router       /users/{username}/list [get]
+func (h *SocialHandler) GetUserList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var params dto.PaginationParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+	params.Normalize()
+
+	var filter dto.UserItemFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+
+	userItems, total, err := h.service.GetUserList(ctx, getUserID(c), c.Param("username"), filter, params)
+	if err != nil {
+		respondSocialError(c, err)
+		return
+	}
+
+	response := dto.NewPaginatedResponse(dto.UserItemsToDTOs(userItems), params.Page, params.Limit, total)
+	respondSuccess(c, http.StatusOK, response)
+}
+
+// GetFollowers retorna os seguidores de um usuário
+// @Summary      Get followers
+// @Description  List the users following a user, most recent first
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Param        username  path   string  true   "Username"
+// @Param        page      query  int     false  "Page number" default(1)
+// @Param        limit     query  int     false  "Items per page" default(20)
+// @Success      200  {object}  dto.PaginatedResponse  "Success - returns followers"
+// @Failure      403  {object}  map[string]string      "Profile not visible"
+// @Failure      404  {object}  map[string]string      "User not found"
+// @Failure      500  {object}  map[string]string      "Internal server error"
+// @Router       /users/{username}/followers [get]
+func (h *SocialHandler) GetFollowers(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var params dto.PaginationParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+	params.Normalize()
+
+	users, total, err := h.service.GetFollowers(ctx, getUserID(c), c.Param("username"), params)
+	if err != nil {
+		respondSocialError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, dto.NewPaginatedResponse(users, params.Page, params.Limit, total))
+}
+
+// GetFollowing retorna os usuários seguidos por um usuário
+// @Summary      Get following
+// @Description  List the users a user follows, most recent first
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Param        username  path   string  true   "Username"
+// @Param        page      query  int     false  "Page number" default(1)
+// @Param        limit     query  int     false  "Items per page" default(20)
+// @Success      200  {object}  dto.PaginatedResponse  "Success - returns followed users"
+// @Failure      403  {object}  map[string]string      "Profile not visible"
+// @Failure      404  {object}  map[string]string      "User not found"
+// @Failure      500  {object}  map[string]string      "Internal server error"
+// @Router       /users/{username}/following [get]
+func (h *SocialHandler) GetFollowing(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var params dto.PaginationParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+	params.Normalize()
+
+	users, total, err := h.service.GetFollowing(ctx, getUserID(c), c.Param("username"), params)
+	if err != nil {
+		respondSocialError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, dto.NewPaginatedResponse(users, params.Page, params.Limit, total))
+}
+
+// Follow faz o usuário autenticado seguir outro usuário
+// @Summary      Follow user
+// @Description  Follow a user. Public profiles are followed immediately (status accepted); followers-only and private profiles get a follow request (status pending) that only counts once the user approves it via /me/follow-requests. Following again has no effect and returns the current status
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Param        username  path  string  true  "Username"
+// @Success      200  {object}  dto.FollowResultDTO  "Following or follow request sent"
+// @Failure      400  {object}  map[string]string    "Cannot follow yourself"
+// @Failure      404  {object}  map[string]string    "User not found"
+// @Failure      500  {object}  map[string]string    "Internal server error"
+// @Router       /users/{username}/follow [post]
+func (h *SocialHandler) Follow(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	status, err := h.service.Follow(ctx, getUserID(c), c.Param("username"))
+	if err != nil {
+		respondSocialError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, dto.FollowResultDTO{Status: string(status)})
+}
+
+// Unfollow faz o usuário autenticado deixar de seguir outro usuário
+// @Summary      Unfollow user
+// @Description  Stop following a user, or cancel a pending follow request
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Param        username  path  string  true  "Username"
+// @Success      204  "Unfollowed"
+// @Failure      404  {object}  map[string]string  "User not found"
+// @Failure      500  {object}  map[string]string  "Internal server error"
+// @Router       /users/{username}/follow [delete]
+func (h *SocialHandler) Unfollow(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	if err := h.service.Unfollow(ctx, getUserID(c), c.Param("username")); err != nil {
+		respondSocialError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetFollowRequests lista as solicitações pendentes para seguir o usuário autenticado
+// @Summary      Get follow requests
+// @Description  List the users waiting for approval to follow the authenticated user, most recent first
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Param        page   query  int  false  "Page number" default(1)
+// @Param        limit  query  int  false  "Items per page" default(20)
+// @Success      200  {object}  dto.PaginatedResponse  "Success - returns the requesting users"
+// @Failure      400  {object}  map[string]string      "Bad request - invalid parameters"
+// @Failure      500  {object}  map[string]string      "Internal server error"
+// @Router       /me/follow-requests [get]
+func (h *SocialHandler) GetFollowRequests(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var params dto.PaginationParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+	params.Normalize()
+
+	users, total, err := h.service.GetFollowRequests(ctx, getUserID(c), params)
+	if err != nil {
+		respondSocialError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, dto.NewPaginatedResponse(users, params.Page, params.Limit, total))
+}
+
+// AcceptFollowRequest aprova uma solicitação para seguir o usuário autenticado
+// @Summary      Accept follow request
+// @Description  Approve a pending follow request. The requester becomes a follower and can see a followers-only profile and its activity in their feed
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Param        username  path  string  true  "Username of the requester"
+// @Success      204  "Follow request accepted"
+// @Failure      404  {object}  map[string]string  "User or follow request not found"
+// @Failure      500  {object}  map[string]string  "Internal server error"
+// @Router       /me/follow-requests/{username}/accept [post]
+func (h *SocialHandler) AcceptFollowRequest(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	if err := h.service.AcceptFollowRequest(ctx, getUserID(c), c.Param("username")); err != nil {
+		respondSocialError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RejectFollowRequest recusa uma solicitação para seguir o usuário autenticado
+// @Summary      Reject follow request
+// @Description  Reject a pending follow request. The requester can ask again later
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Param        username  path  string  true  "Username of the requester"
+// @Success      204  "Follow request rejected"
+// @Failure      404  {object}  map[string]string  "User or follow request not found"
+// @Failure      500  {object}  map[string]string  "Internal server error"
+// @Router       /me/follow-requests/{username} [delete]
+func (h *SocialHandler) RejectFollowRequest(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	if err := h.service.RejectFollowRequest(ctx, getUserID(c), c.Param("username")); err != nil {
+		respondSocialError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetFeed retorna a atividade recente dos usuários seguidos
+// @Summary      Get activity feed
+// @Description  List events from the lists of followed users (added, started, completed, rated, reviewed), most recent first. Private entries and private profiles are excluded
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Param        page   query  int  false  "Page number" default(1)
+// @Param        limit  query  int  false  "Items per page" default(20)
+// @Success      200  {object}  dto.PaginatedResponse  "Success - returns feed events"
+// @Failure      400  {object}  map[string]string      "Bad request - invalid parameters"
+// @Failure      500  {object}  map[string]string      "Internal server error"
+// @Router       /feed [get]
+func (h *SocialHandler) GetFeed(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var params dto.PaginationParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+	params.Normalize()
+
+	events, total, err := h.service.GetFeed(ctx, getUserID(c), params)
+	if err != nil {
+		respondInternalError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, dto.NewPaginatedResponse(events, params.Page, params.Limit, total))
+}
+
+// GetPrivacySettings retorna as configurações de privacidade do usuário autenticado
+// @Summary      Get privacy settings
+// @Description  Get who can see the user's profile, list and activity, which statuses are hidden from the public list and whether ratings and notes are hidden
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  dto.PrivacySettingsDTO  "Success - returns privacy settings"
+// @Failure      500  {object}  map[string]string       "Internal server error"
+// @Router       /me/privacy [get]
+func (h *SocialHandler) GetPrivacySettings(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	settings, err := h.service.GetPrivacySettings(ctx, getUserID(c))
+	if err != nil {
+		respondSocialError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, settings)
+}
+
+// UpdatePrivacySettings altera as configurações de privacidade do usuário autenticado
+// @Summary      Update privacy settings
+// @Description  Replace the privacy settings: profile visibility (public, followers or private; switching to public accepts pending follow requests), statuses hidden from the public list and feed, and whether ratings and notes are hidden. Individual list entries can also be marked private via PUT /my-list/{id}. Settings are enforced on every public and social endpoint
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Param        settings  body  dto.UpdatePrivacySettingsRequest  true  "Privacy settings"
+// @Success      200  {object}  dto.PrivacySettingsDTO  "Privacy settings updated"
+// @Failure      400  {object}  map[string]string       "Bad request - validation error"
+// @Failure      500  {object}  map[string]string       "Internal server error"
+// @Router       /me/privacy [put]
+func (h *SocialHandler) UpdatePrivacySettings(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req dto.UpdatePrivacySettingsRequest
+	if err := validateAndBind(c, &req); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+
+	settings, err := h.service.UpdatePrivacySettings(ctx, getUserID(c), req)
+	if err != nil {
+		respondSocialError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, settings)
+}
+
+// respondSocialError mapeia os erros do serviço social para respostas HTTP
+func respondSocialError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, models.ErrUserNotFound):
+		respondNotFound(c, "User")
+	case errors.Is(err, models.ErrFollowRequestNotFound):
+		respondError(c, http.StatusNotFound, dto.ErrCodeNotFound, err.Error())
+	case errors.Is(err, models.ErrProfileNotVisible):
+		respondError(c, http.StatusForbidden, dto.ErrCodeForbidden, err.Error())
+	case errors.Is(err, models.ErrCannotFollowSelf),
+		errors.Is(err, models.ErrInvalidProfileVisibility),
+		errors.Is(err, models.ErrInvalidStatus),
+		errors.Is(err, models.ErrInvalidMediaType),
+		errors.Is(err, models.ErrInvalidRatingRange):
+		respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, err.Error())
+	default:
+		respondInternalError(c, err)
+	}
+}
@@ -0,0 +1,206 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/services"
+	"github.com/rafaelc-rb/geekery-api/internal/testutil"
+	"gorm.io/gorm"
+)
+
+func setupSocialHandler() (*SocialHandler, *testutil.MockUserRepository, *testutil.MockFollowRepository, *testutil.MockUserItemRepository) {
+	mockUserRepo := &testutil.MockUserRepository{
+		GetByUsernameFunc: func(ctx context.Context, username string) (*models.User, error) {
+			switch username {
+			case "alice":
+				return &models.User{ID: 1, Username: "alice", ProfileVisibility: models.ProfilePublic}, nil
+			case "bob":
+				return &models.User{ID: 2, Username: "bob", ProfileVisibility: models.ProfilePrivate}, nil
+			}
+			return nil, gorm.ErrRecordNotFound
+		},
+	}
+	mockFollowRepo := &testutil.MockFollowRepository{}
+	mockUserItemRepo := &testutil.MockUserItemRepository{}
+	service := services.NewSocialService(mockUserRepo, mockFollowRepo, mockUserItemRepo, &testutil.MockActivityRepository{})
+	handler := NewSocialHandler(service)
+	gin.SetMode(gin.TestMode)
+	return handler, mockUserRepo, mockFollowRepo, mockUserItemRepo
+}
+
+func TestSocialHandler_GetProfile(t *testing.T) {
+	handler, _, _, _ := setupSocialHandler()
+
+	router := gin.New()
+	router.GET("/users/:username", handler.GetProfile)
+
+	tests := []struct {
+		username       string
+		expectedStatus int
+		restricted     bool
+	}{
+		{"alice", http.StatusOK, false},
+		{"bob", http.StatusOK, true},
+		{"nobody", http.StatusNotFound, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.username, func(t *testing.T) {
+			req, _ := http.NewRequest("GET", "/users/"+tt.username, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("Expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+			if w.Code != http.StatusOK {
+				return
+			}
+
+			var profile dto.ProfileDTO
+			if err := json.Unmarshal(w.Body.Bytes(), &profile); err != nil {
+				t.Fatalf("Failed to unmarshal response: %v", err)
+			}
+			if profile.Restricted != tt.restricted {
+				t.Errorf("Expected restricted=%v, got %+v", tt.restricted, profile)
+			}
+		})
+	}
+}
+
+func TestSocialHandler_GetUserList(t *testing.T) {
+	handler, _, _, mockUserItemRepo := setupSocialHandler()
+
+	mockUserItemRepo.SearchPublicFunc = func(ctx context.Context, userID uint, filter dto.UserItemFilter, params dto.PaginationParams) ([]models.UserItem, int64, error) {
+		return []models.UserItem{{ID: 7, UserID: userID, ItemID: 3, Notes: "n"}}, 1, nil
+	}
+
+	router := gin.New()
+	router.GET("/users/:username/list", handler.GetUserList)
+
+	req, _ := http.NewRequest("GET", "/users/alice/list?status=completed", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if !strings.Contains(w.Body.String(), `"total_items":1`) {
+		t.Errorf("Expected one entry, got %s", w.Body.String())
+	}
+
+	// Perfil privado
+	req, _ = http.NewRequest("GET", "/users/bob/list", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusForbidden {
+		t.Errorf("Expected status 403, got %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestSocialHandler_Follow(t *testing.T) {
+	handler, _, mockFollowRepo, _ := setupSocialHandler()
+
+	followed := map[uint]models.FollowStatus{}
+	mockFollowRepo.FollowFunc = func(ctx context.Context, followerID, followingID uint, status models.FollowStatus) error {
+		followed[followingID] = status
+		return nil
+	}
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(3))
+	router.POST("/users/:username/follow", handler.Follow)
+
+	tests := []struct {
+		username   string
+		userID     uint
+		wantStatus models.FollowStatus
+	}{
+		{"alice", 1, models.FollowAccepted}, // Perfil público: segue na hora
+		{"bob", 2, models.FollowPending},    // Perfil privado: solicitação pendente
+	}
+	for _, tt := range tests {
+		req, _ := http.NewRequest("POST", "/users/"+tt.username+"/follow", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("Expected status 200, got %d: %s", w.Code, w.Body.String())
+		}
+		var result dto.FollowResultDTO
+		if err := json.Unmarshal(w.Body.Bytes(), &result); err != nil {
+			t.Fatalf("Failed to parse response: %v", err)
+		}
+		if result.Status != string(tt.wantStatus) || followed[tt.userID] != tt.wantStatus {
+			t.Errorf("Expected %s to be followed with status %s, got %q (saved %q)", tt.username, tt.wantStatus, result.Status, followed[tt.userID])
+		}
+	}
+
+	// Seguir a si mesmo
+	router = gin.New()
+	router.Use(mockAuthMiddleware(2))
+	router.POST("/users/:username/follow", handler.Follow)
+	req, _ := http.NewRequest("POST", "/users/bob/follow", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestSocialHandler_AcceptFollowRequest(t *testing.T) {
+	handler, _, mockFollowRepo, _ := setupSocialHandler()
+
+	mockFollowRepo.AcceptRequestFunc = func(ctx context.Context, followerID, followingID uint) (bool, error) {
+		return followerID == 1 && followingID == 2, nil
+	}
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(2))
+	router.POST("/me/follow-requests/:username/accept", handler.AcceptFollowRequest)
+
+	tests := []struct {
+		username   string
+		wantStatus int
+	}{
+		{"alice", http.StatusNoContent},
+		{"bob", http.StatusNotFound},    // Sem solicitação pendente
+		{"nobody", http.StatusNotFound}, // Usuário inexistente
+	}
+	for _, tt := range tests {
+		req, _ := http.NewRequest("POST", "/me/follow-requests/"+tt.username+"/accept", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		if w.Code != tt.wantStatus {
+			t.Errorf("%s: expected status %d, got %d: %s", tt.username, tt.wantStatus, w.Code, w.Body.String())
+		}
+	}
+}
+
+func TestSocialHandler_UpdatePrivacySettings_InvalidVisibility(t *testing.T) {
+	handler, _, _, _ := setupSocialHandler()
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(1))
+	router.PUT("/me/privacy", handler.UpdatePrivacySettings)
+
+	req, _ := http.NewRequest("PUT", "/me/privacy", strings.NewReader(`{"profile_visibility":"friends"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d: %s", w.Code, w.Body.String())
+	}
+}
@@ -1,7 +1,9 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/rafaelc-rb/geekery-api/internal/dto"
@@ -23,12 +25,26 @@ func NewTagHandler(tagService *services.TagService) *TagHandler {
 
 // CreateTagRequest representa o payload de criação de tag
 type CreateTagRequest struct {
-	Name string `json:"name" binding:"required"`
+	Name     string `json:"name" binding:"required"`
+	Category string `json:"category"`  // genre, theme, demographic, setting, content_warning
+	ParentID *uint  `json:"parent_id"` // Tag pai na hierarquia (opcional)
 }
 
 // UpdateTagRequest representa o payload de atualização de tag
 type UpdateTagRequest struct {
-	Name string `json:"name" binding:"required"`
+	Name     string `json:"name" binding:"required"`
+	Category string `json:"category"`  // genre, theme, demographic, setting, content_warning
+	ParentID *uint  `json:"parent_id"` // Tag pai na hierarquia (opcional)
+}
+
+// AddTagAliasRequest representa o payload de criação de alias
+type AddTagAliasRequest struct {
+	Name string `json:"name" binding:"required,min=2,max=50"`
+}
+
+// MergeTagsRequest representa as tags a serem fundidas na tag destino
+type MergeTagsRequest struct {
+	SourceIDs []uint `json:"source_ids" binding:"required,min=1"`
 }
 
 // CreateTag cria uma nova tag
@@ -52,7 +68,9 @@ func (h *TagHandler) CreateTag(c *gin.Context) {
 	}
 
 	tag := &models.Tag{
-		Name: req.Name,
+		Name:     req.Name,
+		Category: models.TagCategory(req.Category),
+		ParentID: req.ParentID,
 	}
 
 	if err := h.tagService.CreateTag(ctx, tag); err != nil {
@@ -63,30 +81,156 @@ func (h *TagHandler) CreateTag(c *gin.Context) {
 	respondSuccess(c, http.StatusCreated, tag)
 }
 
-// GetAllTags retorna todas as tags
-// @Summary      Get all tags
-// @Description  Get a list of all available tags
+// GetAllTags lista as tags com contagens de uso
+// @Summary      List tags
+// @Description  Paginated list of tags with item counts (total and per media type). Supports search by name/alias, category filter and sorting by name, usage or recency
 // @Tags         tags
 // @Accept       json
 // @Produce      json
-// @Success      200  {array}   models.Tag            "Success - returns all tags"
+// @Param        q         query  string  false  "Search in tag names and aliases"
+// @Param        category  query  string  false  "Filter by category" Enums(genre, theme, demographic, setting, content_warning)
+// @Param        sort      query  string  false  "Sort order (default: name)" Enums(name, usage, recent)
+// @Param        page      query  int     false  "Page number (default: 1)"
+// @Param        limit     query  int     false  "Items per page (default: 20, max: 100)"
+// @Success      200  {object}  dto.PaginatedResponse{data=[]dto.TagUsageDTO}  "Success - returns tags"
+// @Failure      400  {object}  map[string]string     "Bad request - invalid parameters"
 // @Failure      500  {object}  map[string]string     "Internal server error"
 // @Router       /tags [get]
 func (h *TagHandler) GetAllTags(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	tags, err := h.tagService.GetAllTags(ctx)
+	var params dto.PaginationParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+	params.Normalize()
+
+	var filter dto.TagFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+
+	tags, total, err := h.tagService.SearchTags(ctx, filter, params)
+	if err != nil {
+		h.respondTagError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, dto.NewPaginatedResponse(tags, params.Page, params.Limit, total))
+}
+
+// AutocompleteTags sugere tags pelo prefixo
+// @Summary      Autocomplete tags
+// @Description  Suggest tags whose name or alias starts with the given prefix, most used first
+// @Tags         tags
+// @Accept       json
+// @Produce      json
+// @Param        q      query  string  true   "Prefix"
+// @Param        limit  query  int     false  "Max suggestions (default: 10, max: 50)"
+// @Success      200  {array}   dto.TagSuggestionDTO  "Success - returns suggestions"
+// @Failure      400  {object}  map[string]string     "Bad request - invalid limit"
+// @Failure      500  {object}  map[string]string     "Internal server error"
+// @Router       /tags/autocomplete [get]
+func (h *TagHandler) AutocompleteTags(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	limit := 0
+	if value := c.Query("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, "invalid limit")
+			return
+		}
+		limit = parsed
+	}
+
+	suggestions, err := h.tagService.AutocompleteTags(ctx, c.Query("q"), limit)
+	if err != nil {
+		respondInternalError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, suggestions)
+}
+
+// GetTagItems lista os items de uma tag
+// @Summary      List items of a tag
+// @Description  Paginated list of catalog items with this tag or any of its descendant tags
+// @Tags         tags
+// @Accept       json
+// @Produce      json
+// @Param        id     path   int     true   "Tag ID"
+// @Param        type   query  string  false  "Filter by media type" Enums(anime, movie, series, game, manga, light_novel, music, book)
+// @Param        page   query  int     false  "Page number (default: 1)"
+// @Param        limit  query  int     false  "Items per page (default: 20, max: 100)"
+// @Success      200  {object}  dto.PaginatedResponse{data=[]models.Item}  "Success - returns items"
+// @Failure      400  {object}  map[string]string     "Bad request - invalid parameters"
+// @Failure      404  {object}  map[string]string     "Tag not found"
+// @Failure      500  {object}  map[string]string     "Internal server error"
+// @Router       /tags/{id}/items [get]
+func (h *TagHandler) GetTagItems(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	var params dto.PaginationParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+	params.Normalize()
+
+	items, total, err := h.tagService.GetTagItems(ctx, id, c.Query("type"), params)
+	if err != nil {
+		h.respondTagError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, dto.NewPaginatedResponse(items, params.Page, params.Limit, total))
+}
+
+// CleanupOrphanTags remove as tags sem items
+// @Summary      Clean up orphan tags
+// @Description  Permanently delete tags not used by any item (tags with child tags are kept). Use dry_run=true to only list them
+// @Tags         tags
+// @Accept       json
+// @Produce      json
+// @Param        dry_run  query  bool  false  "Only list the tags that would be deleted"
+// @Success      200  {object}  dto.TagCleanupResult  "Cleanup result"
+// @Failure      400  {object}  map[string]string     "Bad request - invalid dry_run"
+// @Failure      500  {object}  map[string]string     "Internal server error"
+// @Router       /tags/cleanup [post]
+func (h *TagHandler) CleanupOrphanTags(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	dryRun := false
+	if value := c.Query("dry_run"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, "invalid dry_run")
+			return
+		}
+		dryRun = parsed
+	}
+
+	result, err := h.tagService.CleanupOrphans(ctx, dryRun)
 	if err != nil {
 		respondInternalError(c, err)
 		return
 	}
 
-	respondSuccess(c, http.StatusOK, tags)
+	respondSuccess(c, http.StatusOK, result)
 }
 
 // GetTagByID retorna uma tag específica
 // @Summary      Get tag by ID
-// @Description  Get a specific tag by its ID
+// @Description  Get a specific tag by its ID, including its children and aliases
 // @Tags         tags
 // @Accept       json
 // @Produce      json
@@ -145,7 +289,9 @@ func (h *TagHandler) UpdateTag(c *gin.Context) {
 	}
 
 	tag := &models.Tag{
-		Name: req.Name,
+		Name:     req.Name,
+		Category: models.TagCategory(req.Category),
+		ParentID: req.ParentID,
 	}
 
 	if err := h.tagService.UpdateTag(ctx, id, tag); err != nil {
@@ -190,3 +336,128 @@ func (h *TagHandler) DeleteTag(c *gin.Context) {
 
 	respondSuccess(c, http.StatusOK, gin.H{"message": "Tag deleted successfully"})
 }
+
+// AddAlias adiciona um alias à tag
+// @Summary      Add tag alias
+// @Description  Add an alternative name that resolves to this tag (e.g. "scifi" for "sci-fi"). Imports and filters use the canonical tag
+// @Tags         tags
+// @Accept       json
+// @Produce      json
+// @Param        id     path  int                 true  "Tag ID"
+// @Param        alias  body  AddTagAliasRequest  true  "Alias data"
+// @Success      201  {object}  models.TagAlias     "Alias created"
+// @Failure      400  {object}  map[string]string   "Bad request - validation error"
+// @Failure      404  {object}  map[string]string   "Tag not found"
+// @Failure      409  {object}  map[string]string   "Name already used by a tag or alias"
+// @Router       /tags/{id}/aliases [post]
+func (h *TagHandler) AddAlias(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	var req AddTagAliasRequest
+	if err := validateAndBind(c, &req); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+
+	alias, err := h.tagService.AddAlias(ctx, id, req.Name)
+	if err != nil {
+		h.respondTagError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusCreated, alias)
+}
+
+// RemoveAlias remove um alias da tag
+// @Summary      Remove tag alias
+// @Description  Remove an alternative name from a tag
+// @Tags         tags
+// @Accept       json
+// @Produce      json
+// @Param        id       path  int  true  "Tag ID"
+// @Param        aliasId  path  int  true  "Alias ID"
+// @Success      204  "Alias removed"
+// @Failure      400  {object}  map[string]string   "Bad request - invalid ID"
+// @Failure      404  {object}  map[string]string   "Alias not found"
+// @Router       /tags/{id}/aliases/{aliasId} [delete]
+func (h *TagHandler) RemoveAlias(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	aliasID, err := validateID(c, "aliasId")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	if err := h.tagService.RemoveAlias(ctx, id, aliasID); err != nil {
+		h.respondTagError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// MergeTags funde outras tags nesta tag
+// @Summary      Merge tags
+// @Description  Merge the source tags into this tag: item associations, children and aliases move to it, source names become aliases and the source tags are removed
+// @Tags         tags
+// @Accept       json
+// @Produce      json
+// @Param        id     path  int               true  "Target tag ID"
+// @Param        merge  body  MergeTagsRequest  true  "Source tag IDs"
+// @Success      200  {object}  models.Tag          "Merged tag"
+// @Failure      400  {object}  map[string]string   "Bad request - validation error"
+// @Failure      404  {object}  map[string]string   "Tag not found"
+// @Router       /tags/{id}/merge [post]
+func (h *TagHandler) MergeTags(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id, err := validateID(c, "id")
+	if err != nil {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeInvalidID, err.Error())
+		return
+	}
+
+	var req MergeTagsRequest
+	if err := validateAndBind(c, &req); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+
+	tag, err := h.tagService.MergeTags(ctx, id, req.SourceIDs)
+	if err != nil {
+		h.respondTagError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, tag)
+}
+
+// respondTagError mapeia os erros da taxonomia de tags para respostas HTTP
+func (h *TagHandler) respondTagError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, models.ErrTagNotFound):
+		respondNotFound(c, "Tag")
+	case errors.Is(err, models.ErrDuplicateTag):
+		respondError(c, http.StatusConflict, dto.ErrCodeDuplicate, err.Error())
+	case errors.Is(err, models.ErrTagNameEmpty),
+		errors.Is(err, models.ErrInvalidTagCategory),
+		errors.Is(err, models.ErrInvalidTagMerge),
+		errors.Is(err, models.ErrInvalidMediaType):
+		respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, err.Error())
+	default:
+		respondInternalError(c, err)
+	}
+}
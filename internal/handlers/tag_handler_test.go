@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/gin-gonic/gin"
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
 	"github.com/rafaelc-rb/geekery-api/internal/models"
 	"github.com/rafaelc-rb/geekery-api/internal/services"
 	"github.com/rafaelc-rb/geekery-api/internal/testutil"
@@ -17,7 +18,7 @@ import (
 
 func setupTagHandler() (*TagHandler, *testutil.MockTagRepository) {
 	mockRepo := &testutil.MockTagRepository{}
-	service := services.NewTagService(mockRepo)
+	service := services.NewTagService(mockRepo, nil)
 	handler := NewTagHandler(service)
 	gin.SetMode(gin.TestMode)
 	return handler, mockRepo
@@ -31,14 +32,16 @@ func TestTagHandler_GetAllTags(t *testing.T) {
 		{Name: "comedy"},
 	}
 
-	mockRepo.GetAllFunc = func(ctx context.Context) ([]models.Tag, error) {
-		return expectedTags, nil
+	var gotFilter dto.TagFilter
+	mockRepo.SearchFunc = func(ctx context.Context, filter dto.TagFilter, params dto.PaginationParams) ([]models.Tag, int64, error) {
+		gotFilter = filter
+		return expectedTags, 2, nil
 	}
 
 	router := gin.New()
 	router.GET("/tags", handler.GetAllTags)
 
-	req, _ := http.NewRequest("GET", "/tags", nil)
+	req, _ := http.NewRequest("GET", "/tags?sort=usage&q=act", nil)
 	w := httptest.NewRecorder()
 	router.ServeHTTP(w, req)
 
@@ -46,14 +49,86 @@ func TestTagHandler_GetAllTags(t *testing.T) {
 		t.Errorf("Expected status 200, got %d", w.Code)
 	}
 
-	var tags []models.Tag
-	err := json.Unmarshal(w.Body.Bytes(), &tags)
+	var response struct {
+		Data       []dto.TagUsageDTO  `json:"data"`
+		Pagination dto.PaginationMeta `json:"pagination"`
+	}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
 	if err != nil {
 		t.Errorf("Failed to unmarshal response: %v", err)
 	}
 
-	if len(tags) != 2 {
-		t.Errorf("Expected 2 tags, got %d", len(tags))
+	if len(response.Data) != 2 {
+		t.Errorf("Expected 2 tags, got %d", len(response.Data))
+	}
+	if response.Pagination.TotalItems != 2 {
+		t.Errorf("Expected total 2, got %d", response.Pagination.TotalItems)
+	}
+	if gotFilter.SortBy != dto.TagSortUsage || gotFilter.Query != "act" {
+		t.Errorf("Expected sort=usage and q=act, got %+v", gotFilter)
+	}
+}
+
+func TestTagHandler_GetAllTags_InvalidSort(t *testing.T) {
+	handler, _ := setupTagHandler()
+
+	router := gin.New()
+	router.GET("/tags", handler.GetAllTags)
+
+	req, _ := http.NewRequest("GET", "/tags?sort=popularity", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", w.Code)
+	}
+}
+
+func TestTagHandler_GetTagItems_TagNotFound(t *testing.T) {
+	handler, mockRepo := setupTagHandler()
+	mockRepo.GetByIDFunc = func(ctx context.Context, id uint) (*models.Tag, error) {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	router := gin.New()
+	router.GET("/tags/:id/items", handler.GetTagItems)
+
+	req, _ := http.NewRequest("GET", "/tags/99/items", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", w.Code)
+	}
+}
+
+func TestTagHandler_CleanupOrphanTags_DryRun(t *testing.T) {
+	handler, mockRepo := setupTagHandler()
+	mockRepo.GetOrphansFunc = func(ctx context.Context) ([]models.Tag, error) {
+		return []models.Tag{{Name: "unused"}}, nil
+	}
+	mockRepo.DeleteOrphansFunc = func(ctx context.Context) ([]models.Tag, error) {
+		t.Error("DeleteOrphans should not be called in dry run")
+		return nil, nil
+	}
+
+	router := gin.New()
+	router.POST("/tags/cleanup", handler.CleanupOrphanTags)
+
+	req, _ := http.NewRequest("POST", "/tags/cleanup?dry_run=true", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("Expected status 200, got %d", w.Code)
+	}
+
+	var result dto.TagCleanupResult
+	if err := json.Unmarshal(w.Body.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if !result.DryRun || result.Deleted != 1 || result.Tags[0] != "unused" {
+		t.Errorf("Unexpected cleanup result: %+v", result)
 	}
 }
 
@@ -209,3 +284,58 @@ func TestTagHandler_DeleteTag(t *testing.T) {
 		t.Errorf("Expected status 200, got %d", w.Code)
 	}
 }
+
+func TestTagHandler_GetAllTags_InvalidCategory(t *testing.T) {
+	handler, _ := setupTagHandler()
+
+	router := gin.New()
+	router.GET("/tags", handler.GetAllTags)
+
+	req, _ := http.NewRequest("GET", "/tags?category=mood", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", w.Code)
+	}
+}
+
+func TestTagHandler_MergeTags_TargetNotFound(t *testing.T) {
+	handler, mockRepo := setupTagHandler()
+	mockRepo.GetByIDFunc = func(ctx context.Context, id uint) (*models.Tag, error) {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	router := gin.New()
+	router.POST("/tags/:id/merge", handler.MergeTags)
+
+	body, _ := json.Marshal(map[string]interface{}{"source_ids": []uint{2, 3}})
+	req, _ := http.NewRequest("POST", "/tags/1/merge", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", w.Code)
+	}
+}
+
+func TestTagHandler_AddAlias_Conflict(t *testing.T) {
+	handler, mockRepo := setupTagHandler()
+	mockRepo.ResolveNameFunc = func(ctx context.Context, name string) (*models.Tag, error) {
+		return &models.Tag{Name: name}, nil
+	}
+
+	router := gin.New()
+	router.POST("/tags/:id/aliases", handler.AddAlias)
+
+	body, _ := json.Marshal(map[string]interface{}{"name": "scifi"})
+	req, _ := http.NewRequest("POST", "/tags/1/aliases", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusConflict {
+		t.Errorf("Expected status 409, got %d", w.Code)
+	}
+}
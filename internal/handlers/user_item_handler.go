@@ -1,7 +1,11 @@
 package handlers
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/rafaelc-rb/geekery-api/internal/dto"
@@ -70,16 +74,26 @@ func (h *UserItemHandler) AddToList(c *gin.Context) {
 	respondSuccess(c, http.StatusCreated, userItem)
 }
 
-// GetMyList retorna a lista completa do usuário com paginação
+// GetMyList retorna a lista do usuário com filtros combinados, busca, ordenação e paginação
 // @Summary      Get my list
-// @Description  Get user's personal tracking list with pagination and filters
+// @Description  Get user's personal tracking list with combined filters, title search, sorting and pagination
 // @Tags         my-list
 // @Accept       json
 // @Produce      json
-// @Param        page      query  int     false  "Page number" default(1)
-// @Param        limit     query  int     false  "Items per page" default(20)
-// @Param        status    query  string  false  "Filter by status" Enums(planned, in_progress, completed, paused, dropped)
-// @Param        favorite  query  bool    false  "Filter favorites only"
+// @Param        page           query  int     false  "Page number" default(1)
+// @Param        limit          query  int     false  "Items per page" default(20)
+// @Param        status         query  string  false  "Filter by status (comma-separated)" example(in_progress,paused)
+// @Param        type           query  string  false  "Filter by media type (comma-separated)" example(anime,comic)
+// @Param        tags           query  string  false  "Filter by tag names or aliases (comma-separated, any match, includes descendant tags)"
+// @Param        personal_tags  query  string  false  "Filter by personal tag names (comma-separated, any match)"
+// @Param        min_rating     query  number  false  "Minimum rating (0-10)"
+// @Param        max_rating     query  number  false  "Maximum rating (0-10)"
+// @Param        favorite       query  bool    false  "Filter by favorite flag"
+// @Param        rewatching     query  bool    false  "Filter by rewatching/rereading"
+// @Param        updated_since  query  string  false  "Only entries updated since (YYYY-MM-DD or RFC3339)"
+// @Param        q              query  string  false  "Search by title within the list"
+// @Param        sort           query  string  false  "Sort field" Enums(rating, updated_at, added, title, progress) default(updated_at)
+// @Param        order          query  string  false  "Sort order" Enums(asc, desc)
 // @Success      200  {object}  dto.PaginatedResponse  "Success - returns user's items"
 // @Failure      400  {object}  map[string]string      "Bad request - invalid parameters"
 // @Failure      500  {object}  map[string]string      "Internal server error"
@@ -96,42 +110,28 @@ func (h *UserItemHandler) GetMyList(c *gin.Context) {
 	}
 	params.Normalize()
 
-	// Parâmetros de filtro opcionais
-	statusParam := c.Query("status")
-	favoriteParam := c.Query("favorite")
-
-	var userItems []models.UserItem
-	var total int64
-	var err error
-
-	// Filtrar por favoritos
-	if favoriteParam == "true" {
-		userItems, total, err = h.service.GetMyFavorites(ctx, userID, params)
-		if err != nil {
-			respondInternalError(c, err)
-			return
-		}
-		response := dto.NewPaginatedResponse(userItems, params.Page, params.Limit, total)
-		respondSuccess(c, http.StatusOK, response)
+	// Parse filtros, busca e ordenação
+	var filter dto.UserItemFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		respondValidationError(c, err)
 		return
 	}
 
-	// Filtrar por status
-	if statusParam != "" {
-		status := models.MediaStatus(statusParam)
-		userItems, total, err = h.service.GetMyListByStatus(ctx, userID, status, params)
+	if since := c.Query("updated_since"); since != "" {
+		updatedSince, err := parseTimeParam(since)
 		if err != nil {
-			respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, err.Error())
+			respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, "invalid updated_since format (use YYYY-MM-DD or RFC3339)")
 			return
 		}
-		response := dto.NewPaginatedResponse(userItems, params.Page, params.Limit, total)
-		respondSuccess(c, http.StatusOK, response)
-		return
+		filter.UpdatedSince = &updatedSince
 	}
 
-	// Retornar lista completa
-	userItems, total, err = h.service.GetMyList(ctx, userID, params)
+	userItems, total, err := h.service.SearchMyList(ctx, userID, filter, params)
 	if err != nil {
+		if errors.Is(err, models.ErrInvalidStatus) || errors.Is(err, models.ErrInvalidMediaType) || errors.Is(err, models.ErrInvalidRatingRange) {
+			respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, err.Error())
+			return
+		}
 		respondInternalError(c, err)
 		return
 	}
@@ -200,6 +200,7 @@ func (h *UserItemHandler) UpdateListItem(c *gin.Context) {
 		ProgressType    models.ProgressType `json:"progress_type"`
 		ProgressData    models.JSONB        `json:"progress_data"`
 		CompletionCount int                 `json:"completion_count"`
+		Private         bool                `json:"private"`
 	}
 
 	if err := validateAndBind(c, &input); err != nil {
@@ -215,6 +216,7 @@ func (h *UserItemHandler) UpdateListItem(c *gin.Context) {
 		ProgressType:    input.ProgressType,
 		ProgressData:    input.ProgressData,
 		CompletionCount: input.CompletionCount,
+		Private:         input.Private,
 	}
 
 	userItem, err := h.service.UpdateListItem(ctx, id, userID, updates)
@@ -255,13 +257,186 @@ func (h *UserItemHandler) RemoveFromList(c *gin.Context) {
 	c.JSON(http.StatusNoContent, nil)
 }
 
+// BulkOperations executa um lote de operações na lista do usuário
+// @Summary      Bulk list operations
+// @Description  Run a batch of operations (add, update_status, set_favorite, delete, set_personal_tags) on user's list in a single transaction
+// @Tags         my-list
+// @Accept       json
+// @Produce      json
+// @Param        operations  body  dto.BulkOperationsRequest  true  "Batch of operations"
+// @Success      200  {object}  dto.BulkResult         "Batch processed - returns per-operation results"
+// @Failure      400  {object}  map[string]string      "Bad request - validation error"
+// @Failure      500  {object}  map[string]string      "Internal server error"
+// @Router       /my-list/bulk [post]
+func (h *UserItemHandler) BulkOperations(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	var input dto.BulkOperationsRequest
+	if err := validateAndBind(c, &input); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+
+	result, err := h.service.BulkOperations(ctx, userID, input)
+	if err != nil {
+		respondInternalError(c, err)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, result)
+}
+
+// GetRecap retorna o resumo anual ("wrapped") da lista do usuário
+// @Summary      Get yearly recap
+// @Description  Year-in-review computed from view history: completions, top-rated items and tags, time per media type, longest activity streak, most rewatched item and first/last completion. Use format=svg or format=png for a shareable card (png for apps that do not display SVG)
+// @Tags         my-list
+// @Accept       json
+// @Produce      json
+// @Produce      image/svg+xml
+// @Produce      image/png
+// @Param        year    query     int     false  "Year (default: current year)"
+// @Param        format  query     string  false  "Response format: json (default), svg or png"
+// @Success      200  {object}  dto.RecapDTO        "Success - returns the recap"
+// @Failure      400  {object}  map[string]string   "Bad request - invalid year or format"
+// @Failure      500  {object}  map[string]string   "Internal server error"
+// @Router       /my-list/recap [get]
+func (h *UserItemHandler) GetRecap(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	year := time.Now().Year()
+	if value := c.Query("year"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, "invalid year")
+			return
+		}
+		year = parsed
+	}
+
+	format := c.DefaultQuery("format", dto.RecapFormatJSON)
+	if format != dto.RecapFormatJSON && format != dto.RecapFormatSVG && format != dto.RecapFormatPNG {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, "format must be json, svg or png")
+		return
+	}
+
+	recap, err := h.service.GetRecap(ctx, userID, year)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidYear) {
+			respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, err.Error())
+			return
+		}
+		respondInternalError(c, err)
+		return
+	}
+
+	if format == dto.RecapFormatSVG {
+		c.Data(http.StatusOK, "image/svg+xml; charset=utf-8", renderRecapCard(recap))
+		return
+	}
+	if format == dto.RecapFormatPNG {
+		card, err := renderRecapCardPNG(recap)
+		if err != nil {
+			respondInternalError(c, err)
+			return
+		}
+		c.Data(http.StatusOK, "image/png", card)
+		return
+	}
+
+	respondSuccess(c, http.StatusOK, recap)
+}
+
+// ExportList exporta a lista do usuário
+// @Summary      Export my list
+// @Description  Stream every list entry with status, rating, progress data, view history, personal tags and notes.
+// @Description  json and csv are portable and complete. mal-xml is the MyAnimeList import format (anime and manga with a MAL ID in external_metadata; use type=anime or type=comic for MAL's separate anime and manga imports).
+// @Description  anilist is an AniList MediaListCollection (anime and manga). Both can be imported back with /my-list/import/{source}
+// @Tags         my-list
+// @Produce      json
+// @Produce      text/csv
+// @Produce      application/xml
+// @Param        type    query     string  false  "Media type (default: all types)" Enums(anime, movie, series, game, comic, novel, book)
+// @Param        format  query     string  false  "File format" Enums(json, csv, mal-xml, anilist) default(json)
+// @Success      200  {file}    file               "Exported list"
+// @Failure      400  {object}  map[string]string  "Bad request - invalid type or format"
+// @Failure      500  {object}  map[string]string  "Internal server error"
+// @Router       /my-list/export [get]
+func (h *UserItemHandler) ExportList(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := getUserID(c)
+
+	var params dto.UserItemExportParams
+	if err := c.ShouldBindQuery(&params); err != nil {
+		respondValidationError(c, err)
+		return
+	}
+
+	mediaType := models.MediaType(params.Type)
+	if mediaType != "" && !mediaType.IsValid() {
+		respondError(c, http.StatusBadRequest, dto.ErrCodeValidation, models.ErrInvalidMediaType.Error())
+		return
+	}
+
+	format := params.Format
+	if format == "" {
+		format = dto.ListExportFormatJSON
+	}
+	fileName := "my-list"
+	if mediaType != "" {
+		fileName += "-" + string(mediaType)
+	}
+
+	c.Header("Content-Type", listExportContentType(format))
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName+listExportExtension(format)))
+	c.Status(http.StatusOK)
+
+	if err := h.service.ExportList(ctx, c.Writer, userID, mediaType, format); err != nil {
+		// Antes do primeiro lote ainda dá para responder com erro; depois, o download é interrompido
+		if !c.Writer.Written() {
+			c.Header("Content-Disposition", "")
+			respondInternalError(c, err)
+			return
+		}
+		_ = c.Error(err)
+		c.Abort()
+	}
+}
+
+// listExportContentType retorna o Content-Type do formato de export da lista
+func listExportContentType(format string) string {
+	switch format {
+	case dto.ListExportFormatCSV:
+		return "text/csv; charset=utf-8"
+	case dto.ListExportFormatMALXML:
+		return "application/xml; charset=utf-8"
+	default:
+		return "application/json; charset=utf-8"
+	}
+}
+
+// listExportExtension retorna a extensão do arquivo do formato de export da lista
+func listExportExtension(format string) string {
+	switch format {
+	case dto.ListExportFormatCSV:
+		return ".csv"
+	case dto.ListExportFormatMALXML:
+		return ".xml"
+	case dto.ListExportFormatAniList:
+		return "-anilist.json"
+	default:
+		return ".json"
+	}
+}
+
 // GetStatistics retorna estatísticas da lista do usuário
 // @Summary      Get list statistics
-// @Description  Get statistics about user's tracking list (totals by status, favorites count)
+// @Description  Get statistics about user's tracking list: status totals, per-media-type status matrix, rating distribution, estimated time spent and completions per month/year
 // @Tags         my-list
 // @Accept       json
 // @Produce      json
-// @Success      200  {object}  map[string]int64       "Success - returns statistics"
+// @Success      200  {object}  dto.UserListStatsDTO   "Success - returns statistics"
 // @Failure      500  {object}  map[string]string      "Internal server error"
 // @Router       /my-list/stats [get]
 func (h *UserItemHandler) GetStatistics(c *gin.Context) {
@@ -4,9 +4,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"image/png"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/rafaelc-rb/geekery-api/internal/dto"
@@ -18,7 +21,7 @@ import (
 func setupUserItemHandler() (*UserItemHandler, *testutil.MockUserItemRepository, *testutil.MockItemRepository) {
 	mockUserItemRepo := &testutil.MockUserItemRepository{}
 	mockItemRepo := &testutil.MockItemRepository{}
-	service := services.NewUserItemService(mockUserItemRepo, mockItemRepo)
+	service := services.NewUserItemService(mockUserItemRepo, mockItemRepo, nil, nil, nil)
 	handler := NewUserItemHandler(service)
 	gin.SetMode(gin.TestMode)
 	return handler, mockUserItemRepo, mockItemRepo
@@ -77,7 +80,7 @@ func TestUserItemHandler_GetMyList(t *testing.T) {
 		{UserID: 1, ItemID: 2, Status: models.StatusInProgress},
 	}
 
-	mockUserItemRepo.GetByUserIDFunc = func(ctx context.Context, userID uint, params dto.PaginationParams) ([]models.UserItem, int64, error) {
+	mockUserItemRepo.SearchFunc = func(ctx context.Context, userID uint, filter dto.UserItemFilter, params dto.PaginationParams) ([]models.UserItem, int64, error) {
 		return expectedItems, 2, nil
 	}
 
@@ -179,15 +182,12 @@ func TestUserItemHandler_RemoveFromList(t *testing.T) {
 func TestUserItemHandler_GetStatistics(t *testing.T) {
 	handler, mockUserItemRepo, _ := setupUserItemHandler()
 
-	expectedStats := map[string]int64{
-		"total":       10,
-		"completed":   5,
-		"in_progress": 3,
-		"planned":     2,
-	}
-
-	mockUserItemRepo.GetStatisticsFunc = func(ctx context.Context, userID uint) (map[string]int64, error) {
-		return expectedStats, nil
+	mockUserItemRepo.GetStatisticsFunc = func(ctx context.Context, userID uint) ([]dto.StatsAggregate, error) {
+		return []dto.StatsAggregate{
+			{MediaType: "anime", Status: "completed", RatingBucket: 9, Count: 5, RatingSum: 45},
+			{MediaType: "anime", Status: "in_progress", Count: 3},
+			{MediaType: "movie", Status: "planned", Count: 2},
+		}, nil
 	}
 
 	router := gin.New()
@@ -202,13 +202,220 @@ func TestUserItemHandler_GetStatistics(t *testing.T) {
 		t.Errorf("Expected status 200, got %d", w.Code)
 	}
 
-	var stats map[string]int64
+	var stats dto.UserListStatsDTO
 	err := json.Unmarshal(w.Body.Bytes(), &stats)
 	if err != nil {
 		t.Errorf("Failed to unmarshal response: %v", err)
 	}
 
-	if stats["total"] != 10 {
-		t.Errorf("Expected total 10, got %d", stats["total"])
+	if stats.Total != 10 {
+		t.Errorf("Expected total 10, got %d", stats.Total)
+	}
+	if stats.ByMediaType["anime"] == nil || stats.ByMediaType["anime"].ByStatus["completed"] != 5 {
+		t.Errorf("Unexpected by_media_type: %+v", stats.ByMediaType)
+	}
+	if stats.Ratings.Mean != 9 {
+		t.Errorf("Expected mean 9, got %v", stats.Ratings.Mean)
+	}
+}
+
+func TestUserItemHandler_BulkOperations_InvalidOp(t *testing.T) {
+	handler, _, _ := setupUserItemHandler()
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(1)) // Mock authenticated user with ID 1
+	router.POST("/my-list/bulk", handler.BulkOperations)
+
+	// set_tags alteraria as tags globais do catálogo: não é uma operação da lista pessoal
+	for _, op := range []string{"explode", "set_tags"} {
+		payload := map[string]interface{}{
+			"operations": []map[string]interface{}{
+				{"op": op, "item_id": 1, "tag_ids": []uint{1}},
+			},
+		}
+		body, _ := json.Marshal(payload)
+
+		req, _ := http.NewRequest("POST", "/my-list/bulk", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("Expected status 400 for op %q, got %d. Body: %s", op, w.Code, w.Body.String())
+		}
+	}
+}
+
+func TestUserItemHandler_GetMyList_CombinedFilters(t *testing.T) {
+	handler, mockUserItemRepo, _ := setupUserItemHandler()
+
+	var received dto.UserItemFilter
+	mockUserItemRepo.SearchFunc = func(ctx context.Context, userID uint, filter dto.UserItemFilter, params dto.PaginationParams) ([]models.UserItem, int64, error) {
+		received = filter
+		return []models.UserItem{}, 0, nil
+	}
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(1)) // Mock authenticated user with ID 1
+	router.GET("/my-list", handler.GetMyList)
+
+	req, _ := http.NewRequest("GET", "/my-list?status=in_progress,paused&favorite=true&type=anime&tags=Action&min_rating=7&updated_since=2026-01-01&sort=progress&order=asc", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d. Body: %s", w.Code, w.Body.String())
+	}
+	if len(received.Statuses) != 2 {
+		t.Errorf("Expected 2 statuses, got %v", received.Statuses)
+	}
+	if received.Favorite == nil || !*received.Favorite {
+		t.Error("Expected favorite filter to be combined with status filter")
+	}
+	if len(received.Tags) != 1 || received.Tags[0] != "action" {
+		t.Errorf("Expected normalized tag 'action', got %v", received.Tags)
+	}
+	if received.MinRating == nil || *received.MinRating != 7 {
+		t.Errorf("Expected min_rating 7, got %v", received.MinRating)
+	}
+	if received.UpdatedSince == nil || received.UpdatedSince.Year() != 2026 {
+		t.Errorf("Expected updated_since in 2026, got %v", received.UpdatedSince)
+	}
+	if received.SortBy != dto.SortByProgress || received.SortOrder != "asc" {
+		t.Errorf("Expected sort progress asc, got %s %s", received.SortBy, received.SortOrder)
+	}
+}
+
+func TestUserItemHandler_GetMyList_InvalidStatus(t *testing.T) {
+	handler, _, _ := setupUserItemHandler()
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(1)) // Mock authenticated user with ID 1
+	router.GET("/my-list", handler.GetMyList)
+
+	req, _ := http.NewRequest("GET", "/my-list?status=watching", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", w.Code)
+	}
+}
+
+func TestUserItemHandler_GetRecap_SVG(t *testing.T) {
+	handler, mockUserItemRepo, _ := setupUserItemHandler()
+
+	mockUserItemRepo.GetWithActivityBetweenFunc = func(ctx context.Context, userID uint, from, to time.Time) ([]models.UserItem, error) {
+		userItem := models.UserItem{
+			Rating:          8,
+			CompletionCount: 1,
+			ProgressData: models.JSONB{
+				"history": []interface{}{
+					map[string]interface{}{"started_at": "2025-03-01T10:00:00Z", "finished_at": "2025-03-02T10:00:00Z"},
+				},
+			},
+			Item: models.Item{Title: "Tom & Jerry <Movie>", Type: models.MediaTypeMovie},
+		}
+		userItem.Item.ID = 1
+		return []models.UserItem{userItem}, nil
+	}
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(1)) // Mock authenticated user with ID 1
+	router.GET("/my-list/recap", handler.GetRecap)
+
+	req, _ := http.NewRequest("GET", "/my-list/recap?year=2025&format=svg", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", w.Code)
+	}
+	if contentType := w.Header().Get("Content-Type"); !strings.HasPrefix(contentType, "image/svg+xml") {
+		t.Errorf("Expected SVG content type, got %s", contentType)
+	}
+	body := w.Body.String()
+	if !strings.Contains(body, "2025") || !strings.Contains(body, "Tom &amp; Jerry &lt;Movie&gt;") {
+		t.Errorf("Expected escaped title and year in card, got %s", body)
+	}
+}
+
+func TestUserItemHandler_GetRecap_PNG(t *testing.T) {
+	handler, _, _ := setupUserItemHandler()
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(1)) // Mock authenticated user with ID 1
+	router.GET("/my-list/recap", handler.GetRecap)
+
+	req, _ := http.NewRequest("GET", "/my-list/recap?year=2025&format=png", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if contentType := w.Header().Get("Content-Type"); contentType != "image/png" {
+		t.Errorf("Expected PNG content type, got %s", contentType)
+	}
+	if _, err := png.Decode(w.Body); err != nil {
+		t.Errorf("Expected a valid PNG, got %v", err)
+	}
+}
+
+func TestUserItemHandler_GetRecap_InvalidParams(t *testing.T) {
+	handler, _, _ := setupUserItemHandler()
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(1)) // Mock authenticated user with ID 1
+	router.GET("/my-list/recap", handler.GetRecap)
+
+	for _, query := range []string{"year=abc", "year=1500", "format=gif"} {
+		req, _ := http.NewRequest("GET", "/my-list/recap?"+query, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected status 400, got %d", query, w.Code)
+		}
+	}
+}
+
+func TestUserItemHandler_ExportList(t *testing.T) {
+	handler, mockUserItemRepo, _ := setupUserItemHandler()
+	mockUserItemRepo.ListAfterIDFunc = func(ctx context.Context, userID uint, mediaType models.MediaType, afterID uint, limit int) ([]models.UserItem, error) {
+		if userID != 1 || mediaType != models.MediaTypeAnime {
+			t.Errorf("Expected anime list of user 1, got user %d and %q", userID, mediaType)
+		}
+		return []models.UserItem{{
+			ID: 1, Status: models.StatusCompleted, Rating: 8,
+			Item: models.Item{ID: 2, Type: models.MediaTypeAnime, Title: "Cowboy Bebop", ExternalMetadata: models.JSONB{"mal": "1"}},
+		}}, nil
+	}
+
+	router := gin.New()
+	router.Use(mockAuthMiddleware(1))
+	router.GET("/my-list/export", handler.ExportList)
+
+	req, _ := http.NewRequest("GET", "/my-list/export?type=anime&format=mal-xml", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if w.Header().Get("Content-Disposition") != `attachment; filename="my-list-anime.xml"` {
+		t.Errorf("Unexpected Content-Disposition %q", w.Header().Get("Content-Disposition"))
+	}
+	if !strings.Contains(w.Body.String(), "<series_animedb_id>1</series_animedb_id>") {
+		t.Errorf("Expected MAL XML entry, got %s", w.Body.String())
+	}
+
+	for _, query := range []string{"type=podcast", "format=xml"} {
+		req, _ = http.NewRequest("GET", "/my-list/export?"+query, nil)
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("Expected status 400 for %s, got %d", query, w.Code)
+		}
 	}
 }
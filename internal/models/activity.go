@@ -0,0 +1,42 @@
+package models
+
+import "time"
+
+// ActivityKind define os tipos de evento de atividade da lista
+type ActivityKind string
+
+const (
+	ActivityAdded         ActivityKind = "added"          // Item adicionado à lista
+	ActivityStatusChanged ActivityKind = "status_changed" // Transição de status (exceto conclusão)
+	ActivityProgress      ActivityKind = "progress"       // Avanço de progresso (episódios, capítulos, etc)
+	ActivityCompleted     ActivityKind = "completed"      // Visualização concluída
+	ActivityRated         ActivityKind = "rated"          // Nota atribuída ou alterada
+	ActivityReviewed      ActivityKind = "reviewed"       // Resenha publicada
+)
+
+// ActivityEvent registra uma ação do usuário na lista pessoal
+// Base para o heatmap de atividade, streaks e progresso das metas
+// Os campos numéricos guardam apenas o avanço (delta) da ação
+type ActivityEvent struct {
+	ID          uint         `json:"id" gorm:"primarykey"`
+	CreatedAt   time.Time    `json:"created_at"`
+	UserID      uint         `json:"user_id" gorm:"not null;index:idx_activity_user_time"`
+	UserItemID  uint         `json:"user_item_id" gorm:"index"`
+	ItemID      uint         `json:"item_id"`
+	MediaType   MediaType    `json:"media_type" gorm:"type:varchar(50)"`
+	Kind        ActivityKind `json:"kind" gorm:"type:varchar(50);not null"`
+	Status      MediaStatus  `json:"status,omitempty" gorm:"type:varchar(50)"` // Status após a ação
+	Completions int          `json:"completions" gorm:"default:0"`
+	Episodes    int          `json:"episodes" gorm:"default:0"`
+	Chapters    int          `json:"chapters" gorm:"default:0"`
+	Pages       int          `json:"pages" gorm:"default:0"`
+	Minutes     int          `json:"minutes" gorm:"default:0"`
+	Hours       float64      `json:"hours" gorm:"default:0"`
+	Rating      float64      `json:"rating" gorm:"default:0"` // Nota após a ação (rated/reviewed)
+	OccurredAt  time.Time    `json:"occurred_at" gorm:"not null;index:idx_activity_user_time"`
+}
+
+// TableName especifica o nome da tabela no banco de dados
+func (ActivityEvent) TableName() string {
+	return "activity_events"
+}
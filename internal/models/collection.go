@@ -0,0 +1,83 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// CollectionVisibility define quem pode ver uma coleção
+type CollectionVisibility string
+
+const (
+	VisibilityPublic   CollectionVisibility = "public"   // Listada e visível para todos
+	VisibilityUnlisted CollectionVisibility = "unlisted" // Visível apenas com o link (share token)
+	VisibilityPrivate  CollectionVisibility = "private"  // Visível apenas para o dono
+)
+
+// IsValid verifica se a visibilidade é válida
+func (v CollectionVisibility) IsValid() bool {
+	return v == VisibilityPublic || v == VisibilityUnlisted || v == VisibilityPrivate
+}
+
+// Collection representa uma lista nomeada e ordenada criada pelo usuário
+// Pode misturar tipos de mídia e não depende da lista pessoal (UserItem)
+type Collection struct {
+	ID          uint                 `json:"id" gorm:"primarykey"`
+	CreatedAt   time.Time            `json:"created_at"`
+	UpdatedAt   time.Time            `json:"updated_at"`
+	DeletedAt   gorm.DeletedAt       `json:"deleted_at,omitempty" gorm:"index"`
+	UserID      uint                 `json:"user_id" gorm:"not null;index"`
+	Title       string               `json:"title" gorm:"not null"`
+	Description string               `json:"description" gorm:"type:text"`
+	Visibility  CollectionVisibility `json:"visibility" gorm:"type:varchar(20);not null;default:'private';index;check:visibility IN ('public','unlisted','private')"`
+	ShareToken  string               `json:"-" gorm:"type:varchar(64);uniqueIndex;not null"` // Link de compartilhamento (unlisted)
+	Items       []CollectionItem     `json:"items,omitempty" gorm:"foreignKey:CollectionID;constraint:OnDelete:CASCADE"`
+	ItemCount   int64                `json:"item_count" gorm:"->;-:migration"` // Calculado nas listagens
+}
+
+// TableName especifica o nome da tabela no banco de dados
+func (Collection) TableName() string {
+	return "collections"
+}
+
+// Validate valida os dados da coleção
+func (c *Collection) Validate() error {
+	if c.UserID == 0 {
+		return ErrUserIDRequired
+	}
+
+	if c.Title == "" {
+		return ErrTitleRequired
+	}
+
+	if !c.Visibility.IsValid() {
+		return ErrInvalidVisibility
+	}
+
+	return nil
+}
+
+// CanBeViewedBy verifica se o usuário (0 = anônimo) pode ver a coleção pelo ID
+// Coleções unlisted só podem ser vistas por terceiros através do share token
+func (c *Collection) CanBeViewedBy(userID uint) bool {
+	return c.UserID == userID || c.Visibility == VisibilityPublic
+}
+
+// CollectionItem representa uma entrada de uma coleção
+// Position é 1-based e contígua dentro da coleção
+type CollectionItem struct {
+	ID           uint      `json:"id" gorm:"primarykey"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	CollectionID uint      `json:"collection_id" gorm:"not null;uniqueIndex:idx_collection_item;index:idx_collection_position"`
+	ItemID       uint      `json:"item_id" gorm:"not null;uniqueIndex:idx_collection_item"`
+	Position     int       `json:"position" gorm:"not null;index:idx_collection_position"`
+	Note         string    `json:"note" gorm:"type:text"`
+	Item         Item      `json:"item,omitempty" gorm:"foreignKey:ItemID"`
+}
+
+// TableName especifica o nome da tabela no banco de dados
+func (CollectionItem) TableName() string {
+	return "collection_items"
+}
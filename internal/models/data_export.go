@@ -0,0 +1,46 @@
+package models
+
+import "time"
+
+// DataExportStatus define o estado de um export de dados da conta
+type DataExportStatus string
+
+const (
+	DataExportQueued    DataExportStatus = "queued"
+	DataExportRunning   DataExportStatus = "running"
+	DataExportCompleted DataExportStatus = "completed"
+	DataExportFailed    DataExportStatus = "failed"
+	DataExportExpired   DataExportStatus = "expired" // Arquivo removido após ExpiresAt
+)
+
+// DataExport representa o export self-service de todos os dados de um usuário
+// O ZIP é gerado por workers em processo (mesma fila do Postgres dos imports)
+// e fica em disco até ExpiresAt, disponível por um link assinado
+type DataExport struct {
+	ID        uint      `json:"id" gorm:"primarykey"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	UserID    uint      `json:"user_id" gorm:"not null;index"`
+
+	// Estado e arquivo gerado
+	Status   DataExportStatus `json:"status" gorm:"type:varchar(20);not null;default:'queued';index"`
+	Attempts int              `json:"attempts" gorm:"not null;default:0"`
+	FilePath string           `json:"-" gorm:"type:text"`
+	Size     int64            `json:"size" gorm:"not null;default:0"` // Tamanho do ZIP em bytes
+	Error    string           `json:"error,omitempty" gorm:"type:text"`
+
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	FinishedAt  *time.Time `json:"finished_at,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty" gorm:"index"` // Definido quando o ZIP fica pronto
+	HeartbeatAt *time.Time `json:"-" gorm:"index"`
+}
+
+// TableName especifica o nome da tabela no banco de dados
+func (DataExport) TableName() string {
+	return "data_exports"
+}
+
+// IsDownloadable indica se o ZIP está pronto e ainda não expirou
+func (e *DataExport) IsDownloadable(now time.Time) bool {
+	return e.Status == DataExportCompleted && e.ExpiresAt != nil && now.Before(*e.ExpiresAt)
+}
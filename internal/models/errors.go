@@ -12,6 +12,9 @@ var (
 	ErrInvalidProgressType    = errors.New("invalid progress type")
 	ErrInvalidCompletionCount = errors.New("completion count cannot be negative")
 	ErrDuplicateEntry         = errors.New("item already in user's list")
+	ErrUserItemNotFound       = errors.New("user item not found or doesn't belong to user")
+	ErrInvalidRatingRange     = errors.New("min_rating cannot be greater than max_rating")
+	ErrInvalidDateRange       = errors.New("invalid date range")
 )
 
 // Erros de validação para Item
@@ -19,10 +22,89 @@ var (
 	ErrTitleRequired       = errors.New("title is required")
 	ErrInvalidMediaType    = errors.New("invalid media type")
 	ErrInvalidYear         = errors.New("year must be between 1900 and current year + 5")
+	ErrItemNotFound        = errors.New("item not found in catalog")
 )
 
 // Erros de validação para Tag
 var (
-	ErrDuplicateTag = errors.New("tag with this name already exists")
-	ErrTagNameEmpty = errors.New("tag name cannot be empty")
+	ErrDuplicateTag       = errors.New("tag with this name already exists")
+	ErrTagNameEmpty       = errors.New("tag name cannot be empty")
+	ErrTagNotFound        = errors.New("tag not found")
+	ErrInvalidTagCategory = errors.New("invalid tag category")
+	ErrTagCycle           = errors.New("tag cannot be its own ancestor")
+	ErrInvalidTagMerge    = errors.New("a tag cannot be merged into itself")
+)
+
+// Erros de validação para PersonalTag
+var (
+	ErrDuplicatePersonalTag = errors.New("you already have a personal tag with this name")
+	ErrPersonalTagNotFound  = errors.New("personal tag not found")
+)
+
+// Erros de validação para Goal
+var (
+	ErrInvalidGoalMetric = errors.New("invalid goal metric")
+	ErrInvalidGoalPeriod = errors.New("invalid goal period")
+	ErrInvalidGoalTarget = errors.New("goal target must be greater than zero")
+	ErrInvalidGoalRange  = errors.New("goal end must be after its start")
+)
+
+// Erros de validação para Collection
+var (
+	ErrInvalidVisibility       = errors.New("visibility must be public, unlisted or private")
+	ErrItemAlreadyInCollection = errors.New("item already in collection")
+	ErrItemNotInCollection     = errors.New("item not in collection")
+	ErrInvalidReorder          = errors.New("item_ids must contain every item of the collection exactly once")
+)
+
+// Erros de validação para Review
+var (
+	ErrReviewBodyRequired  = errors.New("review body is required")
+	ErrReviewNotFound      = errors.New("review not found")
+	ErrDuplicateReview     = errors.New("you already reviewed this item")
+	ErrNotReviewAuthor     = errors.New("only the author can change this review")
+	ErrCannotVoteOwnReview = errors.New("you cannot vote on your own review")
+)
+
+// Erros de validação para o grafo social
+var (
+	ErrUserNotFound             = errors.New("user not found")
+	ErrCannotFollowSelf         = errors.New("you cannot follow yourself")
+	ErrProfileNotVisible        = errors.New("this profile is not visible to you")
+	ErrInvalidProfileVisibility = errors.New("profile visibility must be public, followers or private")
+	ErrFollowRequestNotFound    = errors.New("follow request not found")
+)
+
+// Erros de validação para jobs de import
+var (
+	ErrInvalidImportFile         = errors.New("invalid import file")
+	ErrImportJobNotFound         = errors.New("import job not found")
+	ErrImportJobFinished         = errors.New("import job has already finished")
+	ErrImportErrorReportNotFound = errors.New("import job has no error report")
+)
+
+// Erros de validação para imports de lista pessoal
+var (
+	ErrInvalidListImportSource = errors.New("source must be mal, anilist, letterboxd, goodreads or steam")
+	ErrInvalidListImportFile   = errors.New("invalid list export file")
+	ErrListImportNotFound      = errors.New("list import not found")
+	ErrListImportEntryNotFound = errors.New("list import entry not found")
+	ErrListImportEntryReviewed = errors.New("only unmatched entries can be resolved or dismissed")
+)
+
+// Erros de validação para exports e exclusão da conta
+var (
+	ErrDataExportNotFound       = errors.New("data export not found")
+	ErrDataExportInProgress     = errors.New("a data export is already in progress")
+	ErrDataExportNotReady       = errors.New("data export is not ready for download")
+	ErrInvalidDownloadSignature = errors.New("download link is invalid or has expired")
+	ErrInvalidPassword          = errors.New("password is incorrect")
+)
+
+// Erros do enriquecimento de items com metadados externos
+var (
+	ErrUnknownMetadataProvider = errors.New("metadata provider is unknown or not configured")
+	ErrMetadataNotSupported    = errors.New("no metadata provider supports this media type")
+	ErrMetadataNotFound        = errors.New("no metadata found for item")
+	ErrMetadataProviderFailed  = errors.New("metadata provider request failed")
 )
@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+// FollowStatus define se uma relação de seguir já foi aprovada
+type FollowStatus string
+
+const (
+	FollowPending  FollowStatus = "pending"  // Solicitação aguardando aprovação do usuário seguido
+	FollowAccepted FollowStatus = "accepted" // Relação ativa
+)
+
+// Follow representa a relação de um usuário seguindo outro
+// Seguir um perfil público é imediato; perfis followers/private recebem uma solicitação pendente
+// que só passa a contar (perfil, feed, contadores) depois de aprovada pelo usuário seguido
+type Follow struct {
+	FollowerID  uint         `json:"follower_id" gorm:"primarykey;autoIncrement:false"`
+	FollowingID uint         `json:"following_id" gorm:"primarykey;autoIncrement:false;index"`
+	Status      FollowStatus `json:"status" gorm:"type:varchar(20);not null;default:'accepted';check:status IN ('pending','accepted')"`
+	CreatedAt   time.Time    `json:"created_at"`
+
+	// Relationships
+	Follower  User `json:"-" gorm:"foreignKey:FollowerID;constraint:OnDelete:CASCADE"`
+	Following User `json:"-" gorm:"foreignKey:FollowingID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName especifica o nome da tabela no banco de dados
+func (Follow) TableName() string {
+	return "follows"
+}
@@ -0,0 +1,104 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// GoalMetric define o que uma meta contabiliza
+type GoalMetric string
+
+const (
+	GoalMetricCompletions GoalMetric = "completions" // Items concluídos (ex: "ler 24 livros")
+	GoalMetricEpisodes    GoalMetric = "episodes"
+	GoalMetricChapters    GoalMetric = "chapters"
+	GoalMetricPages       GoalMetric = "pages"
+	GoalMetricMinutes     GoalMetric = "minutes"
+	GoalMetricHours       GoalMetric = "hours"
+)
+
+// ValidGoalMetrics lista todas as métricas de meta válidas
+var ValidGoalMetrics = []GoalMetric{
+	GoalMetricCompletions,
+	GoalMetricEpisodes,
+	GoalMetricChapters,
+	GoalMetricPages,
+	GoalMetricMinutes,
+	GoalMetricHours,
+}
+
+// IsValid verifica se a métrica é válida
+func (m GoalMetric) IsValid() bool {
+	for _, valid := range ValidGoalMetrics {
+		if m == valid {
+			return true
+		}
+	}
+	return false
+}
+
+// GoalPeriod define o período de uma meta
+type GoalPeriod string
+
+const (
+	GoalPeriodYear   GoalPeriod = "year"
+	GoalPeriodMonth  GoalPeriod = "month"
+	GoalPeriodCustom GoalPeriod = "custom"
+)
+
+// IsValid verifica se o período é válido
+func (p GoalPeriod) IsValid() bool {
+	return p == GoalPeriodYear || p == GoalPeriodMonth || p == GoalPeriodCustom
+}
+
+// Goal representa uma meta do usuário (ex: "assistir 300 episódios em 2026")
+// O progresso é calculado a partir dos ActivityEvents no intervalo [StartsAt, EndsAt)
+type Goal struct {
+	ID        uint           `json:"id" gorm:"primarykey"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"index"`
+	UserID    uint           `json:"user_id" gorm:"not null;index"`
+	Title     string         `json:"title"`
+	Metric    GoalMetric     `json:"metric" gorm:"type:varchar(50);not null"`
+	Target    float64        `json:"target" gorm:"not null"`
+	Period    GoalPeriod     `json:"period" gorm:"type:varchar(50);not null"`
+	MediaType *MediaType     `json:"media_type,omitempty" gorm:"type:varchar(50)"` // Opcional: nil = todos os tipos
+	StartsAt  time.Time      `json:"starts_at" gorm:"not null"`
+	EndsAt    time.Time      `json:"ends_at" gorm:"not null"` // Exclusivo
+}
+
+// TableName especifica o nome da tabela no banco de dados
+func (Goal) TableName() string {
+	return "goals"
+}
+
+// Validate valida os dados da meta
+func (g *Goal) Validate() error {
+	if g.UserID == 0 {
+		return ErrUserIDRequired
+	}
+
+	if !g.Metric.IsValid() {
+		return ErrInvalidGoalMetric
+	}
+
+	if !g.Period.IsValid() {
+		return ErrInvalidGoalPeriod
+	}
+
+	if g.Target <= 0 {
+		return ErrInvalidGoalTarget
+	}
+
+	if g.MediaType != nil && !g.MediaType.IsValid() {
+		return ErrInvalidMediaType
+	}
+
+	if !g.EndsAt.After(g.StartsAt) {
+		return ErrInvalidGoalRange
+	}
+
+	return nil
+}
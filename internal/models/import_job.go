@@ -0,0 +1,67 @@
+package models
+
+import (
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ImportJobStatus define o estado de um job de import
+type ImportJobStatus string
+
+const (
+	ImportJobQueued    ImportJobStatus = "queued"
+	ImportJobRunning   ImportJobStatus = "running"
+	ImportJobCompleted ImportJobStatus = "completed"
+	ImportJobFailed    ImportJobStatus = "failed"
+	ImportJobCanceled  ImportJobStatus = "canceled"
+)
+
+// IsFinished indica se o job chegou a um estado final
+func (s ImportJobStatus) IsFinished() bool {
+	return s == ImportJobCompleted || s == ImportJobFailed || s == ImportJobCanceled
+}
+
+// ImportJob representa um import de CSV enfileirado no Postgres
+// O arquivo enviado fica em disco (FilePath) até o job terminar; workers em processo
+// reivindicam jobs na fila e reportam progresso, que também serve de heartbeat
+type ImportJob struct {
+	ID        uint      `json:"id" gorm:"primarykey"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Usuário que enviou o arquivo; apenas ele consulta, cancela e baixa os erros do job
+	UserID uint `json:"-" gorm:"not null;default:0;index"`
+
+	// Parâmetros do import
+	Format      string    `json:"format" gorm:"type:varchar(10);not null;default:'csv'"`
+	MediaType   MediaType `json:"media_type" gorm:"type:varchar(50)"` // Vazio em imports com tipos mistos
+	Mode        string    `json:"mode" gorm:"type:varchar(20);not null"`
+	Transaction string    `json:"transaction" gorm:"type:varchar(20);not null"`
+	DryRun      bool      `json:"dry_run" gorm:"not null;default:false"`
+	FileName    string    `json:"file_name" gorm:"type:varchar(255)"`
+	FilePath    string    `json:"-" gorm:"type:text;not null"`
+
+	// Estado e progresso
+	Status   ImportJobStatus `json:"status" gorm:"type:varchar(20);not null;default:'queued';index"`
+	Attempts int             `json:"attempts" gorm:"not null;default:0"`
+	Lines    int             `json:"lines" gorm:"not null;default:0"`
+	Imported int             `json:"imported" gorm:"not null;default:0"`
+	Failed   int             `json:"failed" gorm:"not null;default:0"`
+	Result   JSONB           `json:"result,omitempty" gorm:"type:jsonb"` // ImportResult final
+	Error    string          `json:"error,omitempty" gorm:"type:text"`   // Erro fatal (arquivo inválido, etc)
+
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	FinishedAt  *time.Time `json:"finished_at,omitempty"`
+	HeartbeatAt *time.Time `json:"-" gorm:"index"`
+}
+
+// TableName especifica o nome da tabela no banco de dados
+func (ImportJob) TableName() string {
+	return "import_jobs"
+}
+
+// ErrorsFilePath retorna o caminho do CSV com as linhas que falharam (qualquer que seja o formato enviado)
+func (j *ImportJob) ErrorsFilePath() string {
+	return strings.TrimSuffix(j.FilePath, filepath.Ext(j.FilePath)) + ".errors.csv"
+}
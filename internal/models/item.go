@@ -60,6 +60,9 @@ type Item struct {
 	GameData   *GameData   `json:"game_data,omitempty" gorm:"foreignKey:ItemID;constraint:OnDelete:CASCADE"`
 	BookData   *BookData   `json:"book_data,omitempty" gorm:"foreignKey:ItemID;constraint:OnDelete:CASCADE"`
 	SeriesData *SeriesData `json:"series_data,omitempty" gorm:"foreignKey:ItemID;constraint:OnDelete:CASCADE"`
+
+	// Agregados da comunidade (nil enquanto ninguém adicionou o item à lista)
+	Stats *ItemStats `json:"stats,omitempty" gorm:"foreignKey:ItemID;constraint:OnDelete:CASCADE"`
 }
 
 // TableName especifica o nome da tabela no banco de dados
@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+// Pesos da similaridade combinada entre items (somam 1)
+const (
+	SimilarityContentWeight       = 0.4 // Tags, criador e tipo de mídia em comum
+	SimilarityCollaborativeWeight = 0.6 // Notas e status de todos os usuários (item-item)
+)
+
+// SimilarityNeighbors é o número máximo de items similares guardados por item
+const SimilarityNeighbors = 50
+
+// ItemSimilarity guarda a similaridade pré-calculada entre dois items do catálogo
+// Calculada pelo job de recomendações; cada par é gravado nos dois sentidos
+type ItemSimilarity struct {
+	ItemID             uint      `json:"item_id" gorm:"primarykey;autoIncrement:false"`
+	SimilarItemID      uint      `json:"similar_item_id" gorm:"primarykey;autoIncrement:false;index"`
+	Score              float64   `json:"score"`               // Similaridade combinada (0-1)
+	ContentScore       float64   `json:"content_score"`       // Similaridade por conteúdo (0-1)
+	CollaborativeScore float64   `json:"collaborative_score"` // Similaridade colaborativa (0-1)
+	UpdatedAt          time.Time `json:"updated_at"`
+
+	// Relationships
+	Item        Item `json:"-" gorm:"foreignKey:ItemID;constraint:OnDelete:CASCADE"`
+	SimilarItem Item `json:"similar_item,omitempty" gorm:"foreignKey:SimilarItemID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName especifica o nome da tabela no banco de dados
+func (ItemSimilarity) TableName() string {
+	return "item_similarities"
+}
@@ -0,0 +1,50 @@
+package models
+
+import "time"
+
+// BayesianMinRatings é o peso (em número de notas) da média global no score ponderado
+// Items com poucas notas ficam próximos da média do seu tipo de mídia
+const BayesianMinRatings = 10
+
+// ItemStats guarda os agregados da comunidade para um item do catálogo
+// Calculados a partir das listas dos usuários (UserItem) e atualizados incrementalmente e por job periódico
+type ItemStats struct {
+	ItemID         uint      `json:"-" gorm:"primarykey;autoIncrement:false"`
+	MeanScore      float64   `json:"mean_score"`                  // Média simples das notas (> 0)
+	WeightedScore  float64   `json:"weighted_score" gorm:"index"` // Média bayesiana (ver BayesianScore)
+	RatingCount    int64     `json:"rating_count"`                // Entradas com nota
+	Members        int64     `json:"members" gorm:"index"`        // Entradas na lista (qualquer status)
+	CompletedCount int64     `json:"completed_count"`
+	DroppedCount   int64     `json:"dropped_count"`
+	FavoriteCount  int64     `json:"favorite_count"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// TableName especifica o nome da tabela no banco de dados
+func (ItemStats) TableName() string {
+	return "item_stats"
+}
+
+// MediaTypeStats guarda a média das notas (> 0) de um tipo de mídia, usada como média global do score bayesiano
+// Recalculada pelo job periódico; as atualizações incrementais apenas leem o último valor
+type MediaTypeStats struct {
+	MediaType  MediaType `json:"media_type" gorm:"primarykey;type:varchar(50)"`
+	MeanRating float64   `json:"mean_rating"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// TableName especifica o nome da tabela no banco de dados
+func (MediaTypeStats) TableName() string {
+	return "media_type_stats"
+}
+
+// BayesianScore calcula a média ponderada (v/(v+m))·R + (m/(v+m))·C
+// R é a média do item, v o número de notas, C a média global e m = BayesianMinRatings
+func BayesianScore(mean float64, ratingCount int64, globalMean float64) float64 {
+	if ratingCount <= 0 {
+		return 0
+	}
+	v := float64(ratingCount)
+	m := float64(BayesianMinRatings)
+	return (v/(v+m))*mean + (m/(v+m))*globalMean
+}
@@ -0,0 +1,30 @@
+package models
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBayesianScore(t *testing.T) {
+	tests := []struct {
+		name        string
+		mean        float64
+		ratingCount int64
+		globalMean  float64
+		expected    float64
+	}{
+		{name: "no_ratings", mean: 9, ratingCount: 0, globalMean: 7, expected: 0},
+		{name: "few_ratings_pulled_to_global_mean", mean: 10, ratingCount: 1, globalMean: 7, expected: (1.0/11.0)*10 + (10.0/11.0)*7},
+		{name: "equal_weight_at_min_ratings", mean: 9, ratingCount: BayesianMinRatings, globalMean: 7, expected: 8},
+		{name: "many_ratings_close_to_mean", mean: 9, ratingCount: 990, globalMean: 7, expected: 0.99*9 + 0.01*7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BayesianScore(tt.mean, tt.ratingCount, tt.globalMean)
+			if math.Abs(got-tt.expected) > 1e-9 {
+				t.Errorf("Expected %f, got %f", tt.expected, got)
+			}
+		})
+	}
+}
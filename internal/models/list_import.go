@@ -0,0 +1,110 @@
+package models
+
+import "time"
+
+// ListImportSource define o serviço de origem de um import de lista pessoal
+type ListImportSource string
+
+const (
+	ListImportSourceMAL        ListImportSource = "mal"        // XML exportado do MyAnimeList (anime ou mangá)
+	ListImportSourceAniList    ListImportSource = "anilist"    // JSON da MediaListCollection do AniList
+	ListImportSourceLetterboxd ListImportSource = "letterboxd" // CSV do export do Letterboxd (diary, ratings, watched ou watchlist)
+	ListImportSourceGoodreads  ListImportSource = "goodreads"  // CSV "Export Library" do Goodreads
+	ListImportSourceSteam      ListImportSource = "steam"      // JSON da biblioteca Steam (GetOwnedGames)
+)
+
+// ValidListImportSources lista as origens de import de lista aceitas
+var ValidListImportSources = []ListImportSource{
+	ListImportSourceMAL,
+	ListImportSourceAniList,
+	ListImportSourceLetterboxd,
+	ListImportSourceGoodreads,
+	ListImportSourceSteam,
+}
+
+// IsValid verifica se a origem é válida
+func (s ListImportSource) IsValid() bool {
+	for _, valid := range ValidListImportSources {
+		if s == valid {
+			return true
+		}
+	}
+	return false
+}
+
+// ListImportEntryStatus define o que aconteceu com uma entrada do arquivo importado
+type ListImportEntryStatus string
+
+const (
+	ListImportEntryImported  ListImportEntryStatus = "imported"  // Encontrada no catálogo e adicionada à lista
+	ListImportEntrySkipped   ListImportEntryStatus = "skipped"   // O item já estava na lista (a entrada existente não é alterada)
+	ListImportEntryUnmatched ListImportEntryStatus = "unmatched" // Aguarda revisão: nenhum (ou mais de um) item do catálogo corresponde
+	ListImportEntryResolved  ListImportEntryStatus = "resolved"  // Revisada: o usuário escolheu o item do catálogo
+	ListImportEntryDismissed ListImportEntryStatus = "dismissed" // Revisada: o usuário descartou a entrada
+	ListImportEntryFailed    ListImportEntryStatus = "failed"    // Encontrada, mas não foi possível adicioná-la à lista
+)
+
+// IsValid verifica se o status da entrada é válido
+func (s ListImportEntryStatus) IsValid() bool {
+	switch s {
+	case ListImportEntryImported, ListImportEntrySkipped, ListImportEntryUnmatched,
+		ListImportEntryResolved, ListImportEntryDismissed, ListImportEntryFailed:
+		return true
+	}
+	return false
+}
+
+// ListImport representa o import de uma lista pessoal exportada de outro serviço
+// As entradas ficam guardadas para a revisão das que não foram encontradas no catálogo
+type ListImport struct {
+	ID        uint             `json:"id" gorm:"primarykey"`
+	CreatedAt time.Time        `json:"created_at"`
+	UserID    uint             `json:"user_id" gorm:"not null;index"`
+	Source    ListImportSource `json:"source" gorm:"type:varchar(20);not null"`
+	FileName  string           `json:"file_name" gorm:"type:varchar(255)"`
+	Total     int              `json:"total" gorm:"not null;default:0"`
+
+	Entries []ListImportEntry `json:"entries,omitempty" gorm:"foreignKey:ListImportID;constraint:OnDelete:CASCADE"`
+}
+
+// TableName especifica o nome da tabela no banco de dados
+func (ListImport) TableName() string {
+	return "list_imports"
+}
+
+// ListImportEntry representa uma entrada do arquivo importado, já convertida para os campos da lista
+// Os dados ficam guardados para que uma entrada não encontrada possa ser resolvida depois
+type ListImportEntry struct {
+	ID           uint                  `json:"id" gorm:"primarykey"`
+	CreatedAt    time.Time             `json:"created_at"`
+	UpdatedAt    time.Time             `json:"updated_at"`
+	ListImportID uint                  `json:"list_import_id" gorm:"not null;index:idx_list_import_entry_status"`
+	Status       ListImportEntryStatus `json:"status" gorm:"type:varchar(20);not null;index:idx_list_import_entry_status"`
+	Reason       string                `json:"reason,omitempty" gorm:"type:text"` // Por que a entrada não foi encontrada ou falhou
+
+	// Identificação na origem
+	Line        int       `json:"line"`                                            // Posição no arquivo
+	ExternalIDs string    `json:"external_ids,omitempty" gorm:"type:varchar(255)"` // source:id|source:id, como no import do catálogo
+	Title       string    `json:"title" gorm:"type:varchar(500)"`
+	Year        int       `json:"year,omitempty"`
+	MediaType   MediaType `json:"media_type" gorm:"type:varchar(50)"` // Tipo provável (mangá do MAL pode ser comic ou novel)
+
+	// Dados da lista convertidos
+	ListStatus      MediaStatus `json:"list_status" gorm:"type:varchar(50)"`
+	Rating          float64     `json:"rating"`   // 0-10
+	Progress        int         `json:"progress"` // Episódios vistos, capítulos lidos ou minutos jogados
+	ProgressVolumes int         `json:"progress_volumes"`
+	CompletionCount int         `json:"completion_count"`
+	StartedAt       *time.Time  `json:"started_at,omitempty"`
+	FinishedAt      *time.Time  `json:"finished_at,omitempty"`
+	Notes           string      `json:"notes,omitempty" gorm:"type:text"`
+
+	// Resultado
+	ItemID     *uint `json:"item_id,omitempty"`
+	UserItemID *uint `json:"user_item_id,omitempty"`
+}
+
+// TableName especifica o nome da tabela no banco de dados
+func (ListImportEntry) TableName() string {
+	return "list_import_entries"
+}
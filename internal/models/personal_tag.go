@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+// PersonalTag representa um rótulo privado do usuário para as entradas da sua lista
+// Diferente de Tag (catálogo global), só é visível e editável pelo próprio usuário
+type PersonalTag struct {
+	ID        uint      `json:"id" gorm:"primarykey"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	UserID    uint      `json:"user_id" gorm:"not null;uniqueIndex:idx_user_personal_tag"`
+	Name      string    `json:"name" gorm:"type:varchar(50);not null;uniqueIndex:idx_user_personal_tag"`
+	Color     string    `json:"color" gorm:"type:varchar(7)"` // Hex opcional (#rrggbb)
+
+	// Número de entradas da lista com o rótulo (preenchido nas listagens)
+	UsageCount int64 `json:"usage_count" gorm:"->;-:migration"`
+}
+
+// TableName especifica o nome da tabela no banco de dados
+func (PersonalTag) TableName() string {
+	return "personal_tags"
+}
+
+// Validate valida os dados do rótulo pessoal
+func (t *PersonalTag) Validate() error {
+	if t.UserID == 0 {
+		return ErrUserIDRequired
+	}
+
+	if t.Name == "" {
+		return ErrTagNameEmpty
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Review representa a resenha pública de um usuário sobre um item do catálogo
+// Separada de UserItem.Notes, que continua sendo texto privado da lista
+type Review struct {
+	ID           uint           `json:"id" gorm:"primarykey"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+	DeletedAt    gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"index"`
+	UserID       uint           `json:"user_id" gorm:"not null;uniqueIndex:idx_user_review,where:deleted_at IS NULL"`
+	ItemID       uint           `json:"item_id" gorm:"not null;index;uniqueIndex:idx_user_review,where:deleted_at IS NULL"`
+	Title        string         `json:"title" gorm:"not null"`
+	Body         string         `json:"body" gorm:"type:text;not null"` // Markdown
+	Spoiler      bool           `json:"spoiler" gorm:"default:false"`
+	Recommended  bool           `json:"recommended"`
+	Rating       float64        `json:"rating" gorm:"default:0"` // Nota da lista no momento da escrita (0 = sem nota)
+	User         User           `json:"user,omitempty" gorm:"foreignKey:UserID"`
+	HelpfulCount int64          `json:"helpful_count" gorm:"->;-:migration"` // Calculado nas consultas
+	EditCount    int64          `json:"edit_count" gorm:"->;-:migration"`    // Calculado nas consultas
+	RatingHidden bool           `json:"-" gorm:"->;-:migration"`             // Autor oculta notas ou a entrada da lista é privada (calculado nas consultas)
+}
+
+// TableName especifica o nome da tabela no banco de dados
+func (Review) TableName() string {
+	return "reviews"
+}
+
+// Validate valida os dados da resenha
+func (r *Review) Validate() error {
+	if r.UserID == 0 {
+		return ErrUserIDRequired
+	}
+
+	if r.ItemID == 0 {
+		return ErrItemIDRequired
+	}
+
+	if r.Title == "" {
+		return ErrTitleRequired
+	}
+
+	if r.Body == "" {
+		return ErrReviewBodyRequired
+	}
+
+	if r.Rating < 0 || r.Rating > 10 {
+		return ErrInvalidRating
+	}
+
+	return nil
+}
+
+// ReviewRevision guarda uma versão anterior da resenha a cada edição do autor
+type ReviewRevision struct {
+	ID          uint      `json:"id" gorm:"primarykey"`
+	CreatedAt   time.Time `json:"created_at"` // Momento em que a versão foi substituída
+	ReviewID    uint      `json:"review_id" gorm:"not null;index"`
+	Title       string    `json:"title"`
+	Body        string    `json:"body" gorm:"type:text"`
+	Spoiler     bool      `json:"spoiler"`
+	Recommended bool      `json:"recommended"`
+	Rating      float64   `json:"rating"`
+}
+
+// TableName especifica o nome da tabela no banco de dados
+func (ReviewRevision) TableName() string {
+	return "review_revisions"
+}
+
+// ReviewVote representa o voto "útil" de um usuário em uma resenha
+type ReviewVote struct {
+	ID        uint      `json:"id" gorm:"primarykey"`
+	CreatedAt time.Time `json:"created_at"`
+	ReviewID  uint      `json:"review_id" gorm:"not null;uniqueIndex:idx_review_vote"`
+	UserID    uint      `json:"user_id" gorm:"not null;uniqueIndex:idx_review_vote;index"`
+}
+
+// TableName especifica o nome da tabela no banco de dados
+func (ReviewVote) TableName() string {
+	return "review_votes"
+}
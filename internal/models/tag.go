@@ -6,12 +6,54 @@ import (
 	"gorm.io/gorm"
 )
 
+// TagCategory representa a categoria de uma tag do catálogo
+type TagCategory string
+
+const (
+	TagCategoryGenre          TagCategory = "genre"
+	TagCategoryTheme          TagCategory = "theme"
+	TagCategoryDemographic    TagCategory = "demographic"
+	TagCategorySetting        TagCategory = "setting"
+	TagCategoryContentWarning TagCategory = "content_warning"
+)
+
+// IsValid verifica se a categoria é válida (vazia = sem categoria)
+func (c TagCategory) IsValid() bool {
+	switch c {
+	case "", TagCategoryGenre, TagCategoryTheme, TagCategoryDemographic, TagCategorySetting, TagCategoryContentWarning:
+		return true
+	}
+	return false
+}
+
 // Tag representa uma tag/categoria que pode ser associada a múltiplos items
+// Tags formam uma hierarquia (ParentID) e podem ter aliases que resolvem para elas
 type Tag struct {
 	ID        uint           `json:"id" gorm:"primarykey"`
 	CreatedAt time.Time      `json:"created_at"`
 	UpdatedAt time.Time      `json:"updated_at"`
 	DeletedAt gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"index"`
 	Name      string         `json:"name" gorm:"uniqueIndex;not null"`
+	Category  TagCategory    `json:"category,omitempty" gorm:"type:varchar(50);default:'';check:category IN ('','genre','theme','demographic','setting','content_warning')"`
+	ParentID  *uint          `json:"parent_id,omitempty" gorm:"index"`
+	Children  []Tag          `json:"children,omitempty" gorm:"foreignKey:ParentID"`
+	Aliases   []TagAlias     `json:"aliases,omitempty" gorm:"foreignKey:TagID"`
 	Items     []Item         `json:"items,omitempty" gorm:"many2many:item_tags;"`
+
+	// Número de items do catálogo com a tag (preenchido nas listagens)
+	ItemCount int64 `json:"item_count,omitempty" gorm:"->;-:migration"`
+}
+
+// TagAlias representa um nome alternativo que resolve para uma tag canônica
+// Ex: "scifi" e "science fiction" -> "sci-fi"
+type TagAlias struct {
+	ID        uint      `json:"id" gorm:"primarykey"`
+	CreatedAt time.Time `json:"created_at"`
+	TagID     uint      `json:"tag_id" gorm:"not null;index"`
+	Name      string    `json:"name" gorm:"uniqueIndex;not null"`
+}
+
+// TableName especifica o nome da tabela no banco de dados
+func (TagAlias) TableName() string {
+	return "tag_aliases"
 }
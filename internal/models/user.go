@@ -1,11 +1,74 @@
 package models
 
 import (
+	"database/sql/driver"
+	"errors"
+	"strings"
 	"time"
 
 	"gorm.io/gorm"
 )
 
+// ProfileVisibility define quem pode ver o perfil e a lista de um usuário
+type ProfileVisibility string
+
+const (
+	ProfilePublic    ProfileVisibility = "public"    // Visível para todos
+	ProfileFollowers ProfileVisibility = "followers" // Visível apenas para quem segue o usuário
+	ProfilePrivate   ProfileVisibility = "private"   // Visível apenas para o dono
+)
+
+// IsValid verifica se a visibilidade do perfil é válida
+func (v ProfileVisibility) IsValid() bool {
+	return v == ProfilePublic || v == ProfileFollowers || v == ProfilePrivate
+}
+
+// StatusList é uma lista de status armazenada como texto separado por vírgulas
+// Usada nas configurações de privacidade (status ocultos da lista pública)
+type StatusList []MediaStatus
+
+// Value implementa a interface driver.Valuer para StatusList
+func (l StatusList) Value() (driver.Value, error) {
+	parts := make([]string, len(l))
+	for i, status := range l {
+		parts[i] = string(status)
+	}
+	return strings.Join(parts, ","), nil
+}
+
+// Scan implementa a interface sql.Scanner para StatusList
+func (l *StatusList) Scan(value interface{}) error {
+	var raw string
+	switch v := value.(type) {
+	case nil:
+	case string:
+		raw = v
+	case []byte:
+		raw = string(v)
+	default:
+		return errors.New("failed to scan StatusList value")
+	}
+
+	list := StatusList{}
+	for _, part := range strings.Split(raw, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			list = append(list, MediaStatus(part))
+		}
+	}
+	*l = list
+	return nil
+}
+
+// Contains verifica se o status está na lista
+func (l StatusList) Contains(status MediaStatus) bool {
+	for _, s := range l {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
 // User representa um usuário do sistema
 type User struct {
 	ID           uint           `json:"id" gorm:"primarykey"`
@@ -17,4 +80,39 @@ type User struct {
 	PasswordHash string         `json:"-" gorm:"not null"` // Never expose password hash in JSON
 	Name         string         `json:"name" gorm:"not null"`
 	UserItems    []UserItem     `json:"user_items,omitempty" gorm:"foreignKey:UserID"`
+
+	// Privacidade (aplicada pelos repositórios em todas as consultas públicas e sociais)
+	ProfileVisibility ProfileVisibility `json:"profile_visibility" gorm:"type:varchar(20);not null;default:'public';check:profile_visibility IN ('public','followers','private')"`
+	HiddenStatuses    StatusList        `json:"hidden_statuses" gorm:"type:varchar(100);not null;default:''"` // Status ocultos da lista pública
+	HideRatings       bool              `json:"hide_ratings" gorm:"not null;default:false"`
+	HideNotes         bool              `json:"hide_notes" gorm:"not null;default:false"`
+}
+
+// CanBeViewedBy verifica se o perfil (e a lista) do usuário pode ser visto por viewerID (0 = anônimo)
+// isFollower indica se o viewer segue o usuário
+func (u *User) CanBeViewedBy(viewerID uint, isFollower bool) bool {
+	if viewerID != 0 && viewerID == u.ID {
+		return true
+	}
+
+	switch u.ProfileVisibility {
+	case ProfilePrivate:
+		return false
+	case ProfileFollowers:
+		return isFollower
+	default:
+		return true
+	}
+}
+
+// RedactListEntry remove de uma entrada da lista os dados que o dono escolheu ocultar (nota, notas e rótulos pessoais)
+// Usado pelos repositórios antes de expor a lista a outros usuários
+func (u *User) RedactListEntry(ui *UserItem) {
+	if u.HideRatings {
+		ui.Rating = 0
+	}
+	if u.HideNotes {
+		ui.Notes = ""
+	}
+	ui.PersonalTags = nil
 }
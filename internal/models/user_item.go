@@ -23,10 +23,12 @@ type UserItem struct {
 	ProgressType    ProgressType   `json:"progress_type" gorm:"type:varchar(50);check:progress_type IN ('episodic','reading','time','percent','boolean')"`
 	ProgressData    JSONB          `json:"progress_data" gorm:"type:jsonb"` // Dados flexíveis de progresso + history
 	CompletionCount int            `json:"completion_count" gorm:"default:0"`
+	Private         bool           `json:"private" gorm:"default:false"` // Entrada oculta do perfil público e do feed
 
 	// Relationships
-	User User `json:"user,omitempty" gorm:"foreignKey:UserID"`
-	Item Item `json:"item,omitempty" gorm:"foreignKey:ItemID"`
+	User         User          `json:"user,omitempty" gorm:"foreignKey:UserID"`
+	Item         Item          `json:"item,omitempty" gorm:"foreignKey:ItemID"`
+	PersonalTags []PersonalTag `json:"personal_tags,omitempty" gorm:"many2many:user_item_personal_tags;"`
 }
 
 // TableName especifica o nome da tabela no banco de dados
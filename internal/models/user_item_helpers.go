@@ -206,6 +206,26 @@ func (ui *UserItem) CompleteCurrentView() {
 	ui.Status = StatusCompleted
 }
 
+// AddView registra no history uma visualização com datas já conhecidas (ex: importada de outro serviço)
+// Sem finishedAt, a visualização fica em andamento
+func (ui *UserItem) AddView(startedAt, finishedAt *time.Time) {
+	entry := map[string]interface{}{
+		"started_at":  nil,
+		"finished_at": nil,
+	}
+	if startedAt != nil {
+		entry["started_at"] = *startedAt
+	}
+	if finishedAt != nil {
+		entry["finished_at"] = *finishedAt
+	}
+
+	if ui.ProgressData == nil {
+		ui.ProgressData = JSONB{}
+	}
+	ui.ProgressData["history"] = append(ui.getHistory(), entry)
+}
+
 // GetCurrentViewNumber retorna o número da visualização atual
 func (ui *UserItem) GetCurrentViewNumber() int {
 	history := ui.getHistory()
@@ -328,7 +348,52 @@ func (ui *UserItem) GetAllViews() []map[string]interface{} {
 	return result
 }
 
+// GetProgressInt retorna um campo numérico do ProgressData (ex: episode, chapter), 0 se ausente
+func (ui *UserItem) GetProgressInt(key string) int {
+	if ui.ProgressData == nil {
+		return 0
+	}
+	return getInt(ui.ProgressData[key])
+}
+
 // IsRewatching verifica se está re-assistindo/re-lendo
 func (ui *UserItem) IsRewatching() bool {
 	return ui.CompletionCount > 0 && ui.Status == StatusInProgress
 }
+
+// ViewPeriod representa uma visualização do history com as datas já parseadas
+type ViewPeriod struct {
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+}
+
+// GetViewPeriods retorna as visualizações do history com started_at/finished_at parseados
+// Entradas sem datas válidas retornam ponteiros nil
+func (ui *UserItem) GetViewPeriods() []ViewPeriod {
+	views := ui.GetAllViews()
+	periods := make([]ViewPeriod, 0, len(views))
+
+	for _, entry := range views {
+		periods = append(periods, ViewPeriod{
+			StartedAt:  parseHistoryTime(entry["started_at"]),
+			FinishedAt: parseHistoryTime(entry["finished_at"]),
+		})
+	}
+
+	return periods
+}
+
+// parseHistoryTime converte um timestamp do history (string RFC3339 ou time.Time)
+func parseHistoryTime(v interface{}) *time.Time {
+	switch value := v.(type) {
+	case time.Time:
+		return &value
+	case string:
+		t, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return nil
+		}
+		return &t
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package models
 
 import (
 	"testing"
+	"time"
 )
 
 func TestUserItem_SetEpisodicProgress(t *testing.T) {
@@ -127,6 +128,30 @@ func TestUserItem_CompleteCurrentView(t *testing.T) {
 	}
 }
 
+func TestUserItem_AddView(t *testing.T) {
+	started := time.Date(2023, 9, 29, 0, 0, 0, 0, time.UTC)
+	finished := time.Date(2024, 3, 22, 0, 0, 0, 0, time.UTC)
+
+	ui := &UserItem{ProgressType: ProgressTypeEpisodic}
+	ui.SetEpisodicProgress(1, 28)
+	ui.AddView(&started, &finished)
+	if ui.IsCurrentViewInProgress() {
+		t.Error("Expected finished view not to be in progress")
+	}
+
+	ui.AddView(&finished, nil)
+	periods := ui.GetViewPeriods()
+	if len(periods) != 2 || periods[0].StartedAt == nil || !periods[0].StartedAt.Equal(started) || periods[1].FinishedAt != nil {
+		t.Errorf("Expected a finished and an ongoing view, got %+v", periods)
+	}
+	if !ui.IsCurrentViewInProgress() {
+		t.Error("Expected view without finished_at to be in progress")
+	}
+	if getInt(ui.ProgressData["episode"]) != 28 {
+		t.Errorf("Expected progress kept, got %v", ui.ProgressData)
+	}
+}
+
 func TestUserItem_GetProgressPercent_Episodic(t *testing.T) {
 	item := &Item{
 		ID:   1,
@@ -188,6 +213,32 @@ func TestUserItem_GetProgressPercent_Reading(t *testing.T) {
 	}
 }
 
+func TestUserItem_GetViewPeriods(t *testing.T) {
+	ui := &UserItem{
+		ProgressType: ProgressTypeEpisodic,
+		ProgressData: JSONB{
+			"history": []interface{}{
+				map[string]interface{}{"started_at": "2026-01-02T10:00:00Z", "finished_at": "2026-01-20T22:30:00Z"},
+				map[string]interface{}{"started_at": "2026-03-01T08:00:00Z", "finished_at": nil},
+			},
+		},
+	}
+
+	periods := ui.GetViewPeriods()
+	if len(periods) != 2 {
+		t.Fatalf("Expected 2 view periods, got %d", len(periods))
+	}
+	if periods[0].StartedAt == nil || periods[0].StartedAt.Day() != 2 {
+		t.Errorf("Expected first view started on day 2, got %v", periods[0].StartedAt)
+	}
+	if periods[0].FinishedAt == nil || periods[0].FinishedAt.Day() != 20 {
+		t.Errorf("Expected first view finished on day 20, got %v", periods[0].FinishedAt)
+	}
+	if periods[1].FinishedAt != nil {
+		t.Errorf("Expected current view to be unfinished, got %v", periods[1].FinishedAt)
+	}
+}
+
 func TestUserItem_Validate(t *testing.T) {
 	tests := []struct {
 		name      string
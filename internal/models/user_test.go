@@ -0,0 +1,73 @@
+package models
+
+import (
+	"testing"
+)
+
+func TestStatusList_ValueAndScan(t *testing.T) {
+	list := StatusList{StatusPlanned, StatusDropped}
+	value, err := list.Value()
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+	if value != "planned,dropped" {
+		t.Errorf("Value() = %v, want planned,dropped", value)
+	}
+
+	var scanned StatusList
+	if err := scanned.Scan([]byte(" planned , dropped ")); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	if len(scanned) != 2 || !scanned.Contains(StatusPlanned) || !scanned.Contains(StatusDropped) {
+		t.Errorf("Scan() = %v, want [planned dropped]", scanned)
+	}
+
+	if err := scanned.Scan(""); err != nil || len(scanned) != 0 {
+		t.Errorf("Scan(\"\") = %v, %v, want empty list", scanned, err)
+	}
+	if err := scanned.Scan(42); err == nil {
+		t.Error("Scan(42) expected error")
+	}
+}
+
+func TestUser_RedactListEntry(t *testing.T) {
+	entry := UserItem{Rating: 8, Notes: "re-read", PersonalTags: []PersonalTag{{Name: "comfort"}}}
+
+	owner := User{HideRatings: true}
+	owner.RedactListEntry(&entry)
+	if entry.Rating != 0 || entry.Notes != "re-read" || entry.PersonalTags != nil {
+		t.Errorf("Unexpected redaction with hidden ratings: %+v", entry)
+	}
+
+	owner = User{HideNotes: true}
+	entry.Rating = 8
+	owner.RedactListEntry(&entry)
+	if entry.Rating != 8 || entry.Notes != "" {
+		t.Errorf("Unexpected redaction with hidden notes: %+v", entry)
+	}
+}
+
+func TestUser_CanBeViewedBy(t *testing.T) {
+	tests := []struct {
+		name       string
+		visibility ProfileVisibility
+		viewerID   uint
+		isFollower bool
+		want       bool
+	}{
+		{"public_anonymous", ProfilePublic, 0, false, true},
+		{"followers_follower", ProfileFollowers, 2, true, true},
+		{"followers_stranger", ProfileFollowers, 2, false, false},
+		{"private_follower", ProfilePrivate, 2, true, false},
+		{"private_owner", ProfilePrivate, 1, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := User{ID: 1, ProfileVisibility: tt.visibility}
+			if got := user.CanBeViewedBy(tt.viewerID, tt.isFollower); got != tt.want {
+				t.Errorf("CanBeViewedBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"gorm.io/gorm"
+)
+
+// AccountRepository lê e apaga todos os dados ligados a um usuário (export e exclusão da conta)
+type AccountRepository struct {
+	db *gorm.DB
+}
+
+// NewAccountRepository cria uma nova instância do repositório da conta
+func NewAccountRepository(db *gorm.DB) *AccountRepository {
+	return &AccountRepository{db: db}
+}
+
+// GetData lê os dados do usuário para o export da conta
+// A lista pessoal não está incluída; ela é lida em lotes por UserItemRepository.ListAfterID
+func (r *AccountRepository) GetData(ctx context.Context, userID uint) (*dto.AccountData, error) {
+	db := r.db.WithContext(ctx)
+	data := &dto.AccountData{}
+
+	if err := db.Where("user_id = ?", userID).Order("id").Find(&data.Reviews).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Joins("JOIN reviews ON reviews.id = review_revisions.review_id AND reviews.deleted_at IS NULL").
+		Where("reviews.user_id = ?", userID).
+		Order("review_revisions.id").
+		Find(&data.ReviewRevisions).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Where("user_id = ?", userID).Order("id").Find(&data.ReviewVotes).Error; err != nil {
+		return nil, err
+	}
+
+	err := db.Where("user_id = ?", userID).
+		Preload("Items", func(db *gorm.DB) *gorm.DB { return db.Order("position") }).
+		Preload("Items.Item").
+		Order("id").
+		Find(&data.Collections).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.Where("user_id = ?", userID).Order("name").Find(&data.PersonalTags).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Where("user_id = ?", userID).Order("id").Find(&data.Goals).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Where("follower_id = ? OR following_id = ?", userID, userID).Order("created_at").Find(&data.Follows).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Where("user_id = ?", userID).Order("occurred_at, id").Find(&data.Activity).Error; err != nil {
+		return nil, err
+	}
+
+	err = db.Where("user_id = ?", userID).
+		Preload("Entries", func(db *gorm.DB) *gorm.DB { return db.Order("line") }).
+		Order("id").
+		Find(&data.ListImports).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.Where("user_id = ?", userID).Order("id").Find(&data.DataExports).Error; err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// Erase apaga definitivamente (sem soft delete) o usuário e todas as linhas ligadas a ele, em uma transação
+// Inclui entradas e resenhas já removidas com soft delete e os votos de outros usuários nas resenhas dele
+// Retorna ErrUserNotFound se o usuário não existe
+func (r *AccountRepository) Erase(ctx context.Context, userID uint) (*dto.AccountErasureResult, error) {
+	result := &dto.AccountErasureResult{Deleted: make(map[string]int64)}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Session mantém o Unscoped em cada consulta derivada de tx
+		tx = tx.Unscoped().Session(&gorm.Session{})
+
+		// Dados necessários depois da exclusão: agregados a recalcular e arquivos a remover
+		if err := tx.Model(&models.UserItem{}).Where("user_id = ?", userID).Distinct().Pluck("item_id", &result.ItemIDs).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.DataExport{}).Where("user_id = ? AND file_path <> ''", userID).Pluck("file_path", &result.ExportFiles).Error; err != nil {
+			return err
+		}
+
+		// Tabela de junção sem model próprio
+		tags := tx.Exec("DELETE FROM user_item_personal_tags WHERE user_item_id IN (SELECT id FROM user_items WHERE user_id = ?)", userID)
+		if tags.Error != nil {
+			return tags.Error
+		}
+		result.Deleted["user_item_personal_tags"] = tags.RowsAffected
+
+		reviews := tx.Model(&models.Review{}).Select("id").Where("user_id = ?", userID)
+		collections := tx.Model(&models.Collection{}).Select("id").Where("user_id = ?", userID)
+		listImports := tx.Model(&models.ListImport{}).Select("id").Where("user_id = ?", userID)
+
+		// Ordem respeitando as foreign keys; o usuário é removido por último
+		steps := []struct {
+			table string
+			query *gorm.DB
+			model interface{}
+		}{
+			{"user_items", tx.Where("user_id = ?", userID), &models.UserItem{}},
+			{"personal_tags", tx.Where("user_id = ?", userID), &models.PersonalTag{}},
+			{"review_votes", tx.Where("user_id = ? OR review_id IN (?)", userID, reviews), &models.ReviewVote{}},
+			{"review_revisions", tx.Where("review_id IN (?)", reviews), &models.ReviewRevision{}},
+			{"reviews", tx.Where("user_id = ?", userID), &models.Review{}},
+			{"collection_items", tx.Where("collection_id IN (?)", collections), &models.CollectionItem{}},
+			{"collections", tx.Where("user_id = ?", userID), &models.Collection{}},
+			{"follows", tx.Where("follower_id = ? OR following_id = ?", userID, userID), &models.Follow{}},
+			{"goals", tx.Where("user_id = ?", userID), &models.Goal{}},
+			{"activity_events", tx.Where("user_id = ?", userID), &models.ActivityEvent{}},
+			{"list_import_entries", tx.Where("list_import_id IN (?)", listImports), &models.ListImportEntry{}},
+			{"list_imports", tx.Where("user_id = ?", userID), &models.ListImport{}},
+			{"data_exports", tx.Where("user_id = ?", userID), &models.DataExport{}},
+			{"users", tx.Where("id = ?", userID), &models.User{}},
+		}
+
+		for _, step := range steps {
+			deleted := step.query.Delete(step.model)
+			if deleted.Error != nil {
+				return deleted.Error
+			}
+			result.Deleted[step.table] = deleted.RowsAffected
+		}
+
+		if result.Deleted["users"] == 0 {
+			return models.ErrUserNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,141 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"gorm.io/gorm"
+)
+
+// activityDaySQL agrupa os eventos por dia (UTC)
+const activityDaySQL = "to_char(activity_events.occurred_at AT TIME ZONE 'UTC', 'YYYY-MM-DD')"
+
+// goalMetricColumns mapeia cada métrica de meta para a coluna somada em activity_events
+var goalMetricColumns = map[models.GoalMetric]string{
+	models.GoalMetricCompletions: "completions",
+	models.GoalMetricEpisodes:    "episodes",
+	models.GoalMetricChapters:    "chapters",
+	models.GoalMetricPages:       "pages",
+	models.GoalMetricMinutes:     "minutes",
+	models.GoalMetricHours:       "hours",
+}
+
+type ActivityRepository struct {
+	db *gorm.DB
+}
+
+// NewActivityRepository cria uma nova instância do repositório de atividade
+func NewActivityRepository(db *gorm.DB) *ActivityRepository {
+	return &ActivityRepository{db: db}
+}
+
+// Create registra eventos de atividade
+func (r *ActivityRepository) Create(ctx context.Context, events []models.ActivityEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Create(&events).Error
+}
+
+// CountByDay retorna o número de eventos por dia no período [from, to)
+func (r *ActivityRepository) CountByDay(ctx context.Context, userID uint, from, to time.Time) ([]dto.DayCount, error) {
+	var days []dto.DayCount
+
+	err := r.db.WithContext(ctx).Model(&models.ActivityEvent{}).
+		Select(activityDaySQL+" AS date, COUNT(*) AS count").
+		Where("user_id = ? AND occurred_at >= ? AND occurred_at < ?", userID, from, to).
+		Group("date").
+		Order("date").
+		Scan(&days).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return days, nil
+}
+
+// GetActiveDays retorna todos os dias (YYYY-MM-DD) com alguma atividade, em ordem
+func (r *ActivityRepository) GetActiveDays(ctx context.Context, userID uint) ([]string, error) {
+	var days []string
+
+	err := r.db.WithContext(ctx).Model(&models.ActivityEvent{}).
+		Distinct(activityDaySQL).
+		Where("user_id = ?", userID).
+		Order("1").
+		Pluck(activityDaySQL, &days).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return days, nil
+}
+
+// SumMetric soma a métrica de uma meta no período [from, to), opcionalmente filtrando por tipo de mídia
+func (r *ActivityRepository) SumMetric(ctx context.Context, userID uint, metric models.GoalMetric, mediaType *models.MediaType, from, to time.Time) (float64, error) {
+	column, ok := goalMetricColumns[metric]
+	if !ok {
+		return 0, models.ErrInvalidGoalMetric
+	}
+
+	query := r.db.WithContext(ctx).Model(&models.ActivityEvent{}).
+		Select(fmt.Sprintf("COALESCE(SUM(%s), 0)", column)).
+		Where("user_id = ? AND occurred_at >= ? AND occurred_at < ?", userID, from, to)
+
+	if mediaType != nil {
+		query = query.Where("media_type = ?", *mediaType)
+	}
+
+	var total float64
+	if err := query.Scan(&total).Error; err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// feedHiddenStatusSQL verifica se um status está entre os status que o usuário oculta da lista pública
+const feedHiddenStatusSQL = "= ANY(string_to_array(users.hidden_statuses, ','))"
+
+// GetFeed retorna os eventos das listas dos usuários seguidos por viewerID (relações aprovadas), mais recentes primeiro
+// Inclui adições, inícios (status in_progress), conclusões, notas e resenhas
+// Aplica as configurações de privacidade de cada usuário: perfis privados, entradas privadas e
+// status ocultos não aparecem; com notas ocultas, eventos de nota são omitidos e as notas zeradas
+func (r *ActivityRepository) GetFeed(ctx context.Context, viewerID uint, params dto.PaginationParams) ([]dto.FeedEventRow, int64, error) {
+	var rows []dto.FeedEventRow
+	var total int64
+
+	params.Normalize()
+
+	query := r.db.WithContext(ctx).Model(&models.ActivityEvent{}).
+		Joins("JOIN follows ON follows.following_id = activity_events.user_id AND follows.follower_id = ? AND follows.status = ?", viewerID, models.FollowAccepted).
+		Joins("JOIN users ON users.id = activity_events.user_id AND users.deleted_at IS NULL").
+		Joins("JOIN items ON items.id = activity_events.item_id AND items.deleted_at IS NULL").
+		Joins("LEFT JOIN user_items ON user_items.id = activity_events.user_item_id").
+		Where("users.profile_visibility <> ?", models.ProfilePrivate).
+		Where("COALESCE(user_items.private, false) = false").
+		Where("NOT (COALESCE(activity_events.status, '') "+feedHiddenStatusSQL+")").
+		Where("NOT (COALESCE(user_items.status, '') "+feedHiddenStatusSQL+")").
+		Where("NOT (users.hide_ratings AND activity_events.kind = ?)", models.ActivityRated).
+		Where("(activity_events.kind IN ? OR (activity_events.kind = ? AND activity_events.status = ?))",
+			[]models.ActivityKind{models.ActivityAdded, models.ActivityCompleted, models.ActivityRated, models.ActivityReviewed},
+			models.ActivityStatusChanged, models.StatusInProgress)
+
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Select(`activity_events.id, activity_events.kind, activity_events.user_id, users.username, users.name,
+			activity_events.item_id, items.title, items.type AS media_type, items.cover_url,
+			activity_events.status, CASE WHEN users.hide_ratings THEN 0 ELSE activity_events.rating END AS rating,
+			activity_events.completions, activity_events.occurred_at`).
+		Order("activity_events.occurred_at DESC, activity_events.id DESC").
+		Limit(params.Limit).
+		Offset(params.GetOffset()).
+		Scan(&rows).Error
+
+	return rows, total, err
+}
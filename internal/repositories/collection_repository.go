@@ -0,0 +1,220 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"gorm.io/gorm"
+)
+
+// collectionWithCountSQL seleciona a coleção com o número de items
+const collectionWithCountSQL = "collections.*, (SELECT COUNT(*) FROM collection_items WHERE collection_items.collection_id = collections.id) AS item_count"
+
+type CollectionRepository struct {
+	db *gorm.DB
+}
+
+// NewCollectionRepository cria uma nova instância do repositório de coleções
+func NewCollectionRepository(db *gorm.DB) *CollectionRepository {
+	return &CollectionRepository{db: db}
+}
+
+// Create cria uma nova coleção
+func (r *CollectionRepository) Create(ctx context.Context, collection *models.Collection) error {
+	return r.db.WithContext(ctx).Omit("Items").Create(collection).Error
+}
+
+// GetByID retorna uma coleção com os items ordenados por posição
+func (r *CollectionRepository) GetByID(ctx context.Context, id uint) (*models.Collection, error) {
+	return r.getWithItems(ctx, "collections.id = ?", id)
+}
+
+// GetByShareToken retorna uma coleção pelo link de compartilhamento
+func (r *CollectionRepository) GetByShareToken(ctx context.Context, token string) (*models.Collection, error) {
+	return r.getWithItems(ctx, "collections.share_token = ?", token)
+}
+
+// getWithItems busca uma coleção com os items (e o item do catálogo) ordenados por posição
+func (r *CollectionRepository) getWithItems(ctx context.Context, query string, args ...interface{}) (*models.Collection, error) {
+	var collection models.Collection
+	err := r.db.WithContext(ctx).
+		Select(collectionWithCountSQL).
+		Preload("Items", func(db *gorm.DB) *gorm.DB {
+			return db.Order("collection_items.position ASC")
+		}).
+		Preload("Items.Item").
+		Preload("Items.Item.Tags").
+		Where(query, args...).
+		First(&collection).Error
+	if err != nil {
+		return nil, err
+	}
+	return &collection, nil
+}
+
+// GetByUserID retorna as coleções do usuário (sem os items), mais recentes primeiro
+func (r *CollectionRepository) GetByUserID(ctx context.Context, userID uint, params dto.PaginationParams) ([]models.Collection, int64, error) {
+	return r.list(ctx, r.db.WithContext(ctx).Where("collections.user_id = ?", userID), params)
+}
+
+// GetPublic retorna as coleções públicas (sem os items), mais recentes primeiro
+func (r *CollectionRepository) GetPublic(ctx context.Context, params dto.PaginationParams) ([]models.Collection, int64, error) {
+	return r.list(ctx, r.db.WithContext(ctx).Where("collections.visibility = ?", models.VisibilityPublic), params)
+}
+
+// list pagina uma consulta de coleções incluindo o número de items
+func (r *CollectionRepository) list(ctx context.Context, query *gorm.DB, params dto.PaginationParams) ([]models.Collection, int64, error) {
+	var collections []models.Collection
+	var total int64
+
+	params.Normalize()
+
+	if err := query.Session(&gorm.Session{}).Model(&models.Collection{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Select(collectionWithCountSQL).
+		Order("collections.updated_at DESC, collections.id DESC").
+		Limit(params.Limit).
+		Offset(params.GetOffset()).
+		Find(&collections).Error
+
+	return collections, total, err
+}
+
+// Update atualiza os dados da coleção (sem alterar os items)
+func (r *CollectionRepository) Update(ctx context.Context, collection *models.Collection) error {
+	return r.db.WithContext(ctx).Omit("Items", "ItemCount").Save(collection).Error
+}
+
+// Delete remove a coleção (soft delete) e suas entradas
+func (r *CollectionRepository) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("collection_id = ?", id).Delete(&models.CollectionItem{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.Collection{}, id).Error
+	})
+}
+
+// GetItem retorna a entrada de um item na coleção
+func (r *CollectionRepository) GetItem(ctx context.Context, collectionID, itemID uint) (*models.CollectionItem, error) {
+	var entry models.CollectionItem
+	err := r.db.WithContext(ctx).
+		Where("collection_id = ? AND item_id = ?", collectionID, itemID).
+		First(&entry).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrItemNotInCollection
+		}
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// AddItem adiciona um item à coleção na posição informada
+// Posição fora do intervalo (ou 0) adiciona ao final; as entradas seguintes são deslocadas
+func (r *CollectionRepository) AddItem(ctx context.Context, entry *models.CollectionItem) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&models.CollectionItem{}).Where("collection_id = ?", entry.CollectionID).Count(&count).Error; err != nil {
+			return err
+		}
+
+		var exists int64
+		if err := tx.Model(&models.CollectionItem{}).
+			Where("collection_id = ? AND item_id = ?", entry.CollectionID, entry.ItemID).
+			Count(&exists).Error; err != nil {
+			return err
+		}
+		if exists > 0 {
+			return models.ErrItemAlreadyInCollection
+		}
+
+		if entry.Position <= 0 || entry.Position > int(count)+1 {
+			entry.Position = int(count) + 1
+		} else {
+			err := tx.Model(&models.CollectionItem{}).
+				Where("collection_id = ? AND position >= ?", entry.CollectionID, entry.Position).
+				UpdateColumn("position", gorm.Expr("position + 1")).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return tx.Omit("Item").Create(entry).Error
+	})
+}
+
+// UpdateItem atualiza a nota de uma entrada da coleção
+func (r *CollectionRepository) UpdateItem(ctx context.Context, entry *models.CollectionItem) error {
+	return r.db.WithContext(ctx).Model(entry).Update("note", entry.Note).Error
+}
+
+// RemoveItem remove um item da coleção e compacta as posições seguintes
+func (r *CollectionRepository) RemoveItem(ctx context.Context, collectionID, itemID uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var entry models.CollectionItem
+		err := tx.Where("collection_id = ? AND item_id = ?", collectionID, itemID).First(&entry).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return models.ErrItemNotInCollection
+			}
+			return err
+		}
+
+		if err := tx.Delete(&entry).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&models.CollectionItem{}).
+			Where("collection_id = ? AND position > ?", collectionID, entry.Position).
+			UpdateColumn("position", gorm.Expr("position - 1")).Error
+	})
+}
+
+// Reorder redefine a ordem da coleção; itemIDs deve conter todos os items exatamente uma vez
+func (r *CollectionRepository) Reorder(ctx context.Context, collectionID uint, itemIDs []uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var current []uint
+		if err := tx.Model(&models.CollectionItem{}).Where("collection_id = ?", collectionID).Pluck("item_id", &current).Error; err != nil {
+			return err
+		}
+
+		if !sameItemSet(current, itemIDs) {
+			return models.ErrInvalidReorder
+		}
+
+		for i, itemID := range itemIDs {
+			err := tx.Model(&models.CollectionItem{}).
+				Where("collection_id = ? AND item_id = ?", collectionID, itemID).
+				UpdateColumn("position", i+1).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// sameItemSet verifica se as duas listas contêm os mesmos IDs, sem repetições
+func sameItemSet(current, requested []uint) bool {
+	if len(current) != len(requested) {
+		return false
+	}
+
+	seen := make(map[uint]bool, len(current))
+	for _, id := range current {
+		seen[id] = true
+	}
+	for _, id := range requested {
+		if !seen[id] {
+			return false
+		}
+		delete(seen, id) // Repetições falham na próxima ocorrência
+	}
+	return true
+}
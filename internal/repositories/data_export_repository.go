@@ -0,0 +1,174 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type DataExportRepository struct {
+	db *gorm.DB
+}
+
+// NewDataExportRepository cria uma nova instância do repositório de exports da conta
+func NewDataExportRepository(db *gorm.DB) *DataExportRepository {
+	return &DataExportRepository{db: db}
+}
+
+// Create enfileira um novo export
+func (r *DataExportRepository) Create(ctx context.Context, export *models.DataExport) error {
+	return r.db.WithContext(ctx).Create(export).Error
+}
+
+// GetByID busca um export pelo ID
+func (r *DataExportRepository) GetByID(ctx context.Context, id uint) (*models.DataExport, error) {
+	var export models.DataExport
+	if err := r.db.WithContext(ctx).First(&export, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrDataExportNotFound
+		}
+		return nil, err
+	}
+	return &export, nil
+}
+
+// GetByIDAndUser busca um export do usuário
+func (r *DataExportRepository) GetByIDAndUser(ctx context.Context, id, userID uint) (*models.DataExport, error) {
+	var export models.DataExport
+	err := r.db.WithContext(ctx).
+		Where("id = ? AND user_id = ?", id, userID).
+		First(&export).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrDataExportNotFound
+		}
+		return nil, err
+	}
+	return &export, nil
+}
+
+// GetByUserID lista os exports do usuário, do mais recente para o mais antigo
+func (r *DataExportRepository) GetByUserID(ctx context.Context, userID uint, params dto.PaginationParams) ([]models.DataExport, int64, error) {
+	var exports []models.DataExport
+	var total int64
+
+	params.Normalize()
+
+	query := r.db.WithContext(ctx).Model(&models.DataExport{}).Where("user_id = ?", userID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Order("created_at DESC, id DESC").
+		Limit(params.Limit).
+		Offset(params.GetOffset()).
+		Find(&exports).Error
+	return exports, total, err
+}
+
+// HasPending indica se o usuário tem um export na fila ou em execução
+func (r *DataExportRepository) HasPending(ctx context.Context, userID uint) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.DataExport{}).
+		Where("user_id = ? AND status IN ?", userID, []models.DataExportStatus{models.DataExportQueued, models.DataExportRunning}).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// Claim reivindica o export mais antigo da fila e o marca como em execução
+// Exports em execução sem heartbeat desde staleBefore voltam a ser elegíveis (mesma fila dos imports)
+// Os ZIPs ficam em disco (DataExportStorageDir): o download pode chegar a outra instância, então com
+// mais de uma instância todas precisam do mesmo diretório compartilhado
+// Retorna nil quando não há export disponível
+func (r *DataExportRepository) Claim(ctx context.Context, staleBefore time.Time) (*models.DataExport, error) {
+	var claimed *models.DataExport
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var export models.DataExport
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? OR (status = ? AND heartbeat_at < ?)",
+				models.DataExportQueued, models.DataExportRunning, staleBefore).
+			Order("id").
+			First(&export).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			return err
+		}
+
+		now := time.Now()
+		if export.StartedAt == nil {
+			export.StartedAt = &now
+		}
+		export.Status = models.DataExportRunning
+		export.Attempts++
+		export.HeartbeatAt = &now
+		if err := tx.Model(&export).Updates(map[string]interface{}{
+			"status":       export.Status,
+			"attempts":     export.Attempts,
+			"started_at":   export.StartedAt,
+			"heartbeat_at": export.HeartbeatAt,
+		}).Error; err != nil {
+			return err
+		}
+		claimed = &export
+		return nil
+	})
+	return claimed, err
+}
+
+// Heartbeat renova o heartbeat de um export em execução
+func (r *DataExportRepository) Heartbeat(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Model(&models.DataExport{}).
+		Where("id = ? AND status = ?", id, models.DataExportRunning).
+		Update("heartbeat_at", time.Now()).Error
+}
+
+// Finish grava o estado final de um export em execução
+// Retorna false se o export não existe mais (conta excluída enquanto o ZIP era gerado)
+func (r *DataExportRepository) Finish(ctx context.Context, export *models.DataExport) (bool, error) {
+	now := time.Now()
+	export.FinishedAt = &now
+	result := r.db.WithContext(ctx).Model(&models.DataExport{}).
+		Where("id = ? AND status = ?", export.ID, models.DataExportRunning).
+		Updates(map[string]interface{}{
+			"status":      export.Status,
+			"file_path":   export.FilePath,
+			"size":        export.Size,
+			"error":       export.Error,
+			"finished_at": export.FinishedAt,
+			"expires_at":  export.ExpiresAt,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// Requeue devolve um export em execução para a fila (ex: desligamento do servidor)
+func (r *DataExportRepository) Requeue(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Model(&models.DataExport{}).
+		Where("id = ? AND status = ?", id, models.DataExportRunning).
+		Updates(map[string]interface{}{"status": models.DataExportQueued, "heartbeat_at": nil}).Error
+}
+
+// GetExpired lista até limit exports concluídos cujo link expirou antes de now
+func (r *DataExportRepository) GetExpired(ctx context.Context, now time.Time, limit int) ([]models.DataExport, error) {
+	var exports []models.DataExport
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND expires_at < ?", models.DataExportCompleted, now).
+		Order("id").
+		Limit(limit).
+		Find(&exports).Error
+	return exports, err
+}
+
+// MarkExpired marca um export como expirado depois que o arquivo foi removido
+func (r *DataExportRepository) MarkExpired(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Model(&models.DataExport{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{"status": models.DataExportExpired, "file_path": ""}).Error
+}
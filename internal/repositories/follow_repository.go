@@ -0,0 +1,143 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type FollowRepository struct {
+	db *gorm.DB
+}
+
+// NewFollowRepository cria uma nova instância do repositório de seguidores
+func NewFollowRepository(db *gorm.DB) *FollowRepository {
+	return &FollowRepository{db: db}
+}
+
+// Follow registra que followerID segue (ou pediu para seguir) followingID com o status informado
+// Seguir de novo não tem efeito: uma solicitação pendente continua pendente
+func (r *FollowRepository) Follow(ctx context.Context, followerID, followingID uint, status models.FollowStatus) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&models.Follow{FollowerID: followerID, FollowingID: followingID, Status: status}).Error
+}
+
+// GetStatus retorna o status da relação entre followerID e followingID ("" quando não existe)
+func (r *FollowRepository) GetStatus(ctx context.Context, followerID, followingID uint) (models.FollowStatus, error) {
+	var follows []models.Follow
+	err := r.db.WithContext(ctx).
+		Where("follower_id = ? AND following_id = ?", followerID, followingID).
+		Limit(1).
+		Find(&follows).Error
+	if err != nil || len(follows) == 0 {
+		return "", err
+	}
+	return follows[0].Status, nil
+}
+
+// Unfollow remove a relação ou a solicitação pendente (sem erro se não existia)
+func (r *FollowRepository) Unfollow(ctx context.Context, followerID, followingID uint) error {
+	return r.db.WithContext(ctx).
+		Where("follower_id = ? AND following_id = ?", followerID, followingID).
+		Delete(&models.Follow{}).Error
+}
+
+// IsFollowing verifica se followerID segue followingID (solicitações pendentes não contam)
+func (r *FollowRepository) IsFollowing(ctx context.Context, followerID, followingID uint) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.Follow{}).
+		Where("follower_id = ? AND following_id = ? AND status = ?", followerID, followingID, models.FollowAccepted).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// CountFollowers retorna quantos usuários seguem o usuário
+func (r *FollowRepository) CountFollowers(ctx context.Context, userID uint) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.Follow{}).
+		Joins("JOIN users ON users.id = follows.follower_id AND users.deleted_at IS NULL").
+		Where("follows.following_id = ? AND follows.status = ?", userID, models.FollowAccepted).
+		Count(&count).Error
+	return count, err
+}
+
+// CountFollowing retorna quantos usuários o usuário segue
+func (r *FollowRepository) CountFollowing(ctx context.Context, userID uint) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.Follow{}).
+		Joins("JOIN users ON users.id = follows.following_id AND users.deleted_at IS NULL").
+		Where("follows.follower_id = ? AND follows.status = ?", userID, models.FollowAccepted).
+		Count(&count).Error
+	return count, err
+}
+
+// GetFollowers retorna os seguidores do usuário, mais recentes primeiro
+func (r *FollowRepository) GetFollowers(ctx context.Context, userID uint, params dto.PaginationParams) ([]models.User, int64, error) {
+	query := r.db.WithContext(ctx).
+		Joins("JOIN follows ON follows.follower_id = users.id").
+		Where("follows.following_id = ? AND follows.status = ?", userID, models.FollowAccepted)
+	return r.listUsers(query, params)
+}
+
+// GetFollowing retorna os usuários seguidos pelo usuário, mais recentes primeiro
+func (r *FollowRepository) GetFollowing(ctx context.Context, userID uint, params dto.PaginationParams) ([]models.User, int64, error) {
+	query := r.db.WithContext(ctx).
+		Joins("JOIN follows ON follows.following_id = users.id").
+		Where("follows.follower_id = ? AND follows.status = ?", userID, models.FollowAccepted)
+	return r.listUsers(query, params)
+}
+
+// GetFollowRequests retorna os usuários com solicitação pendente para seguir o usuário, mais recentes primeiro
+func (r *FollowRepository) GetFollowRequests(ctx context.Context, userID uint, params dto.PaginationParams) ([]models.User, int64, error) {
+	query := r.db.WithContext(ctx).
+		Joins("JOIN follows ON follows.follower_id = users.id").
+		Where("follows.following_id = ? AND follows.status = ?", userID, models.FollowPending)
+	return r.listUsers(query, params)
+}
+
+// AcceptRequest aprova a solicitação pendente de followerID; retorna false se ela não existia
+func (r *FollowRepository) AcceptRequest(ctx context.Context, followerID, followingID uint) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&models.Follow{}).
+		Where("follower_id = ? AND following_id = ? AND status = ?", followerID, followingID, models.FollowPending).
+		Update("status", models.FollowAccepted)
+	return result.RowsAffected > 0, result.Error
+}
+
+// DeleteRequest recusa a solicitação pendente de followerID; retorna false se ela não existia
+func (r *FollowRepository) DeleteRequest(ctx context.Context, followerID, followingID uint) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Where("follower_id = ? AND following_id = ? AND status = ?", followerID, followingID, models.FollowPending).
+		Delete(&models.Follow{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// AcceptAllRequests aprova todas as solicitações pendentes do usuário (ao tornar o perfil público)
+func (r *FollowRepository) AcceptAllRequests(ctx context.Context, userID uint) error {
+	return r.db.WithContext(ctx).Model(&models.Follow{}).
+		Where("following_id = ? AND status = ?", userID, models.FollowPending).
+		Update("status", models.FollowAccepted).Error
+}
+
+// listUsers pagina uma consulta de usuários unida à tabela follows
+func (r *FollowRepository) listUsers(query *gorm.DB, params dto.PaginationParams) ([]models.User, int64, error) {
+	var users []models.User
+	var total int64
+
+	params.Normalize()
+
+	if err := query.Session(&gorm.Session{}).Model(&models.User{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Order("follows.created_at DESC, users.id DESC").
+		Limit(params.Limit).
+		Offset(params.GetOffset()).
+		Find(&users).Error
+
+	return users, total, err
+}
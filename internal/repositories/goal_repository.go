@@ -0,0 +1,54 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"gorm.io/gorm"
+)
+
+type GoalRepository struct {
+	db *gorm.DB
+}
+
+// NewGoalRepository cria uma nova instância do repositório de metas
+func NewGoalRepository(db *gorm.DB) *GoalRepository {
+	return &GoalRepository{db: db}
+}
+
+// Create cria uma nova meta
+func (r *GoalRepository) Create(ctx context.Context, goal *models.Goal) error {
+	return r.db.WithContext(ctx).Create(goal).Error
+}
+
+// GetByUserID retorna as metas do usuário (mais recentes primeiro)
+func (r *GoalRepository) GetByUserID(ctx context.Context, userID uint) ([]models.Goal, error) {
+	var goals []models.Goal
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("starts_at DESC, id DESC").
+		Find(&goals).Error
+	return goals, err
+}
+
+// GetByIDAndUser busca uma meta por ID garantindo que pertence ao usuário
+func (r *GoalRepository) GetByIDAndUser(ctx context.Context, id, userID uint) (*models.Goal, error) {
+	var goal models.Goal
+	err := r.db.WithContext(ctx).
+		Where("id = ? AND user_id = ?", id, userID).
+		First(&goal).Error
+	if err != nil {
+		return nil, err
+	}
+	return &goal, nil
+}
+
+// Update atualiza uma meta existente
+func (r *GoalRepository) Update(ctx context.Context, goal *models.Goal) error {
+	return r.db.WithContext(ctx).Save(goal).Error
+}
+
+// Delete remove uma meta (soft delete)
+func (r *GoalRepository) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Delete(&models.Goal{}, id).Error
+}
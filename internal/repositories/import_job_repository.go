@@ -0,0 +1,135 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ImportJobRepository struct {
+	db *gorm.DB
+}
+
+// NewImportJobRepository cria uma nova instância do repositório de jobs de import
+func NewImportJobRepository(db *gorm.DB) *ImportJobRepository {
+	return &ImportJobRepository{db: db}
+}
+
+// Create enfileira um novo job
+func (r *ImportJobRepository) Create(ctx context.Context, job *models.ImportJob) error {
+	return r.db.WithContext(ctx).Create(job).Error
+}
+
+// GetByID busca um job pelo ID
+func (r *ImportJobRepository) GetByID(ctx context.Context, id uint) (*models.ImportJob, error) {
+	var job models.ImportJob
+	if err := r.db.WithContext(ctx).First(&job, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrImportJobNotFound
+		}
+		return nil, err
+	}
+	return &job, nil
+}
+
+// Claim reivindica o job mais antigo da fila e o marca como em execução
+// Jobs em execução sem heartbeat desde staleBefore (worker que morreu) voltam a ser elegíveis
+// SKIP LOCKED permite vários workers sem disputar o mesmo job. Os arquivos enviados ficam em disco
+// (ImportStorageDir), não no banco: com mais de uma instância, todas precisam do mesmo diretório
+// compartilhado; sem isso, rode uma única instância
+// Retorna nil quando não há job disponível
+func (r *ImportJobRepository) Claim(ctx context.Context, staleBefore time.Time) (*models.ImportJob, error) {
+	var claimed *models.ImportJob
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var job models.ImportJob
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? OR (status = ? AND heartbeat_at < ?)",
+				models.ImportJobQueued, models.ImportJobRunning, staleBefore).
+			Order("id").
+			First(&job).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			return err
+		}
+
+		now := time.Now()
+		if job.StartedAt == nil {
+			job.StartedAt = &now
+		}
+		job.Status = models.ImportJobRunning
+		job.Attempts++
+		job.HeartbeatAt = &now
+		if err := tx.Model(&job).Updates(map[string]interface{}{
+			"status":       job.Status,
+			"attempts":     job.Attempts,
+			"started_at":   job.StartedAt,
+			"heartbeat_at": job.HeartbeatAt,
+		}).Error; err != nil {
+			return err
+		}
+		claimed = &job
+		return nil
+	})
+	return claimed, err
+}
+
+// UpdateProgress grava o progresso de um job em execução e renova o heartbeat
+// Retorna o status atual, para o worker perceber um cancelamento
+func (r *ImportJobRepository) UpdateProgress(ctx context.Context, id uint, lines, imported, failed int) (models.ImportJobStatus, error) {
+	db := r.db.WithContext(ctx)
+	err := db.Model(&models.ImportJob{}).
+		Where("id = ? AND status = ?", id, models.ImportJobRunning).
+		Updates(map[string]interface{}{
+			"lines":        lines,
+			"imported":     imported,
+			"failed":       failed,
+			"heartbeat_at": time.Now(),
+		}).Error
+	if err != nil {
+		return "", err
+	}
+
+	var status models.ImportJobStatus
+	err = db.Model(&models.ImportJob{}).Where("id = ?", id).Pluck("status", &status).Error
+	return status, err
+}
+
+// Finish grava o estado final de um job em execução
+// Não sobrescreve jobs cancelados enquanto terminavam
+func (r *ImportJobRepository) Finish(ctx context.Context, job *models.ImportJob) error {
+	now := time.Now()
+	job.FinishedAt = &now
+	return r.db.WithContext(ctx).Model(&models.ImportJob{}).
+		Where("id = ? AND status = ?", job.ID, models.ImportJobRunning).
+		Updates(map[string]interface{}{
+			"status":      job.Status,
+			"lines":       job.Lines,
+			"imported":    job.Imported,
+			"failed":      job.Failed,
+			"result":      job.Result,
+			"error":       job.Error,
+			"finished_at": job.FinishedAt,
+		}).Error
+}
+
+// Requeue devolve um job em execução para a fila (ex: desligamento do servidor)
+func (r *ImportJobRepository) Requeue(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Model(&models.ImportJob{}).
+		Where("id = ? AND status = ?", id, models.ImportJobRunning).
+		Updates(map[string]interface{}{"status": models.ImportJobQueued, "heartbeat_at": nil}).Error
+}
+
+// Cancel cancela um job que ainda não terminou
+// Retorna false se o job já estava em um estado final
+func (r *ImportJobRepository) Cancel(ctx context.Context, id uint) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&models.ImportJob{}).
+		Where("id = ? AND status IN ?", id, []models.ImportJobStatus{models.ImportJobQueued, models.ImportJobRunning}).
+		Updates(map[string]interface{}{"status": models.ImportJobCanceled, "finished_at": time.Now()})
+	return result.RowsAffected > 0, result.Error
+}
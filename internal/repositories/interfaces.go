@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/rafaelc-rb/geekery-api/internal/dto"
 	"github.com/rafaelc-rb/geekery-api/internal/models"
@@ -16,11 +17,41 @@ type ItemRepositoryInterface interface {
 	Update(ctx context.Context, item *models.Item) error
 	Delete(ctx context.Context, id uint) error
 	SearchByTitle(ctx context.Context, query string, params dto.PaginationParams) ([]models.Item, int64, error)
-	GetByExternalID(ctx context.Context, source, externalID string) (*models.Item, error)
+	GetByExternalID(ctx context.Context, mediaType models.MediaType, source, externalID string) (*models.Item, error)
+	FindByNormalizedTitles(ctx context.Context, mediaType models.MediaType, titles []string) ([]models.Item, error)
+	FindByExternalIDs(ctx context.Context, mediaType models.MediaType, source string, externalIDs []string) ([]models.Item, error)
+	FindByTitleKeys(ctx context.Context, mediaType models.MediaType, keys []string) ([]models.Item, error)
+	CreateBatch(ctx context.Context, items []*models.Item) error
 	GetByYear(ctx context.Context, year int) ([]models.Item, error)
+	ListAfterID(ctx context.Context, mediaType models.MediaType, afterID uint, limit int) ([]models.Item, error)
 	AssociateTags(ctx context.Context, itemID uint, tagIDs []uint) error
 	RemoveTag(ctx context.Context, itemID uint, tagID uint) error
 	CreateSpecificData(ctx context.Context, itemID uint, mediaType models.MediaType, data interface{}) error
+	SaveSpecificData(ctx context.Context, itemID uint, mediaType models.MediaType, data interface{}) error
+	GetRanked(ctx context.Context, filter dto.ItemRankingFilter, params dto.PaginationParams) ([]models.Item, int64, error)
+}
+
+// ItemStatsRepositoryInterface define os métodos do repositório de agregados da comunidade
+type ItemStatsRepositoryInterface interface {
+	Aggregate(ctx context.Context, itemIDs []uint) ([]dto.ItemStatsAggregate, error)
+	MeanRatingByType(ctx context.Context) ([]dto.MediaTypeMean, error)
+	GetMeanRatings(ctx context.Context) ([]dto.MediaTypeMean, error)
+	SaveMeanRatings(ctx context.Context, means []dto.MediaTypeMean) error
+	Upsert(ctx context.Context, stats []models.ItemStats) error
+	DeleteStale(ctx context.Context) error
+}
+
+// RecommendationRepositoryInterface define os métodos do repositório de recomendações
+type RecommendationRepositoryInterface interface {
+	GetItemFeatures(ctx context.Context, itemIDs []uint) ([]dto.ItemFeatures, error)
+	GetItemIDsSharingTags(ctx context.Context, itemID uint, limit int) ([]uint, error)
+	GetItemIDsByCreator(ctx context.Context, creator string, limit int) ([]uint, error)
+	GetTagFrequencies(ctx context.Context, tagIDs []uint) ([]dto.TagFrequency, int64, error)
+	GetCoOccurrences(ctx context.Context, itemID uint, limit int) ([]dto.ItemCoOccurrence, int64, error)
+	GetSignals(ctx context.Context, userID uint) ([]dto.UserItemSignal, error)
+	ReplaceSimilarities(ctx context.Context, similarities []models.ItemSimilarity) error
+	GetSimilarities(ctx context.Context, itemIDs []uint, mediaType models.MediaType) ([]models.ItemSimilarity, error)
+	GetItemsByIDs(ctx context.Context, ids []uint) ([]models.Item, error)
 }
 
 // TagRepositoryInterface define os métodos do repositório de tags
@@ -33,6 +64,17 @@ type TagRepositoryInterface interface {
 	Delete(ctx context.Context, id uint) error
 	FindOrCreate(ctx context.Context, tag *models.Tag) error
 	GetTagsByIDs(ctx context.Context, ids []uint) ([]models.Tag, error)
+	Search(ctx context.Context, filter dto.TagFilter, params dto.PaginationParams) ([]models.Tag, int64, error)
+	CountByMediaType(ctx context.Context, tagIDs []uint) ([]dto.TagMediaTypeCount, error)
+	Autocomplete(ctx context.Context, prefix string, limit int) ([]models.Tag, error)
+	GetItems(ctx context.Context, tagID uint, mediaType models.MediaType, params dto.PaginationParams) ([]models.Item, int64, error)
+	GetOrphans(ctx context.Context) ([]models.Tag, error)
+	DeleteOrphans(ctx context.Context) ([]models.Tag, error)
+	ResolveName(ctx context.Context, name string) (*models.Tag, error)
+	GetAncestorIDs(ctx context.Context, id uint) ([]uint, error)
+	CreateAlias(ctx context.Context, alias *models.TagAlias) error
+	DeleteAlias(ctx context.Context, tagID, aliasID uint) error
+	Merge(ctx context.Context, target *models.Tag, sourceIDs []uint) error
 }
 
 // UserRepositoryInterface define os métodos do repositório de users
@@ -42,7 +84,6 @@ type UserRepositoryInterface interface {
 	GetByEmail(ctx context.Context, email string) (*models.User, error)
 	GetByUsername(ctx context.Context, username string) (*models.User, error)
 	Update(ctx context.Context, user *models.User) error
-	Delete(ctx context.Context, id uint) error
 }
 
 // UserItemRepositoryInterface define os métodos do repositório de user_items
@@ -56,6 +97,148 @@ type UserItemRepositoryInterface interface {
 	Exists(ctx context.Context, userID uint, itemID uint) (bool, error)
 	GetByStatus(ctx context.Context, userID uint, status models.MediaStatus, params dto.PaginationParams) ([]models.UserItem, int64, error)
 	GetFavorites(ctx context.Context, userID uint, params dto.PaginationParams) ([]models.UserItem, int64, error)
-	GetStatistics(ctx context.Context, userID uint) (map[string]int64, error)
+	Search(ctx context.Context, userID uint, filter dto.UserItemFilter, params dto.PaginationParams) ([]models.UserItem, int64, error)
+	SearchPublic(ctx context.Context, userID uint, filter dto.UserItemFilter, params dto.PaginationParams) ([]models.UserItem, int64, error)
+	GetStatistics(ctx context.Context, userID uint) ([]dto.StatsAggregate, error)
+	GetPublicStatistics(ctx context.Context, userID uint) ([]dto.StatsAggregate, error)
+	GetCompletionsByMonth(ctx context.Context, userID uint) ([]dto.PeriodCount, error)
+	GetWithActivityBetween(ctx context.Context, userID uint, from, to time.Time) ([]models.UserItem, error)
 	GetByIDAndUser(ctx context.Context, id uint, userID uint) (*models.UserItem, error)
+	ListAfterID(ctx context.Context, userID uint, mediaType models.MediaType, afterID uint, limit int) ([]models.UserItem, error)
+}
+
+// ActivityRepositoryInterface define os métodos do repositório de eventos de atividade
+type ActivityRepositoryInterface interface {
+	Create(ctx context.Context, events []models.ActivityEvent) error
+	CountByDay(ctx context.Context, userID uint, from, to time.Time) ([]dto.DayCount, error)
+	GetActiveDays(ctx context.Context, userID uint) ([]string, error)
+	SumMetric(ctx context.Context, userID uint, metric models.GoalMetric, mediaType *models.MediaType, from, to time.Time) (float64, error)
+	GetFeed(ctx context.Context, viewerID uint, params dto.PaginationParams) ([]dto.FeedEventRow, int64, error)
+}
+
+// FollowRepositoryInterface define os métodos do repositório de seguidores
+type FollowRepositoryInterface interface {
+	Follow(ctx context.Context, followerID, followingID uint, status models.FollowStatus) error
+	GetStatus(ctx context.Context, followerID, followingID uint) (models.FollowStatus, error)
+	Unfollow(ctx context.Context, followerID, followingID uint) error
+	IsFollowing(ctx context.Context, followerID, followingID uint) (bool, error)
+	CountFollowers(ctx context.Context, userID uint) (int64, error)
+	CountFollowing(ctx context.Context, userID uint) (int64, error)
+	GetFollowers(ctx context.Context, userID uint, params dto.PaginationParams) ([]models.User, int64, error)
+	GetFollowing(ctx context.Context, userID uint, params dto.PaginationParams) ([]models.User, int64, error)
+	GetFollowRequests(ctx context.Context, userID uint, params dto.PaginationParams) ([]models.User, int64, error)
+	AcceptRequest(ctx context.Context, followerID, followingID uint) (bool, error)
+	DeleteRequest(ctx context.Context, followerID, followingID uint) (bool, error)
+	AcceptAllRequests(ctx context.Context, userID uint) error
+}
+
+// GoalRepositoryInterface define os métodos do repositório de metas
+type GoalRepositoryInterface interface {
+	Create(ctx context.Context, goal *models.Goal) error
+	GetByUserID(ctx context.Context, userID uint) ([]models.Goal, error)
+	GetByIDAndUser(ctx context.Context, id, userID uint) (*models.Goal, error)
+	Update(ctx context.Context, goal *models.Goal) error
+	Delete(ctx context.Context, id uint) error
+}
+
+// CollectionRepositoryInterface define os métodos do repositório de coleções
+type CollectionRepositoryInterface interface {
+	Create(ctx context.Context, collection *models.Collection) error
+	GetByID(ctx context.Context, id uint) (*models.Collection, error)
+	GetByShareToken(ctx context.Context, token string) (*models.Collection, error)
+	GetByUserID(ctx context.Context, userID uint, params dto.PaginationParams) ([]models.Collection, int64, error)
+	GetPublic(ctx context.Context, params dto.PaginationParams) ([]models.Collection, int64, error)
+	Update(ctx context.Context, collection *models.Collection) error
+	Delete(ctx context.Context, id uint) error
+	GetItem(ctx context.Context, collectionID, itemID uint) (*models.CollectionItem, error)
+	AddItem(ctx context.Context, entry *models.CollectionItem) error
+	UpdateItem(ctx context.Context, entry *models.CollectionItem) error
+	RemoveItem(ctx context.Context, collectionID, itemID uint) error
+	Reorder(ctx context.Context, collectionID uint, itemIDs []uint) error
+}
+
+// PersonalTagRepositoryInterface define os métodos do repositório de rótulos pessoais
+type PersonalTagRepositoryInterface interface {
+	Create(ctx context.Context, tag *models.PersonalTag) error
+	GetByUserID(ctx context.Context, userID uint) ([]models.PersonalTag, error)
+	GetByIDAndUser(ctx context.Context, id, userID uint) (*models.PersonalTag, error)
+	GetByName(ctx context.Context, userID uint, name string) (*models.PersonalTag, error)
+	GetByIDsAndUser(ctx context.Context, ids []uint, userID uint) ([]models.PersonalTag, error)
+	Update(ctx context.Context, tag *models.PersonalTag) error
+	Delete(ctx context.Context, id uint) error
+	ReplaceForUserItem(ctx context.Context, userItem *models.UserItem, tags []models.PersonalTag) error
+	RemoveFromUserItem(ctx context.Context, userItemID, tagID uint) error
+}
+
+// ReviewRepositoryInterface define os métodos do repositório de resenhas
+type ReviewRepositoryInterface interface {
+	Create(ctx context.Context, review *models.Review) error
+	GetByID(ctx context.Context, id uint) (*models.Review, error)
+	Exists(ctx context.Context, userID, itemID uint) (bool, error)
+	GetByItemID(ctx context.Context, itemID uint, filter dto.ReviewFilter, params dto.PaginationParams) ([]models.Review, int64, error)
+	Update(ctx context.Context, review *models.Review, previous *models.ReviewRevision) error
+	Delete(ctx context.Context, id uint) error
+	GetRevisions(ctx context.Context, reviewID uint) ([]models.ReviewRevision, error)
+	AddVote(ctx context.Context, vote *models.ReviewVote) error
+	RemoveVote(ctx context.Context, reviewID, userID uint) error
+	GetVotedReviewIDs(ctx context.Context, userID uint, reviewIDs []uint) ([]uint, error)
+}
+
+// ImportJobRepositoryInterface define os métodos da fila de jobs de import
+type ImportJobRepositoryInterface interface {
+	Create(ctx context.Context, job *models.ImportJob) error
+	GetByID(ctx context.Context, id uint) (*models.ImportJob, error)
+	Claim(ctx context.Context, staleBefore time.Time) (*models.ImportJob, error)
+	UpdateProgress(ctx context.Context, id uint, lines, imported, failed int) (models.ImportJobStatus, error)
+	Finish(ctx context.Context, job *models.ImportJob) error
+	Requeue(ctx context.Context, id uint) error
+	Cancel(ctx context.Context, id uint) (bool, error)
+}
+
+// ListImportRepositoryInterface define os métodos do repositório de imports de lista pessoal
+type ListImportRepositoryInterface interface {
+	Create(ctx context.Context, listImport *models.ListImport) error
+	GetByIDAndUser(ctx context.Context, id, userID uint) (*models.ListImport, error)
+	GetByUserID(ctx context.Context, userID uint, params dto.PaginationParams) ([]models.ListImport, int64, error)
+	CountEntriesByStatus(ctx context.Context, listImportIDs []uint) ([]dto.ListImportStatusCount, error)
+	GetEntries(ctx context.Context, listImportID uint, status models.ListImportEntryStatus, params dto.PaginationParams) ([]models.ListImportEntry, int64, error)
+	GetEntry(ctx context.Context, listImportID, entryID uint) (*models.ListImportEntry, error)
+	UpdateEntry(ctx context.Context, entry *models.ListImportEntry) error
+}
+
+// DataExportRepositoryInterface define os métodos da fila de exports dos dados da conta
+type DataExportRepositoryInterface interface {
+	Create(ctx context.Context, export *models.DataExport) error
+	GetByID(ctx context.Context, id uint) (*models.DataExport, error)
+	GetByIDAndUser(ctx context.Context, id, userID uint) (*models.DataExport, error)
+	GetByUserID(ctx context.Context, userID uint, params dto.PaginationParams) ([]models.DataExport, int64, error)
+	HasPending(ctx context.Context, userID uint) (bool, error)
+	Claim(ctx context.Context, staleBefore time.Time) (*models.DataExport, error)
+	Heartbeat(ctx context.Context, id uint) error
+	Finish(ctx context.Context, export *models.DataExport) (bool, error)
+	Requeue(ctx context.Context, id uint) error
+	GetExpired(ctx context.Context, now time.Time, limit int) ([]models.DataExport, error)
+	MarkExpired(ctx context.Context, id uint) error
+}
+
+// AccountRepositoryInterface define os métodos de leitura e exclusão de todos os dados de um usuário
+type AccountRepositoryInterface interface {
+	GetData(ctx context.Context, userID uint) (*dto.AccountData, error)
+	Erase(ctx context.Context, userID uint) (*dto.AccountErasureResult, error)
+}
+
+// Repositories agrupa os repositórios que compartilham a mesma transação
+type Repositories struct {
+	Items        ItemRepositoryInterface
+	Tags         TagRepositoryInterface
+	UserItems    UserItemRepositoryInterface
+	Activities   ActivityRepositoryInterface
+	PersonalTags PersonalTagRepositoryInterface
+	ListImports  ListImportRepositoryInterface
+	UnitOfWork   UnitOfWorkInterface // Permite transações aninhadas (savepoints)
+}
+
+// UnitOfWorkInterface executa operações de vários repositórios em uma única transação
+type UnitOfWorkInterface interface {
+	Do(ctx context.Context, fn func(tx *Repositories) error) error
 }
@@ -2,12 +2,17 @@ package repositories
 
 import (
 	"context"
+	"reflect"
 
 	"github.com/rafaelc-rb/geekery-api/internal/dto"
 	"github.com/rafaelc-rb/geekery-api/internal/models"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// createBatchSize limita o número de linhas por INSERT em CreateBatch
+const createBatchSize = 500
+
 type ItemRepository struct {
 	db *gorm.DB
 }
@@ -38,6 +43,7 @@ func (r *ItemRepository) GetAll(ctx context.Context, params dto.PaginationParams
 	// Buscar items paginados
 	err := r.db.WithContext(ctx).
 		Preload("Tags").
+		Preload("Stats").
 		Limit(params.Limit).
 		Offset(params.GetOffset()).
 		Find(&items).Error
@@ -48,7 +54,7 @@ func (r *ItemRepository) GetAll(ctx context.Context, params dto.PaginationParams
 // GetByID retorna um item específico pelo ID com Preload condicional baseado no tipo
 func (r *ItemRepository) GetByID(ctx context.Context, id uint) (*models.Item, error) {
 	var item models.Item
-	err := r.db.WithContext(ctx).Preload("Tags").First(&item, id).Error
+	err := r.db.WithContext(ctx).Preload("Tags").Preload("Stats").First(&item, id).Error
 	if err != nil {
 		return nil, err
 	}
@@ -114,7 +120,7 @@ func (r *ItemRepository) GetByType(ctx context.Context, mediaType models.MediaTy
 
 	// Configurar query com Preload condicional baseado no tipo
 	// Isso evita N+1 queries ao carregar dados específicos
-	query := r.db.WithContext(ctx).Preload("Tags")
+	query := r.db.WithContext(ctx).Preload("Tags").Preload("Stats")
 
 	// Preload dados específicos baseado no tipo (em uma única query)
 	switch mediaType {
@@ -140,9 +146,57 @@ func (r *ItemRepository) GetByType(ctx context.Context, mediaType models.MediaTy
 	return items, total, err
 }
 
+// GetRanked retorna items ordenados pelos agregados da comunidade (score ou popularidade)
+// Items sem agregados (em nenhuma lista) ficam no final
+func (r *ItemRepository) GetRanked(ctx context.Context, filter dto.ItemRankingFilter, params dto.PaginationParams) ([]models.Item, int64, error) {
+	var items []models.Item
+	var total int64
+
+	params.Normalize()
+
+	query := r.db.WithContext(ctx).Model(&models.Item{})
+	if filter.Type != "" {
+		query = query.Where("items.type = ?", filter.Type)
+	}
+	if filter.ReleasedFrom != nil {
+		query = query.Where("items.release_date >= ?", *filter.ReleasedFrom)
+	}
+	if filter.ReleasedTo != nil {
+		query = query.Where("items.release_date < ?", *filter.ReleasedTo)
+	}
+
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query = query.Joins("LEFT JOIN item_stats ON item_stats.item_id = items.id")
+	switch filter.SortBy {
+	case dto.ItemSortPopularity:
+		query = query.
+			Order("COALESCE(item_stats.members, 0) DESC").
+			Order("COALESCE(item_stats.weighted_score, 0) DESC")
+	default:
+		query = query.
+			Order("COALESCE(item_stats.weighted_score, 0) DESC").
+			Order("COALESCE(item_stats.rating_count, 0) DESC")
+	}
+
+	err := query.
+		Select("items.*").
+		Preload("Tags").
+		Preload("Stats").
+		Order("items.id ASC").
+		Limit(params.Limit).
+		Offset(params.GetOffset()).
+		Find(&items).Error
+
+	return items, total, err
+}
+
 // Update atualiza um item existente no catálogo
+// Associações (tags, dados específicos, stats) têm métodos próprios e não são gravadas aqui
 func (r *ItemRepository) Update(ctx context.Context, item *models.Item) error {
-	return r.db.WithContext(ctx).Save(item).Error
+	return r.db.WithContext(ctx).Omit(clause.Associations).Save(item).Error
 }
 
 // Delete remove um item do catálogo
@@ -169,6 +223,7 @@ func (r *ItemRepository) SearchByTitle(ctx context.Context, query string, params
 	// Buscar items paginados
 	err := r.db.WithContext(ctx).
 		Preload("Tags").
+		Preload("Stats").
 		Where("LOWER(title) LIKE LOWER(?)", searchQuery).
 		Limit(params.Limit).
 		Offset(params.GetOffset()).
@@ -177,12 +232,14 @@ func (r *ItemRepository) SearchByTitle(ctx context.Context, query string, params
 	return items, total, err
 }
 
-// GetByExternalID busca um item por ID externo (MAL, IMDb, etc)
-func (r *ItemRepository) GetByExternalID(ctx context.Context, source, externalID string) (*models.Item, error) {
+// GetByExternalID busca um item de um tipo por ID externo (MAL, IMDb, etc)
+// O tipo é necessário porque algumas fontes reutilizam IDs entre mídias (ex: anime e mangá no MAL)
+func (r *ItemRepository) GetByExternalID(ctx context.Context, mediaType models.MediaType, source, externalID string) (*models.Item, error) {
 	var item models.Item
 	// Busca no campo JSONB external_metadata
 	err := r.db.WithContext(ctx).Preload("Tags").
-		Where("external_metadata->>? = ?", source, externalID).
+		Where("type = ? AND external_metadata->>? = ?", mediaType, source, externalID).
+		Order("id").
 		First(&item).Error
 	if err != nil {
 		return nil, err
@@ -190,6 +247,38 @@ func (r *ItemRepository) GetByExternalID(ctx context.Context, source, externalID
 	return &item, nil
 }
 
+// ListAfterID retorna até limit items com ID maior que afterID, em ordem de ID, com tags e dados específicos
+// Usado para percorrer o catálogo inteiro em lotes (paginação por chave, sem OFFSET); mediaType vazio inclui todos os tipos
+func (r *ItemRepository) ListAfterID(ctx context.Context, mediaType models.MediaType, afterID uint, limit int) ([]models.Item, error) {
+	var items []models.Item
+
+	query := r.db.WithContext(ctx).Preload("Tags", func(db *gorm.DB) *gorm.DB {
+		return db.Order("tags.name")
+	})
+
+	switch mediaType {
+	case "":
+		query = query.Preload("AnimeData").Preload("MovieData").Preload("GameData").Preload("BookData").Preload("SeriesData")
+	case models.MediaTypeAnime:
+		query = query.Preload("AnimeData").Where("type = ?", mediaType)
+	case models.MediaTypeMovie:
+		query = query.Preload("MovieData").Where("type = ?", mediaType)
+	case models.MediaTypeGame:
+		query = query.Preload("GameData").Where("type = ?", mediaType)
+	case models.MediaTypeBook, models.MediaTypeComic, models.MediaTypeNovel:
+		query = query.Preload("BookData").Where("type = ?", mediaType)
+	case models.MediaTypeSeries:
+		query = query.Preload("SeriesData").Where("type = ?", mediaType)
+	}
+
+	err := query.
+		Where("id > ?", afterID).
+		Order("id").
+		Limit(limit).
+		Find(&items).Error
+	return items, err
+}
+
 // GetByYear retorna items de um ano específico
 func (r *ItemRepository) GetByYear(ctx context.Context, year int) ([]models.Item, error) {
 	var items []models.Item
@@ -200,6 +289,108 @@ func (r *ItemRepository) GetByYear(ctx context.Context, year int) ([]models.Item
 	return items, err
 }
 
+// FindByNormalizedTitles busca items de um tipo cujo título normalizado (minúsculas, espaços colapsados) está em titles
+// Os títulos já devem estar normalizados
+func (r *ItemRepository) FindByNormalizedTitles(ctx context.Context, mediaType models.MediaType, titles []string) ([]models.Item, error) {
+	var items []models.Item
+	if len(titles) == 0 {
+		return items, nil
+	}
+
+	err := r.db.WithContext(ctx).
+		Where("type = ? AND regexp_replace(LOWER(TRIM(title)), '\\s+', ' ', 'g') IN ?", mediaType, titles).
+		Order("id").
+		Find(&items).Error
+	return items, err
+}
+
+// FindByTitleKeys busca items de um tipo pela chave de título: minúsculas, apenas letras e números
+// Encontra títulos que diferem só em pontuação e espaços (ex: "Steins;Gate" e "Steins Gate"); as chaves já devem estar normalizadas
+func (r *ItemRepository) FindByTitleKeys(ctx context.Context, mediaType models.MediaType, keys []string) ([]models.Item, error) {
+	var items []models.Item
+	if len(keys) == 0 {
+		return items, nil
+	}
+
+	err := r.db.WithContext(ctx).
+		Where("type = ? AND regexp_replace(LOWER(title), '[^[:alnum:]]+', '', 'g') IN ?", mediaType, keys).
+		Order("id").
+		Find(&items).Error
+	return items, err
+}
+
+// FindByExternalIDs busca items de um tipo pelos IDs externos de uma fonte (MAL, IMDb, etc)
+func (r *ItemRepository) FindByExternalIDs(ctx context.Context, mediaType models.MediaType, source string, externalIDs []string) ([]models.Item, error) {
+	var items []models.Item
+	if len(externalIDs) == 0 {
+		return items, nil
+	}
+
+	err := r.db.WithContext(ctx).
+		Where("type = ? AND external_metadata->>? IN ?", mediaType, source, externalIDs).
+		Order("id").
+		Find(&items).Error
+	return items, err
+}
+
+// CreateBatch cria items com seus dados específicos e tags em lotes (poucas idas ao banco por lote)
+// Os dados específicos vêm do campo correspondente ao tipo (AnimeData, BookData...) e as tags de Tags (apenas os IDs)
+func (r *ItemRepository) CreateBatch(ctx context.Context, items []*models.Item) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	db := r.db.WithContext(ctx)
+	if err := db.Omit(clause.Associations).CreateInBatches(items, createBatchSize).Error; err != nil {
+		return err
+	}
+
+	var (
+		animeData  []*models.AnimeData
+		movieData  []*models.MovieData
+		gameData   []*models.GameData
+		bookData   []*models.BookData
+		seriesData []*models.SeriesData
+		itemTags   []map[string]interface{}
+	)
+	for _, item := range items {
+		switch {
+		case item.AnimeData != nil:
+			item.AnimeData.ItemID = item.ID
+			animeData = append(animeData, item.AnimeData)
+		case item.MovieData != nil:
+			item.MovieData.ItemID = item.ID
+			movieData = append(movieData, item.MovieData)
+		case item.GameData != nil:
+			item.GameData.ItemID = item.ID
+			gameData = append(gameData, item.GameData)
+		case item.BookData != nil:
+			item.BookData.ItemID = item.ID
+			bookData = append(bookData, item.BookData)
+		case item.SeriesData != nil:
+			item.SeriesData.ItemID = item.ID
+			seriesData = append(seriesData, item.SeriesData)
+		}
+		for _, tag := range item.Tags {
+			itemTags = append(itemTags, map[string]interface{}{"item_id": item.ID, "tag_id": tag.ID})
+		}
+	}
+
+	for _, details := range []interface{}{animeData, movieData, gameData, bookData, seriesData} {
+		if reflect.ValueOf(details).Len() == 0 {
+			continue
+		}
+		if err := db.CreateInBatches(details, createBatchSize).Error; err != nil {
+			return err
+		}
+	}
+
+	if len(itemTags) > 0 {
+		return db.Table("item_tags").Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(itemTags, createBatchSize).Error
+	}
+	return nil
+}
+
 // AssociateTags associa tags a um item
 func (r *ItemRepository) AssociateTags(ctx context.Context, itemID uint, tagIDs []uint) error {
 	var item models.Item
@@ -261,3 +452,44 @@ func (r *ItemRepository) CreateSpecificData(ctx context.Context, itemID uint, me
 	}
 	return nil
 }
+
+// SaveSpecificData cria ou atualiza os dados específicos de um item (upsert por item_id)
+func (r *ItemRepository) SaveSpecificData(ctx context.Context, itemID uint, mediaType models.MediaType, data interface{}) error {
+	switch mediaType {
+	case models.MediaTypeAnime:
+		if animeData, ok := data.(*models.AnimeData); ok {
+			animeData.ItemID = itemID
+			return r.upsertSpecificData(ctx, animeData, "episodes", "studio")
+		}
+	case models.MediaTypeMovie:
+		if movieData, ok := data.(*models.MovieData); ok {
+			movieData.ItemID = itemID
+			return r.upsertSpecificData(ctx, movieData, "director", "runtime")
+		}
+	case models.MediaTypeGame:
+		if gameData, ok := data.(*models.GameData); ok {
+			gameData.ItemID = itemID
+			return r.upsertSpecificData(ctx, gameData, "platform", "developer", "average_playtime")
+		}
+	case models.MediaTypeBook, models.MediaTypeComic, models.MediaTypeNovel:
+		if bookData, ok := data.(*models.BookData); ok {
+			bookData.ItemID = itemID
+			return r.upsertSpecificData(ctx, bookData, "author", "volumes", "chapters", "pages", "format", "publisher")
+		}
+	case models.MediaTypeSeries:
+		if seriesData, ok := data.(*models.SeriesData); ok {
+			seriesData.ItemID = itemID
+			return r.upsertSpecificData(ctx, seriesData, "seasons", "episodes")
+		}
+	}
+	return nil
+}
+
+// upsertSpecificData insere data ou, se o item já tem dados específicos, atualiza as colunas informadas
+// deleted_at é limpo para reativar dados removidos por soft delete
+func (r *ItemRepository) upsertSpecificData(ctx context.Context, data interface{}, columns ...string) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "item_id"}},
+		DoUpdates: clause.AssignmentColumns(append(columns, "updated_at", "deleted_at")),
+	}).Create(data).Error
+}
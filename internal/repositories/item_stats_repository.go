@@ -0,0 +1,109 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// itemStatsUpsertBatch limita o número de linhas por INSERT na atualização dos agregados
+const itemStatsUpsertBatch = 500
+
+type ItemStatsRepository struct {
+	db *gorm.DB
+}
+
+// NewItemStatsRepository cria uma nova instância do repositório de agregados de items
+func NewItemStatsRepository(db *gorm.DB) *ItemStatsRepository {
+	return &ItemStatsRepository{db: db}
+}
+
+// Aggregate calcula os agregados das listas dos usuários por item
+// Com itemIDs vazio, agrega todos os items que estão em alguma lista
+func (r *ItemStatsRepository) Aggregate(ctx context.Context, itemIDs []uint) ([]dto.ItemStatsAggregate, error) {
+	var rows []dto.ItemStatsAggregate
+
+	query := r.db.WithContext(ctx).Table("user_items").
+		Select(`user_items.item_id AS item_id,
+			items.type AS media_type,
+			COALESCE(AVG(user_items.rating) FILTER (WHERE user_items.rating > 0), 0) AS mean_score,
+			COUNT(*) FILTER (WHERE user_items.rating > 0) AS rating_count,
+			COUNT(*) AS members,
+			COUNT(*) FILTER (WHERE user_items.status = ?) AS completed_count,
+			COUNT(*) FILTER (WHERE user_items.status = ?) AS dropped_count,
+			COUNT(*) FILTER (WHERE user_items.favorite) AS favorite_count`,
+			models.StatusCompleted, models.StatusDropped).
+		Joins("JOIN items ON items.id = user_items.item_id AND items.deleted_at IS NULL").
+		Where("user_items.deleted_at IS NULL")
+	if len(itemIDs) > 0 {
+		query = query.Where("user_items.item_id IN ?", itemIDs)
+	}
+
+	err := query.Group("user_items.item_id, items.type").Scan(&rows).Error
+	return rows, err
+}
+
+// MeanRatingByType calcula a média das notas (> 0) de cada tipo de mídia sobre todas as listas
+// Consulta cara: usada apenas pelo recálculo completo (ver SaveMeanRatings/GetMeanRatings)
+func (r *ItemStatsRepository) MeanRatingByType(ctx context.Context) ([]dto.MediaTypeMean, error) {
+	var means []dto.MediaTypeMean
+	err := r.db.WithContext(ctx).Table("user_items").
+		Select("items.type AS media_type, AVG(user_items.rating) AS mean").
+		Joins("JOIN items ON items.id = user_items.item_id AND items.deleted_at IS NULL").
+		Where("user_items.deleted_at IS NULL AND user_items.rating > 0").
+		Group("items.type").
+		Scan(&means).Error
+	return means, err
+}
+
+// GetMeanRatings retorna as médias por tipo de mídia gravadas no último recálculo completo
+func (r *ItemStatsRepository) GetMeanRatings(ctx context.Context) ([]dto.MediaTypeMean, error) {
+	var means []dto.MediaTypeMean
+	err := r.db.WithContext(ctx).Model(&models.MediaTypeStats{}).
+		Select("media_type, mean_rating AS mean").
+		Scan(&means).Error
+	return means, err
+}
+
+// SaveMeanRatings substitui as médias por tipo de mídia gravadas
+func (r *ItemStatsRepository) SaveMeanRatings(ctx context.Context, means []dto.MediaTypeMean) error {
+	now := time.Now()
+	rows := make([]models.MediaTypeStats, len(means))
+	for i, mean := range means {
+		rows[i] = models.MediaTypeStats{MediaType: models.MediaType(mean.MediaType), MeanRating: mean.Mean, UpdatedAt: now}
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Session(&gorm.Session{AllowGlobalUpdate: true}).Delete(&models.MediaTypeStats{}).Error; err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		return tx.Create(&rows).Error
+	})
+}
+
+// Upsert grava os agregados, substituindo os valores existentes
+func (r *ItemStatsRepository) Upsert(ctx context.Context, stats []models.ItemStats) error {
+	if len(stats) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "item_id"}},
+			UpdateAll: true,
+		}).
+		CreateInBatches(stats, itemStatsUpsertBatch).Error
+}
+
+// DeleteStale remove os agregados de items que não estão em nenhuma lista
+func (r *ItemStatsRepository) DeleteStale(ctx context.Context) error {
+	return r.db.WithContext(ctx).
+		Where("item_id NOT IN (SELECT user_items.item_id FROM user_items WHERE user_items.deleted_at IS NULL)").
+		Delete(&models.ItemStats{}).Error
+}
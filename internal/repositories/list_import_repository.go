@@ -0,0 +1,130 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ListImportRepository struct {
+	db *gorm.DB
+}
+
+// NewListImportRepository cria uma nova instância do repositório de imports de lista
+func NewListImportRepository(db *gorm.DB) *ListImportRepository {
+	return &ListImportRepository{db: db}
+}
+
+// Create grava o import e suas entradas (em lotes, já que um export pode ter milhares de entradas)
+func (r *ListImportRepository) Create(ctx context.Context, listImport *models.ListImport) error {
+	db := r.db.WithContext(ctx)
+	if err := db.Omit(clause.Associations).Create(listImport).Error; err != nil {
+		return err
+	}
+	if len(listImport.Entries) == 0 {
+		return nil
+	}
+
+	for i := range listImport.Entries {
+		listImport.Entries[i].ListImportID = listImport.ID
+	}
+	return db.CreateInBatches(listImport.Entries, createBatchSize).Error
+}
+
+// GetByIDAndUser busca um import garantindo que pertence ao usuário (sem as entradas)
+func (r *ListImportRepository) GetByIDAndUser(ctx context.Context, id, userID uint) (*models.ListImport, error) {
+	var listImport models.ListImport
+	err := r.db.WithContext(ctx).
+		Where("id = ? AND user_id = ?", id, userID).
+		First(&listImport).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrListImportNotFound
+		}
+		return nil, err
+	}
+	return &listImport, nil
+}
+
+// GetByUserID retorna os imports do usuário (mais recentes primeiro) com paginação
+func (r *ListImportRepository) GetByUserID(ctx context.Context, userID uint, params dto.PaginationParams) ([]models.ListImport, int64, error) {
+	var imports []models.ListImport
+	var total int64
+
+	params.Normalize()
+
+	query := r.db.WithContext(ctx).Model(&models.ListImport{}).Where("user_id = ?", userID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Order("created_at DESC, id DESC").
+		Limit(params.Limit).
+		Offset(params.GetOffset()).
+		Find(&imports).Error
+	return imports, total, err
+}
+
+// CountEntriesByStatus conta as entradas de cada import por status
+func (r *ListImportRepository) CountEntriesByStatus(ctx context.Context, listImportIDs []uint) ([]dto.ListImportStatusCount, error) {
+	var counts []dto.ListImportStatusCount
+	if len(listImportIDs) == 0 {
+		return counts, nil
+	}
+
+	err := r.db.WithContext(ctx).
+		Model(&models.ListImportEntry{}).
+		Select("list_import_id, status, COUNT(*) AS count").
+		Where("list_import_id IN ?", listImportIDs).
+		Group("list_import_id, status").
+		Scan(&counts).Error
+	return counts, err
+}
+
+// GetEntries retorna as entradas de um import na ordem do arquivo, opcionalmente filtradas por status
+func (r *ListImportRepository) GetEntries(ctx context.Context, listImportID uint, status models.ListImportEntryStatus, params dto.PaginationParams) ([]models.ListImportEntry, int64, error) {
+	var entries []models.ListImportEntry
+	var total int64
+
+	params.Normalize()
+
+	query := r.db.WithContext(ctx).Model(&models.ListImportEntry{}).Where("list_import_id = ?", listImportID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Order("line, id").
+		Limit(params.Limit).
+		Offset(params.GetOffset()).
+		Find(&entries).Error
+	return entries, total, err
+}
+
+// GetEntry busca uma entrada de um import
+func (r *ListImportRepository) GetEntry(ctx context.Context, listImportID, entryID uint) (*models.ListImportEntry, error) {
+	var entry models.ListImportEntry
+	err := r.db.WithContext(ctx).
+		Where("id = ? AND list_import_id = ?", entryID, listImportID).
+		First(&entry).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrListImportEntryNotFound
+		}
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// UpdateEntry atualiza uma entrada (resultado da revisão)
+func (r *ListImportRepository) UpdateEntry(ctx context.Context, entry *models.ListImportEntry) error {
+	return r.db.WithContext(ctx).Save(entry).Error
+}
@@ -0,0 +1,100 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"gorm.io/gorm"
+)
+
+// personalTagUsageSQL seleciona o rótulo com o número de entradas da lista que o utilizam
+const personalTagUsageSQL = "personal_tags.*, (SELECT COUNT(*) FROM user_item_personal_tags JOIN user_items ON user_items.id = user_item_personal_tags.user_item_id AND user_items.deleted_at IS NULL WHERE user_item_personal_tags.personal_tag_id = personal_tags.id) AS usage_count"
+
+type PersonalTagRepository struct {
+	db *gorm.DB
+}
+
+// NewPersonalTagRepository cria uma nova instância do repositório de rótulos pessoais
+func NewPersonalTagRepository(db *gorm.DB) *PersonalTagRepository {
+	return &PersonalTagRepository{db: db}
+}
+
+// Create cria um novo rótulo pessoal
+func (r *PersonalTagRepository) Create(ctx context.Context, tag *models.PersonalTag) error {
+	return r.db.WithContext(ctx).Create(tag).Error
+}
+
+// GetByUserID retorna os rótulos do usuário ordenados por nome, com o número de usos
+func (r *PersonalTagRepository) GetByUserID(ctx context.Context, userID uint) ([]models.PersonalTag, error) {
+	var tags []models.PersonalTag
+	err := r.db.WithContext(ctx).
+		Select(personalTagUsageSQL).
+		Where("personal_tags.user_id = ?", userID).
+		Order("personal_tags.name ASC").
+		Find(&tags).Error
+	return tags, err
+}
+
+// GetByIDAndUser busca um rótulo garantindo que pertence ao usuário
+func (r *PersonalTagRepository) GetByIDAndUser(ctx context.Context, id, userID uint) (*models.PersonalTag, error) {
+	var tag models.PersonalTag
+	err := r.db.WithContext(ctx).
+		Select(personalTagUsageSQL).
+		Where("personal_tags.id = ? AND personal_tags.user_id = ?", id, userID).
+		First(&tag).Error
+	if err != nil {
+		return nil, err
+	}
+	return &tag, nil
+}
+
+// GetByName busca um rótulo do usuário pelo nome
+func (r *PersonalTagRepository) GetByName(ctx context.Context, userID uint, name string) (*models.PersonalTag, error) {
+	var tag models.PersonalTag
+	err := r.db.WithContext(ctx).Where("user_id = ? AND name = ?", userID, name).First(&tag).Error
+	if err != nil {
+		return nil, err
+	}
+	return &tag, nil
+}
+
+// GetByIDsAndUser retorna os rótulos do usuário com os IDs informados
+// IDs de outros usuários (ou inexistentes) são ignorados
+func (r *PersonalTagRepository) GetByIDsAndUser(ctx context.Context, ids []uint, userID uint) ([]models.PersonalTag, error) {
+	var tags []models.PersonalTag
+	if len(ids) == 0 {
+		return tags, nil
+	}
+	err := r.db.WithContext(ctx).Where("id IN ? AND user_id = ?", ids, userID).Find(&tags).Error
+	return tags, err
+}
+
+// Update atualiza nome e cor do rótulo
+func (r *PersonalTagRepository) Update(ctx context.Context, tag *models.PersonalTag) error {
+	return r.db.WithContext(ctx).Model(tag).Select("name", "color").Updates(tag).Error
+}
+
+// Delete remove o rótulo e suas associações com as entradas da lista
+func (r *PersonalTagRepository) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM user_item_personal_tags WHERE personal_tag_id = ?", id).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.PersonalTag{}, id).Error
+	})
+}
+
+// ReplaceForUserItem substitui os rótulos de uma entrada da lista
+func (r *PersonalTagRepository) ReplaceForUserItem(ctx context.Context, userItem *models.UserItem, tags []models.PersonalTag) error {
+	association := r.db.WithContext(ctx).Model(userItem).Association("PersonalTags")
+	if len(tags) == 0 {
+		return association.Clear()
+	}
+	return association.Replace(tags)
+}
+
+// RemoveFromUserItem remove um rótulo de uma entrada da lista
+func (r *PersonalTagRepository) RemoveFromUserItem(ctx context.Context, userItemID, tagID uint) error {
+	return r.db.WithContext(ctx).
+		Exec("DELETE FROM user_item_personal_tags WHERE user_item_id = ? AND personal_tag_id = ?", userItemID, tagID).Error
+}
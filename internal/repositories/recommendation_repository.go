@@ -0,0 +1,214 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"gorm.io/gorm"
+)
+
+// similarityInsertBatch limita o número de linhas por INSERT na gravação do modelo
+const similarityInsertBatch = 1000
+
+// itemCreatorSQL resolve o "criador" de um item a partir dos dados específicos do seu tipo
+const itemCreatorSQL = `COALESCE(
+	NULLIF(anime_details.studio, ''),
+	NULLIF(movie_details.director, ''),
+	NULLIF(game_details.developer, ''),
+	NULLIF(book_details.author, ''),
+	'')`
+
+type RecommendationRepository struct {
+	db *gorm.DB
+}
+
+// NewRecommendationRepository cria uma nova instância do repositório de recomendações
+func NewRecommendationRepository(db *gorm.DB) *RecommendationRepository {
+	return &RecommendationRepository{db: db}
+}
+
+// itemsWithCreator retorna uma query de items com os dados específicos necessários para resolver o criador
+func (r *RecommendationRepository) itemsWithCreator(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).Model(&models.Item{}).
+		Joins("LEFT JOIN anime_details ON anime_details.item_id = items.id AND anime_details.deleted_at IS NULL").
+		Joins("LEFT JOIN movie_details ON movie_details.item_id = items.id AND movie_details.deleted_at IS NULL").
+		Joins("LEFT JOIN game_details ON game_details.item_id = items.id AND game_details.deleted_at IS NULL").
+		Joins("LEFT JOIN book_details ON book_details.item_id = items.id AND book_details.deleted_at IS NULL")
+}
+
+// GetItemFeatures retorna os atributos de conteúdo (tipo, criador e tags) dos items informados (nil = todos)
+func (r *RecommendationRepository) GetItemFeatures(ctx context.Context, itemIDs []uint) ([]dto.ItemFeatures, error) {
+	var features []dto.ItemFeatures
+
+	query := r.itemsWithCreator(ctx).
+		Select("items.id AS item_id, items.type AS media_type, " + itemCreatorSQL + " AS creator")
+	if itemIDs != nil {
+		query = query.Where("items.id IN ?", itemIDs)
+	}
+	if err := query.Order("items.id ASC").Scan(&features).Error; err != nil {
+		return nil, err
+	}
+
+	var itemTags []struct {
+		ItemID uint
+		TagID  uint
+	}
+	tagsQuery := r.db.WithContext(ctx).Table("item_tags").
+		Select("item_tags.item_id, item_tags.tag_id").
+		Joins("JOIN items ON items.id = item_tags.item_id AND items.deleted_at IS NULL")
+	if itemIDs != nil {
+		tagsQuery = tagsQuery.Where("item_tags.item_id IN ?", itemIDs)
+	}
+	if err := tagsQuery.Scan(&itemTags).Error; err != nil {
+		return nil, err
+	}
+
+	index := make(map[uint]int, len(features))
+	for i := range features {
+		index[features[i].ItemID] = i
+	}
+	for _, it := range itemTags {
+		if i, ok := index[it.ItemID]; ok {
+			features[i].TagIDs = append(features[i].TagIDs, it.TagID)
+		}
+	}
+
+	return features, nil
+}
+
+// GetItemIDsSharingTags retorna os items com mais tags em comum com o item informado
+func (r *RecommendationRepository) GetItemIDsSharingTags(ctx context.Context, itemID uint, limit int) ([]uint, error) {
+	var ids []uint
+	err := r.db.WithContext(ctx).Table("item_tags AS own").
+		Select("other.item_id").
+		Joins("JOIN item_tags AS other ON other.tag_id = own.tag_id AND other.item_id <> own.item_id").
+		Joins("JOIN items ON items.id = other.item_id AND items.deleted_at IS NULL").
+		Where("own.item_id = ?", itemID).
+		Group("other.item_id").
+		Order("COUNT(*) DESC, other.item_id ASC").
+		Limit(limit).
+		Pluck("other.item_id", &ids).Error
+	return ids, err
+}
+
+// GetItemIDsByCreator retorna os items do mesmo criador (comparação sem diferenciar maiúsculas)
+func (r *RecommendationRepository) GetItemIDsByCreator(ctx context.Context, creator string, limit int) ([]uint, error) {
+	var ids []uint
+	err := r.itemsWithCreator(ctx).
+		Where("LOWER(TRIM("+itemCreatorSQL+")) = LOWER(TRIM(?))", creator).
+		Order("items.id ASC").
+		Limit(limit).
+		Pluck("items.id", &ids).Error
+	return ids, err
+}
+
+// GetTagFrequencies retorna em quantos items cada tag aparece e o total de items do catálogo (para o IDF)
+func (r *RecommendationRepository) GetTagFrequencies(ctx context.Context, tagIDs []uint) ([]dto.TagFrequency, int64, error) {
+	var frequencies []dto.TagFrequency
+	var total int64
+
+	if err := r.db.WithContext(ctx).Model(&models.Item{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if len(tagIDs) == 0 {
+		return frequencies, total, nil
+	}
+
+	err := r.db.WithContext(ctx).Table("item_tags").
+		Select("item_tags.tag_id, COUNT(*) AS item_count").
+		Joins("JOIN items ON items.id = item_tags.item_id AND items.deleted_at IS NULL").
+		Where("item_tags.tag_id IN ?", tagIDs).
+		Group("item_tags.tag_id").
+		Scan(&frequencies).Error
+	return frequencies, total, err
+}
+
+// GetCoOccurrences retorna os items que mais aparecem nas mesmas listas que o item informado
+// Também retorna quantas listas contêm o próprio item
+func (r *RecommendationRepository) GetCoOccurrences(ctx context.Context, itemID uint, limit int) ([]dto.ItemCoOccurrence, int64, error) {
+	var rows []dto.ItemCoOccurrence
+	var members int64
+
+	if err := r.db.WithContext(ctx).Model(&models.UserItem{}).Where("item_id = ?", itemID).Count(&members).Error; err != nil {
+		return nil, 0, err
+	}
+	if members == 0 {
+		return rows, 0, nil
+	}
+
+	err := r.db.WithContext(ctx).Table("user_items AS own").
+		Select(`other.item_id AS item_id,
+			COUNT(*) AS shared,
+			(SELECT COUNT(*) FROM user_items WHERE user_items.item_id = other.item_id AND user_items.deleted_at IS NULL) AS members`).
+		Joins("JOIN user_items AS other ON other.user_id = own.user_id AND other.item_id <> own.item_id AND other.deleted_at IS NULL").
+		Joins("JOIN items ON items.id = other.item_id AND items.deleted_at IS NULL").
+		Where("own.item_id = ? AND own.deleted_at IS NULL", itemID).
+		Group("other.item_id").
+		Order("shared DESC, other.item_id ASC").
+		Limit(limit).
+		Scan(&rows).Error
+	return rows, members, err
+}
+
+// GetSignals retorna as interações (nota, status, favorito) das listas dos usuários
+// Com userID = 0, retorna as interações de todos os usuários
+func (r *RecommendationRepository) GetSignals(ctx context.Context, userID uint) ([]dto.UserItemSignal, error) {
+	var signals []dto.UserItemSignal
+
+	query := r.db.WithContext(ctx).Model(&models.UserItem{}).
+		Select("user_items.user_id, user_items.item_id, user_items.rating, user_items.status, user_items.favorite").
+		Joins("JOIN items ON items.id = user_items.item_id AND items.deleted_at IS NULL")
+	if userID != 0 {
+		query = query.Where("user_items.user_id = ?", userID)
+	}
+
+	err := query.Order("user_items.user_id ASC, user_items.item_id ASC").Scan(&signals).Error
+	return signals, err
+}
+
+// ReplaceSimilarities substitui todo o modelo de similaridade em uma única transação
+func (r *RecommendationRepository) ReplaceSimilarities(ctx context.Context, similarities []models.ItemSimilarity) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Session(&gorm.Session{AllowGlobalUpdate: true}).Delete(&models.ItemSimilarity{}).Error; err != nil {
+			return err
+		}
+		if len(similarities) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(similarities, similarityInsertBatch).Error
+	})
+}
+
+// GetSimilarities retorna os items similares aos items informados, opcionalmente filtrando o tipo do similar
+func (r *RecommendationRepository) GetSimilarities(ctx context.Context, itemIDs []uint, mediaType models.MediaType) ([]models.ItemSimilarity, error) {
+	var similarities []models.ItemSimilarity
+	if len(itemIDs) == 0 {
+		return similarities, nil
+	}
+
+	query := r.db.WithContext(ctx).
+		Joins("JOIN items ON items.id = item_similarities.similar_item_id AND items.deleted_at IS NULL").
+		Where("item_similarities.item_id IN ?", itemIDs)
+	if mediaType != "" {
+		query = query.Where("items.type = ?", mediaType)
+	}
+
+	err := query.Order("item_similarities.score DESC").Find(&similarities).Error
+	return similarities, err
+}
+
+// GetItemsByIDs retorna os items informados com tags e agregados da comunidade
+func (r *RecommendationRepository) GetItemsByIDs(ctx context.Context, ids []uint) ([]models.Item, error) {
+	var items []models.Item
+	if len(ids) == 0 {
+		return items, nil
+	}
+
+	err := r.db.WithContext(ctx).
+		Preload("Tags").
+		Preload("Stats").
+		Where("id IN ?", ids).
+		Find(&items).Error
+	return items, err
+}
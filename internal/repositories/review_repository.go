@@ -0,0 +1,167 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// reviewRatingHiddenSQL verifica se a nota da resenha deve ser ocultada: o autor oculta as notas
+// ou marcou a entrada da lista como privada (mesmas regras do feed e da lista pública)
+const reviewRatingHiddenSQL = "(COALESCE(authors.hide_ratings, false) OR COALESCE(user_items.private, false))"
+
+// reviewWithCountsSQL seleciona a resenha com o número de votos úteis, de edições e se a nota é oculta
+const reviewWithCountsSQL = "reviews.*, " +
+	"(SELECT COUNT(*) FROM review_votes WHERE review_votes.review_id = reviews.id) AS helpful_count, " +
+	"(SELECT COUNT(*) FROM review_revisions WHERE review_revisions.review_id = reviews.id) AS edit_count, " +
+	reviewRatingHiddenSQL + " AS rating_hidden"
+
+// withRatingPrivacy une o autor e a entrada da lista dele, usados por reviewRatingHiddenSQL
+func withRatingPrivacy(query *gorm.DB) *gorm.DB {
+	return query.
+		Joins("LEFT JOIN users AS authors ON authors.id = reviews.user_id").
+		Joins("LEFT JOIN user_items ON user_items.user_id = reviews.user_id AND user_items.item_id = reviews.item_id")
+}
+
+type ReviewRepository struct {
+	db *gorm.DB
+}
+
+// NewReviewRepository cria uma nova instância do repositório de resenhas
+func NewReviewRepository(db *gorm.DB) *ReviewRepository {
+	return &ReviewRepository{db: db}
+}
+
+// Create cria uma nova resenha
+func (r *ReviewRepository) Create(ctx context.Context, review *models.Review) error {
+	return r.db.WithContext(ctx).Omit("User").Create(review).Error
+}
+
+// GetByID retorna uma resenha com o autor e as contagens
+// Rating mantém a nota gravada; RatingHidden indica se ela pode ser exposta
+func (r *ReviewRepository) GetByID(ctx context.Context, id uint) (*models.Review, error) {
+	var review models.Review
+	err := withRatingPrivacy(r.db.WithContext(ctx)).
+		Select(reviewWithCountsSQL).
+		Preload("User").
+		Where("reviews.id = ?", id).
+		First(&review).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrReviewNotFound
+		}
+		return nil, err
+	}
+	return &review, nil
+}
+
+// Exists verifica se o usuário já escreveu uma resenha do item
+func (r *ReviewRepository) Exists(ctx context.Context, userID, itemID uint) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.Review{}).
+		Where("user_id = ? AND item_id = ?", userID, itemID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// GetByItemID retorna as resenhas de um item com filtros, ordenação e paginação
+// A ordenação por nota trata notas ocultas como sem nota, para não revelá-las pela posição
+func (r *ReviewRepository) GetByItemID(ctx context.Context, itemID uint, filter dto.ReviewFilter, params dto.PaginationParams) ([]models.Review, int64, error) {
+	var reviews []models.Review
+	var total int64
+
+	params.Normalize()
+
+	query := r.db.WithContext(ctx).Model(&models.Review{}).Where("reviews.item_id = ?", itemID)
+	if filter.Recommended != nil {
+		query = query.Where("reviews.recommended = ?", *filter.Recommended)
+	}
+	if filter.HideSpoilers {
+		query = query.Where("reviews.spoiler = ?", false)
+	}
+
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	switch filter.SortBy {
+	case dto.ReviewSortRecent:
+		query = query.Order("reviews.created_at DESC")
+	case dto.ReviewSortRating:
+		query = query.Order("CASE WHEN " + reviewRatingHiddenSQL + " THEN 0 ELSE reviews.rating END DESC").Order("helpful_count DESC")
+	default:
+		query = query.Order("helpful_count DESC").Order("reviews.created_at DESC")
+	}
+
+	err := withRatingPrivacy(query).
+		Select(reviewWithCountsSQL).
+		Preload("User").
+		Order("reviews.id DESC").
+		Limit(params.Limit).
+		Offset(params.GetOffset()).
+		Find(&reviews).Error
+
+	return reviews, total, err
+}
+
+// Update salva a resenha guardando a versão anterior no histórico
+func (r *ReviewRepository) Update(ctx context.Context, review *models.Review, previous *models.ReviewRevision) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(previous).Error; err != nil {
+			return err
+		}
+		return tx.Omit("User", "HelpfulCount", "EditCount").Save(review).Error
+	})
+}
+
+// Delete remove a resenha (soft delete) e seus votos
+// O histórico de edições é mantido junto com o registro removido
+func (r *ReviewRepository) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("review_id = ?", id).Delete(&models.ReviewVote{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.Review{}, id).Error
+	})
+}
+
+// GetRevisions retorna as versões anteriores da resenha, mais recentes primeiro
+func (r *ReviewRepository) GetRevisions(ctx context.Context, reviewID uint) ([]models.ReviewRevision, error) {
+	var revisions []models.ReviewRevision
+	err := r.db.WithContext(ctx).
+		Where("review_id = ?", reviewID).
+		Order("created_at DESC, id DESC").
+		Find(&revisions).Error
+	return revisions, err
+}
+
+// AddVote registra o voto útil do usuário (votar de novo não tem efeito)
+func (r *ReviewRepository) AddVote(ctx context.Context, vote *models.ReviewVote) error {
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(vote).Error
+}
+
+// RemoveVote remove o voto útil do usuário (sem erro se não havia voto)
+func (r *ReviewRepository) RemoveVote(ctx context.Context, reviewID, userID uint) error {
+	return r.db.WithContext(ctx).
+		Where("review_id = ? AND user_id = ?", reviewID, userID).
+		Delete(&models.ReviewVote{}).Error
+}
+
+// GetVotedReviewIDs retorna quais das resenhas informadas o usuário votou como úteis
+func (r *ReviewRepository) GetVotedReviewIDs(ctx context.Context, userID uint, reviewIDs []uint) ([]uint, error) {
+	var ids []uint
+	if len(reviewIDs) == 0 {
+		return ids, nil
+	}
+
+	err := r.db.WithContext(ctx).Model(&models.ReviewVote{}).
+		Where("user_id = ? AND review_id IN ?", userID, reviewIDs).
+		Pluck("review_id", &ids).Error
+	return ids, err
+}
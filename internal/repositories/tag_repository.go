@@ -2,7 +2,9 @@ package repositories
 
 import (
 	"context"
+	"errors"
 
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
 	"github.com/rafaelc-rb/geekery-api/internal/models"
 	"gorm.io/gorm"
 )
@@ -31,7 +33,7 @@ func (r *TagRepository) GetAll(ctx context.Context) ([]models.Tag, error) {
 // GetByID retorna uma tag específica pelo ID
 func (r *TagRepository) GetByID(ctx context.Context, id uint) (*models.Tag, error) {
 	var tag models.Tag
-	err := r.db.WithContext(ctx).Preload("Items").First(&tag, id).Error
+	err := r.db.WithContext(ctx).Preload("Items").Preload("Children").Preload("Aliases").First(&tag, id).Error
 	if err != nil {
 		return nil, err
 	}
@@ -54,17 +56,33 @@ func (r *TagRepository) Update(ctx context.Context, tag *models.Tag) error {
 }
 
 // Delete remove uma tag do banco de dados (soft delete)
+// Os filhos passam para o pai da tag removida e os aliases são removidos
 func (r *TagRepository) Delete(ctx context.Context, id uint) error {
-	return r.db.WithContext(ctx).Delete(&models.Tag{}, id).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec("UPDATE tags SET parent_id = (SELECT parent.parent_id FROM tags AS parent WHERE parent.id = ?) WHERE parent_id = ?", id, id).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Where("tag_id = ?", id).Delete(&models.TagAlias{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.Tag{}, id).Error
+	})
 }
 
-// FindOrCreate busca uma tag pelo nome ou cria se não existir
+// FindOrCreate busca uma tag pelo nome (ou alias) ou cria se não existir
+// Aliases resolvem para a tag canônica, evitando duplicatas como "scifi" e "sci-fi"
 func (r *TagRepository) FindOrCreate(ctx context.Context, tag *models.Tag) error {
-	err := r.db.WithContext(ctx).Where("name = ?", tag.Name).FirstOrCreate(tag).Error
-	if err != nil {
+	existing, err := r.ResolveName(ctx, tag.Name)
+	if err == nil {
+		*tag = *existing
+		return nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
 		return err
 	}
-	return nil
+
+	return r.db.WithContext(ctx).Where("name = ?", tag.Name).FirstOrCreate(tag).Error
 }
 
 // GetTagsByIDs retorna múltiplas tags pelos seus IDs
@@ -73,3 +91,236 @@ func (r *TagRepository) GetTagsByIDs(ctx context.Context, ids []uint) ([]models.
 	err := r.db.WithContext(ctx).Find(&tags, ids).Error
 	return tags, err
 }
+
+// tagTreeSQL seleciona os IDs das tags que atendem a roots e de todos os seus descendentes
+// UNION (sem ALL) evita laços infinitos caso a hierarquia tenha um ciclo
+func tagTreeSQL(roots string) string {
+	return `WITH RECURSIVE tag_tree AS (
+		SELECT tags.id FROM tags WHERE tags.deleted_at IS NULL AND (` + roots + `)
+		UNION
+		SELECT tags.id FROM tags JOIN tag_tree ON tags.parent_id = tag_tree.id
+		WHERE tags.deleted_at IS NULL
+	) SELECT id FROM tag_tree`
+}
+
+// tagNameRootsSQL seleciona as tags pelos nomes ou aliases. Parâmetros: nomes, nomes
+const tagNameRootsSQL = "tags.name IN ? OR tags.id IN (SELECT tag_aliases.tag_id FROM tag_aliases WHERE tag_aliases.name IN ?)"
+
+// tagItemCountSQL conta os items do catálogo (não removidos) associados à tag
+const tagItemCountSQL = "(SELECT COUNT(*) FROM item_tags JOIN items ON items.id = item_tags.item_id AND items.deleted_at IS NULL WHERE item_tags.tag_id = tags.id)"
+
+// Search retorna tags com o número de items, filtrando por nome/alias e categoria
+func (r *TagRepository) Search(ctx context.Context, filter dto.TagFilter, params dto.PaginationParams) ([]models.Tag, int64, error) {
+	var tags []models.Tag
+	var total int64
+
+	params.Normalize()
+
+	query := r.db.WithContext(ctx).Model(&models.Tag{})
+	if filter.Query != "" {
+		pattern := "%" + filter.Query + "%"
+		query = query.Where("LOWER(tags.name) LIKE LOWER(?) OR tags.id IN (SELECT tag_aliases.tag_id FROM tag_aliases WHERE LOWER(tag_aliases.name) LIKE LOWER(?))", pattern, pattern)
+	}
+	if filter.Category != "" {
+		query = query.Where("tags.category = ?", filter.Category)
+	}
+
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	switch filter.SortBy {
+	case dto.TagSortUsage:
+		query = query.Order("item_count DESC").Order("tags.name ASC")
+	case dto.TagSortRecent:
+		query = query.Order("tags.created_at DESC").Order("tags.id DESC")
+	default:
+		query = query.Order("tags.name ASC")
+	}
+
+	err := query.
+		Select("tags.*, " + tagItemCountSQL + " AS item_count").
+		Limit(params.Limit).
+		Offset(params.GetOffset()).
+		Find(&tags).Error
+
+	return tags, total, err
+}
+
+// CountByMediaType retorna o número de items por tipo de mídia para cada tag informada
+func (r *TagRepository) CountByMediaType(ctx context.Context, tagIDs []uint) ([]dto.TagMediaTypeCount, error) {
+	var counts []dto.TagMediaTypeCount
+	if len(tagIDs) == 0 {
+		return counts, nil
+	}
+
+	err := r.db.WithContext(ctx).Table("item_tags").
+		Select("item_tags.tag_id AS tag_id, items.type AS media_type, COUNT(*) AS count").
+		Joins("JOIN items ON items.id = item_tags.item_id AND items.deleted_at IS NULL").
+		Where("item_tags.tag_id IN ?", tagIDs).
+		Group("item_tags.tag_id, items.type").
+		Scan(&counts).Error
+
+	return counts, err
+}
+
+// Autocomplete retorna as tags cujo nome ou alias começa com o prefixo, mais usadas primeiro
+func (r *TagRepository) Autocomplete(ctx context.Context, prefix string, limit int) ([]models.Tag, error) {
+	var tags []models.Tag
+	pattern := prefix + "%"
+
+	err := r.db.WithContext(ctx).
+		Select("tags.*, "+tagItemCountSQL+" AS item_count").
+		Where("tags.name LIKE ? OR tags.id IN (SELECT tag_aliases.tag_id FROM tag_aliases WHERE tag_aliases.name LIKE ?)", pattern, pattern).
+		Order("item_count DESC").
+		Order("tags.name ASC").
+		Limit(limit).
+		Find(&tags).Error
+
+	return tags, err
+}
+
+// GetItems retorna os items do catálogo associados à tag ou a qualquer uma de suas descendentes
+func (r *TagRepository) GetItems(ctx context.Context, tagID uint, mediaType models.MediaType, params dto.PaginationParams) ([]models.Item, int64, error) {
+	var items []models.Item
+	var total int64
+
+	params.Normalize()
+
+	query := r.db.WithContext(ctx).Model(&models.Item{}).
+		Where("items.id IN (SELECT item_tags.item_id FROM item_tags WHERE item_tags.tag_id IN ("+tagTreeSQL("tags.id = ?")+"))", tagID)
+	if mediaType != "" {
+		query = query.Where("items.type = ?", mediaType)
+	}
+
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Preload("Tags").
+		Order("items.title ASC").
+		Order("items.id ASC").
+		Limit(params.Limit).
+		Offset(params.GetOffset()).
+		Find(&items).Error
+
+	return items, total, err
+}
+
+// orphanTagsSQL seleciona tags sem items (não removidos) e sem tags filhas
+const orphanTagsSQL = `NOT EXISTS (SELECT 1 FROM item_tags JOIN items ON items.id = item_tags.item_id AND items.deleted_at IS NULL WHERE item_tags.tag_id = tags.id)
+	AND NOT EXISTS (SELECT 1 FROM tags AS children WHERE children.parent_id = tags.id AND children.deleted_at IS NULL)`
+
+// GetOrphans retorna as tags que nenhum item usa (e que não têm filhas)
+func (r *TagRepository) GetOrphans(ctx context.Context) ([]models.Tag, error) {
+	var tags []models.Tag
+	err := r.db.WithContext(ctx).Where(orphanTagsSQL).Order("tags.name ASC").Find(&tags).Error
+	return tags, err
+}
+
+// DeleteOrphans remove definitivamente as tags órfãs (com seus aliases) e retorna as removidas
+// A remoção definitiva libera os nomes para uso futuro
+func (r *TagRepository) DeleteOrphans(ctx context.Context) ([]models.Tag, error) {
+	var tags []models.Tag
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where(orphanTagsSQL).Order("tags.name ASC").Find(&tags).Error; err != nil {
+			return err
+		}
+		if len(tags) == 0 {
+			return nil
+		}
+
+		ids := make([]uint, len(tags))
+		for i, tag := range tags {
+			ids[i] = tag.ID
+		}
+
+		// Associações restantes apontam apenas para items removidos
+		if err := tx.Exec("DELETE FROM item_tags WHERE tag_id IN ?", ids).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("tag_id IN ?", ids).Delete(&models.TagAlias{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Delete(&models.Tag{}, ids).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// ResolveName busca uma tag pelo nome canônico ou por um de seus aliases
+func (r *TagRepository) ResolveName(ctx context.Context, name string) (*models.Tag, error) {
+	var tag models.Tag
+	err := r.db.WithContext(ctx).
+		Where("name = ?", name).
+		Or("id IN (?)", r.db.Model(&models.TagAlias{}).Select("tag_id").Where("name = ?", name)).
+		First(&tag).Error
+	if err != nil {
+		return nil, err
+	}
+	return &tag, nil
+}
+
+// GetAncestorIDs retorna os IDs de todos os ancestrais da tag (pai, avô, ...)
+func (r *TagRepository) GetAncestorIDs(ctx context.Context, id uint) ([]uint, error) {
+	var ids []uint
+	err := r.db.WithContext(ctx).Raw(`WITH RECURSIVE ancestors AS (
+			SELECT tags.parent_id AS id FROM tags WHERE tags.id = ?
+			UNION
+			SELECT tags.parent_id FROM tags JOIN ancestors ON tags.id = ancestors.id
+		) SELECT id FROM ancestors WHERE id IS NOT NULL`, id).
+		Scan(&ids).Error
+	return ids, err
+}
+
+// CreateAlias cria um alias para uma tag
+func (r *TagRepository) CreateAlias(ctx context.Context, alias *models.TagAlias) error {
+	return r.db.WithContext(ctx).Create(alias).Error
+}
+
+// DeleteAlias remove um alias de uma tag
+func (r *TagRepository) DeleteAlias(ctx context.Context, tagID, aliasID uint) error {
+	result := r.db.WithContext(ctx).Where("id = ? AND tag_id = ?", aliasID, tagID).Delete(&models.TagAlias{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// Merge funde as tags de origem na tag destino em uma transação:
+// as associações item_tags, os filhos e os aliases passam para o destino,
+// os nomes de origem viram aliases do destino e as tags de origem são removidas
+func (r *TagRepository) Merge(ctx context.Context, target *models.Tag, sourceIDs []uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		statements := []struct {
+			sql  string
+			args []interface{}
+		}{
+			{`INSERT INTO item_tags (item_id, tag_id)
+				SELECT DISTINCT item_tags.item_id, ? FROM item_tags WHERE item_tags.tag_id IN ?
+				ON CONFLICT DO NOTHING`, []interface{}{target.ID, sourceIDs}},
+			{`DELETE FROM item_tags WHERE tag_id IN ?`, []interface{}{sourceIDs}},
+			{`UPDATE tags SET parent_id = ? WHERE parent_id IN ? AND id <> ?`, []interface{}{target.ID, sourceIDs, target.ID}},
+			{`UPDATE tags SET parent_id = ? WHERE id = ?`, []interface{}{target.ParentID, target.ID}},
+			{`UPDATE tag_aliases SET tag_id = ? WHERE tag_id IN ?`, []interface{}{target.ID, sourceIDs}},
+			{`INSERT INTO tag_aliases (created_at, tag_id, name)
+				SELECT NOW(), ?, tags.name FROM tags WHERE tags.id IN ?
+				ON CONFLICT (name) DO NOTHING`, []interface{}{target.ID, sourceIDs}},
+		}
+
+		for _, statement := range statements {
+			if err := tx.Exec(statement.sql, statement.args...).Error; err != nil {
+				return err
+			}
+		}
+
+		// Remoção definitiva: libera os nomes, que agora são aliases do destino
+		return tx.Unscoped().Delete(&models.Tag{}, sourceIDs).Error
+	})
+}
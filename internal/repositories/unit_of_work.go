@@ -0,0 +1,33 @@
+package repositories
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type UnitOfWork struct {
+	db *gorm.DB
+}
+
+// NewUnitOfWork cria uma nova unidade de trabalho transacional
+func NewUnitOfWork(db *gorm.DB) *UnitOfWork {
+	return &UnitOfWork{db: db}
+}
+
+// Do executa fn dentro de uma transação, com repositórios ligados a ela
+// Se fn retornar erro, a transação sofre rollback
+// Quando chamado a partir de uma transação existente, o GORM usa um savepoint
+func (u *UnitOfWork) Do(ctx context.Context, fn func(tx *Repositories) error) error {
+	return u.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(&Repositories{
+			Items:        NewItemRepository(tx),
+			Tags:         NewTagRepository(tx),
+			UserItems:    NewUserItemRepository(tx),
+			Activities:   NewActivityRepository(tx),
+			PersonalTags: NewPersonalTagRepository(tx),
+			ListImports:  NewListImportRepository(tx),
+			UnitOfWork:   NewUnitOfWork(tx),
+		})
+	})
+}
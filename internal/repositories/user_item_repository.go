@@ -2,7 +2,10 @@ package repositories
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"strings"
+	"time"
 
 	"github.com/rafaelc-rb/geekery-api/internal/dto"
 	"github.com/rafaelc-rb/geekery-api/internal/models"
@@ -25,33 +28,13 @@ func (r *UserItemRepository) Create(ctx context.Context, userItem *models.UserIt
 
 // GetByUserID retorna todos os items da lista de um usuário com paginação
 func (r *UserItemRepository) GetByUserID(ctx context.Context, userID uint, params dto.PaginationParams) ([]models.UserItem, int64, error) {
-	var userItems []models.UserItem
-	var total int64
-
-	// Normalizar parâmetros
-	params.Normalize()
-
-	// Contar total de items do usuário
-	if err := r.db.WithContext(ctx).Model(&models.UserItem{}).Where("user_id = ?", userID).Count(&total).Error; err != nil {
-		return nil, 0, err
-	}
-
-	// Buscar items paginados
-	err := r.db.WithContext(ctx).
-		Preload("Item").
-		Preload("Item.Tags").
-		Where("user_id = ?", userID).
-		Limit(params.Limit).
-		Offset(params.GetOffset()).
-		Find(&userItems).Error
-
-	return userItems, total, err
+	return r.Search(ctx, userID, dto.UserItemFilter{}, params)
 }
 
 // GetByUserAndItem busca um item específico na lista do usuário
 func (r *UserItemRepository) GetByUserAndItem(ctx context.Context, userID, itemID uint) (*models.UserItem, error) {
 	var userItem models.UserItem
-	err := r.db.WithContext(ctx).Preload("Item").Preload("Item.Tags").Where("user_id = ? AND item_id = ?", userID, itemID).First(&userItem).Error
+	err := r.db.WithContext(ctx).Preload("Item").Preload("Item.Tags").Preload("PersonalTags").Where("user_id = ? AND item_id = ?", userID, itemID).First(&userItem).Error
 	if err != nil {
 		return nil, err
 	}
@@ -61,7 +44,7 @@ func (r *UserItemRepository) GetByUserAndItem(ctx context.Context, userID, itemI
 // GetByID retorna um user item pelo ID
 func (r *UserItemRepository) GetByID(ctx context.Context, id uint) (*models.UserItem, error) {
 	var userItem models.UserItem
-	err := r.db.WithContext(ctx).Preload("Item").Preload("Item.Tags").First(&userItem, id).Error
+	err := r.db.WithContext(ctx).Preload("Item").Preload("Item.Tags").Preload("PersonalTags").First(&userItem, id).Error
 	if err != nil {
 		return nil, err
 	}
@@ -69,8 +52,9 @@ func (r *UserItemRepository) GetByID(ctx context.Context, id uint) (*models.User
 }
 
 // Update atualiza um item da lista do usuário
+// Os rótulos pessoais são gerenciados pelo PersonalTagRepository
 func (r *UserItemRepository) Update(ctx context.Context, userItem *models.UserItem) error {
-	return r.db.WithContext(ctx).Save(userItem).Error
+	return r.db.WithContext(ctx).Omit("PersonalTags").Save(userItem).Error
 }
 
 // Delete remove um item da lista do usuário
@@ -87,24 +71,34 @@ func (r *UserItemRepository) Exists(ctx context.Context, userID, itemID uint) (b
 
 // GetByStatus retorna items do usuário filtrados por status com paginação
 func (r *UserItemRepository) GetByStatus(ctx context.Context, userID uint, status models.MediaStatus, params dto.PaginationParams) ([]models.UserItem, int64, error) {
+	return r.Search(ctx, userID, dto.UserItemFilter{Statuses: []string{string(status)}}, params)
+}
+
+// GetFavorites retorna todos os items favoritos do usuário com paginação
+func (r *UserItemRepository) GetFavorites(ctx context.Context, userID uint, params dto.PaginationParams) ([]models.UserItem, int64, error) {
+	favorite := true
+	return r.Search(ctx, userID, dto.UserItemFilter{Favorite: &favorite}, params)
+}
+
+// Search retorna items da lista do usuário aplicando filtros, busca por título e ordenação
+func (r *UserItemRepository) Search(ctx context.Context, userID uint, filter dto.UserItemFilter, params dto.PaginationParams) ([]models.UserItem, int64, error) {
 	var userItems []models.UserItem
 	var total int64
 
 	// Normalizar parâmetros
 	params.Normalize()
 
-	// Contar total de items com o status
-	if err := r.db.WithContext(ctx).Model(&models.UserItem{}).
-		Where("user_id = ? AND status = ?", userID, status).
-		Count(&total).Error; err != nil {
+	// Contar total de items que atendem aos filtros
+	if err := r.listQuery(ctx, userID, filter).Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 
-	// Buscar items paginados
-	err := r.db.WithContext(ctx).
+	// Buscar items paginados e ordenados
+	query := r.orderListQuery(r.listQuery(ctx, userID, filter), filter)
+	err := query.
 		Preload("Item").
 		Preload("Item.Tags").
-		Where("user_id = ? AND status = ?", userID, status).
+		Preload("PersonalTags").
 		Limit(params.Limit).
 		Offset(params.GetOffset()).
 		Find(&userItems).Error
@@ -112,89 +106,380 @@ func (r *UserItemRepository) GetByStatus(ctx context.Context, userID uint, statu
 	return userItems, total, err
 }
 
-// GetFavorites retorna todos os items favoritos do usuário com paginação
-func (r *UserItemRepository) GetFavorites(ctx context.Context, userID uint, params dto.PaginationParams) ([]models.UserItem, int64, error) {
+// SearchPublic retorna as entradas da lista visíveis para outros usuários
+// Aplica as configurações de privacidade do dono: entradas privadas e status ocultos não aparecem,
+// a nota e as anotações são removidas conforme configurado e rótulos pessoais nunca são expostos
+// Com notas ocultas, filtros e ordenação por nota são ignorados (revelariam as notas)
+func (r *UserItemRepository) SearchPublic(ctx context.Context, userID uint, filter dto.UserItemFilter, params dto.PaginationParams) ([]models.UserItem, int64, error) {
 	var userItems []models.UserItem
 	var total int64
 
-	// Normalizar parâmetros
+	owner, err := r.privacySettings(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	filter.PersonalTags = nil
+	if owner.HideRatings {
+		filter.MinRating = nil
+		filter.MaxRating = nil
+		if filter.SortBy == dto.SortByRating {
+			filter.SortBy = ""
+		}
+	}
+
 	params.Normalize()
 
-	// Contar total de favoritos
-	if err := r.db.WithContext(ctx).Model(&models.UserItem{}).
-		Where("user_id = ? AND favorite = ?", userID, true).
-		Count(&total).Error; err != nil {
+	if err := publicEntries(r.listQuery(ctx, userID, filter), owner).Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 
-	// Buscar items paginados
-	err := r.db.WithContext(ctx).
+	query := r.orderListQuery(publicEntries(r.listQuery(ctx, userID, filter), owner), filter)
+	err = query.
 		Preload("Item").
 		Preload("Item.Tags").
-		Where("user_id = ? AND favorite = ?", userID, true).
 		Limit(params.Limit).
 		Offset(params.GetOffset()).
 		Find(&userItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
 
-	return userItems, total, err
+	for i := range userItems {
+		owner.RedactListEntry(&userItems[i])
+	}
+	return userItems, total, nil
 }
 
-// GetStatistics retorna estatísticas da lista do usuário
-func (r *UserItemRepository) GetStatistics(ctx context.Context, userID uint) (map[string]int64, error) {
-	stats := make(map[string]int64)
+// privacySettings carrega as configurações de privacidade do dono da lista
+func (r *UserItemRepository) privacySettings(ctx context.Context, userID uint) (*models.User, error) {
+	var owner models.User
+	err := r.db.WithContext(ctx).
+		Select("id", "profile_visibility", "hidden_statuses", "hide_ratings", "hide_notes").
+		First(&owner, userID).Error
+	if err != nil {
+		return nil, err
+	}
+	return &owner, nil
+}
 
-	// Total de items
-	var total int64
-	err := r.db.WithContext(ctx).Model(&models.UserItem{}).Where("user_id = ?", userID).Count(&total).Error
+// publicEntries restringe uma query de user_items às entradas que o dono permite exibir
+func publicEntries(query *gorm.DB, owner *models.User) *gorm.DB {
+	query = query.Where("user_items.private = ?", false)
+	if len(owner.HiddenStatuses) > 0 {
+		hidden := make([]string, len(owner.HiddenStatuses))
+		for i, status := range owner.HiddenStatuses {
+			hidden[i] = string(status)
+		}
+		query = query.Where("user_items.status NOT IN ?", hidden)
+	}
+	return query
+}
+
+// listQuery monta a query base da lista pessoal com todos os filtros
+// Todas as consultas de listagem passam por aqui
+func (r *UserItemRepository) listQuery(ctx context.Context, userID uint, filter dto.UserItemFilter) *gorm.DB {
+	query := r.db.WithContext(ctx).Model(&models.UserItem{}).
+		Joins("JOIN items ON items.id = user_items.item_id AND items.deleted_at IS NULL").
+		Where("user_items.user_id = ?", userID)
+
+	if len(filter.Statuses) > 0 {
+		query = query.Where("user_items.status IN ?", filter.Statuses)
+	}
+
+	if len(filter.MediaTypes) > 0 {
+		query = query.Where("items.type IN ?", filter.MediaTypes)
+	}
+
+	// Items que possuem qualquer uma das tags informadas (ou de suas descendentes)
+	// Aliases resolvem para a tag canônica
+	if len(filter.Tags) > 0 {
+		query = query.Where("user_items.item_id IN (SELECT item_tags.item_id FROM item_tags WHERE item_tags.tag_id IN ("+tagTreeSQL(tagNameRootsSQL)+"))",
+			filter.Tags, filter.Tags)
+	}
+
+	// Entradas com qualquer um dos rótulos pessoais informados
+	if len(filter.PersonalTags) > 0 {
+		labeled := r.db.Table("user_item_personal_tags").
+			Select("user_item_personal_tags.user_item_id").
+			Joins("JOIN personal_tags ON personal_tags.id = user_item_personal_tags.personal_tag_id").
+			Where("personal_tags.user_id = ? AND personal_tags.name IN ?", userID, filter.PersonalTags)
+		query = query.Where("user_items.id IN (?)", labeled)
+	}
+
+	if filter.MinRating != nil {
+		query = query.Where("user_items.rating >= ?", *filter.MinRating)
+	}
+	if filter.MaxRating != nil {
+		query = query.Where("user_items.rating <= ?", *filter.MaxRating)
+	}
+
+	if filter.Favorite != nil {
+		query = query.Where("user_items.favorite = ?", *filter.Favorite)
+	}
+
+	// Re-assistindo: já completou ao menos uma vez e está em progresso (ver UserItem.IsRewatching)
+	if filter.Rewatching != nil {
+		rewatching := "user_items.completion_count > 0 AND user_items.status = ?"
+		if *filter.Rewatching {
+			query = query.Where(rewatching, models.StatusInProgress)
+		} else {
+			query = query.Not(rewatching, models.StatusInProgress)
+		}
+	}
+
+	if filter.UpdatedSince != nil {
+		query = query.Where("user_items.updated_at >= ?", *filter.UpdatedSince)
+	}
+
+	if filter.Query != "" {
+		query = query.Where(`LOWER(items.title) LIKE LOWER(?) ESCAPE '\'`, containsPattern(filter.Query))
+	}
+
+	return query
+}
+
+// progressPercentSQL calcula a porcentagem de progresso no banco
+// Espelha UserItem.GetProgressPercent (requer joins com as tabelas de detalhes)
+const progressPercentSQL = `COALESCE(CASE user_items.progress_type
+	WHEN 'episodic' THEN (user_items.progress_data->>'episode')::numeric / NULLIF(COALESCE(anime_details.episodes, series_details.episodes), 0) * 100
+	WHEN 'time' THEN (user_items.progress_data->>'minutes_watched')::numeric / NULLIF(movie_details.runtime, 0) * 100
+	WHEN 'reading' THEN COALESCE(
+		NULLIF((user_items.progress_data->>'chapter')::numeric, 0) / NULLIF(book_details.chapters, 0),
+		NULLIF((user_items.progress_data->>'page')::numeric, 0) / NULLIF(book_details.pages, 0),
+		NULLIF((user_items.progress_data->>'volume')::numeric, 0) / NULLIF(book_details.volumes, 0)
+	) * 100
+	WHEN 'percent' THEN (user_items.progress_data->>'percent')::numeric
+	WHEN 'boolean' THEN CASE WHEN user_items.progress_data->>'listened' = 'true' THEN 100 ELSE 0 END
+END, 0)`
+
+// likeEscaper escapa os curingas do LIKE (%, _ e a própria barra) para buscá-los literalmente
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// containsPattern monta o padrão LIKE que encontra o termo em qualquer posição (usar com ESCAPE '\')
+func containsPattern(term string) string {
+	return "%" + likeEscaper.Replace(term) + "%"
+}
+
+// joinItemDetails adiciona os joins com as tabelas de dados específicos
+func joinItemDetails(query *gorm.DB) *gorm.DB {
+	return query.
+		Joins("LEFT JOIN anime_details ON anime_details.item_id = user_items.item_id AND anime_details.deleted_at IS NULL").
+		Joins("LEFT JOIN series_details ON series_details.item_id = user_items.item_id AND series_details.deleted_at IS NULL").
+		Joins("LEFT JOIN movie_details ON movie_details.item_id = user_items.item_id AND movie_details.deleted_at IS NULL").
+		Joins("LEFT JOIN book_details ON book_details.item_id = user_items.item_id AND book_details.deleted_at IS NULL").
+		Joins("LEFT JOIN game_details ON game_details.item_id = user_items.item_id AND game_details.deleted_at IS NULL")
+}
+
+// orderListQuery aplica a ordenação da lista pessoal
+// Padrão: updated_at desc (title usa asc por padrão)
+func (r *UserItemRepository) orderListQuery(query *gorm.DB, filter dto.UserItemFilter) *gorm.DB {
+	direction := "DESC"
+	if filter.SortOrder == "asc" || (filter.SortOrder == "" && filter.SortBy == dto.SortByTitle) {
+		direction = "ASC"
+	}
+
+	var column string
+	switch filter.SortBy {
+	case dto.SortByRating:
+		column = "user_items.rating"
+	case dto.SortByAdded:
+		column = "user_items.created_at"
+	case dto.SortByTitle:
+		column = "LOWER(items.title)"
+	case dto.SortByProgress:
+		query = joinItemDetails(query)
+		column = progressPercentSQL
+	default:
+		column = "user_items.updated_at"
+	}
+
+	// Desempate estável para a paginação
+	return query.Order(column + " " + direction).Order("user_items.id " + direction)
+}
+
+// statisticsSelectSQL agrega a lista por tipo de mídia, status e nota arredondada em uma única query
+// O consumo é estimado: completions anteriores contam o total do catálogo e a visualização
+// atual conta o progresso registrado no ProgressData
+const statisticsSelectSQL = `items.type AS media_type,
+	user_items.status AS status,
+	CASE WHEN user_items.rating > 0 THEN GREATEST(ROUND(user_items.rating), 1)::int ELSE 0 END AS rating_bucket,
+	COUNT(*) AS count,
+	COUNT(*) FILTER (WHERE user_items.favorite) AS favorites,
+	COALESCE(SUM(user_items.rating), 0) AS rating_sum,
+	COALESCE(SUM(CASE WHEN user_items.progress_type = 'episodic' THEN
+		user_items.completion_count * COALESCE(anime_details.episodes, series_details.episodes, 0)
+		+ CASE WHEN user_items.status <> 'completed' OR COALESCE(anime_details.episodes, series_details.episodes, 0) = 0
+			THEN COALESCE((user_items.progress_data->>'episode')::numeric, 0) ELSE 0 END
+	END), 0) AS episodes_watched,
+	COALESCE(SUM(CASE WHEN user_items.progress_type = 'reading' THEN
+		user_items.completion_count * COALESCE(book_details.chapters, 0)
+		+ CASE WHEN user_items.status <> 'completed' OR COALESCE(book_details.chapters, 0) = 0
+			THEN COALESCE((user_items.progress_data->>'chapter')::numeric, 0) ELSE 0 END
+	END), 0) AS chapters_read,
+	COALESCE(SUM(CASE WHEN user_items.progress_type = 'reading' THEN
+		user_items.completion_count * COALESCE(book_details.pages, 0)
+		+ CASE WHEN user_items.status <> 'completed' OR COALESCE(book_details.pages, 0) = 0
+			THEN COALESCE((user_items.progress_data->>'page')::numeric, 0) ELSE 0 END
+	END), 0) AS pages_read,
+	COALESCE(SUM(CASE WHEN user_items.progress_type = 'time' THEN
+		user_items.completion_count * COALESCE(movie_details.runtime, 0)
+		+ CASE WHEN user_items.status <> 'completed' OR COALESCE(movie_details.runtime, 0) = 0
+			THEN COALESCE((user_items.progress_data->>'minutes_watched')::numeric, 0) ELSE 0 END
+	END), 0) AS minutes_watched,
+	COALESCE(SUM(CASE WHEN user_items.progress_type = 'percent' THEN
+		CASE WHEN COALESCE((user_items.progress_data->>'hours')::numeric, 0) > 0
+			THEN (user_items.progress_data->>'hours')::numeric
+				+ GREATEST(user_items.completion_count - CASE WHEN user_items.status = 'completed' THEN 1 ELSE 0 END, 0) * COALESCE(game_details.average_playtime, 0)
+			ELSE user_items.completion_count * COALESCE(game_details.average_playtime, 0)
+		END
+	END), 0) AS game_hours`
+
+// GetStatistics retorna as estatísticas agregadas da lista do usuário
+// Uma linha por combinação de tipo de mídia, status e nota arredondada
+func (r *UserItemRepository) GetStatistics(ctx context.Context, userID uint) ([]dto.StatsAggregate, error) {
+	return r.statistics(ctx, userID, nil)
+}
+
+// GetPublicStatistics retorna as estatísticas agregadas apenas das entradas visíveis para outros usuários
+// Respeita entradas privadas e status ocultos; com notas ocultas, a distribuição de notas fica vazia
+func (r *UserItemRepository) GetPublicStatistics(ctx context.Context, userID uint) ([]dto.StatsAggregate, error) {
+	owner, err := r.privacySettings(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
-	stats["total"] = total
 
-	// Por status
-	statuses := []models.MediaStatus{
-		models.StatusInProgress,
-		models.StatusCompleted,
-		models.StatusPlanned,
-		models.StatusPaused,
-		models.StatusDropped,
+	aggregates, err := r.statistics(ctx, userID, owner)
+	if err != nil {
+		return nil, err
 	}
 
-	for _, status := range statuses {
-		var count int64
-		err := r.db.WithContext(ctx).Model(&models.UserItem{}).
-			Where("user_id = ? AND status = ?", userID, status).
-			Count(&count).Error
-		if err != nil {
-			return nil, err
+	if owner.HideRatings {
+		for i := range aggregates {
+			aggregates[i].RatingBucket = 0
+			aggregates[i].RatingSum = 0
 		}
-		stats[string(status)] = count
 	}
+	return aggregates, nil
+}
 
-	// Favoritos
-	var favorites int64
-	err = r.db.WithContext(ctx).Model(&models.UserItem{}).
-		Where("user_id = ? AND favorite = ?", userID, true).
-		Count(&favorites).Error
+// statistics agrega a lista do usuário; com owner informado, apenas as entradas que ele permite exibir
+func (r *UserItemRepository) statistics(ctx context.Context, userID uint, owner *models.User) ([]dto.StatsAggregate, error) {
+	var aggregates []dto.StatsAggregate
+
+	query := r.db.WithContext(ctx).Table("user_items").
+		Select(statisticsSelectSQL).
+		Joins("JOIN items ON items.id = user_items.item_id AND items.deleted_at IS NULL")
+	if owner != nil {
+		query = publicEntries(query, owner)
+	}
+
+	err := joinItemDetails(query).
+		Where("user_items.user_id = ? AND user_items.deleted_at IS NULL", userID).
+		Group("media_type, status, rating_bucket").
+		Scan(&aggregates).Error
 	if err != nil {
 		return nil, err
 	}
-	stats["favorites"] = favorites
 
-	return stats, nil
+	return aggregates, nil
+}
+
+// GetCompletionsByMonth retorna o número de conclusões por mês (YYYY-MM)
+// Baseado nas entradas do history com finished_at
+func (r *UserItemRepository) GetCompletionsByMonth(ctx context.Context, userID uint) ([]dto.PeriodCount, error) {
+	var periods []dto.PeriodCount
+
+	err := r.db.WithContext(ctx).Table("user_items").
+		Select("to_char(date_trunc('month', (history.entry->>'finished_at')::timestamptz AT TIME ZONE 'UTC'), 'YYYY-MM') AS period, COUNT(*) AS count").
+		Joins(historyEntriesJoinSQL).
+		Where("user_items.user_id = ? AND user_items.deleted_at IS NULL", userID).
+		Where("history.entry->>'finished_at' IS NOT NULL").
+		Group("period").
+		Order("period").
+		Scan(&periods).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return periods, nil
+}
+
+// historyArraySQL retorna o array history do ProgressData (vazio quando ausente)
+const historyArraySQL = `CASE WHEN jsonb_typeof(user_items.progress_data->'history') = 'array'
+	THEN user_items.progress_data->'history' ELSE '[]'::jsonb END`
+
+// historyEntriesJoinSQL expande o array history do ProgressData em linhas (history.entry)
+const historyEntriesJoinSQL = `CROSS JOIN LATERAL jsonb_array_elements(` + historyArraySQL + `) AS history(entry)`
+
+// GetWithActivityBetween retorna os items da lista com alguma visualização iniciada ou
+// concluída no período [from, to), com o Item e os dados específicos carregados
+func (r *UserItemRepository) GetWithActivityBetween(ctx context.Context, userID uint, from, to time.Time) ([]models.UserItem, error) {
+	var userItems []models.UserItem
+
+	activity := `EXISTS (SELECT 1 FROM jsonb_array_elements(` + historyArraySQL + `) AS history(entry)
+		WHERE ((history.entry->>'started_at')::timestamptz >= @from AND (history.entry->>'started_at')::timestamptz < @to)
+		OR ((history.entry->>'finished_at')::timestamptz >= @from AND (history.entry->>'finished_at')::timestamptz < @to))`
+
+	err := r.db.WithContext(ctx).
+		Preload("Item").
+		Preload("Item.Tags").
+		Preload("Item.AnimeData").
+		Preload("Item.MovieData").
+		Preload("Item.GameData").
+		Preload("Item.BookData").
+		Preload("Item.SeriesData").
+		Where("user_items.user_id = ?", userID).
+		Where(activity, sql.Named("from", from), sql.Named("to", to)).
+		Find(&userItems).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return userItems, nil
 }
 
 // GetByIDAndUser busca um user item por ID garantindo que pertence ao usuário
 func (r *UserItemRepository) GetByIDAndUser(ctx context.Context, id, userID uint) (*models.UserItem, error) {
 	var userItem models.UserItem
-	err := r.db.WithContext(ctx).Preload("Item").Preload("Item.Tags").
+	err := r.db.WithContext(ctx).Preload("Item").Preload("Item.Tags").Preload("PersonalTags").
 		Where("id = ? AND user_id = ?", id, userID).
 		First(&userItem).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("user item not found or doesn't belong to user")
+			return nil, models.ErrUserItemNotFound
 		}
 		return nil, err
 	}
 	return &userItem, nil
 }
+
+// ListAfterID retorna até limit items da lista do usuário com ID maior que afterID, em ordem de ID,
+// com o Item, os dados específicos e as tags pessoais carregados
+// Usado para percorrer a lista inteira em lotes (paginação por chave, sem OFFSET); mediaType vazio inclui todos os tipos
+func (r *UserItemRepository) ListAfterID(ctx context.Context, userID uint, mediaType models.MediaType, afterID uint, limit int) ([]models.UserItem, error) {
+	var userItems []models.UserItem
+
+	query := r.db.WithContext(ctx).
+		Preload("Item").
+		Preload("Item.AnimeData").
+		Preload("Item.MovieData").
+		Preload("Item.GameData").
+		Preload("Item.BookData").
+		Preload("Item.SeriesData").
+		Preload("PersonalTags", func(db *gorm.DB) *gorm.DB {
+			return db.Order("personal_tags.name")
+		}).
+		Where("user_items.user_id = ? AND user_items.id > ?", userID, afterID)
+
+	if mediaType != "" {
+		query = query.Where("user_items.item_id IN (?)", r.db.Model(&models.Item{}).Select("id").Where("type = ?", mediaType))
+	}
+
+	err := query.Order("user_items.id").Limit(limit).Find(&userItems).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return userItems, nil
+}
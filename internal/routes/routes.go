@@ -1,6 +1,7 @@
 package routes
 
 import (
+	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -15,7 +16,7 @@ import (
 )
 
 // SetupRoutes configura todas as rotas da API
-func SetupRoutes(r *gin.Engine, db *gorm.DB) {
+func SetupRoutes(r *gin.Engine, db *gorm.DB, similarItemsCache *services.SimilarItemsCache) {
 	// Health check
 	r.GET("/api/health", func(c *gin.Context) {
 		c.JSON(200, gin.H{
@@ -42,22 +43,61 @@ func SetupRoutes(r *gin.Engine, db *gorm.DB) {
 	tagRepo := repositories.NewTagRepository(db)
 	userItemRepo := repositories.NewUserItemRepository(db)
 	userRepo := repositories.NewUserRepository(db)
+	activityRepo := repositories.NewActivityRepository(db)
+	goalRepo := repositories.NewGoalRepository(db)
+	collectionRepo := repositories.NewCollectionRepository(db)
+	personalTagRepo := repositories.NewPersonalTagRepository(db)
+	reviewRepo := repositories.NewReviewRepository(db)
+	itemStatsRepo := repositories.NewItemStatsRepository(db)
+	recommendationRepo := repositories.NewRecommendationRepository(db)
+	followRepo := repositories.NewFollowRepository(db)
+	importJobRepo := repositories.NewImportJobRepository(db)
+	listImportRepo := repositories.NewListImportRepository(db)
+	dataExportRepo := repositories.NewDataExportRepository(db)
+	accountRepo := repositories.NewAccountRepository(db)
+	unitOfWork := repositories.NewUnitOfWork(db)
 
 	// ========================================
 	// Serviços
 	// ========================================
-	itemService := services.NewItemService(itemRepo, tagRepo)
-	tagService := services.NewTagService(tagRepo)
-	userItemService := services.NewUserItemService(userItemRepo, itemRepo)
+	itemService := services.NewItemService(itemRepo, tagRepo, unitOfWork, similarItemsCache)
+	tagService := services.NewTagService(tagRepo, similarItemsCache)
+	userItemService := services.NewUserItemService(userItemRepo, itemRepo, unitOfWork, activityRepo, itemStatsRepo)
 	authService := services.NewAuthService(userRepo, jwtManager)
+	activityService := services.NewActivityService(activityRepo)
+	goalService := services.NewGoalService(goalRepo, activityRepo)
+	collectionService := services.NewCollectionService(collectionRepo, itemRepo)
+	personalTagService := services.NewPersonalTagService(personalTagRepo, userItemRepo)
+	reviewService := services.NewReviewService(reviewRepo, itemRepo, userItemRepo, activityRepo)
+	recommendationService := services.NewRecommendationService(recommendationRepo, itemRepo, similarItemsCache)
+	socialService := services.NewSocialService(userRepo, followRepo, userItemRepo, activityRepo)
+	importJobService := services.NewImportJobService(importJobRepo, itemService, cfg.ImportStorageDir)
+	listImportService := services.NewListImportService(listImportRepo, itemRepo, unitOfWork, itemStatsRepo)
+	metadataService := services.NewMetadataService(itemRepo, unitOfWork, similarItemsCache, metadataProviders(cfg)...)
+	accountService := services.NewAccountService(userRepo, userItemRepo, dataExportRepo, accountRepo, itemStatsRepo, cfg.DataExportStorageDir, cfg.DataExportTTL, cfg.JWTSecret)
 
 	// ========================================
 	// Handlers
 	// ========================================
-	itemHandler := handlers.NewItemHandler(itemService)
+	itemHandler := handlers.NewItemHandler(itemService, importJobService)
 	tagHandler := handlers.NewTagHandler(tagService)
 	userItemHandler := handlers.NewUserItemHandler(userItemService)
 	authHandler := handlers.NewAuthHandler(authService)
+	goalHandler := handlers.NewGoalHandler(goalService)
+	activityHandler := handlers.NewActivityHandler(activityService)
+	collectionHandler := handlers.NewCollectionHandler(collectionService)
+	personalTagHandler := handlers.NewPersonalTagHandler(personalTagService)
+	reviewHandler := handlers.NewReviewHandler(reviewService)
+	recommendationHandler := handlers.NewRecommendationHandler(recommendationService)
+	socialHandler := handlers.NewSocialHandler(socialService)
+	importJobHandler := handlers.NewImportJobHandler(importJobService)
+	listImportHandler := handlers.NewListImportHandler(listImportService)
+	accountHandler := handlers.NewAccountHandler(accountService)
+	metadataHandler := handlers.NewMetadataHandler(metadataService)
+
+	// Middlewares de autenticação por rota
+	requireAuth := auth.AuthMiddleware(jwtManager)
+	optionalAuth := auth.OptionalAuthMiddleware(jwtManager)
 
 	// ========================================
 	// Rotas Públicas - Catálogo de Items
@@ -66,21 +106,42 @@ func SetupRoutes(r *gin.Engine, db *gorm.DB) {
 	{
 		itemsRoutes.GET("", itemHandler.GetAllItems)           // GET /api/items?type=anime
 		itemsRoutes.GET("/search", itemHandler.SearchItems)    // GET /api/items/search?q=attack
+		itemsRoutes.GET("/rankings", itemHandler.GetRankings)  // GET /api/items/rankings?type=anime&sort=score&season=current
 		itemsRoutes.GET("/:id", itemHandler.GetItemByID)       // GET /api/items/1
+		itemsRoutes.GET("/:id/similar", recommendationHandler.GetSimilarItems)      // GET /api/items/1/similar?same_type=true
+		itemsRoutes.GET("/:id/reviews", optionalAuth, reviewHandler.GetItemReviews) // GET /api/items/1/reviews?sort=helpful
+		itemsRoutes.POST("/:id/reviews", requireAuth, reviewHandler.CreateReview)   // POST /api/items/1/reviews
 
 		// Rotas admin (futuramente protegidas)
 		itemsRoutes.POST("", itemHandler.CreateItem)           // POST /api/items
 		itemsRoutes.PUT("/:id", itemHandler.UpdateItem)        // PUT /api/items/1
 		itemsRoutes.DELETE("/:id", itemHandler.DeleteItem)     // DELETE /api/items/1
+		itemsRoutes.POST("/:id/enrich", requireAuth, metadataHandler.EnrichItem) // POST /api/items/1/enrich?provider=anilist&overwrite=false
+
+		// Import endpoints (o job fica associado a quem enviou o arquivo)
+		itemsRoutes.POST("/import", requireAuth, itemHandler.ImportItems)           // POST /api/items/import (CSV com coluna type, JSON ou NDJSON)
+		itemsRoutes.POST("/import/anime", requireAuth, itemHandler.ImportAnime)     // POST /api/items/import/anime
+		itemsRoutes.POST("/import/comic", requireAuth, itemHandler.ImportComic)     // POST /api/items/import/comic
+		itemsRoutes.POST("/import/novel", requireAuth, itemHandler.ImportNovel)     // POST /api/items/import/novel
+		itemsRoutes.POST("/import/movie", requireAuth, itemHandler.ImportMovie)     // POST /api/items/import/movie
+		itemsRoutes.POST("/import/series", requireAuth, itemHandler.ImportSeries)   // POST /api/items/import/series
+		itemsRoutes.POST("/import/game", requireAuth, itemHandler.ImportGame)       // POST /api/items/import/game
+		itemsRoutes.POST("/import/book", requireAuth, itemHandler.ImportBook)       // POST /api/items/import/book
 
-		// Import endpoints
-		itemsRoutes.POST("/import/anime", itemHandler.ImportAnime)     // POST /api/items/import/anime
-		itemsRoutes.POST("/import/comic", itemHandler.ImportComic)     // POST /api/items/import/comic
-		itemsRoutes.POST("/import/novel", itemHandler.ImportNovel)     // POST /api/items/import/novel
-		itemsRoutes.POST("/import/movie", itemHandler.ImportMovie)     // POST /api/items/import/movie
-		itemsRoutes.POST("/import/series", itemHandler.ImportSeries)   // POST /api/items/import/series
-		itemsRoutes.POST("/import/game", itemHandler.ImportGame)       // POST /api/items/import/game
-		itemsRoutes.POST("/import/book", itemHandler.ImportBook)       // POST /api/items/import/book
+		// Export endpoint (mesmo formato aceito pelos imports)
+		itemsRoutes.GET("/export", itemHandler.ExportItems) // GET /api/items/export?type=anime&format=csv
+	}
+
+	// ========================================
+	// Jobs de Import (apenas de quem enviou o arquivo)
+	// Os imports acima são enfileirados e processados pelos workers
+	// ========================================
+	importsRoutes := api.Group("/imports")
+	importsRoutes.Use(requireAuth)
+	{
+		importsRoutes.GET("/:id", importJobHandler.GetImportJob)                // GET /api/imports/1
+		importsRoutes.DELETE("/:id", importJobHandler.CancelImportJob)          // DELETE /api/imports/1
+		importsRoutes.GET("/:id/errors", importJobHandler.GetImportJobErrors)   // GET /api/imports/1/errors
 	}
 
 	// ========================================
@@ -89,10 +150,16 @@ func SetupRoutes(r *gin.Engine, db *gorm.DB) {
 	tagsRoutes := api.Group("/tags")
 	{
 		tagsRoutes.POST("", tagHandler.CreateTag)          // POST /api/tags
-		tagsRoutes.GET("", tagHandler.GetAllTags)          // GET /api/tags
+		tagsRoutes.GET("", tagHandler.GetAllTags)          // GET /api/tags?sort=usage&q=
+		tagsRoutes.GET("/autocomplete", tagHandler.AutocompleteTags)       // GET /api/tags/autocomplete?q=
+		tagsRoutes.POST("/cleanup", tagHandler.CleanupOrphanTags)          // POST /api/tags/cleanup?dry_run=true
 		tagsRoutes.GET("/:id", tagHandler.GetTagByID)      // GET /api/tags/1
+		tagsRoutes.GET("/:id/items", tagHandler.GetTagItems)               // GET /api/tags/1/items
 		tagsRoutes.PUT("/:id", tagHandler.UpdateTag)       // PUT /api/tags/1
 		tagsRoutes.DELETE("/:id", tagHandler.DeleteTag)    // DELETE /api/tags/1
+		tagsRoutes.POST("/:id/aliases", tagHandler.AddAlias)               // POST /api/tags/1/aliases
+		tagsRoutes.DELETE("/:id/aliases/:aliasId", tagHandler.RemoveAlias) // DELETE /api/tags/1/aliases/2
+		tagsRoutes.POST("/:id/merge", tagHandler.MergeTags)                // POST /api/tags/1/merge
 	}
 
 	// ========================================
@@ -112,10 +179,122 @@ func SetupRoutes(r *gin.Engine, db *gorm.DB) {
 	myListRoutes.Use(auth.AuthMiddleware(jwtManager)) // Proteger todas as rotas deste grupo
 	{
 		myListRoutes.POST("", userItemHandler.AddToList)              // POST /api/my-list
-		myListRoutes.GET("", userItemHandler.GetMyList)               // GET /api/my-list?status=watching&favorite=true
+		myListRoutes.GET("", userItemHandler.GetMyList)               // GET /api/my-list?status=in_progress,paused&favorite=true&sort=rating
+		myListRoutes.POST("/bulk", userItemHandler.BulkOperations)    // POST /api/my-list/bulk
 		myListRoutes.GET("/stats", userItemHandler.GetStatistics)     // GET /api/my-list/stats
+		myListRoutes.GET("/recap", userItemHandler.GetRecap)          // GET /api/my-list/recap?year=2026&format=svg
+		myListRoutes.GET("/export", userItemHandler.ExportList)       // GET /api/my-list/export?format=json|csv|mal-xml|anilist
+		myListRoutes.GET("/recommendations", recommendationHandler.GetRecommendations) // GET /api/my-list/recommendations?type=anime&limit=20
+		myListRoutes.POST("/import/:source", listImportHandler.ImportList)               // POST /api/my-list/import/mal (multipart: file)
+		myListRoutes.GET("/imports", listImportHandler.GetListImports)                   // GET /api/my-list/imports
+		myListRoutes.GET("/imports/:id", listImportHandler.GetListImport)                // GET /api/my-list/imports/1
+		myListRoutes.GET("/imports/:id/entries", listImportHandler.GetListImportEntries) // GET /api/my-list/imports/1/entries?status=unmatched
+		myListRoutes.POST("/imports/:id/entries/:entryId/resolve", listImportHandler.ResolveListImportEntry) // POST /api/my-list/imports/1/entries/5/resolve
+		myListRoutes.POST("/imports/:id/entries/:entryId/dismiss", listImportHandler.DismissListImportEntry) // POST /api/my-list/imports/1/entries/5/dismiss
 		myListRoutes.GET("/:id", userItemHandler.GetMyListItem)       // GET /api/my-list/1
 		myListRoutes.PUT("/:id", userItemHandler.UpdateListItem)      // PUT /api/my-list/1
 		myListRoutes.DELETE("/:id", userItemHandler.RemoveFromList)   // DELETE /api/my-list/1
+		myListRoutes.PUT("/:id/tags", personalTagHandler.SetListItemTags)              // PUT /api/my-list/1/tags
+		myListRoutes.DELETE("/:id/tags/:tagId", personalTagHandler.RemoveListItemTag)  // DELETE /api/my-list/1/tags/3
+	}
+
+	// ========================================
+	// Rotas Protegidas - Metas, Atividade e Rótulos Pessoais do Usuário
+	// Requer autenticação JWT
+	// ========================================
+	meRoutes := api.Group("/me")
+	meRoutes.Use(auth.AuthMiddleware(jwtManager))
+	{
+		meRoutes.GET("/goals", goalHandler.GetGoals)          // GET /api/me/goals
+		meRoutes.POST("/goals", goalHandler.CreateGoal)       // POST /api/me/goals
+		meRoutes.GET("/goals/:id", goalHandler.GetGoal)       // GET /api/me/goals/1
+		meRoutes.PUT("/goals/:id", goalHandler.UpdateGoal)    // PUT /api/me/goals/1
+		meRoutes.DELETE("/goals/:id", goalHandler.DeleteGoal) // DELETE /api/me/goals/1
+		meRoutes.GET("/activity", activityHandler.GetActivity) // GET /api/me/activity?from=2026-01-01&to=2026-12-31
+		meRoutes.GET("/tags", personalTagHandler.GetTags)          // GET /api/me/tags
+		meRoutes.POST("/tags", personalTagHandler.CreateTag)       // POST /api/me/tags
+		meRoutes.PUT("/tags/:id", personalTagHandler.UpdateTag)    // PUT /api/me/tags/1
+		meRoutes.DELETE("/tags/:id", personalTagHandler.DeleteTag) // DELETE /api/me/tags/1
+		meRoutes.GET("/privacy", socialHandler.GetPrivacySettings)    // GET /api/me/privacy
+		meRoutes.PUT("/privacy", socialHandler.UpdatePrivacySettings) // PUT /api/me/privacy
+		meRoutes.GET("/follow-requests", socialHandler.GetFollowRequests)                     // GET /api/me/follow-requests
+		meRoutes.POST("/follow-requests/:username/accept", socialHandler.AcceptFollowRequest) // POST /api/me/follow-requests/alice/accept
+		meRoutes.DELETE("/follow-requests/:username", socialHandler.RejectFollowRequest)      // DELETE /api/me/follow-requests/alice
+	}
+
+	// ========================================
+	// Dados da conta: export (ZIP gerado em segundo plano) e exclusão definitiva
+	// O download não exige JWT: o link é assinado e expira com o export
+	// ========================================
+	accountRoutes := api.Group("/account")
+	{
+		accountRoutes.DELETE("", requireAuth, accountHandler.DeleteAccount)                   // DELETE /api/account
+		accountRoutes.POST("/exports", requireAuth, accountHandler.RequestDataExport)         // POST /api/account/exports
+		accountRoutes.GET("/exports", requireAuth, accountHandler.GetDataExports)             // GET /api/account/exports
+		accountRoutes.GET("/exports/:id", requireAuth, accountHandler.GetDataExport)          // GET /api/account/exports/1
+		accountRoutes.GET("/exports/:id/download", accountHandler.DownloadDataExport)         // GET /api/account/exports/1/download?expires=&signature=
+	}
+
+	// ========================================
+	// Perfis, seguidores e feed
+	// Perfis com autenticação opcional (respeitam a visibilidade); seguir e feed requerem JWT
+	// ========================================
+	usersRoutes := api.Group("/users")
+	{
+		usersRoutes.GET("/:username", optionalAuth, socialHandler.GetProfile)             // GET /api/users/alice
+		usersRoutes.GET("/:username/list", optionalAuth, socialHandler.GetUserList)       // GET /api/users/alice/list?status=completed
+		usersRoutes.GET("/:username/followers", optionalAuth, socialHandler.GetFollowers) // GET /api/users/alice/followers
+		usersRoutes.GET("/:username/following", optionalAuth, socialHandler.GetFollowing) // GET /api/users/alice/following
+		usersRoutes.POST("/:username/follow", requireAuth, socialHandler.Follow)          // POST /api/users/alice/follow
+		usersRoutes.DELETE("/:username/follow", requireAuth, socialHandler.Unfollow)      // DELETE /api/users/alice/follow
+	}
+	api.GET("/feed", requireAuth, socialHandler.GetFeed) // GET /api/feed
+
+	// ========================================
+	// Coleções personalizadas
+	// Leitura com autenticação opcional (públicas/compartilhadas); escrita requer JWT
+	// ========================================
+	collectionsRoutes := api.Group("/collections")
+	{
+		collectionsRoutes.GET("", requireAuth, collectionHandler.GetMyCollections)                     // GET /api/collections
+		collectionsRoutes.POST("", requireAuth, collectionHandler.CreateCollection)                    // POST /api/collections
+		collectionsRoutes.GET("/public", collectionHandler.GetPublicCollections)                       // GET /api/collections/public
+		collectionsRoutes.GET("/shared/:token", optionalAuth, collectionHandler.GetSharedCollection)   // GET /api/collections/shared/abc123
+		collectionsRoutes.GET("/:id", optionalAuth, collectionHandler.GetCollection)                   // GET /api/collections/1
+		collectionsRoutes.PUT("/:id", requireAuth, collectionHandler.UpdateCollection)                 // PUT /api/collections/1
+		collectionsRoutes.DELETE("/:id", requireAuth, collectionHandler.DeleteCollection)              // DELETE /api/collections/1
+		collectionsRoutes.PUT("/:id/reorder", requireAuth, collectionHandler.Reorder)                  // PUT /api/collections/1/reorder
+		collectionsRoutes.POST("/:id/items", requireAuth, collectionHandler.AddItem)                   // POST /api/collections/1/items
+		collectionsRoutes.PUT("/:id/items/:itemId", requireAuth, collectionHandler.UpdateItem)         // PUT /api/collections/1/items/42
+		collectionsRoutes.DELETE("/:id/items/:itemId", requireAuth, collectionHandler.RemoveItem)      // DELETE /api/collections/1/items/42
+	}
+
+	// ========================================
+	// Resenhas públicas
+	// Leitura com autenticação opcional (indica os votos do usuário); escrita requer JWT
+	// ========================================
+	reviewsRoutes := api.Group("/reviews")
+	{
+		reviewsRoutes.GET("/:id", optionalAuth, reviewHandler.GetReview)                    // GET /api/reviews/1
+		reviewsRoutes.PUT("/:id", requireAuth, reviewHandler.UpdateReview)                  // PUT /api/reviews/1
+		reviewsRoutes.DELETE("/:id", requireAuth, reviewHandler.DeleteReview)               // DELETE /api/reviews/1
+		reviewsRoutes.GET("/:id/history", reviewHandler.GetReviewHistory)                   // GET /api/reviews/1/history
+		reviewsRoutes.POST("/:id/helpful", requireAuth, reviewHandler.VoteHelpful)          // POST /api/reviews/1/helpful
+		reviewsRoutes.DELETE("/:id/helpful", requireAuth, reviewHandler.RemoveHelpfulVote)  // DELETE /api/reviews/1/helpful
+	}
+}
+
+// metadataProviders monta os providers de metadados externos em ordem de prioridade
+// TMDB e IGDB exigem credenciais e ficam de fora quando elas não estão configuradas
+func metadataProviders(cfg *config.Config) []services.MetadataProvider {
+	client := &http.Client{Timeout: cfg.MetadataTimeout}
+
+	providers := []services.MetadataProvider{services.NewAniListProvider(client, "")}
+	if cfg.TMDBAPIToken != "" {
+		providers = append(providers, services.NewTMDBProvider(client, "", cfg.TMDBAPIToken))
+	}
+	if cfg.IGDBClientID != "" && cfg.IGDBAccessToken != "" {
+		providers = append(providers, services.NewIGDBProvider(client, "", cfg.IGDBClientID, cfg.IGDBAccessToken))
 	}
+	return append(providers, services.NewOpenLibraryProvider(client, ""))
 }
@@ -0,0 +1,273 @@
+package services
+
+import (
+	"archive/zip"
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"gorm.io/gorm"
+)
+
+// accessTokensNote explica em tokens.json por que não há tokens de acesso no export
+const accessTokensNote = "Access tokens are stateless JWTs valid for 24 hours; they are not stored and cannot be listed"
+
+// writeArchive escreve em w o ZIP com todos os dados do usuário, um arquivo JSON por conjunto:
+// profile.json, list.json (com o ProgressData completo), reviews.json, review_votes.json,
+// collections.json, personal_tags.json, goals.json, follows.json, activity.json,
+// list_imports.json e tokens.json (metadados de links de compartilhamento e de exports)
+func (s *AccountService) writeArchive(ctx context.Context, export *models.DataExport, w io.Writer) error {
+	user, err := s.userRepo.GetByID(ctx, export.UserID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.ErrUserNotFound
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	data, err := s.accountRepo.GetData(ctx, export.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to get account data: %w", err)
+	}
+
+	archive := zip.NewWriter(w)
+
+	if err := writeArchiveJSON(archive, "profile.json", accountProfileRecord(user)); err != nil {
+		return err
+	}
+	if err := s.writeArchiveList(ctx, archive, export); err != nil {
+		return err
+	}
+
+	files := []struct {
+		name  string
+		value interface{}
+	}{
+		{"reviews.json", accountReviewRecords(data.Reviews, data.ReviewRevisions)},
+		{"review_votes.json", accountVoteRecords(data.ReviewVotes)},
+		{"collections.json", accountCollectionRecords(data.Collections)},
+		{"personal_tags.json", nonNil(data.PersonalTags)},
+		{"goals.json", nonNil(data.Goals)},
+		{"follows.json", accountFollowsRecord(user.ID, data.Follows)},
+		{"activity.json", nonNil(data.Activity)},
+		{"list_imports.json", nonNil(data.ListImports)},
+		{"tokens.json", accountTokensRecord(data.Collections, data.DataExports)},
+	}
+	for _, file := range files {
+		if err := writeArchiveJSON(archive, file.name, file.value); err != nil {
+			return err
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return fmt.Errorf("failed to write export archive: %w", err)
+	}
+	return nil
+}
+
+// writeArchiveList escreve list.json lendo a lista em lotes; cada lote renova o heartbeat do export
+func (s *AccountService) writeArchiveList(ctx context.Context, archive *zip.Writer, export *models.DataExport) error {
+	file, err := createArchiveFile(archive, "list.json")
+	if err != nil {
+		return err
+	}
+	writer := &jsonExportWriter{writer: bufio.NewWriter(file)}
+
+	var afterID uint
+	for {
+		userItems, err := s.userItemRepo.ListAfterID(ctx, export.UserID, "", afterID, exportBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list user items: %w", err)
+		}
+
+		for i := range userItems {
+			data, err := json.Marshal(accountListRecord(&userItems[i]))
+			if err != nil {
+				return fmt.Errorf("failed to encode list item %d: %w", userItems[i].ID, err)
+			}
+			if err := writer.writeRecord(data); err != nil {
+				return err
+			}
+		}
+		if err := writer.flush(); err != nil {
+			return err
+		}
+		if err := s.exportRepo.Heartbeat(ctx, export.ID); err != nil {
+			return fmt.Errorf("failed to record data export heartbeat: %w", err)
+		}
+
+		if len(userItems) < exportBatchSize {
+			return writer.close()
+		}
+		afterID = userItems[len(userItems)-1].ID
+	}
+}
+
+// createArchiveFile adiciona um arquivo comprimido ao ZIP
+func createArchiveFile(archive *zip.Writer, name string) (io.Writer, error) {
+	file, err := archive.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: time.Now()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	return file, nil
+}
+
+// writeArchiveJSON adiciona ao ZIP um arquivo JSON indentado com value
+func writeArchiveJSON(archive *zip.Writer, name string, value interface{}) error {
+	file, err := createArchiveFile(archive, name)
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(value); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	return nil
+}
+
+// nonNil garante que listas vazias sejam escritas como [] e não null
+func nonNil[T any](values []T) []T {
+	if values == nil {
+		return []T{}
+	}
+	return values
+}
+
+func accountProfileRecord(user *models.User) dto.AccountProfileRecord {
+	hidden := make([]string, len(user.HiddenStatuses))
+	for i, status := range user.HiddenStatuses {
+		hidden[i] = string(status)
+	}
+	return dto.AccountProfileRecord{
+		ID:                user.ID,
+		Email:             user.Email,
+		Username:          user.Username,
+		Name:              user.Name,
+		ProfileVisibility: string(user.ProfileVisibility),
+		HiddenStatuses:    hidden,
+		HideRatings:       user.HideRatings,
+		HideNotes:         user.HideNotes,
+		CreatedAt:         user.CreatedAt,
+		UpdatedAt:         user.UpdatedAt,
+	}
+}
+
+// accountListRecord inclui entradas de items já removidos do catálogo (sem título), que continuam sendo dados do usuário
+func accountListRecord(userItem *models.UserItem) dto.AccountListRecord {
+	return dto.AccountListRecord{
+		ID:                 userItem.ID,
+		ItemID:             userItem.ItemID,
+		UserItemFileRecord: userItemToFileRecord(userItem),
+		ProgressData:       userItem.ProgressData,
+	}
+}
+
+func accountReviewRecords(reviews []models.Review, revisions []models.ReviewRevision) []dto.AccountReviewRecord {
+	byReview := make(map[uint][]models.ReviewRevision)
+	for _, revision := range revisions {
+		byReview[revision.ReviewID] = append(byReview[revision.ReviewID], revision)
+	}
+
+	records := make([]dto.AccountReviewRecord, len(reviews))
+	for i, review := range reviews {
+		records[i] = dto.AccountReviewRecord{
+			ID:          review.ID,
+			ItemID:      review.ItemID,
+			Title:       review.Title,
+			Body:        review.Body,
+			Spoiler:     review.Spoiler,
+			Recommended: review.Recommended,
+			Rating:      review.Rating,
+			CreatedAt:   review.CreatedAt,
+			UpdatedAt:   review.UpdatedAt,
+			Revisions:   nonNil(byReview[review.ID]),
+		}
+	}
+	return records
+}
+
+func accountVoteRecords(votes []models.ReviewVote) []dto.AccountVoteRecord {
+	records := make([]dto.AccountVoteRecord, len(votes))
+	for i, vote := range votes {
+		records[i] = dto.AccountVoteRecord{ReviewID: vote.ReviewID, CreatedAt: vote.CreatedAt}
+	}
+	return records
+}
+
+func accountCollectionRecords(collections []models.Collection) []dto.AccountCollectionRecord {
+	records := make([]dto.AccountCollectionRecord, len(collections))
+	for i, collection := range collections {
+		items := make([]dto.AccountCollectionItemRecord, len(collection.Items))
+		for j, entry := range collection.Items {
+			items[j] = dto.AccountCollectionItemRecord{
+				ItemID:   entry.ItemID,
+				Type:     string(entry.Item.Type),
+				Title:    entry.Item.Title,
+				Position: entry.Position,
+				Note:     entry.Note,
+				AddedAt:  entry.CreatedAt,
+			}
+		}
+		records[i] = dto.AccountCollectionRecord{
+			ID:          collection.ID,
+			Title:       collection.Title,
+			Description: collection.Description,
+			Visibility:  string(collection.Visibility),
+			CreatedAt:   collection.CreatedAt,
+			UpdatedAt:   collection.UpdatedAt,
+			Items:       items,
+		}
+	}
+	return records
+}
+
+// accountFollowsRecord separa quem o usuário segue de quem o segue
+func accountFollowsRecord(userID uint, follows []models.Follow) dto.AccountFollowsRecord {
+	record := dto.AccountFollowsRecord{
+		Following: []dto.AccountFollowRecord{},
+		Followers: []dto.AccountFollowRecord{},
+	}
+	for _, follow := range follows {
+		if follow.FollowerID == userID {
+			record.Following = append(record.Following, dto.AccountFollowRecord{UserID: follow.FollowingID, Status: string(follow.Status), CreatedAt: follow.CreatedAt})
+		} else {
+			record.Followers = append(record.Followers, dto.AccountFollowRecord{UserID: follow.FollowerID, Status: string(follow.Status), CreatedAt: follow.CreatedAt})
+		}
+	}
+	return record
+}
+
+// accountTokensRecord lista os metadados das credenciais da conta, sem os segredos (share tokens, assinaturas)
+func accountTokensRecord(collections []models.Collection, exports []models.DataExport) dto.AccountTokensRecord {
+	record := dto.AccountTokensRecord{
+		AccessTokens: accessTokensNote,
+		ShareLinks:   make([]dto.AccountShareLinkRecord, 0, len(collections)),
+		DataExports:  make([]dto.AccountDataExportRecord, len(exports)),
+	}
+	for _, collection := range collections {
+		if collection.ShareToken == "" {
+			continue
+		}
+		record.ShareLinks = append(record.ShareLinks, dto.AccountShareLinkRecord{
+			CollectionID: collection.ID,
+			Title:        collection.Title,
+			Visibility:   string(collection.Visibility),
+			CreatedAt:    collection.CreatedAt,
+		})
+	}
+	for i, export := range exports {
+		record.DataExports[i] = dto.AccountDataExportRecord{
+			ID:        export.ID,
+			Status:    string(export.Status),
+			CreatedAt: export.CreatedAt,
+			ExpiresAt: export.ExpiresAt,
+		}
+	}
+	return record
+}
@@ -0,0 +1,336 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/logger"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/repositories"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+const (
+	// dataExportStaleAfter é o tempo sem heartbeat após o qual um export em execução volta para a fila
+	// O heartbeat é renovado a cada lote da lista pessoal
+	dataExportStaleAfter = 5 * time.Minute
+	// maxDataExportAttempts limita as retomadas de um export que derruba o worker repetidamente
+	maxDataExportAttempts = 3
+	// dataExportCleanupBatch é o número de exports expirados removidos por vez na limpeza
+	dataExportCleanupBatch = 100
+)
+
+// AccountService cuida dos dados da conta: export self-service (ZIP gerado em segundo plano,
+// baixado por link assinado) e exclusão definitiva
+type AccountService struct {
+	userRepo     repositories.UserRepositoryInterface
+	userItemRepo repositories.UserItemRepositoryInterface
+	exportRepo   repositories.DataExportRepositoryInterface
+	accountRepo  repositories.AccountRepositoryInterface
+	statsRepo    repositories.ItemStatsRepositoryInterface
+	storageDir   string
+	ttl          time.Duration
+	signingKey   []byte
+}
+
+// NewAccountService cria uma nova instância do service da conta
+// storageDir guarda os ZIPs até expirarem (ttl); signingKey assina os links de download
+// (storageDir precisa ser o mesmo em todas as instâncias: o download pode chegar a outra instância)
+func NewAccountService(
+	userRepo repositories.UserRepositoryInterface,
+	userItemRepo repositories.UserItemRepositoryInterface,
+	exportRepo repositories.DataExportRepositoryInterface,
+	accountRepo repositories.AccountRepositoryInterface,
+	statsRepo repositories.ItemStatsRepositoryInterface,
+	storageDir string,
+	ttl time.Duration,
+	signingKey string,
+) *AccountService {
+	return &AccountService{
+		userRepo:     userRepo,
+		userItemRepo: userItemRepo,
+		exportRepo:   exportRepo,
+		accountRepo:  accountRepo,
+		statsRepo:    statsRepo,
+		storageDir:   storageDir,
+		ttl:          ttl,
+		signingKey:   []byte(signingKey),
+	}
+}
+
+// RequestExport enfileira um export de todos os dados do usuário
+// Apenas um export por usuário pode estar na fila ou em execução
+func (s *AccountService) RequestExport(ctx context.Context, userID uint) (*dto.DataExportDTO, error) {
+	pending, err := s.exportRepo.HasPending(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check pending exports: %w", err)
+	}
+	if pending {
+		return nil, models.ErrDataExportInProgress
+	}
+
+	export := &models.DataExport{UserID: userID, Status: models.DataExportQueued}
+	if err := s.exportRepo.Create(ctx, export); err != nil {
+		return nil, fmt.Errorf("failed to enqueue data export: %w", err)
+	}
+
+	return s.toDTO(export), nil
+}
+
+// GetExports retorna os exports do usuário
+func (s *AccountService) GetExports(ctx context.Context, userID uint, params dto.PaginationParams) ([]dto.DataExportDTO, int64, error) {
+	exports, total, err := s.exportRepo.GetByUserID(ctx, userID, params)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get data exports: %w", err)
+	}
+
+	result := make([]dto.DataExportDTO, len(exports))
+	for i := range exports {
+		result[i] = *s.toDTO(&exports[i])
+	}
+	return result, total, nil
+}
+
+// GetExport retorna um export do usuário; o link de download vem assinado enquanto o ZIP estiver disponível
+func (s *AccountService) GetExport(ctx context.Context, userID, id uint) (*dto.DataExportDTO, error) {
+	export, err := s.exportRepo.GetByIDAndUser(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.toDTO(export), nil
+}
+
+// DownloadPath valida o link assinado e retorna o caminho do ZIP
+// O link não exige autenticação: a assinatura cobre o ID e a expiração
+func (s *AccountService) DownloadPath(ctx context.Context, id uint, expires int64, signature string) (string, error) {
+	if !hmac.Equal([]byte(signature), []byte(s.sign(id, expires))) || time.Now().Unix() >= expires {
+		return "", models.ErrInvalidDownloadSignature
+	}
+
+	export, err := s.exportRepo.GetByID(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if !export.IsDownloadable(time.Now()) {
+		return "", models.ErrDataExportNotReady
+	}
+	if _, err := os.Stat(export.FilePath); err != nil {
+		return "", models.ErrDataExportNotReady
+	}
+	return export.FilePath, nil
+}
+
+// DeleteAccount apaga definitivamente a conta e todos os dados ligados a ela, após confirmar a senha
+// Remove também os ZIPs de exports e recalcula os agregados dos items que estavam na lista
+func (s *AccountService) DeleteAccount(ctx context.Context, userID uint, password string) (*dto.AccountErasureResult, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		return nil, models.ErrInvalidPassword
+	}
+
+	result, err := s.accountRepo.Erase(ctx, userID)
+	if err != nil {
+		if errors.Is(err, models.ErrUserNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to erase account: %w", err)
+	}
+
+	for _, path := range result.ExportFiles {
+		s.removeFile(path)
+	}
+	refreshItemStats(ctx, s.statsRepo, result.ItemIDs...)
+
+	logger.Info().Uint("user_id", userID).Interface("deleted", result.Deleted).Msg("Account erased")
+	return result, nil
+}
+
+// RunWorkers processa a fila de exports com workers concorrentes até o contexto ser cancelado
+// Exports interrompidos pelo desligamento voltam para a fila e são gerados do início
+func (s *AccountService) RunWorkers(ctx context.Context, workers int, pollInterval time.Duration) {
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.work(ctx, pollInterval)
+		}()
+	}
+	wg.Wait()
+}
+
+// RunPeriodicCleanup remove os ZIPs expirados imediatamente e depois a cada interval, até o contexto ser cancelado
+func (s *AccountService) RunPeriodicCleanup(ctx context.Context, interval time.Duration) {
+	runPeriodically(ctx, interval, "data export cleanup", s.CleanupExpired)
+}
+
+// CleanupExpired remove do disco os ZIPs cujo link expirou e marca os exports como expirados
+func (s *AccountService) CleanupExpired(ctx context.Context) error {
+	for {
+		exports, err := s.exportRepo.GetExpired(ctx, time.Now(), dataExportCleanupBatch)
+		if err != nil {
+			return fmt.Errorf("failed to get expired data exports: %w", err)
+		}
+
+		for _, export := range exports {
+			s.removeFile(export.FilePath)
+			if err := s.exportRepo.MarkExpired(ctx, export.ID); err != nil {
+				return fmt.Errorf("failed to expire data export %d: %w", export.ID, err)
+			}
+		}
+
+		if len(exports) < dataExportCleanupBatch {
+			return nil
+		}
+	}
+}
+
+// work reivindica e processa exports; quando a fila está vazia, espera pollInterval
+func (s *AccountService) work(ctx context.Context, pollInterval time.Duration) {
+	for {
+		processed, err := s.ProcessNext(ctx)
+		if err != nil {
+			logger.Error().Err(err).Str("job", "data export worker").Msg("Background job failed")
+		}
+		if processed && err == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// ProcessNext reivindica e gera o próximo export da fila
+// Retorna false quando não havia export disponível
+func (s *AccountService) ProcessNext(ctx context.Context) (bool, error) {
+	if ctx.Err() != nil {
+		return false, nil
+	}
+
+	export, err := s.exportRepo.Claim(ctx, time.Now().Add(-dataExportStaleAfter))
+	if err != nil {
+		return false, fmt.Errorf("failed to claim data export: %w", err)
+	}
+	if export == nil {
+		return false, nil
+	}
+
+	return true, s.process(ctx, export)
+}
+
+// process gera o ZIP de um export reivindicado e grava seu estado final
+func (s *AccountService) process(ctx context.Context, export *models.DataExport) error {
+	logger.Info().Uint("data_export", export.ID).Uint("user_id", export.UserID).Int("attempt", export.Attempts).Msg("Data export started")
+
+	if export.Attempts > maxDataExportAttempts {
+		return s.finish(export, fmt.Errorf("data export abandoned after %d attempts", maxDataExportAttempts))
+	}
+
+	if err := os.MkdirAll(s.storageDir, 0o755); err != nil {
+		return s.finish(export, fmt.Errorf("failed to create export storage: %w", err))
+	}
+	file, err := os.CreateTemp(s.storageDir, fmt.Sprintf("export-%d-*.zip", export.ID))
+	if err != nil {
+		return s.finish(export, fmt.Errorf("failed to create export file: %w", err))
+	}
+	export.FilePath = file.Name()
+
+	buildErr := s.writeArchive(ctx, export, file)
+	if closeErr := file.Close(); buildErr == nil {
+		buildErr = closeErr
+	}
+
+	if buildErr != nil && ctx.Err() != nil {
+		// Desligamento: devolve o export para a fila (com contexto próprio, pois ctx já foi cancelado)
+		s.removeFile(export.FilePath)
+		requeueCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := s.exportRepo.Requeue(requeueCtx, export.ID); err != nil {
+			return fmt.Errorf("failed to requeue data export %d: %w", export.ID, err)
+		}
+		logger.Info().Uint("data_export", export.ID).Msg("Data export requeued")
+		return nil
+	}
+
+	if buildErr == nil {
+		if info, err := os.Stat(export.FilePath); err == nil {
+			export.Size = info.Size()
+		}
+	}
+	return s.finish(export, buildErr)
+}
+
+// finish grava o resultado (ou a falha) de um export
+// Um ZIP cujo export não existe mais (conta excluída durante a geração) é removido
+func (s *AccountService) finish(export *models.DataExport, buildErr error) error {
+	export.Status = models.DataExportCompleted
+	if buildErr != nil {
+		export.Status = models.DataExportFailed
+		export.Error = buildErr.Error()
+		if export.FilePath != "" {
+			s.removeFile(export.FilePath)
+			export.FilePath = ""
+		}
+	} else {
+		expiresAt := time.Now().Add(s.ttl)
+		export.ExpiresAt = &expiresAt
+	}
+
+	// O ctx do worker pode ter sido cancelado no fim da geração; o estado final precisa ser gravado
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	found, err := s.exportRepo.Finish(ctx, export)
+	if err != nil {
+		return fmt.Errorf("failed to finish data export %d: %w", export.ID, err)
+	}
+	if !found && export.FilePath != "" {
+		s.removeFile(export.FilePath)
+	}
+
+	logger.Info().Uint("data_export", export.ID).Str("status", string(export.Status)).Int64("size", export.Size).Msg("Data export finished")
+	return nil
+}
+
+// toDTO converte o export e, se o ZIP estiver disponível, inclui o link assinado de download
+func (s *AccountService) toDTO(export *models.DataExport) *dto.DataExportDTO {
+	exportDTO := dto.DataExportToDTO(export)
+	if export.IsDownloadable(time.Now()) {
+		expires := export.ExpiresAt.Unix()
+		exportDTO.DownloadURL = fmt.Sprintf("/api/account/exports/%d/download?expires=%d&signature=%s", export.ID, expires, s.sign(export.ID, expires))
+	}
+	return exportDTO
+}
+
+// sign calcula a assinatura HMAC-SHA256 do link de download de um export
+func (s *AccountService) sign(id uint, expires int64) string {
+	mac := hmac.New(sha256.New, s.signingKey)
+	fmt.Fprintf(mac, "data-export:%d:%d", id, expires)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// removeFile apaga um arquivo do storage, apenas logando falhas
+func (s *AccountService) removeFile(path string) {
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		logger.Warn().Err(err).Str("path", path).Msg("Failed to remove data export file")
+	}
+}
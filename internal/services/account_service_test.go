@@ -0,0 +1,301 @@
+package services
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/testutil"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const accountTestSecret = "test-secret-key-for-account-exports"
+
+// setupAccountService cria o service com um usuário (senha "password123"), a lista de exportFixtureList
+// e uma resenha editada, uma coleção compartilhável e um seguidor
+func setupAccountService(t *testing.T) (*AccountService, *testutil.MockDataExportRepository, *testutil.MockAccountRepository) {
+	t.Helper()
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("Failed to hash password: %v", err)
+	}
+	userRepo := &testutil.MockUserRepository{
+		GetByIDFunc: func(ctx context.Context, id uint) (*models.User, error) {
+			return &models.User{ID: id, Email: "alice@example.com", Username: "alice", Name: "Alice", PasswordHash: string(hash),
+				ProfileVisibility: models.ProfileFollowers, HiddenStatuses: models.StatusList{models.StatusDropped}}, nil
+		},
+	}
+	userItemRepo := &testutil.MockUserItemRepository{
+		ListAfterIDFunc: func(ctx context.Context, userID uint, mediaType models.MediaType, afterID uint, limit int) ([]models.UserItem, error) {
+			if afterID > 0 {
+				return nil, nil
+			}
+			return exportFixtureList(), nil
+		},
+	}
+	accountRepo := &testutil.MockAccountRepository{
+		GetDataFunc: func(ctx context.Context, userID uint) (*dto.AccountData, error) {
+			return &dto.AccountData{
+				Reviews:         []models.Review{{ID: 4, UserID: userID, ItemID: 10, Title: "Masterpiece", Body: "Edited", Rating: 9.5}},
+				ReviewRevisions: []models.ReviewRevision{{ID: 1, ReviewID: 4, Title: "Great", Body: "First draft"}},
+				Collections: []models.Collection{{ID: 2, UserID: userID, Title: "Favorites", Visibility: models.VisibilityUnlisted, ShareToken: "secret-share-token",
+					Items: []models.CollectionItem{{CollectionID: 2, ItemID: 12, Position: 1, Item: models.Item{ID: 12, Type: models.MediaTypeMovie, Title: "Heat"}}}}},
+				Follows: []models.Follow{{FollowerID: userID, FollowingID: 8}, {FollowerID: 9, FollowingID: userID}},
+			}, nil
+		},
+	}
+
+	exportRepo := &testutil.MockDataExportRepository{}
+	service := NewAccountService(userRepo, userItemRepo, exportRepo, accountRepo, nil, t.TempDir(), time.Hour, accountTestSecret)
+	return service, exportRepo, accountRepo
+}
+
+// processExport gera o export 5 do usuário 1 e retorna o estado gravado
+func processExport(t *testing.T, service *AccountService, exportRepo *testutil.MockDataExportRepository) *models.DataExport {
+	t.Helper()
+
+	var finished *models.DataExport
+	exportRepo.ClaimFunc = func(ctx context.Context, staleBefore time.Time) (*models.DataExport, error) {
+		return &models.DataExport{ID: 5, UserID: 1, Status: models.DataExportRunning, Attempts: 1}, nil
+	}
+	exportRepo.FinishFunc = func(ctx context.Context, export *models.DataExport) (bool, error) {
+		finished = export
+		return true, nil
+	}
+
+	processed, err := service.ProcessNext(context.Background())
+	if !processed || err != nil {
+		t.Fatalf("Expected export to be processed, got %v (%v)", processed, err)
+	}
+	if finished == nil || finished.Status != models.DataExportCompleted {
+		t.Fatalf("Expected completed export, got %+v", finished)
+	}
+	return finished
+}
+
+// readArchive retorna o conteúdo de cada arquivo do ZIP
+func readArchive(t *testing.T, path string) map[string][]byte {
+	t.Helper()
+
+	archive, err := zip.OpenReader(path)
+	if err != nil {
+		t.Fatalf("Failed to open export archive: %v", err)
+	}
+	defer archive.Close()
+
+	files := make(map[string][]byte)
+	for _, file := range archive.File {
+		reader, err := file.Open()
+		if err != nil {
+			t.Fatalf("Failed to open %s: %v", file.Name, err)
+		}
+		data, err := io.ReadAll(reader)
+		reader.Close()
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", file.Name, err)
+		}
+		files[file.Name] = data
+	}
+	return files
+}
+
+func TestAccountService_RequestExportRejectsPending(t *testing.T) {
+	service, exportRepo, _ := setupAccountService(t)
+	exportRepo.HasPendingFunc = func(ctx context.Context, userID uint) (bool, error) {
+		return true, nil
+	}
+
+	_, err := service.RequestExport(context.Background(), 1)
+	if !errors.Is(err, models.ErrDataExportInProgress) {
+		t.Errorf("Expected ErrDataExportInProgress, got %v", err)
+	}
+}
+
+func TestAccountService_ProcessNextWritesArchive(t *testing.T) {
+	service, exportRepo, _ := setupAccountService(t)
+	export := processExport(t, service, exportRepo)
+
+	if export.ExpiresAt == nil || export.Size == 0 {
+		t.Fatalf("Expected expiration and size, got %+v", export)
+	}
+	files := readArchive(t, export.FilePath)
+
+	for _, name := range []string{"profile.json", "list.json", "reviews.json", "review_votes.json", "collections.json",
+		"personal_tags.json", "goals.json", "follows.json", "activity.json", "list_imports.json", "tokens.json"} {
+		if _, ok := files[name]; !ok {
+			t.Errorf("Expected %s in the archive", name)
+		}
+	}
+
+	var profile dto.AccountProfileRecord
+	if err := json.Unmarshal(files["profile.json"], &profile); err != nil || profile.Email != "alice@example.com" || profile.HiddenStatuses[0] != "dropped" {
+		t.Errorf("Expected profile, got %+v (%v)", profile, err)
+	}
+
+	var list []dto.AccountListRecord
+	if err := json.Unmarshal(files["list.json"], &list); err != nil || len(list) != 3 {
+		t.Fatalf("Expected 3 list entries, got %d (%v)", len(list), err)
+	}
+	history, ok := list[0].ProgressData["history"].([]interface{})
+	if !ok || len(history) != 2 || list[0].Title != "Fullmetal Alchemist: Brotherhood" {
+		t.Errorf("Expected full progress history in list.json, got %+v", list[0])
+	}
+
+	var reviews []dto.AccountReviewRecord
+	if err := json.Unmarshal(files["reviews.json"], &reviews); err != nil || len(reviews) != 1 || len(reviews[0].Revisions) != 1 {
+		t.Errorf("Expected review with its revision, got %+v (%v)", reviews, err)
+	}
+
+	var follows dto.AccountFollowsRecord
+	if err := json.Unmarshal(files["follows.json"], &follows); err != nil || len(follows.Following) != 1 || follows.Followers[0].UserID != 9 {
+		t.Errorf("Expected one follow in each direction, got %+v (%v)", follows, err)
+	}
+
+	var tokens dto.AccountTokensRecord
+	if err := json.Unmarshal(files["tokens.json"], &tokens); err != nil || len(tokens.ShareLinks) != 1 || tokens.ShareLinks[0].CollectionID != 2 {
+		t.Errorf("Expected share link metadata, got %+v (%v)", tokens, err)
+	}
+	if strings.Contains(string(files["tokens.json"]), "secret-share-token") || strings.Contains(string(files["collections.json"]), "secret-share-token") {
+		t.Error("Expected share token secret to be left out of the archive")
+	}
+	if string(files["goals.json"]) != "[]\n" {
+		t.Errorf("Expected empty goals as [], got %q", files["goals.json"])
+	}
+}
+
+func TestAccountService_SignedDownload(t *testing.T) {
+	service, exportRepo, _ := setupAccountService(t)
+	export := processExport(t, service, exportRepo)
+	exportRepo.GetByIDAndUserFunc = func(ctx context.Context, id, userID uint) (*models.DataExport, error) {
+		return export, nil
+	}
+	exportRepo.GetByIDFunc = func(ctx context.Context, id uint) (*models.DataExport, error) {
+		return export, nil
+	}
+
+	result, err := service.GetExport(context.Background(), 1, 5)
+	if err != nil || result.DownloadURL == "" {
+		t.Fatalf("Expected signed download URL, got %+v (%v)", result, err)
+	}
+	link, err := url.Parse(result.DownloadURL)
+	if err != nil || link.Path != "/api/account/exports/5/download" {
+		t.Fatalf("Expected download path, got %q", result.DownloadURL)
+	}
+	expires, _ := strconv.ParseInt(link.Query().Get("expires"), 10, 64)
+	signature := link.Query().Get("signature")
+
+	path, err := service.DownloadPath(context.Background(), 5, expires, signature)
+	if err != nil || path != export.FilePath {
+		t.Errorf("Expected export file, got %q (%v)", path, err)
+	}
+
+	tests := []struct {
+		name      string
+		id        uint
+		expires   int64
+		signature string
+	}{
+		{"tampered signature", 5, expires, strings.Repeat("0", len(signature))},
+		{"other export", 6, expires, signature},
+		{"extended expiration", 5, expires + 3600, signature},
+		{"expired link", 5, time.Now().Add(-time.Minute).Unix(), service.sign(5, time.Now().Add(-time.Minute).Unix())},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := service.DownloadPath(context.Background(), tt.id, tt.expires, tt.signature); !errors.Is(err, models.ErrInvalidDownloadSignature) {
+				t.Errorf("Expected ErrInvalidDownloadSignature, got %v", err)
+			}
+		})
+	}
+
+	// Expirado no banco (mesmo com um link ainda válido): o ZIP não está mais disponível
+	past := time.Now().Add(-time.Minute)
+	export.ExpiresAt = &past
+	if _, err := service.DownloadPath(context.Background(), 5, expires, signature); !errors.Is(err, models.ErrDataExportNotReady) {
+		t.Errorf("Expected ErrDataExportNotReady, got %v", err)
+	}
+}
+
+func TestAccountService_ProcessRemovesArchiveOfErasedAccount(t *testing.T) {
+	service, exportRepo, _ := setupAccountService(t)
+	exportRepo.ClaimFunc = func(ctx context.Context, staleBefore time.Time) (*models.DataExport, error) {
+		return &models.DataExport{ID: 5, UserID: 1, Status: models.DataExportRunning, Attempts: 1}, nil
+	}
+	var path string
+	exportRepo.FinishFunc = func(ctx context.Context, export *models.DataExport) (bool, error) {
+		path = export.FilePath
+		return false, nil
+	}
+
+	if _, err := service.ProcessNext(context.Background()); err != nil {
+		t.Fatalf("Expected export to be processed, got %v", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("Expected archive %s to be removed, got %v", path, err)
+	}
+}
+
+func TestAccountService_DeleteAccount(t *testing.T) {
+	service, _, accountRepo := setupAccountService(t)
+	exportFile := filepath.Join(service.storageDir, "export-3.zip")
+	if err := os.WriteFile(exportFile, []byte("zip"), 0o644); err != nil {
+		t.Fatalf("Failed to write export file: %v", err)
+	}
+
+	erased := false
+	accountRepo.EraseFunc = func(ctx context.Context, userID uint) (*dto.AccountErasureResult, error) {
+		erased = true
+		return &dto.AccountErasureResult{Deleted: map[string]int64{"users": 1, "user_items": 3}, ExportFiles: []string{exportFile}}, nil
+	}
+
+	if _, err := service.DeleteAccount(context.Background(), 1, "wrong-password"); !errors.Is(err, models.ErrInvalidPassword) || erased {
+		t.Fatalf("Expected ErrInvalidPassword without erasing, got %v (erased: %v)", err, erased)
+	}
+
+	result, err := service.DeleteAccount(context.Background(), 1, "password123")
+	if err != nil || !erased || result.Deleted["user_items"] != 3 {
+		t.Fatalf("Expected account erased, got %+v (%v)", result, err)
+	}
+	if _, err := os.Stat(exportFile); !os.IsNotExist(err) {
+		t.Errorf("Expected export file to be removed, got %v", err)
+	}
+}
+
+func TestAccountService_CleanupExpired(t *testing.T) {
+	service, exportRepo, _ := setupAccountService(t)
+	path := filepath.Join(service.storageDir, "export-4.zip")
+	if err := os.WriteFile(path, []byte("zip"), 0o644); err != nil {
+		t.Fatalf("Failed to write export file: %v", err)
+	}
+
+	var expired []uint
+	exportRepo.GetExpiredFunc = func(ctx context.Context, now time.Time, limit int) ([]models.DataExport, error) {
+		return []models.DataExport{{ID: 4, FilePath: path, Status: models.DataExportCompleted}}, nil
+	}
+	exportRepo.MarkExpiredFunc = func(ctx context.Context, id uint) error {
+		expired = append(expired, id)
+		return nil
+	}
+
+	if err := service.CleanupExpired(context.Background()); err != nil {
+		t.Fatalf("Expected cleanup to succeed, got %v", err)
+	}
+	if len(expired) != 1 || expired[0] != 4 {
+		t.Errorf("Expected export 4 marked as expired, got %v", expired)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("Expected expired file to be removed, got %v", err)
+	}
+}
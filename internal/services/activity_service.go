@@ -0,0 +1,209 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/logger"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/repositories"
+)
+
+// activityDefaultDays é o tamanho padrão do heatmap (um ano até hoje)
+const activityDefaultDays = 365
+
+// activityMaxDays limita o período consultado no heatmap
+const activityMaxDays = 3 * 366
+
+type ActivityService struct {
+	activityRepo repositories.ActivityRepositoryInterface
+}
+
+// NewActivityService cria uma nova instância do serviço de atividade
+func NewActivityService(activityRepo repositories.ActivityRepositoryInterface) *ActivityService {
+	return &ActivityService{activityRepo: activityRepo}
+}
+
+// GetActivity retorna o heatmap diário de atividade no período e as streaks do usuário
+// Sem from/to, retorna os últimos 365 dias até hoje (UTC)
+func (s *ActivityService) GetActivity(ctx context.Context, userID uint, from, to *time.Time) (*dto.ActivityDTO, error) {
+	today := truncateDay(time.Now())
+
+	end := today
+	if to != nil {
+		end = truncateDay(*to)
+	}
+	start := end.AddDate(0, 0, -(activityDefaultDays - 1))
+	if from != nil {
+		start = truncateDay(*from)
+	}
+
+	if start.After(end) || end.Sub(start) > activityMaxDays*24*time.Hour {
+		return nil, models.ErrInvalidDateRange
+	}
+
+	counts, err := s.activityRepo.CountByDay(ctx, userID, start, end.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get activity: %w", err)
+	}
+
+	activeDays, err := s.activityRepo.GetActiveDays(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active days: %w", err)
+	}
+
+	activity := &dto.ActivityDTO{
+		From: start.Format(recapDayLayout),
+		To:   end.Format(recapDayLayout),
+		Days: make([]dto.DayCount, 0, int(end.Sub(start).Hours()/24)+1),
+	}
+
+	// Preencher todos os dias do período (dias sem atividade com count 0)
+	byDay := make(map[string]int64, len(counts))
+	for _, day := range counts {
+		byDay[day.Date] = day.Count
+	}
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		date := day.Format(recapDayLayout)
+		count := byDay[date]
+		if count > 0 {
+			activity.ActiveDays++
+		}
+		activity.Total += count
+		activity.Days = append(activity.Days, dto.DayCount{Date: date, Count: count})
+	}
+
+	daySet := make(map[string]bool, len(activeDays))
+	for _, day := range activeDays {
+		daySet[day] = true
+	}
+	activity.LongestStreak = longestStreak(daySet)
+	activity.CurrentStreak = currentStreak(daySet, today)
+
+	return activity, nil
+}
+
+// currentStreak calcula a sequência de dias com atividade que termina hoje
+// Se ainda não houve atividade hoje, a sequência que termina ontem continua valendo
+func currentStreak(activeDays map[string]bool, today time.Time) dto.StreakDTO {
+	day := today
+	if !activeDays[day.Format(recapDayLayout)] {
+		day = day.AddDate(0, 0, -1)
+	}
+
+	streak := dto.StreakDTO{}
+	for activeDays[day.Format(recapDayLayout)] {
+		streak.Days++
+		streak.StartDate = day.Format(recapDayLayout)
+		if streak.EndDate == "" {
+			streak.EndDate = streak.StartDate
+		}
+		day = day.AddDate(0, 0, -1)
+	}
+
+	return streak
+}
+
+// truncateDay retorna o início do dia (UTC)
+func truncateDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// activitySnapshot guarda o estado de um user item antes de uma alteração
+// Usado para derivar os eventos de atividade (transições de status e avanço de progresso)
+type activitySnapshot struct {
+	Status          models.MediaStatus
+	CompletionCount int
+	Episode         float64
+	Chapter         float64
+	Page            float64
+	Minutes         float64
+	Hours           float64
+	Rating          float64
+}
+
+// snapshotUserItem captura o estado atual de um user item
+func snapshotUserItem(ui *models.UserItem) activitySnapshot {
+	return activitySnapshot{
+		Status:          ui.Status,
+		CompletionCount: ui.CompletionCount,
+		Episode:         progressValue(ui.ProgressData, "episode"),
+		Chapter:         progressValue(ui.ProgressData, "chapter"),
+		Page:            progressValue(ui.ProgressData, "page"),
+		Minutes:         progressValue(ui.ProgressData, "minutes_watched"),
+		Hours:           progressValue(ui.ProgressData, "hours"),
+		Rating:          ui.Rating,
+	}
+}
+
+// activityEvents deriva os eventos de atividade comparando o estado anterior com o atual
+// Retrocessos de progresso (ex: nova visualização) não geram eventos
+func activityEvents(before activitySnapshot, ui *models.UserItem, now time.Time) []models.ActivityEvent {
+	after := snapshotUserItem(ui)
+	newEvent := func(kind models.ActivityKind) models.ActivityEvent {
+		return models.ActivityEvent{
+			UserID:     ui.UserID,
+			UserItemID: ui.ID,
+			ItemID:     ui.ItemID,
+			MediaType:  ui.Item.Type,
+			Kind:       kind,
+			Status:     ui.Status,
+			OccurredAt: now,
+		}
+	}
+
+	var events []models.ActivityEvent
+
+	if after.CompletionCount > before.CompletionCount {
+		event := newEvent(models.ActivityCompleted)
+		event.Completions = after.CompletionCount - before.CompletionCount
+		events = append(events, event)
+	} else if after.Status != before.Status {
+		events = append(events, newEvent(models.ActivityStatusChanged))
+	}
+
+	// Remover a nota não gera evento
+	if after.Rating > 0 && after.Rating != before.Rating {
+		rated := newEvent(models.ActivityRated)
+		rated.Rating = after.Rating
+		events = append(events, rated)
+	}
+
+	progress := newEvent(models.ActivityProgress)
+	progress.Episodes = int(positiveDelta(before.Episode, after.Episode))
+	progress.Chapters = int(positiveDelta(before.Chapter, after.Chapter))
+	progress.Pages = int(positiveDelta(before.Page, after.Page))
+	progress.Minutes = int(positiveDelta(before.Minutes, after.Minutes))
+	progress.Hours = positiveDelta(before.Hours, after.Hours)
+	if progress.Episodes > 0 || progress.Chapters > 0 || progress.Pages > 0 || progress.Minutes > 0 || progress.Hours > 0 {
+		events = append(events, progress)
+	}
+
+	return events
+}
+
+// positiveDelta retorna o avanço entre dois valores (0 quando o valor diminuiu)
+func positiveDelta(before, after float64) float64 {
+	if after > before {
+		return after - before
+	}
+	return 0
+}
+
+// recordActivity registra eventos de atividade sem interromper a operação principal
+// Falhas são apenas logadas: a lista do usuário é a fonte de verdade
+func recordActivity(ctx context.Context, activityRepo repositories.ActivityRepositoryInterface, events []models.ActivityEvent) {
+	if activityRepo == nil || len(events) == 0 {
+		return
+	}
+
+	if err := activityRepo.Create(ctx, events); err != nil {
+		logger.Warn().
+			Err(err).
+			Uint("user_id", events[0].UserID).
+			Msg("Failed to record list activity")
+	}
+}
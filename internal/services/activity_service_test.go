@@ -0,0 +1,128 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/testutil"
+)
+
+func TestGetActivity_FillsDaysAndStreaks(t *testing.T) {
+	ctx := context.Background()
+	today := truncateDay(time.Now())
+	day := func(offset int) string {
+		return today.AddDate(0, 0, offset).Format("2006-01-02")
+	}
+
+	mockActivityRepo := &testutil.MockActivityRepository{
+		CountByDayFunc: func(ctx context.Context, userID uint, from, to time.Time) ([]dto.DayCount, error) {
+			return []dto.DayCount{{Date: day(-1), Count: 2}, {Date: day(0), Count: 3}}, nil
+		},
+		GetActiveDaysFunc: func(ctx context.Context, userID uint) ([]string, error) {
+			return []string{day(-40), day(-39), day(-38), day(-37), day(-1), day(0)}, nil
+		},
+	}
+
+	service := NewActivityService(mockActivityRepo)
+	from := today.AddDate(0, 0, -6)
+	activity, err := service.GetActivity(ctx, 1, &from, nil)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(activity.Days) != 7 {
+		t.Errorf("Expected 7 days, got %d", len(activity.Days))
+	}
+	if activity.Total != 5 || activity.ActiveDays != 2 {
+		t.Errorf("Expected total 5 over 2 days, got %d over %d", activity.Total, activity.ActiveDays)
+	}
+	if activity.CurrentStreak.Days != 2 || activity.CurrentStreak.EndDate != day(0) {
+		t.Errorf("Unexpected current streak: %+v", activity.CurrentStreak)
+	}
+	if activity.LongestStreak.Days != 4 || activity.LongestStreak.StartDate != day(-40) {
+		t.Errorf("Unexpected longest streak: %+v", activity.LongestStreak)
+	}
+}
+
+func TestGetActivity_InvalidRange(t *testing.T) {
+	service := NewActivityService(&testutil.MockActivityRepository{})
+
+	from := time.Now()
+	to := from.AddDate(0, 0, -1)
+	_, err := service.GetActivity(context.Background(), 1, &from, &to)
+	if err != models.ErrInvalidDateRange {
+		t.Errorf("Expected ErrInvalidDateRange, got %v", err)
+	}
+}
+
+func TestActivityEvents(t *testing.T) {
+	now := time.Now()
+	ui := &models.UserItem{
+		UserID:       1,
+		ItemID:       2,
+		Status:       models.StatusInProgress,
+		ProgressType: models.ProgressTypeEpisodic,
+		Item:         models.Item{Type: models.MediaTypeAnime},
+	}
+	ui.SetEpisodicProgress(1, 4)
+	before := snapshotUserItem(ui)
+
+	ui.SetEpisodicProgress(1, 12)
+	ui.CompleteCurrentView()
+
+	events := activityEvents(before, ui, now)
+	if len(events) != 2 {
+		t.Fatalf("Expected completed and progress events, got %+v", events)
+	}
+	if events[0].Kind != models.ActivityCompleted || events[0].Completions != 1 {
+		t.Errorf("Unexpected completion event: %+v", events[0])
+	}
+	if events[1].Kind != models.ActivityProgress || events[1].Episodes != 8 || events[1].MediaType != models.MediaTypeAnime {
+		t.Errorf("Unexpected progress event: %+v", events[1])
+	}
+
+	// Uma nova visualização reseta o progresso sem gerar avanço
+	before = snapshotUserItem(ui)
+	ui.StartNewView()
+	events = activityEvents(before, ui, now)
+	if len(events) != 1 || events[0].Kind != models.ActivityStatusChanged {
+		t.Errorf("Expected only a status change, got %+v", events)
+	}
+
+	// Alterar a nota gera um evento rated; removê-la não
+	before = snapshotUserItem(ui)
+	ui.Rating = 8.5
+	events = activityEvents(before, ui, now)
+	if len(events) != 1 || events[0].Kind != models.ActivityRated || events[0].Rating != 8.5 {
+		t.Errorf("Expected a rated event, got %+v", events)
+	}
+
+	before = snapshotUserItem(ui)
+	ui.Rating = 0
+	if events = activityEvents(before, ui, now); len(events) != 0 {
+		t.Errorf("Expected no event when clearing the rating, got %+v", events)
+	}
+}
+
+func TestUpdateListItem_RecordsActivity(t *testing.T) {
+	ctx := context.Background()
+	mockUserItemRepo := &testutil.MockUserItemRepository{
+		GetByIDAndUserFunc: func(ctx context.Context, id, userID uint) (*models.UserItem, error) {
+			return &models.UserItem{UserID: userID, ItemID: 3, Status: models.StatusPlanned}, nil
+		},
+	}
+	mockActivityRepo := &testutil.MockActivityRepository{}
+
+	service := NewUserItemService(mockUserItemRepo, nil, nil, mockActivityRepo, nil)
+	_, err := service.UpdateListItem(ctx, 1, 1, &models.UserItem{Status: models.StatusCompleted})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(mockActivityRepo.Created) != 1 || mockActivityRepo.Created[0].Kind != models.ActivityCompleted {
+		t.Errorf("Expected a completed event, got %+v", mockActivityRepo.Created)
+	}
+}
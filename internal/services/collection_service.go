@@ -0,0 +1,265 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/repositories"
+	"gorm.io/gorm"
+)
+
+// collectionSharePath é o caminho público das coleções compartilhadas por link
+const collectionSharePath = "/api/collections/shared/"
+
+type CollectionService struct {
+	collectionRepo repositories.CollectionRepositoryInterface
+	itemRepo       repositories.ItemRepositoryInterface
+}
+
+// NewCollectionService cria uma nova instância do serviço de coleções
+func NewCollectionService(collectionRepo repositories.CollectionRepositoryInterface, itemRepo repositories.ItemRepositoryInterface) *CollectionService {
+	return &CollectionService{
+		collectionRepo: collectionRepo,
+		itemRepo:       itemRepo,
+	}
+}
+
+// CreateCollection cria uma coleção para o usuário (privada por padrão)
+func (s *CollectionService) CreateCollection(ctx context.Context, userID uint, req dto.CollectionRequest) (*dto.CollectionDTO, error) {
+	token, err := newShareToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate share token: %w", err)
+	}
+
+	collection := &models.Collection{
+		UserID:      userID,
+		Title:       req.Title,
+		Description: req.Description,
+		Visibility:  collectionVisibility(req.Visibility),
+		ShareToken:  token,
+	}
+
+	if err := collection.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.collectionRepo.Create(ctx, collection); err != nil {
+		return nil, fmt.Errorf("failed to create collection: %w", err)
+	}
+
+	return collectionForViewer(collection, userID), nil
+}
+
+// GetMyCollections retorna as coleções do usuário (sem os items)
+func (s *CollectionService) GetMyCollections(ctx context.Context, userID uint, params dto.PaginationParams) ([]dto.CollectionDTO, int64, error) {
+	collections, total, err := s.collectionRepo.GetByUserID(ctx, userID, params)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get collections: %w", err)
+	}
+
+	result := make([]dto.CollectionDTO, 0, len(collections))
+	for i := range collections {
+		result = append(result, *collectionForViewer(&collections[i], userID))
+	}
+	return result, total, nil
+}
+
+// GetPublicCollections retorna as coleções públicas (sem os items)
+func (s *CollectionService) GetPublicCollections(ctx context.Context, params dto.PaginationParams) ([]dto.CollectionDTO, int64, error) {
+	collections, total, err := s.collectionRepo.GetPublic(ctx, params)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get public collections: %w", err)
+	}
+
+	return dto.CollectionsToDTOs(collections), total, nil
+}
+
+// GetCollection retorna uma coleção com os items se o usuário (0 = anônimo) puder vê-la
+// Coleções privadas ou unlisted de outros usuários retornam not found
+func (s *CollectionService) GetCollection(ctx context.Context, id, viewerID uint) (*dto.CollectionDTO, error) {
+	collection, err := s.collectionRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !collection.CanBeViewedBy(viewerID) {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return collectionForViewer(collection, viewerID), nil
+}
+
+// GetSharedCollection retorna uma coleção pelo link de compartilhamento
+// Funciona para coleções unlisted e públicas; privadas retornam not found
+func (s *CollectionService) GetSharedCollection(ctx context.Context, token string, viewerID uint) (*dto.CollectionDTO, error) {
+	collection, err := s.collectionRepo.GetByShareToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if collection.Visibility == models.VisibilityPrivate && collection.UserID != viewerID {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return collectionForViewer(collection, viewerID), nil
+}
+
+// UpdateCollection atualiza título, descrição e visibilidade de uma coleção do usuário
+func (s *CollectionService) UpdateCollection(ctx context.Context, id, userID uint, req dto.CollectionRequest) (*dto.CollectionDTO, error) {
+	collection, err := s.getOwnedCollection(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	collection.Title = req.Title
+	collection.Description = req.Description
+	if req.Visibility != "" {
+		collection.Visibility = models.CollectionVisibility(req.Visibility)
+	}
+
+	if err := collection.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.collectionRepo.Update(ctx, collection); err != nil {
+		return nil, fmt.Errorf("failed to update collection: %w", err)
+	}
+
+	return collectionForViewer(collection, userID), nil
+}
+
+// DeleteCollection remove uma coleção do usuário
+func (s *CollectionService) DeleteCollection(ctx context.Context, id, userID uint) error {
+	if _, err := s.getOwnedCollection(ctx, id, userID); err != nil {
+		return err
+	}
+
+	if err := s.collectionRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete collection: %w", err)
+	}
+	return nil
+}
+
+// AddItem adiciona um item do catálogo à coleção (não precisa estar na lista do usuário)
+func (s *CollectionService) AddItem(ctx context.Context, id, userID uint, req dto.AddCollectionItemRequest) (*dto.CollectionDTO, error) {
+	if _, err := s.getOwnedCollection(ctx, id, userID); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.itemRepo.GetByID(ctx, req.ItemID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrItemNotFound
+		}
+		return nil, fmt.Errorf("failed to verify item existence: %w", err)
+	}
+
+	entry := &models.CollectionItem{
+		CollectionID: id,
+		ItemID:       req.ItemID,
+		Position:     req.Position,
+		Note:         req.Note,
+	}
+	if err := s.collectionRepo.AddItem(ctx, entry); err != nil {
+		if errors.Is(err, models.ErrItemAlreadyInCollection) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to add item to collection: %w", err)
+	}
+
+	return s.GetCollection(ctx, id, userID)
+}
+
+// UpdateItemNote atualiza a nota de uma entrada da coleção
+func (s *CollectionService) UpdateItemNote(ctx context.Context, id, userID, itemID uint, req dto.UpdateCollectionItemRequest) (*dto.CollectionDTO, error) {
+	if _, err := s.getOwnedCollection(ctx, id, userID); err != nil {
+		return nil, err
+	}
+
+	entry, err := s.collectionRepo.GetItem(ctx, id, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	entry.Note = req.Note
+	if err := s.collectionRepo.UpdateItem(ctx, entry); err != nil {
+		return nil, fmt.Errorf("failed to update collection item: %w", err)
+	}
+
+	return s.GetCollection(ctx, id, userID)
+}
+
+// RemoveItem remove um item da coleção
+func (s *CollectionService) RemoveItem(ctx context.Context, id, userID, itemID uint) error {
+	if _, err := s.getOwnedCollection(ctx, id, userID); err != nil {
+		return err
+	}
+
+	if err := s.collectionRepo.RemoveItem(ctx, id, itemID); err != nil {
+		if errors.Is(err, models.ErrItemNotInCollection) {
+			return err
+		}
+		return fmt.Errorf("failed to remove collection item: %w", err)
+	}
+	return nil
+}
+
+// Reorder redefine a ordem completa dos items da coleção
+func (s *CollectionService) Reorder(ctx context.Context, id, userID uint, req dto.ReorderCollectionRequest) (*dto.CollectionDTO, error) {
+	if _, err := s.getOwnedCollection(ctx, id, userID); err != nil {
+		return nil, err
+	}
+
+	if err := s.collectionRepo.Reorder(ctx, id, req.ItemIDs); err != nil {
+		if errors.Is(err, models.ErrInvalidReorder) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to reorder collection: %w", err)
+	}
+
+	return s.GetCollection(ctx, id, userID)
+}
+
+// getOwnedCollection busca uma coleção garantindo que pertence ao usuário
+// Coleções de outros usuários retornam not found (sem revelar que existem)
+func (s *CollectionService) getOwnedCollection(ctx context.Context, id, userID uint) (*models.Collection, error) {
+	collection, err := s.collectionRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if collection.UserID != userID {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return collection, nil
+}
+
+// collectionForViewer converte a coleção para DTO, expondo o link de compartilhamento apenas ao dono
+func collectionForViewer(collection *models.Collection, viewerID uint) *dto.CollectionDTO {
+	collectionDTO := dto.CollectionToDTO(collection)
+	if collection.UserID == viewerID && collection.Visibility != models.VisibilityPrivate {
+		collectionDTO.ShareURL = collectionSharePath + collection.ShareToken
+	}
+	return collectionDTO
+}
+
+// collectionVisibility retorna a visibilidade informada ou private por padrão
+func collectionVisibility(value string) models.CollectionVisibility {
+	if value == "" {
+		return models.VisibilityPrivate
+	}
+	return models.CollectionVisibility(value)
+}
+
+// newShareToken gera um token aleatório para links de compartilhamento
+func newShareToken() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
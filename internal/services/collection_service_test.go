@@ -0,0 +1,126 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/testutil"
+	"gorm.io/gorm"
+)
+
+func TestCreateCollection_DefaultsToPrivate(t *testing.T) {
+	var created *models.Collection
+	mockCollectionRepo := &testutil.MockCollectionRepository{
+		CreateFunc: func(ctx context.Context, collection *models.Collection) error {
+			created = collection
+			return nil
+		},
+	}
+
+	service := NewCollectionService(mockCollectionRepo, &testutil.MockItemRepository{})
+	collection, err := service.CreateCollection(context.Background(), 1, dto.CollectionRequest{Title: "Best of 2026"})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if created.Visibility != models.VisibilityPrivate || created.ShareToken == "" {
+		t.Errorf("Expected private collection with share token, got %+v", created)
+	}
+	if collection.ShareURL != "" {
+		t.Errorf("Expected no share URL for private collection, got %s", collection.ShareURL)
+	}
+}
+
+func TestGetCollection_Visibility(t *testing.T) {
+	tests := []struct {
+		name       string
+		visibility models.CollectionVisibility
+		viewerID   uint
+		wantErr    bool
+	}{
+		{"public for anonymous", models.VisibilityPublic, 0, false},
+		{"unlisted for other user", models.VisibilityUnlisted, 2, true},
+		{"private for other user", models.VisibilityPrivate, 2, true},
+		{"private for owner", models.VisibilityPrivate, 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCollectionRepo := &testutil.MockCollectionRepository{
+				GetByIDFunc: func(ctx context.Context, id uint) (*models.Collection, error) {
+					return &models.Collection{ID: id, UserID: 1, Title: "List", Visibility: tt.visibility, ShareToken: "abc"}, nil
+				},
+			}
+
+			service := NewCollectionService(mockCollectionRepo, &testutil.MockItemRepository{})
+			_, err := service.GetCollection(context.Background(), 1, tt.viewerID)
+
+			if tt.wantErr && !errors.Is(err, gorm.ErrRecordNotFound) {
+				t.Errorf("Expected not found, got %v", err)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestGetSharedCollection_Unlisted(t *testing.T) {
+	mockCollectionRepo := &testutil.MockCollectionRepository{
+		GetByShareTokenFunc: func(ctx context.Context, token string) (*models.Collection, error) {
+			return &models.Collection{ID: 1, UserID: 1, Title: "List", Visibility: models.VisibilityUnlisted, ShareToken: token}, nil
+		},
+	}
+
+	service := NewCollectionService(mockCollectionRepo, &testutil.MockItemRepository{})
+	collection, err := service.GetSharedCollection(context.Background(), "abc", 0)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if collection.ShareURL != "" {
+		t.Errorf("Expected share URL hidden from non-owner, got %s", collection.ShareURL)
+	}
+}
+
+func TestAddCollectionItem_NotOwner(t *testing.T) {
+	mockCollectionRepo := &testutil.MockCollectionRepository{
+		GetByIDFunc: func(ctx context.Context, id uint) (*models.Collection, error) {
+			return &models.Collection{ID: id, UserID: 2, Title: "List", Visibility: models.VisibilityPublic}, nil
+		},
+		AddItemFunc: func(ctx context.Context, entry *models.CollectionItem) error {
+			t.Error("AddItem should not be called for another user's collection")
+			return nil
+		},
+	}
+
+	service := NewCollectionService(mockCollectionRepo, &testutil.MockItemRepository{})
+	_, err := service.AddItem(context.Background(), 1, 1, dto.AddCollectionItemRequest{ItemID: 10})
+
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("Expected not found, got %v", err)
+	}
+}
+
+func TestAddCollectionItem_ItemNotInCatalog(t *testing.T) {
+	mockCollectionRepo := &testutil.MockCollectionRepository{
+		GetByIDFunc: func(ctx context.Context, id uint) (*models.Collection, error) {
+			return &models.Collection{ID: id, UserID: 1, Title: "List", Visibility: models.VisibilityPrivate}, nil
+		},
+	}
+	mockItemRepo := &testutil.MockItemRepository{
+		GetByIDFunc: func(ctx context.Context, id uint) (*models.Item, error) {
+			return nil, gorm.ErrRecordNotFound
+		},
+	}
+
+	service := NewCollectionService(mockCollectionRepo, mockItemRepo)
+	_, err := service.AddItem(context.Background(), 1, 1, dto.AddCollectionItemRequest{ItemID: 99})
+
+	if !errors.Is(err, models.ErrItemNotFound) {
+		t.Errorf("Expected ErrItemNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,182 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/repositories"
+)
+
+type GoalService struct {
+	goalRepo     repositories.GoalRepositoryInterface
+	activityRepo repositories.ActivityRepositoryInterface
+}
+
+// NewGoalService cria uma nova instância do serviço de metas
+func NewGoalService(goalRepo repositories.GoalRepositoryInterface, activityRepo repositories.ActivityRepositoryInterface) *GoalService {
+	return &GoalService{
+		goalRepo:     goalRepo,
+		activityRepo: activityRepo,
+	}
+}
+
+// CreateGoal cria uma meta para o usuário
+func (s *GoalService) CreateGoal(ctx context.Context, userID uint, req dto.GoalRequest) (*dto.GoalDTO, error) {
+	goal := &models.Goal{UserID: userID}
+	if err := applyGoalRequest(goal, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.goalRepo.Create(ctx, goal); err != nil {
+		return nil, fmt.Errorf("failed to create goal: %w", err)
+	}
+
+	return s.withProgress(ctx, goal)
+}
+
+// GetGoals retorna as metas do usuário com o progresso calculado
+func (s *GoalService) GetGoals(ctx context.Context, userID uint) ([]dto.GoalDTO, error) {
+	goals, err := s.goalRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get goals: %w", err)
+	}
+
+	result := make([]dto.GoalDTO, 0, len(goals))
+	for i := range goals {
+		goalDTO, err := s.withProgress(ctx, &goals[i])
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *goalDTO)
+	}
+
+	return result, nil
+}
+
+// GetGoal retorna uma meta do usuário com o progresso calculado
+func (s *GoalService) GetGoal(ctx context.Context, id, userID uint) (*dto.GoalDTO, error) {
+	goal, err := s.goalRepo.GetByIDAndUser(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.withProgress(ctx, goal)
+}
+
+// UpdateGoal substitui os dados de uma meta do usuário
+func (s *GoalService) UpdateGoal(ctx context.Context, id, userID uint, req dto.GoalRequest) (*dto.GoalDTO, error) {
+	goal, err := s.goalRepo.GetByIDAndUser(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := applyGoalRequest(goal, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.goalRepo.Update(ctx, goal); err != nil {
+		return nil, fmt.Errorf("failed to update goal: %w", err)
+	}
+
+	return s.withProgress(ctx, goal)
+}
+
+// DeleteGoal remove uma meta do usuário
+func (s *GoalService) DeleteGoal(ctx context.Context, id, userID uint) error {
+	if _, err := s.goalRepo.GetByIDAndUser(ctx, id, userID); err != nil {
+		return err
+	}
+
+	if err := s.goalRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete goal: %w", err)
+	}
+
+	return nil
+}
+
+// applyGoalRequest aplica o payload na meta, resolvendo o período em [StartsAt, EndsAt)
+func applyGoalRequest(goal *models.Goal, req dto.GoalRequest) error {
+	goal.Title = req.Title
+	goal.Metric = models.GoalMetric(req.Metric)
+	goal.Target = req.Target
+	goal.Period = models.GoalPeriod(req.Period)
+
+	goal.MediaType = nil
+	if req.MediaType != "" {
+		mediaType := models.MediaType(req.MediaType)
+		goal.MediaType = &mediaType
+	}
+
+	year := req.Year
+	if year == 0 {
+		year = time.Now().Year()
+	}
+
+	switch goal.Period {
+	case models.GoalPeriodYear:
+		goal.StartsAt = time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		goal.EndsAt = goal.StartsAt.AddDate(1, 0, 0)
+	case models.GoalPeriodMonth:
+		month := time.Month(req.Month)
+		if req.Month == 0 {
+			month = time.Now().Month()
+		}
+		goal.StartsAt = time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+		goal.EndsAt = goal.StartsAt.AddDate(0, 1, 0)
+	case models.GoalPeriodCustom:
+		if req.StartsAt == nil || req.EndsAt == nil {
+			return models.ErrInvalidGoalRange
+		}
+		goal.StartsAt = truncateDay(*req.StartsAt)
+		goal.EndsAt = truncateDay(*req.EndsAt).AddDate(0, 0, 1) // ends_at inclusivo
+	}
+
+	return goal.Validate()
+}
+
+// withProgress converte a meta para GoalDTO calculando o progresso a partir da atividade
+func (s *GoalService) withProgress(ctx context.Context, goal *models.Goal) (*dto.GoalDTO, error) {
+	current, err := s.activityRepo.SumMetric(ctx, goal.UserID, goal.Metric, goal.MediaType, goal.StartsAt, goal.EndsAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute goal progress: %w", err)
+	}
+
+	return goalProgress(goal, current, time.Now()), nil
+}
+
+// goalProgress monta o GoalDTO a partir do valor atual
+// Expected é o valor que deveria ter sido atingido até now num ritmo linear
+func goalProgress(goal *models.Goal, current float64, now time.Time) *dto.GoalDTO {
+	goalDTO := &dto.GoalDTO{
+		ID:        goal.ID,
+		Title:     goal.Title,
+		Metric:    string(goal.Metric),
+		Target:    goal.Target,
+		Period:    string(goal.Period),
+		StartsAt:  goal.StartsAt,
+		EndsAt:    goal.EndsAt,
+		Current:   current,
+		Percent:   math.Min(math.Round(current/goal.Target*10000)/100, 100),
+		Achieved:  current >= goal.Target,
+		CreatedAt: goal.CreatedAt,
+	}
+	if goal.MediaType != nil {
+		goalDTO.MediaType = string(*goal.MediaType)
+	}
+
+	switch {
+	case !now.After(goal.StartsAt):
+		goalDTO.Expected = 0
+	case !now.Before(goal.EndsAt):
+		goalDTO.Expected = goal.Target
+	default:
+		elapsed := now.Sub(goal.StartsAt).Seconds() / goal.EndsAt.Sub(goal.StartsAt).Seconds()
+		goalDTO.Expected = math.Round(goal.Target*elapsed*100) / 100
+	}
+
+	return goalDTO
+}
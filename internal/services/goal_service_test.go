@@ -0,0 +1,81 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/testutil"
+)
+
+func TestCreateGoal_YearlyWithMediaType(t *testing.T) {
+	ctx := context.Background()
+	mockGoalRepo := &testutil.MockGoalRepository{}
+	mockActivityRepo := &testutil.MockActivityRepository{
+		SumMetricFunc: func(ctx context.Context, userID uint, metric models.GoalMetric, mediaType *models.MediaType, from, to time.Time) (float64, error) {
+			if metric != models.GoalMetricCompletions || mediaType == nil || *mediaType != models.MediaTypeBook {
+				t.Errorf("Unexpected metric filter: %s %v", metric, mediaType)
+			}
+			if !from.Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)) || !to.Equal(time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)) {
+				t.Errorf("Unexpected period %v - %v", from, to)
+			}
+			return 6, nil
+		},
+	}
+
+	service := NewGoalService(mockGoalRepo, mockActivityRepo)
+	goal, err := service.CreateGoal(ctx, 1, dto.GoalRequest{
+		Title:     "Read 24 books",
+		Metric:    "completions",
+		Target:    24,
+		Period:    "year",
+		Year:      2026,
+		MediaType: "book",
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if goal.Current != 6 || goal.Percent != 25 || goal.Achieved {
+		t.Errorf("Unexpected progress: %+v", goal)
+	}
+}
+
+func TestCreateGoal_InvalidCustomRange(t *testing.T) {
+	service := NewGoalService(&testutil.MockGoalRepository{}, &testutil.MockActivityRepository{})
+
+	start := time.Date(2026, 5, 10, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, -3)
+	_, err := service.CreateGoal(context.Background(), 1, dto.GoalRequest{
+		Metric:   "episodes",
+		Target:   100,
+		Period:   "custom",
+		StartsAt: &start,
+		EndsAt:   &end,
+	})
+
+	if err != models.ErrInvalidGoalRange {
+		t.Errorf("Expected ErrInvalidGoalRange, got %v", err)
+	}
+}
+
+func TestGoalProgress_Expected(t *testing.T) {
+	goal := &models.Goal{
+		Metric:   models.GoalMetricEpisodes,
+		Target:   300,
+		Period:   models.GoalPeriodCustom,
+		StartsAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndsAt:   time.Date(2026, 1, 11, 0, 0, 0, 0, time.UTC),
+	}
+
+	progress := goalProgress(goal, 360, time.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC))
+
+	if progress.Expected != 150 {
+		t.Errorf("Expected 150 at half period, got %v", progress.Expected)
+	}
+	if progress.Percent != 100 || !progress.Achieved {
+		t.Errorf("Expected achieved goal capped at 100%%, got %+v", progress)
+	}
+}
@@ -0,0 +1,383 @@
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/logger"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/repositories"
+)
+
+const (
+	// importJobStaleAfter é o tempo sem heartbeat após o qual um job em execução é considerado abandonado
+	// (worker ou servidor que morreu) e volta a ser reivindicado; o heartbeat é renovado a cada lote
+	importJobStaleAfter = 5 * time.Minute
+	// maxImportJobAttempts limita as retomadas de um job que derruba o worker repetidamente
+	maxImportJobAttempts = 3
+)
+
+type ImportJobService struct {
+	jobRepo     repositories.ImportJobRepositoryInterface
+	itemService *ItemService
+	storageDir  string
+}
+
+// NewImportJobService cria uma nova instância do service de jobs de import
+// storageDir guarda os arquivos enviados até o job terminar e os CSVs de erros
+// (precisa ser o mesmo diretório em todas as instâncias: o worker que reivindica o job lê o upload dele)
+func NewImportJobService(jobRepo repositories.ImportJobRepositoryInterface, itemService *ItemService, storageDir string) *ImportJobService {
+	return &ImportJobService{
+		jobRepo:     jobRepo,
+		itemService: itemService,
+		storageDir:  storageDir,
+	}
+}
+
+// Enqueue grava o arquivo enviado em disco e enfileira o import
+// Com mediaType vazio, o import é misto e cada linha informa o próprio tipo
+// O início do arquivo (headers do CSV, abertura do array JSON) é validado antes de enfileirar,
+// para que um arquivo do tipo ou formato errado falhe na hora
+func (s *ImportJobService) Enqueue(ctx context.Context, userID uint, mediaType models.MediaType, opts dto.ImportOptions, fileName string, file io.Reader) (*dto.ImportJobDTO, error) {
+	if mediaType != "" && !mediaType.IsValid() {
+		return nil, fmt.Errorf("invalid media type: %s", mediaType)
+	}
+	if opts.Format == "" {
+		opts.Format = dto.ImportFormatCSV
+	}
+	if opts.Mode == "" {
+		opts.Mode = dto.ImportModeCreate
+	}
+	if opts.Transaction == "" {
+		opts.Transaction = dto.ImportTransactionRow
+	}
+
+	if err := os.MkdirAll(s.storageDir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create import storage: %w", err)
+	}
+	upload, err := os.CreateTemp(s.storageDir, "import-*."+opts.Format)
+	if err != nil {
+		return nil, fmt.Errorf("failed to store upload: %w", err)
+	}
+	path := upload.Name()
+
+	_, copyErr := io.Copy(upload, file)
+	if closeErr := upload.Close(); copyErr == nil {
+		copyErr = closeErr
+	}
+	if copyErr != nil {
+		s.removeFile(path)
+		return nil, fmt.Errorf("failed to store upload: %w", copyErr)
+	}
+
+	if err := s.validateStart(path, opts.Format, mediaType); err != nil {
+		s.removeFile(path)
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidImportFile, err)
+	}
+
+	job := &models.ImportJob{
+		UserID:      userID,
+		Format:      opts.Format,
+		MediaType:   mediaType,
+		Mode:        opts.Mode,
+		Transaction: opts.Transaction,
+		DryRun:      opts.DryRun,
+		FileName:    fileName,
+		FilePath:    path,
+		Status:      models.ImportJobQueued,
+	}
+	if err := s.jobRepo.Create(ctx, job); err != nil {
+		s.removeFile(path)
+		return nil, fmt.Errorf("failed to enqueue import: %w", err)
+	}
+
+	return dto.ImportJobToDTO(job), nil
+}
+
+// GetJob retorna um job do usuário com seu andamento
+func (s *ImportJobService) GetJob(ctx context.Context, id, userID uint) (*dto.ImportJobDTO, error) {
+	job, err := s.getOwnedJob(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	return dto.ImportJobToDTO(job), nil
+}
+
+// CancelJob cancela um job na fila ou em execução
+// Um job em execução para no próximo lote; no modo row, os lotes já gravados permanecem
+func (s *ImportJobService) CancelJob(ctx context.Context, id, userID uint) (*dto.ImportJobDTO, error) {
+	job, err := s.getOwnedJob(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if job.Status.IsFinished() {
+		return nil, models.ErrImportJobFinished
+	}
+
+	wasQueued := job.Status == models.ImportJobQueued
+
+	canceled, err := s.jobRepo.Cancel(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel import job: %w", err)
+	}
+	if !canceled {
+		// Terminou entre a leitura e o cancelamento
+		return nil, models.ErrImportJobFinished
+	}
+
+	// Um job que nem começou não será reivindicado de novo; o arquivo pode sair já
+	if wasQueued {
+		s.removeFile(job.FilePath)
+	}
+
+	job, err = s.jobRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return dto.ImportJobToDTO(job), nil
+}
+
+// ErrorsFilePath retorna o caminho do CSV com todas as linhas que falharam no job
+func (s *ImportJobService) ErrorsFilePath(ctx context.Context, id, userID uint) (string, error) {
+	job, err := s.getOwnedJob(ctx, id, userID)
+	if err != nil {
+		return "", err
+	}
+	if job.Failed == 0 {
+		return "", models.ErrImportErrorReportNotFound
+	}
+	path := job.ErrorsFilePath()
+	if _, err := os.Stat(path); err != nil {
+		return "", models.ErrImportErrorReportNotFound
+	}
+	return path, nil
+}
+
+// getOwnedJob busca um job garantindo que foi enviado pelo usuário
+// Jobs de outros usuários retornam not found (sem revelar que existem)
+func (s *ImportJobService) getOwnedJob(ctx context.Context, id, userID uint) (*models.ImportJob, error) {
+	job, err := s.jobRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if job.UserID != userID {
+		return nil, models.ErrImportJobNotFound
+	}
+	return job, nil
+}
+
+// RunWorkers processa a fila com workers concorrentes até o contexto ser cancelado
+// Jobs interrompidos pelo desligamento voltam para a fila e são retomados do último lote gravado
+func (s *ImportJobService) RunWorkers(ctx context.Context, workers int, pollInterval time.Duration) {
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.work(ctx, pollInterval)
+		}()
+	}
+	wg.Wait()
+}
+
+// work reivindica e processa jobs; quando a fila está vazia, espera pollInterval
+func (s *ImportJobService) work(ctx context.Context, pollInterval time.Duration) {
+	for {
+		processed, err := s.ProcessNext(ctx)
+		if err != nil {
+			logger.Error().Err(err).Str("job", "import worker").Msg("Background job failed")
+		}
+		if processed && err == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// ProcessNext reivindica e executa o próximo job da fila
+// Retorna false quando não havia job disponível
+func (s *ImportJobService) ProcessNext(ctx context.Context) (bool, error) {
+	if ctx.Err() != nil {
+		return false, nil
+	}
+
+	job, err := s.jobRepo.Claim(ctx, time.Now().Add(-importJobStaleAfter))
+	if err != nil {
+		return false, fmt.Errorf("failed to claim import job: %w", err)
+	}
+	if job == nil {
+		return false, nil
+	}
+
+	return true, s.process(ctx, job)
+}
+
+// process executa um job reivindicado e grava seu estado final
+func (s *ImportJobService) process(ctx context.Context, job *models.ImportJob) error {
+	logger.Info().Uint("import_job", job.ID).Str("media_type", string(job.MediaType)).Int("attempt", job.Attempts).Msg("Import job started")
+
+	if job.Attempts > maxImportJobAttempts {
+		return s.finish(job, nil, fmt.Errorf("import job abandoned after %d attempts", maxImportJobAttempts))
+	}
+
+	upload, err := os.Open(job.FilePath)
+	if err != nil {
+		return s.finish(job, nil, fmt.Errorf("failed to open uploaded file: %w", err))
+	}
+	defer upload.Close()
+
+	// Um job interrompido no modo row (sem dry run) já commitou os lotes até o progresso gravado e
+	// continua dali; nos demais modos nada foi gravado e o arquivo é reprocessado do início
+	opts := dto.ImportOptions{Format: job.Format, Mode: job.Mode, Transaction: job.Transaction, DryRun: job.DryRun}
+	resume := job.Lines > 0 && job.Transaction == dto.ImportTransactionRow && !job.DryRun
+	if resume {
+		opts.ResumeFrom = &dto.ImportProgress{Lines: job.Lines, Imported: job.Imported, Failed: job.Failed}
+		logger.Info().Uint("import_job", job.ID).Int("line", job.Lines).Msg("Import job resumed")
+	}
+
+	// Na retomada, o relatório de erros continua o da tentativa anterior
+	flags := os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	if !resume {
+		flags |= os.O_TRUNC
+	}
+	errorsFile, err := os.OpenFile(job.ErrorsFilePath(), flags, 0o644)
+	if err != nil {
+		return s.finish(job, nil, fmt.Errorf("failed to create error report: %w", err))
+	}
+	errorsWriter := csv.NewWriter(errorsFile)
+	if info, statErr := errorsFile.Stat(); statErr == nil && info.Size() == 0 {
+		_ = errorsWriter.Write([]string{"line", "title", "error"})
+	}
+
+	// O progresso é o heartbeat do job e também o ponto em que um cancelamento é percebido
+	jobCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	// Os erros de um lote entram no relatório junto com o progresso, para que uma retomada não os repita
+	var pendingErrors [][]string
+	callbacks := ImportCallbacks{
+		OnProgress: func(progress dto.ImportProgress) {
+			for _, row := range pendingErrors {
+				_ = errorsWriter.Write(row)
+			}
+			pendingErrors = pendingErrors[:0]
+			errorsWriter.Flush()
+			job.Lines, job.Imported, job.Failed = progress.Lines, progress.Imported, progress.Failed
+			status, progressErr := s.jobRepo.UpdateProgress(ctx, job.ID, progress.Lines, progress.Imported, progress.Failed)
+			if progressErr != nil {
+				logger.Warn().Err(progressErr).Uint("import_job", job.ID).Msg("Failed to record import progress")
+				return
+			}
+			if status == models.ImportJobCanceled {
+				cancel()
+			}
+		},
+		OnError: func(importErr dto.ImportError) {
+			pendingErrors = append(pendingErrors, []string{strconv.Itoa(importErr.Line), importErr.Title, importErr.Error})
+		},
+	}
+
+	result, importErr := s.itemService.ImportItems(jobCtx, upload, job.MediaType, opts, callbacks)
+
+	errorsWriter.Flush()
+	if writeErr := errorsWriter.Error(); writeErr != nil {
+		logger.Warn().Err(writeErr).Uint("import_job", job.ID).Msg("Failed to write import error report")
+	}
+	if closeErr := errorsFile.Close(); closeErr != nil {
+		logger.Warn().Err(closeErr).Uint("import_job", job.ID).Msg("Failed to write import error report")
+	}
+
+	// Um import que terminou antes da interrupção é gravado normalmente
+	switch {
+	case importErr != nil && ctx.Err() != nil:
+		// Desligamento: devolve o job para a fila (com contexto próprio, pois ctx já foi cancelado)
+		requeueCtx, cancelRequeue := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancelRequeue()
+		if err := s.jobRepo.Requeue(requeueCtx, job.ID); err != nil {
+			return fmt.Errorf("failed to requeue import job %d: %w", job.ID, err)
+		}
+		logger.Info().Uint("import_job", job.ID).Msg("Import job requeued")
+		return nil
+	case importErr != nil && jobCtx.Err() != nil:
+		// Cancelado pelo usuário: o status já foi gravado por CancelJob
+		s.removeFile(job.FilePath)
+		logger.Info().Uint("import_job", job.ID).Msg("Import job canceled")
+		return nil
+	}
+
+	return s.finish(job, result, importErr)
+}
+
+// finish grava o resultado (ou a falha) de um job e remove o arquivo enviado
+func (s *ImportJobService) finish(job *models.ImportJob, result *dto.ImportResult, importErr error) error {
+	job.Status = models.ImportJobCompleted
+	if importErr != nil {
+		job.Status = models.ImportJobFailed
+		job.Error = importErr.Error()
+	}
+	if result != nil {
+		job.Lines, job.Imported, job.Failed = result.TotalLines, result.Imported, result.Failed
+		encoded, err := importResultToJSONB(result)
+		if err != nil {
+			return fmt.Errorf("failed to encode import result: %w", err)
+		}
+		job.Result = encoded
+	}
+
+	// O ctx do worker pode ter sido cancelado no fim do import; o estado final precisa ser gravado
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.jobRepo.Finish(ctx, job); err != nil {
+		return fmt.Errorf("failed to finish import job %d: %w", job.ID, err)
+	}
+
+	s.removeFile(job.FilePath)
+	logger.Info().Uint("import_job", job.ID).Str("status", string(job.Status)).
+		Int("imported", job.Imported).Int("failed", job.Failed).Msg("Import job finished")
+	return nil
+}
+
+// validateStart lê apenas o início do arquivo (headers do CSV, abertura do array JSON)
+func (s *ImportJobService) validateStart(path, format string, mediaType models.MediaType) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to read upload: %w", err)
+	}
+	defer file.Close()
+
+	_, err = newImportSource(file, format, mediaType)
+	return err
+}
+
+// removeFile apaga um arquivo do storage, apenas logando falhas
+func (s *ImportJobService) removeFile(path string) {
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		logger.Warn().Err(err).Str("path", path).Msg("Failed to remove import file")
+	}
+}
+
+// importResultToJSONB converte o resultado do import para gravação em coluna JSONB
+func importResultToJSONB(result *dto.ImportResult) (models.JSONB, error) {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	var encoded models.JSONB
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return nil, err
+	}
+	return encoded, nil
+}
@@ -0,0 +1,217 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/testutil"
+)
+
+// setupImportJobService cria o service com um job enfileirado a partir de csv
+func setupImportJobService(t *testing.T, csv string) (*ImportJobService, *testutil.MockImportJobRepository, *models.ImportJob) {
+	t.Helper()
+
+	var created []string
+	var txErr error
+	itemService := setupImportService(&created, &txErr)
+
+	var job *models.ImportJob
+	jobRepo := &testutil.MockImportJobRepository{
+		CreateFunc: func(ctx context.Context, j *models.ImportJob) error {
+			j.ID = 7
+			job = j
+			return nil
+		},
+		GetByIDFunc: func(ctx context.Context, id uint) (*models.ImportJob, error) {
+			return job, nil
+		},
+	}
+	service := NewImportJobService(jobRepo, itemService, t.TempDir())
+
+	if _, err := service.Enqueue(context.Background(), 1, models.MediaTypeAnime, dto.ImportOptions{}, "anime.csv", strings.NewReader(csv)); err != nil {
+		t.Fatalf("Expected import to be queued, got %v", err)
+	}
+	return service, jobRepo, job
+}
+
+func TestImportJobService_Enqueue(t *testing.T) {
+	_, _, job := setupImportJobService(t, importAnimeCSV)
+
+	if job.Status != models.ImportJobQueued || job.UserID != 1 || job.Mode != dto.ImportModeCreate || job.Transaction != dto.ImportTransactionRow {
+		t.Errorf("Expected queued job of user 1 with default options, got %+v", job)
+	}
+	data, err := os.ReadFile(job.FilePath)
+	if err != nil || string(data) != importAnimeCSV {
+		t.Errorf("Expected upload stored at %s, got %q (%v)", job.FilePath, data, err)
+	}
+}
+
+func TestImportJobService_EnqueueRejectsInvalidHeaders(t *testing.T) {
+	dir := t.TempDir()
+	service := NewImportJobService(&testutil.MockImportJobRepository{}, NewItemService(&testutil.MockItemRepository{}, &testutil.MockTagRepository{}, nil, nil), dir)
+
+	_, err := service.Enqueue(context.Background(), 1, models.MediaTypeAnime, dto.ImportOptions{}, "movies.csv", strings.NewReader("title,runtime,director\nAlien,117,Scott\n"))
+	if !errors.Is(err, models.ErrInvalidImportFile) {
+		t.Fatalf("Expected ErrInvalidImportFile, got %v", err)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("Expected rejected upload to be removed, got %d files", len(entries))
+	}
+}
+
+func TestImportJobService_ProcessNext(t *testing.T) {
+	service, jobRepo, job := setupImportJobService(t, importAnimeCSV)
+
+	var finished *models.ImportJob
+	jobRepo.ClaimFunc = func(ctx context.Context, staleBefore time.Time) (*models.ImportJob, error) {
+		if finished != nil {
+			return nil, nil
+		}
+		job.Status = models.ImportJobRunning
+		job.Attempts = 1
+		return job, nil
+	}
+	jobRepo.FinishFunc = func(ctx context.Context, j *models.ImportJob) error {
+		finished = j
+		return nil
+	}
+
+	processed, err := service.ProcessNext(context.Background())
+	if err != nil || !processed {
+		t.Fatalf("Expected job to be processed, got processed=%v err=%v", processed, err)
+	}
+	if finished == nil || finished.Status != models.ImportJobCompleted || finished.Imported != 2 || finished.Failed != 1 {
+		t.Fatalf("Expected completed job with 2 imported and 1 failed, got %+v", finished)
+	}
+	if finished.Result["created"] != float64(2) {
+		t.Errorf("Expected result stored on the job, got %v", finished.Result)
+	}
+
+	// O arquivo enviado sai; o relatório de erros fica disponível
+	if _, err := os.Stat(job.FilePath); !os.IsNotExist(err) {
+		t.Errorf("Expected upload to be removed, got %v", err)
+	}
+	path, err := service.ErrorsFilePath(context.Background(), job.ID, 1)
+	if err != nil {
+		t.Fatalf("Expected error report, got %v", err)
+	}
+	report, _ := os.ReadFile(path)
+	if lines := strings.Split(strings.TrimSpace(string(report)), "\n"); len(lines) != 2 || !strings.HasPrefix(lines[1], "3,") {
+		t.Errorf("Expected header and line 3 in error report, got %q", report)
+	}
+
+	processed, err = service.ProcessNext(context.Background())
+	if err != nil || processed {
+		t.Errorf("Expected empty queue, got processed=%v err=%v", processed, err)
+	}
+}
+
+func TestImportJobService_ProcessNextResumesRequeuedJob(t *testing.T) {
+	service, jobRepo, job := setupImportJobService(t, importAnimeCSV)
+
+	// Tentativa anterior interrompida após gravar as duas primeiras linhas (Frieren ok, linha 3 com erro)
+	job.Lines, job.Imported, job.Failed = 2, 1, 1
+	if err := os.WriteFile(job.ErrorsFilePath(), []byte("line,title,error\n3,Broken,invalid episodes\n"), 0o644); err != nil {
+		t.Fatalf("Failed to write previous error report: %v", err)
+	}
+
+	jobRepo.ClaimFunc = func(ctx context.Context, staleBefore time.Time) (*models.ImportJob, error) {
+		job.Status = models.ImportJobRunning
+		job.Attempts = 2
+		return job, nil
+	}
+	var finished *models.ImportJob
+	jobRepo.FinishFunc = func(ctx context.Context, j *models.ImportJob) error {
+		finished = j
+		return nil
+	}
+
+	if _, err := service.ProcessNext(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if finished == nil || finished.Lines != 3 || finished.Imported != 2 || finished.Failed != 1 {
+		t.Fatalf("Expected counters to continue from the stored progress, got %+v", finished)
+	}
+	if finished.Result["created"] != float64(1) || finished.Result["resumed_from_line"] != float64(2) {
+		t.Errorf("Expected only the remaining line to be imported, got %v", finished.Result)
+	}
+
+	report, _ := os.ReadFile(job.ErrorsFilePath())
+	if lines := strings.Split(strings.TrimSpace(string(report)), "\n"); len(lines) != 2 || !strings.HasPrefix(lines[1], "3,") {
+		t.Errorf("Expected error report of the previous attempt kept without duplicates, got %q", report)
+	}
+}
+
+func TestImportJobService_ProcessNextStopsWhenCanceled(t *testing.T) {
+	var b strings.Builder
+	b.WriteString("title,episodes,studio\n")
+	for i := 0; i < importBatchSize*2; i++ {
+		b.WriteString("Anime,12,Studio\n")
+	}
+	service, jobRepo, job := setupImportJobService(t, b.String())
+
+	jobRepo.ClaimFunc = func(ctx context.Context, staleBefore time.Time) (*models.ImportJob, error) {
+		job.Status = models.ImportJobRunning
+		return job, nil
+	}
+	var progressCalls int
+	jobRepo.UpdateProgressFunc = func(ctx context.Context, id uint, lines, imported, failed int) (models.ImportJobStatus, error) {
+		progressCalls++
+		return models.ImportJobCanceled, nil
+	}
+	jobRepo.FinishFunc = func(ctx context.Context, j *models.ImportJob) error {
+		t.Error("Expected canceled job not to be finished")
+		return nil
+	}
+
+	if _, err := service.ProcessNext(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if progressCalls != 1 || job.Lines != importBatchSize {
+		t.Errorf("Expected import to stop after the first batch, got %d progress calls at line %d", progressCalls, job.Lines)
+	}
+}
+
+func TestImportJobService_JobOfAnotherUser(t *testing.T) {
+	service, jobRepo, job := setupImportJobService(t, importAnimeCSV)
+	jobRepo.CancelFunc = func(ctx context.Context, id uint) (bool, error) {
+		t.Error("Expected job of another user not to be canceled")
+		return false, nil
+	}
+
+	if _, err := service.GetJob(context.Background(), job.ID, 2); !errors.Is(err, models.ErrImportJobNotFound) {
+		t.Errorf("Expected ErrImportJobNotFound on get, got %v", err)
+	}
+	if _, err := service.CancelJob(context.Background(), job.ID, 2); !errors.Is(err, models.ErrImportJobNotFound) {
+		t.Errorf("Expected ErrImportJobNotFound on cancel, got %v", err)
+	}
+	if _, err := service.ErrorsFilePath(context.Background(), job.ID, 2); !errors.Is(err, models.ErrImportJobNotFound) {
+		t.Errorf("Expected ErrImportJobNotFound on errors download, got %v", err)
+	}
+}
+
+func TestImportJobService_CancelJob(t *testing.T) {
+	service, jobRepo, job := setupImportJobService(t, importAnimeCSV)
+
+	jobRepo.CancelFunc = func(ctx context.Context, id uint) (bool, error) {
+		job.Status = models.ImportJobCanceled
+		return true, nil
+	}
+	canceled, err := service.CancelJob(context.Background(), job.ID, 1)
+	if err != nil || canceled.Status != string(models.ImportJobCanceled) {
+		t.Fatalf("Expected job canceled, got %+v (%v)", canceled, err)
+	}
+	if _, err := os.Stat(job.FilePath); !os.IsNotExist(err) {
+		t.Errorf("Expected upload of a queued job to be removed, got %v", err)
+	}
+
+	if _, err := service.CancelJob(context.Background(), job.ID, 1); !errors.Is(err, models.ErrImportJobFinished) {
+		t.Errorf("Expected ErrImportJobFinished, got %v", err)
+	}
+}
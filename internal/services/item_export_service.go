@@ -0,0 +1,358 @@
+package services
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+)
+
+// exportBatchSize é o número de items lidos do banco e escritos por vez
+const exportBatchSize = 500
+
+// ExportItems escreve o catálogo em w no formato pedido (csv, json ou ndjson), lendo em lotes
+// Com mediaType vazio exporta todos os tipos; o CSV então ganha a coluna type, como no import misto
+// O arquivo gerado pode ser importado sem alterações: por tipo em /items/import/{type} ou misto em /items/import
+func (s *ItemService) ExportItems(ctx context.Context, w io.Writer, mediaType models.MediaType, format string) error {
+	if mediaType != "" && !mediaType.IsValid() {
+		return models.ErrInvalidMediaType
+	}
+
+	writer, err := newItemExportWriter(w, format, mediaType)
+	if err != nil {
+		return err
+	}
+
+	var afterID uint
+	for {
+		items, err := s.itemRepo.ListAfterID(ctx, mediaType, afterID, exportBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list items: %w", err)
+		}
+
+		for i := range items {
+			if err := writer.write(&items[i]); err != nil {
+				return err
+			}
+		}
+		// Cada lote vai para o cliente antes de o próximo ser lido
+		if err := writer.flush(); err != nil {
+			return err
+		}
+
+		if len(items) < exportBatchSize {
+			return writer.close()
+		}
+		afterID = items[len(items)-1].ID
+	}
+}
+
+// itemExportWriter escreve items em um formato de arquivo
+type itemExportWriter interface {
+	write(item *models.Item) error
+	flush() error
+	close() error // Finaliza o arquivo (ex: fecha o array JSON)
+}
+
+func newItemExportWriter(w io.Writer, format string, mediaType models.MediaType) (itemExportWriter, error) {
+	switch format {
+	case "", dto.ImportFormatCSV:
+		return newCSVExportWriter(w, mediaType)
+	case dto.ImportFormatJSON:
+		return &jsonExportWriter{writer: bufio.NewWriter(w)}, nil
+	case dto.ImportFormatNDJSON:
+		return &jsonExportWriter{writer: bufio.NewWriter(w), lines: true}, nil
+	default:
+		return nil, fmt.Errorf("unsupported export format: %s", format)
+	}
+}
+
+// csvExportWriter escreve as colunas aceitas pelo import do tipo (ou do import misto)
+type csvExportWriter struct {
+	writer  *csv.Writer
+	headers []string
+}
+
+func newCSVExportWriter(w io.Writer, mediaType models.MediaType) (*csvExportWriter, error) {
+	headers := exportHeadersForType(mediaType)
+	writer := csv.NewWriter(w)
+	if err := writer.Write(headers); err != nil {
+		return nil, fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return &csvExportWriter{writer: writer, headers: headers}, nil
+}
+
+func (e *csvExportWriter) write(item *models.Item) error {
+	fields := specificExportFields(item)
+	record := make([]string, len(e.headers))
+
+	for i, header := range e.headers {
+		switch header {
+		case "type":
+			record[i] = string(item.Type)
+		case "title":
+			record[i] = item.Title
+		case "description":
+			record[i] = item.Description
+		case "release_date":
+			record[i] = exportReleaseDate(item)
+		case "cover_url":
+			record[i] = item.CoverURL
+		case "tags":
+			record[i] = strings.Join(exportTagNames(item), "|")
+		case "external_metadata":
+			record[i] = formatExternalMetadata(item.ExternalMetadata)
+		default:
+			if value, ok := fields[header]; ok {
+				record[i] = exportScalar(value)
+			}
+		}
+	}
+
+	if err := e.writer.Write(record); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return nil
+}
+
+func (e *csvExportWriter) flush() error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+func (e *csvExportWriter) close() error {
+	return e.flush()
+}
+
+// jsonExportWriter escreve um array JSON ou, com lines, um item por linha (NDJSON)
+type jsonExportWriter struct {
+	writer *bufio.Writer
+	lines  bool
+	count  int
+}
+
+func (e *jsonExportWriter) write(item *models.Item) error {
+	data, err := json.Marshal(itemToFileRecord(item))
+	if err != nil {
+		return fmt.Errorf("failed to encode item %d: %w", item.ID, err)
+	}
+	return e.writeRecord(data)
+}
+
+// writeRecord escreve um registro já codificado como elemento do array ou linha do NDJSON
+func (e *jsonExportWriter) writeRecord(data []byte) error {
+	prefix := ",\n"
+	switch {
+	case e.lines:
+		prefix = ""
+	case e.count == 0:
+		prefix = "[\n"
+	}
+	e.count++
+
+	if _, err := e.writer.WriteString(prefix); err != nil {
+		return err
+	}
+	if _, err := e.writer.Write(data); err != nil {
+		return err
+	}
+	if e.lines {
+		return e.writer.WriteByte('\n')
+	}
+	return nil
+}
+
+func (e *jsonExportWriter) flush() error {
+	return e.writer.Flush()
+}
+
+func (e *jsonExportWriter) close() error {
+	if !e.lines {
+		closing := "\n]\n"
+		if e.count == 0 {
+			closing = "[]\n"
+		}
+		if _, err := e.writer.WriteString(closing); err != nil {
+			return err
+		}
+	}
+	return e.flush()
+}
+
+// specificColumnsForType retorna as colunas específicas do tipo aceitas pelo import (obrigatórias e opcionais)
+func specificColumnsForType(mediaType models.MediaType) []string {
+	switch mediaType {
+	case models.MediaTypeAnime:
+		return []string{"episodes", "studio"}
+	case models.MediaTypeComic:
+		return []string{"chapters", "volumes", "author", "format", "publisher"}
+	case models.MediaTypeNovel:
+		return []string{"volumes", "chapters", "author", "format", "publisher"}
+	case models.MediaTypeMovie:
+		return []string{"runtime", "director"}
+	case models.MediaTypeSeries:
+		return []string{"seasons", "episodes"}
+	case models.MediaTypeGame:
+		return []string{"platform", "developer"}
+	case models.MediaTypeBook:
+		return []string{"pages", "author", "publisher"}
+	default:
+		return nil
+	}
+}
+
+// exportHeadersForType monta o header do CSV na mesma ordem dos templates de import
+// Sem tipo, inclui a coluna type e as colunas específicas de todos os tipos
+func exportHeadersForType(mediaType models.MediaType) []string {
+	headers := []string{"title", "description", "release_date", "cover_url", "tags"}
+
+	if mediaType != "" {
+		headers = append(headers, specificColumnsForType(mediaType)...)
+	} else {
+		headers = append([]string{"type"}, headers...)
+		seen := make(map[string]bool)
+		for _, t := range models.ValidMediaTypes {
+			for _, column := range specificColumnsForType(t) {
+				if !seen[column] {
+					seen[column] = true
+					headers = append(headers, column)
+				}
+			}
+		}
+	}
+
+	return append(headers, "external_metadata")
+}
+
+// specificExportFields retorna os dados específicos do item pelos nomes das colunas de import
+// Campos vazios (zero) são omitidos, como uma coluna em branco no CSV
+func specificExportFields(item *models.Item) map[string]interface{} {
+	fields := make(map[string]interface{})
+	set := func(name string, value interface{}) {
+		switch v := value.(type) {
+		case int:
+			if v != 0 {
+				fields[name] = v
+			}
+		case string:
+			if v != "" {
+				fields[name] = v
+			}
+		}
+	}
+
+	switch item.Type {
+	case models.MediaTypeAnime:
+		if data := item.AnimeData; data != nil {
+			set("episodes", data.Episodes)
+			set("studio", data.Studio)
+		}
+	case models.MediaTypeMovie:
+		if data := item.MovieData; data != nil {
+			set("runtime", data.Runtime)
+			set("director", data.Director)
+		}
+	case models.MediaTypeSeries:
+		if data := item.SeriesData; data != nil {
+			set("seasons", data.Seasons)
+			set("episodes", data.Episodes)
+		}
+	case models.MediaTypeGame:
+		if data := item.GameData; data != nil {
+			set("platform", data.Platform)
+			set("developer", data.Developer)
+		}
+	case models.MediaTypeComic, models.MediaTypeNovel, models.MediaTypeBook:
+		if data := item.BookData; data != nil {
+			set("author", data.Author)
+			set("publisher", data.Publisher)
+			if item.Type == models.MediaTypeBook {
+				set("pages", data.Pages)
+			} else {
+				set("chapters", data.Chapters)
+				set("volumes", data.Volumes)
+				set("format", data.Format)
+			}
+		}
+	}
+
+	return fields
+}
+
+// itemToFileRecord converte o item para o formato JSON/NDJSON do import genérico
+func itemToFileRecord(item *models.Item) dto.ItemFileRecord {
+	record := dto.ItemFileRecord{
+		Type:        string(item.Type),
+		Title:       item.Title,
+		Description: item.Description,
+		ReleaseDate: exportReleaseDate(item),
+		CoverURL:    item.CoverURL,
+		Tags:        exportTagNames(item),
+	}
+
+	if len(item.ExternalMetadata) > 0 {
+		record.ExternalMetadata = item.ExternalMetadata
+	}
+	if fields := specificExportFields(item); len(fields) > 0 {
+		record.SpecificData = fields
+	}
+
+	return record
+}
+
+func exportReleaseDate(item *models.Item) string {
+	if item.ReleaseDate == nil {
+		return ""
+	}
+	return item.ReleaseDate.Format("2006-01-02")
+}
+
+func exportTagNames(item *models.Item) []string {
+	names := make([]string, 0, len(item.Tags))
+	for _, tag := range item.Tags {
+		names = append(names, tag.Name)
+	}
+	return names
+}
+
+// formatExternalMetadata converte o JSONB para "source:id|source:id" (ordenado por source), o inverso de parseExternalMetadata
+func formatExternalMetadata(metadata models.JSONB) string {
+	sources := make([]string, 0, len(metadata))
+	for source := range metadata {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+
+	pairs := make([]string, 0, len(sources))
+	for _, source := range sources {
+		if id := exportScalar(metadata[source]); id != "" {
+			pairs = append(pairs, source+":"+id)
+		}
+	}
+	return strings.Join(pairs, "|")
+}
+
+// exportScalar converte um valor para o texto da coluna CSV
+// IDs numéricos salvos como número no JSONB (float64) são escritos sem notação científica
+func exportScalar(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
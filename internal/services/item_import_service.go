@@ -2,154 +2,766 @@ package services
 
 import (
 	"context"
-	"encoding/csv"
 	"errors"
 	"fmt"
 	"io"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/rafaelc-rb/geekery-api/internal/dto"
 	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/repositories"
 )
 
-// ImportItemsFromCSV importa múltiplos items de um arquivo CSV
-func (s *ItemService) ImportItemsFromCSV(ctx context.Context, reader io.Reader, mediaType models.MediaType) (*dto.ImportResult, error) {
-	// Validar tipo
+const (
+	importBatchSize = 500  // Linhas gravadas por lote
+	maxImportErrors = 100  // Erros detalhados no resultado (os demais são apenas contados)
+	maxImportRows   = 1000 // Linhas detalhadas no resultado
+)
+
+var (
+	// errImportRollback sinaliza que o import deve sofrer rollback (dry run ou falha no modo file)
+	errImportRollback = errors.New("import rolled back")
+	errImportEmpty    = errors.New("CSV file is empty or has no data rows")
+)
+
+// ImportCallbacks recebe eventos de um import em andamento (todos os campos são opcionais)
+type ImportCallbacks struct {
+	OnProgress func(progress dto.ImportProgress) // Chamado a cada lote processado
+	OnError    func(importErr dto.ImportError)   // Chamado para toda linha com erro, mesmo além do limite do resultado
+}
+
+// ImportItemsFromCSV importa múltiplos items de um tipo a partir de um arquivo CSV
+func (s *ItemService) ImportItemsFromCSV(ctx context.Context, reader io.Reader, mediaType models.MediaType, opts dto.ImportOptions, callbacks ImportCallbacks) (*dto.ImportResult, error) {
 	if !mediaType.IsValid() {
 		return nil, fmt.Errorf("invalid media type: %s", mediaType)
 	}
+	opts.Format = dto.ImportFormatCSV
+	return s.ImportItems(ctx, reader, mediaType, opts, callbacks)
+}
+
+// ImportItems importa múltiplos items de um arquivo CSV, JSON (array) ou NDJSON (opts.Format)
+// Com mediaType vazio, o import é misto e cada linha informa o próprio tipo (coluna ou campo type)
+// O arquivo é lido em streaming e gravado em lotes, com memória limitada independentemente do tamanho
+// No modo row, cada lote é commitado ao ser gravado e linhas com erro são puladas;
+// no modo file, qualquer falha desfaz o arquivo inteiro
+// Com DryRun, o import roda até o fim e sofre rollback, reportando o que seria criado
+// callbacks permite acompanhar o andamento e coletar todos os erros (ex: jobs em background)
+func (s *ItemService) ImportItems(ctx context.Context, reader io.Reader, mediaType models.MediaType, opts dto.ImportOptions, callbacks ImportCallbacks) (*dto.ImportResult, error) {
+	if mediaType != "" && !mediaType.IsValid() {
+		return nil, fmt.Errorf("invalid media type: %s", mediaType)
+	}
+	if opts.Format == "" {
+		opts.Format = dto.ImportFormatCSV
+	}
 
-	// Parse CSV
-	csvReader := csv.NewReader(reader)
-	csvReader.TrimLeadingSpace = true
-	records, err := csvReader.ReadAll()
+	// Ler o início do arquivo (headers do CSV, abertura do array JSON); as linhas são lidas em streaming
+	source, err := newImportSource(reader, opts.Format, mediaType)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse CSV: %w", err)
+		return nil, err
 	}
 
-	if len(records) < 2 {
-		return nil, errors.New("CSV file is empty or has no data rows")
+	if opts.Mode == "" {
+		opts.Mode = dto.ImportModeCreate
 	}
-
-	// Validar headers
-	headers := records[0]
-	if err := s.validateHeadersForType(headers, mediaType); err != nil {
-		return nil, err
+	if opts.Transaction == "" {
+		opts.Transaction = dto.ImportTransactionRow
 	}
 
-	result := &dto.ImportResult{
-		Success:    true,
-		MediaType:  string(mediaType),
-		TotalLines: len(records) - 1,
-		Errors:     []dto.ImportError{},
+	imp := &itemImport{
+		service:   s,
+		source:    source,
+		mediaType: mediaType,
+		opts:      opts,
+		tags:      newImportTagCache(),
+		callbacks: callbacks,
+		result: &dto.ImportResult{
+			Format:      opts.Format,
+			MediaType:   string(mediaType),
+			Mode:        opts.Mode,
+			Transaction: opts.Transaction,
+			DryRun:      opts.DryRun,
+			Rows:        []dto.ImportRowResult{},
+			Errors:      []dto.ImportError{},
+		},
+	}
+	if opts.ResumeFrom != nil {
+		imp.skip = opts.ResumeFrom.Lines
+		imp.result.ResumedFromLine = opts.ResumeFrom.Lines
+		imp.result.TotalLines, imp.result.Imported, imp.result.Failed = opts.ResumeFrom.Lines, opts.ResumeFrom.Imported, opts.ResumeFrom.Failed
 	}
 
 	// Processar linhas
-	err = s.processImportInTransaction(ctx, records[1:], headers, mediaType, result)
+	err = s.processImportInTransaction(ctx, imp)
 
 	if err != nil {
+		if errors.Is(err, errImportEmpty) {
+			return nil, err
+		}
 		return nil, fmt.Errorf("import failed: %w", err)
 	}
 
+	result := imp.result
+	result.Success = !result.RolledBack
+	if result.Created+result.Updated > 0 && !result.DryRun {
+		s.similarCache.Invalidate()
+	}
+
 	return result, nil
 }
 
-// processImportInTransaction processa o import (chamado por ImportItemsFromCSV)
-func (s *ItemService) processImportInTransaction(ctx context.Context, records [][]string, headers []string, mediaType models.MediaType, result *dto.ImportResult) error {
-	for i, record := range records {
-		lineNum := i + 2 // Linha real no CSV (1-indexed + header)
+// processImportInTransaction grava os lotes do import
+// No modo row (sem dry run), cada lote é uma transação própria, evitando uma transação longa em arquivos grandes;
+// nos demais modos, todos os lotes rodam em savepoints de uma única transação, desfeita ao final se necessário
+func (s *ItemService) processImportInTransaction(ctx context.Context, imp *itemImport) error {
+	result := imp.result
 
-		// Parse linha para Item
-		item, specificData, tagNames, err := s.parseCSVRecordByType(headers, record, mediaType)
-		if err != nil {
-			result.Errors = append(result.Errors, dto.ImportError{
-				Line:  lineNum,
-				Title: getFieldValue(headers, record, "title"),
-				Error: err.Error(),
-			})
-			result.Failed++
+	if imp.opts.Transaction == dto.ImportTransactionRow && !imp.opts.DryRun {
+		return imp.run(ctx, s.uow)
+	}
+
+	err := s.uow.Do(ctx, func(tx *repositories.Repositories) error {
+		if err := imp.run(ctx, tx.UnitOfWork); err != nil {
+			return err
+		}
+
+		if imp.opts.Transaction == dto.ImportTransactionFile && result.Failed > 0 {
+			result.RolledBack = true
+			return errImportRollback
+		}
+		if imp.opts.DryRun {
+			return errImportRollback
+		}
+		return nil
+	})
+
+	if err != nil && !errors.Is(err, errImportRollback) {
+		return err
+	}
+
+	if result.RolledBack {
+		// Nenhuma linha foi (ou seria) gravada
+		result.Imported, result.Created, result.Updated, result.Unchanged = 0, 0, 0, 0
+		for i := range result.Rows {
+			if result.Rows[i].Action == dto.ImportActionCreate {
+				result.Rows[i].ItemID = 0
+			}
+		}
+	}
+	return nil
+}
+
+// itemImport guarda o estado de um import em andamento
+type itemImport struct {
+	service   *ItemService
+	source    importSource
+	mediaType models.MediaType // Vazio em imports mistos
+	opts      dto.ImportOptions
+	tags      *importTagCache
+	callbacks ImportCallbacks
+	result    *dto.ImportResult
+	skip      int // Linhas já processadas antes de uma retomada
+}
+
+// importLine é uma linha de dados já convertida para item
+type importLine struct {
+	num          int          // Linha real no arquivo (CSV: 1-indexed + header; JSON: posição no array)
+	record       importRecord // Registro original, usado para reprocessar a linha isoladamente
+	item         *models.Item
+	specificData interface{}
+	tagNames     []string
+}
+
+// importOutcome é o resultado de uma linha gravada em um lote
+type importOutcome struct {
+	action string
+	itemID uint
+	err    error
+}
+
+// run lê o arquivo em streaming e grava as linhas em lotes usando uow (transações ou savepoints)
+func (imp *itemImport) run(ctx context.Context, uow repositories.UnitOfWorkInterface) error {
+	batch := make([]importLine, 0, importBatchSize)
+
+	for {
+		lineNum, record, err := imp.source.next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if imp.skip > 0 {
+			// Retomada: a linha já foi gravada (ou falhou) antes da interrupção
+			var rowErr *importRowError
+			if err != nil && !errors.As(err, &rowErr) {
+				return err
+			}
+			imp.skip--
 			continue
 		}
+		imp.result.TotalLines++
 
-		// Criar item usando o service normal
-		if err := s.createItemWithTags(ctx, item, specificData, tagNames); err != nil {
-			result.Errors = append(result.Errors, dto.ImportError{
-				Line:  lineNum,
-				Title: item.Title,
-				Error: err.Error(),
-			})
-			result.Failed++
+		if err != nil {
+			var rowErr *importRowError
+			if errors.As(err, &rowErr) {
+				imp.fail(lineNum, rowErr.title, rowErr.err)
+				continue
+			}
+			return err
+		}
+
+		line, err := imp.parse(lineNum, record)
+		if err != nil {
+			imp.fail(lineNum, record.field("title"), err)
 			continue
 		}
 
-		result.Imported++
+		batch = append(batch, line)
+		if len(batch) == importBatchSize {
+			if err := imp.flush(ctx, uow, batch); err != nil {
+				return err
+			}
+			batch = batch[:0]
+		}
 	}
 
-	return nil
+	if imp.result.TotalLines == 0 {
+		return errImportEmpty
+	}
+	return imp.flush(ctx, uow, batch)
+}
+
+// parse converte um registro em uma linha do import
+// Em imports mistos, o tipo vem do próprio registro
+func (imp *itemImport) parse(lineNum int, record importRecord) (importLine, error) {
+	mediaType := imp.mediaType
+	if mediaType == "" {
+		mediaType = models.MediaType(strings.ToLower(record.field("type")))
+		if !mediaType.IsValid() {
+			return importLine{}, fmt.Errorf("invalid or missing type: '%s'", record.field("type"))
+		}
+	}
+
+	item, specificData, tagNames, err := parseImportRecord(record, mediaType)
+	if err != nil {
+		return importLine{}, err
+	}
+	return importLine{num: lineNum, record: record, item: item, specificData: specificData, tagNames: normalizeTagNames(tagNames)}, nil
 }
 
-// createItemWithTags cria um item com dados específicos e tags
-func (s *ItemService) createItemWithTags(ctx context.Context, item *models.Item, specificData interface{}, tagNames []string) error {
-	// Validar item
-	if err := item.Validate(); err != nil {
+// flush grava um lote em uma transação (ou savepoint)
+// Se a gravação do lote falhar, ele é desfeito e as linhas são regravadas uma a uma para isolar as que falham
+func (imp *itemImport) flush(ctx context.Context, uow repositories.UnitOfWorkInterface, batch []importLine) error {
+	if len(batch) == 0 {
+		return nil
+	}
+	// Import cancelado (ex: job cancelado): interrompe antes de gravar o próximo lote
+	if err := ctx.Err(); err != nil {
 		return err
 	}
 
-	// Verificar se já existe item com mesmo título e tipo
-	existingItems, _, err := s.itemRepo.SearchByTitle(ctx, item.Title, dto.PaginationParams{Page: 1, Limit: 10})
+	var outcomes []importOutcome
+	err := uow.Do(ctx, func(tx *repositories.Repositories) error {
+		var batchErr error
+		outcomes, batchErr = imp.writeBatch(ctx, tx, batch)
+		return batchErr
+	})
+
 	if err == nil {
-		for _, existing := range existingItems {
-			// Comparação case-insensitive do título e tipo exato
-			if strings.EqualFold(existing.Title, item.Title) && existing.Type == item.Type {
-				return fmt.Errorf("item '%s' (type: %s) already exists with ID %d", item.Title, item.Type, existing.ID)
+		imp.tags.commit()
+		for i, line := range batch {
+			imp.record(line, outcomes[i])
+		}
+	} else {
+		imp.tags.discard()
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+
+		for _, line := range batch {
+			// Reprocessa a partir do registro original: os objetos do lote desfeito podem ter IDs atribuídos
+			fresh, parseErr := imp.parse(line.num, line.record)
+			if parseErr != nil {
+				imp.fail(line.num, line.item.Title, parseErr)
+				continue
+			}
+
+			var outcome importOutcome
+			rowErr := uow.Do(ctx, func(tx *repositories.Repositories) error {
+				lineOutcomes, lineErr := imp.writeBatch(ctx, tx, []importLine{fresh})
+				if lineErr != nil {
+					return lineErr
+				}
+				outcome = lineOutcomes[0]
+				return nil
+			})
+			if rowErr != nil {
+				imp.tags.discard()
+				if ctxErr := ctx.Err(); ctxErr != nil {
+					return ctxErr
+				}
+				outcome = importOutcome{err: rowErr}
+			} else {
+				imp.tags.commit()
 			}
+			imp.record(fresh, outcome)
 		}
 	}
 
-	// Criar item base
-	if err := s.itemRepo.Create(ctx, item); err != nil {
-		return fmt.Errorf("failed to create item: %w", err)
+	if imp.callbacks.OnProgress != nil {
+		imp.callbacks.OnProgress(dto.ImportProgress{
+			Lines:    imp.result.TotalLines,
+			Imported: imp.result.Imported,
+			Failed:   imp.result.Failed,
+		})
 	}
+	return nil
+}
 
-	// Criar dados específicos
-	if specificData != nil {
-		if err := s.itemRepo.CreateSpecificData(ctx, item.ID, item.Type, specificData); err != nil {
-			return fmt.Errorf("failed to create specific data: %w", err)
-		}
+// writeBatch grava um lote de linhas: busca as correspondências no catálogo com poucas consultas,
+// atualiza os items encontrados (modo upsert) e cria os demais com inserts em lote
+// Erros de validação ficam no resultado da linha; o erro retornado indica falha de gravação do lote
+func (imp *itemImport) writeBatch(ctx context.Context, tx *repositories.Repositories, batch []importLine) ([]importOutcome, error) {
+	outcomes := make([]importOutcome, len(batch))
+	upsert := imp.opts.Mode == dto.ImportModeUpsert
+
+	index, err := imp.lookupBatch(ctx, tx, batch, upsert)
+	if err != nil {
+		return nil, err
 	}
 
-	// Associar tags (find or create)
-	if len(tagNames) > 0 {
-		tagIDs := []uint{}
-		for _, name := range tagNames {
-			name = strings.ToLower(strings.TrimSpace(name))
-			if name == "" {
+	var (
+		toCreate  []*models.Item
+		createdAt []int
+		deferred  []int // Linhas que dependem de items criados neste mesmo lote
+	)
+	pending := make(map[string]int)
+
+	for i, line := range batch {
+		item := line.item
+		if err := item.Validate(); err != nil {
+			outcomes[i].err = err
+			continue
+		}
+		keys := importKeys(item)
+
+		if !upsert {
+			if existing := index.byTitle[titleKey(item.Type, item.Title)]; len(existing) > 0 {
+				outcomes[i].err = fmt.Errorf("item '%s' (type: %s) already exists with ID %d", item.Title, item.Type, existing[0].ID)
+				continue
+			}
+			if j, ok := pending[keys[0]]; ok {
+				outcomes[i].err = fmt.Errorf("item '%s' (type: %s) already exists at line %d", item.Title, item.Type, batch[j].num)
+				continue
+			}
+		} else {
+			if pendingIndex(pending, keys) >= 0 {
+				deferred = append(deferred, i)
 				continue
 			}
 
-			// Find or create tag
-			tag := &models.Tag{Name: name}
-			if err := s.tagRepo.FindOrCreate(ctx, tag); err != nil {
-				return fmt.Errorf("failed to find/create tag '%s': %w", name, err)
+			match, err := index.match(item)
+			if err != nil {
+				outcomes[i].err = err
+				continue
+			}
+			if match != 0 {
+				existing, err := tx.Items.GetByID(ctx, match)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get matched item: %w", err)
+				}
+				changed, err := updateImportedItem(ctx, tx, imp.tags, existing, item, line.specificData, line.tagNames)
+				if err != nil {
+					return nil, err
+				}
+				outcomes[i] = importOutcome{action: dto.ImportActionUnchanged, itemID: existing.ID}
+				if changed {
+					outcomes[i].action = dto.ImportActionUpdate
+				}
+				continue
 			}
-			tagIDs = append(tagIDs, tag.ID)
 		}
 
-		if len(tagIDs) > 0 {
-			if err := s.itemRepo.AssociateTags(ctx, item.ID, tagIDs); err != nil {
-				return fmt.Errorf("failed to associate tags: %w", err)
+		tagIDs, err := imp.tags.resolve(ctx, tx.Tags, line.tagNames)
+		if err != nil {
+			return nil, err
+		}
+		for _, tagID := range tagIDs {
+			item.Tags = append(item.Tags, models.Tag{ID: tagID})
+		}
+		setSpecificData(item, line.specificData)
+
+		for _, key := range keys {
+			pending[key] = i
+		}
+		toCreate = append(toCreate, item)
+		createdAt = append(createdAt, i)
+	}
+
+	if err := tx.Items.CreateBatch(ctx, toCreate); err != nil {
+		return nil, fmt.Errorf("failed to create items: %w", err)
+	}
+	for n, i := range createdAt {
+		outcomes[i] = importOutcome{action: dto.ImportActionCreate, itemID: toCreate[n].ID}
+	}
+
+	// Repetições de linhas criadas neste lote: gravadas agora, encontrando os items recém-criados
+	for _, i := range deferred {
+		var outcome importOutcome
+		err := tx.UnitOfWork.Do(ctx, func(sp *repositories.Repositories) error {
+			lineOutcomes, err := imp.writeBatch(ctx, sp, batch[i:i+1])
+			if err != nil {
+				return err
 			}
+			outcome = lineOutcomes[0]
+			return nil
+		})
+		if err != nil {
+			outcome = importOutcome{err: err}
 		}
+		outcomes[i] = outcome
 	}
 
-	return nil
+	return outcomes, nil
+}
+
+// importIndex guarda os items do catálogo candidatos às linhas de um lote
+// As chaves incluem o tipo, pois um lote de import misto tem items de vários tipos
+type importIndex struct {
+	byTitle    map[string][]models.Item   // Tipo + título normalizado -> items
+	byExternal map[string]map[string]uint // Tipo + fonte -> ID externo -> item
+}
+
+// lookupBatch busca os candidatos de um lote: por tipo, uma consulta por título e, no upsert, uma por fonte de ID externo
+func (imp *itemImport) lookupBatch(ctx context.Context, tx *repositories.Repositories, batch []importLine, upsert bool) (*importIndex, error) {
+	index := &importIndex{byTitle: map[string][]models.Item{}, byExternal: map[string]map[string]uint{}}
+
+	titles := make(map[models.MediaType][]string)
+	externalIDs := make(map[models.MediaType]map[string][]string)
+	for _, line := range batch {
+		mediaType := line.item.Type
+		titles[mediaType] = append(titles[mediaType], normalizeTitle(line.item.Title))
+		if upsert {
+			if externalIDs[mediaType] == nil {
+				externalIDs[mediaType] = make(map[string][]string)
+			}
+			for source, id := range line.item.ExternalMetadata {
+				externalIDs[mediaType][source] = append(externalIDs[mediaType][source], fmt.Sprint(id))
+			}
+		}
+	}
+
+	for mediaType, typeTitles := range titles {
+		items, err := tx.Items.FindByNormalizedTitles(ctx, mediaType, typeTitles)
+		if err != nil {
+			return nil, fmt.Errorf("failed to match titles: %w", err)
+		}
+		for _, item := range items {
+			key := titleKey(mediaType, item.Title)
+			index.byTitle[key] = append(index.byTitle[key], item)
+		}
+	}
+
+	for mediaType, sources := range externalIDs {
+		for source, ids := range sources {
+			items, err := tx.Items.FindByExternalIDs(ctx, mediaType, source, ids)
+			if err != nil {
+				return nil, fmt.Errorf("failed to match external IDs from %s: %w", source, err)
+			}
+			key := externalKey(mediaType, source)
+			index.byExternal[key] = make(map[string]uint, len(items))
+			for _, item := range items {
+				id := fmt.Sprint(item.ExternalMetadata[source])
+				if _, ok := index.byExternal[key][id]; !ok {
+					index.byExternal[key][id] = item.ID
+				}
+			}
+		}
+	}
+
+	return index, nil
+}
+
+// match retorna o ID do item do catálogo correspondente a uma linha (0 quando não há): primeiro pelos IDs externos,
+// depois pelo título normalizado e ano de lançamento
+func (index *importIndex) match(item *models.Item) (uint, error) {
+	sources := make([]string, 0, len(item.ExternalMetadata))
+	for source := range item.ExternalMetadata {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+
+	for _, source := range sources {
+		if id, ok := index.byExternal[externalKey(item.Type, source)][fmt.Sprint(item.ExternalMetadata[source])]; ok {
+			return id, nil
+		}
+	}
+
+	var matches []models.Item
+	for _, candidate := range index.byTitle[titleKey(item.Type, item.Title)] {
+		// Sem data de um dos lados, o título basta
+		if item.ReleaseDate == nil || candidate.ReleaseDate == nil || item.ReleaseDate.Year() == candidate.ReleaseDate.Year() {
+			matches = append(matches, candidate)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return 0, nil
+	case 1:
+		return matches[0].ID, nil
+	default:
+		return 0, fmt.Errorf("item '%s' (type: %s) matches %d existing items; add an external ID or release_date to disambiguate", item.Title, item.Type, len(matches))
+	}
+}
+
+// importKeys retorna as chaves que identificam uma linha dentro do lote (título normalizado primeiro, depois IDs externos)
+func importKeys(item *models.Item) []string {
+	keys := []string{"title:" + titleKey(item.Type, item.Title)}
+	for source, id := range item.ExternalMetadata {
+		keys = append(keys, "external:"+externalKey(item.Type, source)+":"+fmt.Sprint(id))
+	}
+	return keys
+}
+
+// titleKey identifica um título normalizado dentro de um tipo
+func titleKey(mediaType models.MediaType, title string) string {
+	return string(mediaType) + ":" + normalizeTitle(title)
+}
+
+// externalKey identifica uma fonte de IDs externos dentro de um tipo (IDs do MAL de anime e manga são distintos)
+func externalKey(mediaType models.MediaType, source string) string {
+	return string(mediaType) + ":" + source
+}
+
+// pendingIndex retorna a linha do lote ainda não gravada que compartilha alguma chave com keys (-1 se nenhuma)
+func pendingIndex(pending map[string]int, keys []string) int {
+	for _, key := range keys {
+		if i, ok := pending[key]; ok {
+			return i
+		}
+	}
+	return -1
+}
+
+// record adiciona o resultado de uma linha gravada ao resultado do import
+func (imp *itemImport) record(line importLine, outcome importOutcome) {
+	if outcome.err != nil {
+		imp.fail(line.num, line.item.Title, outcome.err)
+		return
+	}
+
+	result := imp.result
+	result.Imported++
+	switch outcome.action {
+	case dto.ImportActionCreate:
+		result.Created++
+		if imp.opts.DryRun {
+			outcome.itemID = 0
+		}
+	case dto.ImportActionUpdate:
+		result.Updated++
+	default:
+		result.Unchanged++
+	}
+
+	if len(result.Rows) < maxImportRows {
+		result.Rows = append(result.Rows, dto.ImportRowResult{Line: line.num, Title: line.item.Title, Action: outcome.action, ItemID: outcome.itemID})
+	} else {
+		result.RowsTruncated = true
+	}
+}
+
+// fail adiciona uma linha com erro ao resultado do import
+func (imp *itemImport) fail(lineNum int, title string, err error) {
+	result := imp.result
+	result.Failed++
+	importErr := dto.ImportError{Line: lineNum, Title: title, Error: err.Error()}
+	if imp.callbacks.OnError != nil {
+		imp.callbacks.OnError(importErr)
+	}
+	if len(result.Errors) < maxImportErrors {
+		result.Errors = append(result.Errors, importErr)
+	} else {
+		result.ErrorsTruncated = true
+	}
+}
+
+// importTagCache resolve nomes de tags uma única vez por import
+// Tags resolvidas dentro de uma transação ficam pendentes até o commit, pois o rollback pode desfazer sua criação
+type importTagCache struct {
+	ids     map[string]uint
+	pending map[string]uint
+}
+
+// newImportTagCache cria um cache de tags vazio
+func newImportTagCache() *importTagCache {
+	return &importTagCache{ids: map[string]uint{}, pending: map[string]uint{}}
+}
+
+// resolve retorna os IDs das tags (já normalizadas), buscando ou criando apenas as que ainda não estão no cache
+func (c *importTagCache) resolve(ctx context.Context, tagRepo repositories.TagRepositoryInterface, names []string) ([]uint, error) {
+	ids := make([]uint, 0, len(names))
+	seen := make(map[uint]bool, len(names))
+	for _, name := range names {
+		id, ok := c.ids[name]
+		if !ok {
+			id, ok = c.pending[name]
+		}
+		if !ok {
+			tag := &models.Tag{Name: name}
+			if err := tagRepo.FindOrCreate(ctx, tag); err != nil {
+				return nil, fmt.Errorf("failed to find/create tag '%s': %w", name, err)
+			}
+			id = tag.ID
+			c.pending[name] = id
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// commit confirma as tags resolvidas desde o último commit
+func (c *importTagCache) commit() {
+	for name, id := range c.pending {
+		c.ids[name] = id
+	}
+	c.pending = map[string]uint{}
+}
+
+// discard descarta as tags resolvidas em uma transação desfeita
+func (c *importTagCache) discard() {
+	c.pending = map[string]uint{}
+}
+
+// setSpecificData coloca os dados específicos no campo do item correspondente ao tipo, para CreateBatch
+func setSpecificData(item *models.Item, data interface{}) {
+	switch d := data.(type) {
+	case *models.AnimeData:
+		item.AnimeData = d
+	case *models.MovieData:
+		item.MovieData = d
+	case *models.GameData:
+		item.GameData = d
+	case *models.BookData:
+		item.BookData = d
+	case *models.SeriesData:
+		item.SeriesData = d
+	}
+}
+
+// updateImportedItem aplica uma linha do import sobre um item existente e informa se algo mudou
+// Colunas vazias não apagam os dados atuais; IDs externos são mesclados e as tags da linha substituem as atuais
+func updateImportedItem(ctx context.Context, tx *repositories.Repositories, tags *importTagCache, existing, row *models.Item, specificData interface{}, tagNames []string) (bool, error) {
+	changed := false
+	if existing.Title != row.Title {
+		existing.Title = row.Title
+		changed = true
+	}
+	if row.Description != "" && existing.Description != row.Description {
+		existing.Description = row.Description
+		changed = true
+	}
+	if row.CoverURL != "" && existing.CoverURL != row.CoverURL {
+		existing.CoverURL = row.CoverURL
+		changed = true
+	}
+	if row.ReleaseDate != nil && (existing.ReleaseDate == nil || !existing.ReleaseDate.Equal(*row.ReleaseDate)) {
+		existing.ReleaseDate = row.ReleaseDate
+		changed = true
+	}
+	for source, id := range row.ExternalMetadata {
+		if existing.ExternalMetadata == nil {
+			existing.ExternalMetadata = models.JSONB{}
+		}
+		if current, ok := existing.ExternalMetadata[source]; !ok || fmt.Sprint(current) != fmt.Sprint(id) {
+			existing.ExternalMetadata[source] = id
+			changed = true
+		}
+	}
+
+	if changed {
+		if err := tx.Items.Update(ctx, existing); err != nil {
+			return false, fmt.Errorf("failed to update item: %w", err)
+		}
+	}
+
+	if specificData != nil && specificDataChanged(existing, specificData) {
+		if err := tx.Items.SaveSpecificData(ctx, existing.ID, existing.Type, specificData); err != nil {
+			return false, fmt.Errorf("failed to update specific data: %w", err)
+		}
+		changed = true
+	}
+
+	if len(tagNames) > 0 && !sameTagNames(existing.Tags, tagNames) {
+		tagIDs, err := tags.resolve(ctx, tx.Tags, tagNames)
+		if err != nil {
+			return false, err
+		}
+		if err := tx.Items.AssociateTags(ctx, existing.ID, tagIDs); err != nil {
+			return false, fmt.Errorf("failed to associate tags: %w", err)
+		}
+		changed = true
+	}
+
+	return changed, nil
+}
+
+// specificDataChanged compara os dados específicos atuais do item com os da linha importada
+func specificDataChanged(existing *models.Item, data interface{}) bool {
+	switch d := data.(type) {
+	case *models.AnimeData:
+		current := existing.AnimeData
+		return current == nil || current.Episodes != d.Episodes || current.Studio != d.Studio
+	case *models.MovieData:
+		current := existing.MovieData
+		return current == nil || current.Director != d.Director || current.Runtime != d.Runtime
+	case *models.GameData:
+		current := existing.GameData
+		return current == nil || current.Platform != d.Platform || current.Developer != d.Developer
+	case *models.BookData:
+		current := existing.BookData
+		return current == nil || current.Author != d.Author || current.Volumes != d.Volumes ||
+			current.Chapters != d.Chapters || current.Pages != d.Pages ||
+			current.Format != d.Format || current.Publisher != d.Publisher
+	case *models.SeriesData:
+		current := existing.SeriesData
+		return current == nil || current.Seasons != d.Seasons || current.Episodes != d.Episodes
+	}
+	return false
+}
+
+// normalizeTitle normaliza um título para comparação (minúsculas e espaços colapsados)
+func normalizeTitle(title string) string {
+	return strings.Join(strings.Fields(strings.ToLower(title)), " ")
+}
+
+// sameTagNames verifica se o item já tem exatamente as tags informadas (ignorando repetições)
+func sameTagNames(tags []models.Tag, names []string) bool {
+	current := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		current[tag.Name] = true
+	}
+	wanted := make(map[string]bool, len(names))
+	for _, name := range names {
+		if !current[name] {
+			return false
+		}
+		wanted[name] = true
+	}
+	return len(wanted) == len(current)
 }
 
 // validateHeadersForType valida se o CSV tem os headers corretos para o tipo
-func (s *ItemService) validateHeadersForType(headers []string, mediaType models.MediaType) error {
-	required := getRequiredHeadersForType(mediaType)
+func validateHeadersForType(headers []string, mediaType models.MediaType) error {
+	return validateHeaders(headers, getRequiredHeadersForType(mediaType), "type "+string(mediaType))
+}
+
+// validateHeaders valida se o CSV tem as colunas obrigatórias (context aparece na mensagem de erro)
+func validateHeaders(headers, required []string, context string) error {
 	headerSet := make(map[string]bool)
 
 	for _, h := range headers {
@@ -158,7 +770,7 @@ func (s *ItemService) validateHeadersForType(headers []string, mediaType models.
 
 	for _, req := range required {
 		if !headerSet[req] {
-			return fmt.Errorf("missing required column '%s' for type %s", req, mediaType)
+			return fmt.Errorf("missing required column '%s' for %s", req, context)
 		}
 	}
 
@@ -189,14 +801,15 @@ func getRequiredHeadersForType(mediaType models.MediaType) []string {
 	}
 }
 
-// parseCSVRecordByType faz parse específico por tipo
-func (s *ItemService) parseCSVRecordByType(headers, record []string, mediaType models.MediaType) (*models.Item, interface{}, []string, error) {
+// parseImportRecord faz parse específico por tipo
+// É o mesmo para todos os formatos: CSV, JSON e NDJSON têm exatamente as mesmas validações
+func parseImportRecord(record importRecord, mediaType models.MediaType) (*models.Item, interface{}, []string, error) {
 	// Parse campos comuns
 	item := &models.Item{
 		Type:        mediaType,
-		Title:       getFieldValue(headers, record, "title"),
-		Description: getFieldValue(headers, record, "description"),
-		CoverURL:    getFieldValue(headers, record, "cover_url"),
+		Title:       record.field("title"),
+		Description: record.field("description"),
+		CoverURL:    record.field("cover_url"),
 	}
 
 	// Validar title
@@ -205,7 +818,7 @@ func (s *ItemService) parseCSVRecordByType(headers, record []string, mediaType m
 	}
 
 	// Parse release_date
-	if dateStr := getFieldValue(headers, record, "release_date"); dateStr != "" {
+	if dateStr := record.field("release_date"); dateStr != "" {
 		if date, err := time.Parse("2006-01-02", dateStr); err == nil {
 			item.ReleaseDate = &date
 		} else {
@@ -213,22 +826,9 @@ func (s *ItemService) parseCSVRecordByType(headers, record []string, mediaType m
 		}
 	}
 
-	// Parse tags (separadas por |)
-	var tagNames []string
-	if tagsStr := getFieldValue(headers, record, "tags"); tagsStr != "" {
-		for _, tag := range strings.Split(tagsStr, "|") {
-			if tag = strings.TrimSpace(tag); tag != "" {
-				tagNames = append(tagNames, tag)
-			}
-		}
-	}
-
-	// Parse external_metadata (formato: source:id|source:id)
-	if metadataStr := getFieldValue(headers, record, "external_metadata"); metadataStr != "" {
-		item.ExternalMetadata = parseExternalMetadata(metadataStr)
-	} else {
-		item.ExternalMetadata = models.JSONB{}
-	}
+	// Tags e external_metadata (no CSV: "a|b" e "source:id|source:id")
+	tagNames := record.tagNames()
+	item.ExternalMetadata = record.externalMetadata()
 
 	// Parse dados específicos por tipo
 	var specificData interface{}
@@ -236,19 +836,19 @@ func (s *ItemService) parseCSVRecordByType(headers, record []string, mediaType m
 
 	switch mediaType {
 	case models.MediaTypeAnime:
-		specificData, err = parseAnimeData(headers, record)
+		specificData, err = parseAnimeData(record)
 	case models.MediaTypeComic:
-		specificData, err = parseComicData(headers, record)
+		specificData, err = parseComicData(record)
 	case models.MediaTypeMovie:
-		specificData, err = parseMovieData(headers, record)
+		specificData, err = parseMovieData(record)
 	case models.MediaTypeSeries:
-		specificData, err = parseSeriesData(headers, record)
+		specificData, err = parseSeriesData(record)
 	case models.MediaTypeGame:
-		specificData, err = parseGameData(headers, record)
+		specificData, err = parseGameData(record)
 	case models.MediaTypeBook:
-		specificData, err = parseBookData(headers, record)
+		specificData, err = parseBookData(record)
 	case models.MediaTypeNovel:
-		specificData, err = parseNovelData(headers, record)
+		specificData, err = parseNovelData(record)
 	}
 
 	if err != nil {
@@ -289,12 +889,12 @@ func getFieldValue(headers, record []string, fieldName string) string {
 }
 
 // Parsers específicos por tipo
-func parseAnimeData(headers, record []string) (*models.AnimeData, error) {
+func parseAnimeData(record importRecord) (*models.AnimeData, error) {
 	data := &models.AnimeData{
-		Studio: getFieldValue(headers, record, "studio"),
+		Studio: record.field("studio"),
 	}
 
-	if episodesStr := getFieldValue(headers, record, "episodes"); episodesStr != "" {
+	if episodesStr := record.field("episodes"); episodesStr != "" {
 		episodes, err := strconv.Atoi(episodesStr)
 		if err != nil {
 			return nil, fmt.Errorf("invalid episodes value: %s", episodesStr)
@@ -310,14 +910,14 @@ func parseAnimeData(headers, record []string) (*models.AnimeData, error) {
 	return data, nil
 }
 
-func parseComicData(headers, record []string) (*models.BookData, error) {
+func parseComicData(record importRecord) (*models.BookData, error) {
 	data := &models.BookData{
-		Author:    getFieldValue(headers, record, "author"),
-		Format:    getFieldValue(headers, record, "format"),
-		Publisher: getFieldValue(headers, record, "publisher"),
+		Author:    record.field("author"),
+		Format:    record.field("format"),
+		Publisher: record.field("publisher"),
 	}
 
-	if chaptersStr := getFieldValue(headers, record, "chapters"); chaptersStr != "" {
+	if chaptersStr := record.field("chapters"); chaptersStr != "" {
 		chapters, err := strconv.Atoi(chaptersStr)
 		if err != nil {
 			return nil, fmt.Errorf("invalid chapters value: %s", chaptersStr)
@@ -330,7 +930,7 @@ func parseComicData(headers, record []string) (*models.BookData, error) {
 		return nil, errors.New("chapters is required")
 	}
 
-	if volumesStr := getFieldValue(headers, record, "volumes"); volumesStr != "" {
+	if volumesStr := record.field("volumes"); volumesStr != "" {
 		volumes, err := strconv.Atoi(volumesStr)
 		if err != nil {
 			return nil, fmt.Errorf("invalid volumes value: %s", volumesStr)
@@ -352,12 +952,12 @@ func parseComicData(headers, record []string) (*models.BookData, error) {
 	return data, nil
 }
 
-func parseMovieData(headers, record []string) (*models.MovieData, error) {
+func parseMovieData(record importRecord) (*models.MovieData, error) {
 	data := &models.MovieData{
-		Director: getFieldValue(headers, record, "director"),
+		Director: record.field("director"),
 	}
 
-	if runtimeStr := getFieldValue(headers, record, "runtime"); runtimeStr != "" {
+	if runtimeStr := record.field("runtime"); runtimeStr != "" {
 		runtime, err := strconv.Atoi(runtimeStr)
 		if err != nil {
 			return nil, fmt.Errorf("invalid runtime value: %s", runtimeStr)
@@ -373,11 +973,11 @@ func parseMovieData(headers, record []string) (*models.MovieData, error) {
 	return data, nil
 }
 
-func parseSeriesData(headers, record []string) (*models.SeriesData, error) {
+func parseSeriesData(record importRecord) (*models.SeriesData, error) {
 	data := &models.SeriesData{}
 	// Note: network field exists in CSV but not in model, so we skip it
 
-	if seasonsStr := getFieldValue(headers, record, "seasons"); seasonsStr != "" {
+	if seasonsStr := record.field("seasons"); seasonsStr != "" {
 		seasons, err := strconv.Atoi(seasonsStr)
 		if err != nil {
 			return nil, fmt.Errorf("invalid seasons value: %s", seasonsStr)
@@ -390,7 +990,7 @@ func parseSeriesData(headers, record []string) (*models.SeriesData, error) {
 		return nil, errors.New("seasons is required")
 	}
 
-	if episodesStr := getFieldValue(headers, record, "episodes"); episodesStr != "" {
+	if episodesStr := record.field("episodes"); episodesStr != "" {
 		episodes, err := strconv.Atoi(episodesStr)
 		if err != nil {
 			return nil, fmt.Errorf("invalid episodes value: %s", episodesStr)
@@ -406,10 +1006,10 @@ func parseSeriesData(headers, record []string) (*models.SeriesData, error) {
 	return data, nil
 }
 
-func parseGameData(headers, record []string) (*models.GameData, error) {
+func parseGameData(record importRecord) (*models.GameData, error) {
 	data := &models.GameData{
-		Platform:  getFieldValue(headers, record, "platform"),
-		Developer: getFieldValue(headers, record, "developer"),
+		Platform:  record.field("platform"),
+		Developer: record.field("developer"),
 		// Note: publisher field exists in CSV but not in model, so we skip it
 	}
 
@@ -424,13 +1024,13 @@ func parseGameData(headers, record []string) (*models.GameData, error) {
 	return data, nil
 }
 
-func parseBookData(headers, record []string) (*models.BookData, error) {
+func parseBookData(record importRecord) (*models.BookData, error) {
 	data := &models.BookData{
-		Author:    getFieldValue(headers, record, "author"),
-		Publisher: getFieldValue(headers, record, "publisher"),
+		Author:    record.field("author"),
+		Publisher: record.field("publisher"),
 	}
 
-	if pagesStr := getFieldValue(headers, record, "pages"); pagesStr != "" {
+	if pagesStr := record.field("pages"); pagesStr != "" {
 		pages, err := strconv.Atoi(pagesStr)
 		if err != nil {
 			return nil, fmt.Errorf("invalid pages value: %s", pagesStr)
@@ -450,14 +1050,14 @@ func parseBookData(headers, record []string) (*models.BookData, error) {
 	return data, nil
 }
 
-func parseNovelData(headers, record []string) (*models.BookData, error) {
+func parseNovelData(record importRecord) (*models.BookData, error) {
 	data := &models.BookData{
-		Author:    getFieldValue(headers, record, "author"),
-		Format:    getFieldValue(headers, record, "format"),
-		Publisher: getFieldValue(headers, record, "publisher"),
+		Author:    record.field("author"),
+		Format:    record.field("format"),
+		Publisher: record.field("publisher"),
 	}
 
-	if volumesStr := getFieldValue(headers, record, "volumes"); volumesStr != "" {
+	if volumesStr := record.field("volumes"); volumesStr != "" {
 		volumes, err := strconv.Atoi(volumesStr)
 		if err != nil {
 			return nil, fmt.Errorf("invalid volumes value: %s", volumesStr)
@@ -468,7 +1068,7 @@ func parseNovelData(headers, record []string) (*models.BookData, error) {
 		data.Volumes = volumes
 	}
 
-	if chaptersStr := getFieldValue(headers, record, "chapters"); chaptersStr != "" {
+	if chaptersStr := record.field("chapters"); chaptersStr != "" {
 		chapters, err := strconv.Atoi(chaptersStr)
 		if err != nil {
 			return nil, fmt.Errorf("invalid chapters value: %s", chaptersStr)
@@ -0,0 +1,272 @@
+package services
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+)
+
+// maxNDJSONLineSize limita o tamanho de uma linha NDJSON (um item)
+const maxNDJSONLineSize = 1 << 20
+
+// importRecord é uma linha de import independente do formato do arquivo
+// Todos os formatos passam pelo mesmo parse (parseImportRecord), com as mesmas validações
+type importRecord interface {
+	field(name string) string // Valor textual do campo (vazio quando ausente)
+	tagNames() []string
+	externalMetadata() models.JSONB
+}
+
+// importSource lê as linhas de um arquivo de import em streaming
+type importSource interface {
+	// next retorna a próxima linha e sua posição no arquivo (io.EOF ao final)
+	// Um *importRowError invalida apenas a linha; os demais erros interrompem a leitura
+	next() (int, importRecord, error)
+}
+
+// importRowError é um erro de leitura que afeta apenas uma linha
+type importRowError struct {
+	title string
+	err   error
+}
+
+func (e *importRowError) Error() string { return e.err.Error() }
+
+func (e *importRowError) Unwrap() error { return e.err }
+
+// newImportSource prepara a leitura do arquivo no formato informado
+// Com mediaType vazio (import misto), cada linha informa o próprio tipo
+func newImportSource(reader io.Reader, format string, mediaType models.MediaType) (importSource, error) {
+	switch format {
+	case "", dto.ImportFormatCSV:
+		return newCSVImportSource(reader, mediaType)
+	case dto.ImportFormatJSON:
+		return newJSONImportSource(reader)
+	case dto.ImportFormatNDJSON:
+		scanner := bufio.NewScanner(reader)
+		scanner.Buffer(make([]byte, 64*1024), maxNDJSONLineSize)
+		return &ndjsonImportSource{scanner: scanner}, nil
+	default:
+		return nil, fmt.Errorf("unsupported import format: %s", format)
+	}
+}
+
+// csvImportSource lê um CSV com header; a coluna type é obrigatória nos imports mistos
+type csvImportSource struct {
+	reader  *csv.Reader
+	headers []string
+	line    int
+}
+
+func newCSVImportSource(reader io.Reader, mediaType models.MediaType) (*csvImportSource, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.TrimLeadingSpace = true
+	headers, err := csvReader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errImportEmpty
+		}
+		return nil, fmt.Errorf("failed to parse CSV: %w", err)
+	}
+
+	if mediaType != "" {
+		err = validateHeadersForType(headers, mediaType)
+	} else {
+		err = validateHeaders(headers, []string{"title", "type"}, "mixed imports")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &csvImportSource{reader: csvReader, headers: headers, line: 1}, nil
+}
+
+func (s *csvImportSource) next() (int, importRecord, error) {
+	values, err := s.reader.Read()
+	if errors.Is(err, io.EOF) {
+		return 0, nil, io.EOF
+	}
+	s.line++
+	record := csvRecord{headers: s.headers, values: values}
+
+	if err != nil {
+		// Número de colunas errado invalida apenas a linha; outros erros de parse interrompem a leitura
+		if errors.Is(err, csv.ErrFieldCount) {
+			return s.line, nil, &importRowError{title: record.field("title"), err: err}
+		}
+		return s.line, nil, fmt.Errorf("failed to parse CSV: %w", err)
+	}
+	return s.line, record, nil
+}
+
+// csvRecord é uma linha de CSV: tags separadas por | e external_metadata no formato source:id|source:id
+type csvRecord struct {
+	headers []string
+	values  []string
+}
+
+func (r csvRecord) field(name string) string {
+	return getFieldValue(r.headers, r.values, name)
+}
+
+func (r csvRecord) tagNames() []string {
+	var names []string
+	for _, tag := range strings.Split(r.field("tags"), "|") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			names = append(names, tag)
+		}
+	}
+	return names
+}
+
+func (r csvRecord) externalMetadata() models.JSONB {
+	return parseExternalMetadata(r.field("external_metadata"))
+}
+
+// jsonImportSource lê um array JSON de items, um elemento por vez
+// A posição reportada é a do item no array (1-indexed)
+type jsonImportSource struct {
+	decoder *json.Decoder
+	index   int
+}
+
+func newJSONImportSource(reader io.Reader) (*jsonImportSource, error) {
+	decoder := json.NewDecoder(reader)
+	decoder.UseNumber()
+
+	token, err := decoder.Token()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errImportEmpty
+		}
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return nil, errors.New("JSON import must be an array of items (use NDJSON for one item per line)")
+	}
+
+	return &jsonImportSource{decoder: decoder}, nil
+}
+
+func (s *jsonImportSource) next() (int, importRecord, error) {
+	if !s.decoder.More() {
+		if _, err := s.decoder.Token(); err != nil {
+			return 0, nil, fmt.Errorf("failed to parse JSON: %w", err)
+		}
+		return 0, nil, io.EOF
+	}
+	s.index++
+
+	var record jsonRecord
+	if err := s.decoder.Decode(&record); err != nil {
+		// Tipo errado em um campo invalida apenas o item; JSON malformado interrompe a leitura
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			return s.index, nil, &importRowError{title: record.Title, err: fmt.Errorf("invalid value for %s", typeErr.Field)}
+		}
+		return s.index, nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+	return s.index, &record, nil
+}
+
+// ndjsonImportSource lê um item JSON por linha; linhas em branco são ignoradas
+// Como cada linha é independente, JSON inválido invalida apenas a linha
+type ndjsonImportSource struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+func (s *ndjsonImportSource) next() (int, importRecord, error) {
+	for s.scanner.Scan() {
+		s.line++
+		data := strings.TrimSpace(s.scanner.Text())
+		if data == "" {
+			continue
+		}
+
+		decoder := json.NewDecoder(strings.NewReader(data))
+		decoder.UseNumber()
+		var record jsonRecord
+		if err := decoder.Decode(&record); err != nil {
+			return s.line, nil, &importRowError{title: record.Title, err: fmt.Errorf("invalid JSON: %w", err)}
+		}
+		return s.line, &record, nil
+	}
+
+	if err := s.scanner.Err(); err != nil {
+		return s.line + 1, nil, fmt.Errorf("failed to read NDJSON: %w", err)
+	}
+	return 0, nil, io.EOF
+}
+
+// jsonRecord é um item em JSON/NDJSON, no mesmo formato gerado pelo export
+// Os campos de specific_data são lidos como as colunas específicas do CSV
+type jsonRecord dto.ItemFileRecord
+
+func (r *jsonRecord) field(name string) string {
+	switch name {
+	case "type":
+		return strings.TrimSpace(r.Type)
+	case "title":
+		return strings.TrimSpace(r.Title)
+	case "description":
+		return strings.TrimSpace(r.Description)
+	case "release_date":
+		return strings.TrimSpace(r.ReleaseDate)
+	case "cover_url":
+		return strings.TrimSpace(r.CoverURL)
+	}
+	return jsonScalar(r.SpecificData[name])
+}
+
+func (r *jsonRecord) tagNames() []string {
+	var names []string
+	for _, tag := range r.Tags {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			names = append(names, tag)
+		}
+	}
+	return names
+}
+
+// externalMetadata normaliza os IDs para texto, como no CSV, para que as correspondências do upsert
+// não dependam do formato de origem
+func (r *jsonRecord) externalMetadata() models.JSONB {
+	metadata := models.JSONB{}
+	for source, value := range r.ExternalMetadata {
+		source = strings.ToLower(strings.TrimSpace(source))
+		if id := jsonScalar(value); source != "" && id != "" {
+			metadata[source] = id
+		}
+	}
+	return metadata
+}
+
+// jsonScalar converte um valor JSON simples para o texto equivalente de uma coluna CSV
+// Números são mantidos como escritos (12 -> "12", 12.5 -> "12.5"), para passarem pelas mesmas validações
+func jsonScalar(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return strings.TrimSpace(v)
+	case json.Number:
+		return v.String()
+	case bool:
+		if v {
+			return "true"
+		}
+		return "false"
+	default:
+		// Objetos e arrays não correspondem a nenhuma coluna; o texto gerado falha na validação do campo
+		data, _ := json.Marshal(v)
+		return string(data)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/rafaelc-rb/geekery-api/internal/dto"
 	"github.com/rafaelc-rb/geekery-api/internal/models"
@@ -13,15 +14,19 @@ import (
 )
 
 type ItemService struct {
-	itemRepo repositories.ItemRepositoryInterface
-	tagRepo  repositories.TagRepositoryInterface
+	itemRepo     repositories.ItemRepositoryInterface
+	tagRepo      repositories.TagRepositoryInterface
+	uow          repositories.UnitOfWorkInterface // Transações do import
+	similarCache *SimilarItemsCache               // Opcional: invalidado quando tags ou dados do catálogo mudam
 }
 
 // NewItemService cria uma nova instância do serviço de items (catálogo global)
-func NewItemService(itemRepo repositories.ItemRepositoryInterface, tagRepo repositories.TagRepositoryInterface) *ItemService {
+func NewItemService(itemRepo repositories.ItemRepositoryInterface, tagRepo repositories.TagRepositoryInterface, uow repositories.UnitOfWorkInterface, similarCache *SimilarItemsCache) *ItemService {
 	return &ItemService{
-		itemRepo: itemRepo,
-		tagRepo:  tagRepo,
+		itemRepo:     itemRepo,
+		tagRepo:      tagRepo,
+		uow:          uow,
+		similarCache: similarCache,
 	}
 }
 
@@ -53,6 +58,7 @@ func (s *ItemService) CreateItem(ctx context.Context, item *models.Item, tagIDs
 		}
 	}
 
+	s.similarCache.Invalidate()
 	return nil
 }
 
@@ -91,6 +97,7 @@ func (s *ItemService) CreateItemWithSpecificData(ctx context.Context, item *mode
 		}
 	}
 
+	s.similarCache.Invalidate()
 	return nil
 }
 
@@ -127,6 +134,71 @@ func (s *ItemService) SearchItems(ctx context.Context, query string, params dto.
 	return s.itemRepo.SearchByTitle(ctx, query, params)
 }
 
+// GetRankedItems retorna items ordenados pelos agregados da comunidade (sort=score|popularity)
+func (s *ItemService) GetRankedItems(ctx context.Context, filter dto.ItemRankingFilter, params dto.PaginationParams) ([]models.Item, int64, error) {
+	if filter.Type != "" && !models.MediaType(filter.Type).IsValid() {
+		return nil, 0, models.ErrInvalidMediaType
+	}
+
+	if filter.Season != "" {
+		from, to := seasonRange(filter.Season, filter.Year, time.Now())
+		filter.ReleasedFrom = &from
+		filter.ReleasedTo = &to
+	}
+
+	items, total, err := s.itemRepo.GetRanked(ctx, filter, params)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get ranked items: %w", err)
+	}
+	return items, total, nil
+}
+
+// GetRankings retorna o ranking de items com a posição de cada um (ex: top anime, jogos mais populares da temporada)
+func (s *ItemService) GetRankings(ctx context.Context, filter dto.ItemRankingFilter, params dto.PaginationParams) ([]dto.RankedItemDTO, int64, error) {
+	params.Normalize()
+
+	items, total, err := s.GetRankedItems(ctx, filter, params)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rankings := make([]dto.RankedItemDTO, len(items))
+	for i := range items {
+		rankings[i] = dto.RankedItemDTO{
+			Rank: params.GetOffset() + i + 1,
+			Item: dto.ItemToDTO(&items[i]),
+		}
+	}
+	return rankings, total, nil
+}
+
+// seasonRange resolve uma temporada no intervalo [from, to) de datas de lançamento
+// Temporadas são trimestres: winter (jan-mar), spring (abr-jun), summer (jul-set), fall (out-dez)
+// "current" usa o trimestre de now; year = 0 usa o ano de now
+func seasonRange(season string, year int, now time.Time) (time.Time, time.Time) {
+	if year == 0 {
+		year = now.Year()
+	}
+
+	var month time.Month
+	switch season {
+	case dto.SeasonWinter:
+		month = time.January
+	case dto.SeasonSpring:
+		month = time.April
+	case dto.SeasonSummer:
+		month = time.July
+	case dto.SeasonFall:
+		month = time.October
+	default: // dto.SeasonCurrent
+		year = now.Year()
+		month = time.Month((int(now.Month())-1)/3*3 + 1)
+	}
+
+	from := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	return from, from.AddDate(0, 3, 0)
+}
+
 // UpdateItem atualiza um item do catálogo (admin apenas)
 func (s *ItemService) UpdateItem(ctx context.Context, id uint, updatedItem *models.Item, tagIDs []uint, tagNames []string) error {
 	// Verificar se existe
@@ -172,6 +244,7 @@ func (s *ItemService) UpdateItem(ctx context.Context, id uint, updatedItem *mode
 		}
 	}
 
+	s.similarCache.Invalidate()
 	return nil
 }
 
@@ -183,6 +256,8 @@ func (s *ItemService) DeleteItem(ctx context.Context, id uint) error {
 		}
 		return fmt.Errorf("failed to delete item: %w", err)
 	}
+
+	s.similarCache.Invalidate()
 	return nil
 }
 
@@ -220,5 +295,6 @@ func (s *ItemService) AssociateTags(ctx context.Context, itemID uint, tagIDs []u
 		return fmt.Errorf("failed to associate tags: %w", err)
 	}
 
+	s.similarCache.Invalidate()
 	return nil
 }
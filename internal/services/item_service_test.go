@@ -3,10 +3,14 @@ package services
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/rafaelc-rb/geekery-api/internal/dto"
 	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/repositories"
 	"github.com/rafaelc-rb/geekery-api/internal/testutil"
 )
 
@@ -23,7 +27,7 @@ func TestCreateItem_Success(t *testing.T) {
 	}
 	mockTagRepo := &testutil.MockTagRepository{}
 
-	service := NewItemService(mockRepo, mockTagRepo)
+	service := NewItemService(mockRepo, mockTagRepo, nil, nil)
 
 	item := &models.Item{
 		Title: "Test Item",
@@ -44,7 +48,7 @@ func TestCreateItem_ValidationError(t *testing.T) {
 	ctx := context.Background()
 	mockRepo := &testutil.MockItemRepository{}
 	mockTagRepo := &testutil.MockTagRepository{}
-	service := NewItemService(mockRepo, mockTagRepo)
+	service := NewItemService(mockRepo, mockTagRepo, nil, nil)
 
 	item := &models.Item{
 		Title: "", // Invalid: empty title
@@ -74,7 +78,7 @@ func TestCreateItemWithSpecificData_Success(t *testing.T) {
 	}
 
 	mockTagRepo := &testutil.MockTagRepository{}
-	service := NewItemService(mockRepo, mockTagRepo)
+	service := NewItemService(mockRepo, mockTagRepo, nil, nil)
 
 	item := &models.Item{
 		Title: "Attack on Titan",
@@ -107,7 +111,7 @@ func TestGetAllItems_Success(t *testing.T) {
 	}
 
 	mockTagRepo := &testutil.MockTagRepository{}
-	service := NewItemService(mockRepo, mockTagRepo)
+	service := NewItemService(mockRepo, mockTagRepo, nil, nil)
 	params := dto.PaginationParams{Page: 1, Limit: 20}
 	items, total, err := service.GetAllItems(ctx, params)
 
@@ -141,7 +145,7 @@ func TestGetAllItems_WithPagination(t *testing.T) {
 	}
 
 	mockTagRepo := &testutil.MockTagRepository{}
-	service := NewItemService(mockRepo, mockTagRepo)
+	service := NewItemService(mockRepo, mockTagRepo, nil, nil)
 	params := dto.PaginationParams{Page: 2, Limit: 10}
 	items, total, err := service.GetAllItems(ctx, params)
 
@@ -171,7 +175,7 @@ func TestGetItemByID_Success(t *testing.T) {
 	}
 
 	mockTagRepo := &testutil.MockTagRepository{}
-	service := NewItemService(mockRepo, mockTagRepo)
+	service := NewItemService(mockRepo, mockTagRepo, nil, nil)
 	item, err := service.GetItemByID(ctx, 1)
 
 	if err != nil {
@@ -191,7 +195,7 @@ func TestGetItemByID_NotFound(t *testing.T) {
 	}
 
 	mockTagRepo := &testutil.MockTagRepository{}
-	service := NewItemService(mockRepo, mockTagRepo)
+	service := NewItemService(mockRepo, mockTagRepo, nil, nil)
 	_, err := service.GetItemByID(ctx, 999)
 
 	if err == nil {
@@ -220,7 +224,7 @@ func TestUpdateItem_Success(t *testing.T) {
 	}
 
 	mockTagRepo := &testutil.MockTagRepository{}
-	service := NewItemService(mockRepo, mockTagRepo)
+	service := NewItemService(mockRepo, mockTagRepo, nil, nil)
 
 	updatedItem := &models.Item{
 		Title: "New Title",
@@ -243,10 +247,572 @@ func TestDeleteItem_Success(t *testing.T) {
 	}
 
 	mockTagRepo := &testutil.MockTagRepository{}
-	service := NewItemService(mockRepo, mockTagRepo)
+	service := NewItemService(mockRepo, mockTagRepo, nil, nil)
 	err := service.DeleteItem(ctx, 1)
 
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
 	}
 }
+
+const importAnimeCSV = `title,episodes,studio,tags
+Frieren,28,Madhouse,fantasy|adventure
+Broken,abc,Studio,
+Dandadan,12,Science SARU,
+`
+
+// recordCreatedItems simula CreateBatch, atribuindo IDs sequenciais e registrando os títulos criados
+func recordCreatedItems(created *[]string) func(ctx context.Context, items []*models.Item) error {
+	return func(ctx context.Context, items []*models.Item) error {
+		for _, item := range items {
+			*created = append(*created, item.Title)
+			item.ID = uint(len(*created))
+		}
+		return nil
+	}
+}
+
+// setupImportService cria o serviço de import com mocks e registra o erro retornado à transação (rollback quando != nil)
+func setupImportService(created *[]string, txErr *error) *ItemService {
+	mockRepo := &testutil.MockItemRepository{CreateBatchFunc: recordCreatedItems(created)}
+	mockTagRepo := &testutil.MockTagRepository{}
+	savepoints := &testutil.MockUnitOfWork{Items: mockRepo, Tags: mockTagRepo}
+	uow := &testutil.MockUnitOfWork{
+		DoFunc: func(ctx context.Context, fn func(tx *repositories.Repositories) error) error {
+			*txErr = fn(&repositories.Repositories{Items: mockRepo, Tags: mockTagRepo, UnitOfWork: savepoints})
+			return *txErr
+		},
+	}
+	return NewItemService(mockRepo, mockTagRepo, uow, nil)
+}
+
+func TestImportItemsFromCSV_RowModeSkipsFailedLines(t *testing.T) {
+	var created []string
+	var txErr error
+	service := setupImportService(&created, &txErr)
+
+	result, err := service.ImportItemsFromCSV(context.Background(), strings.NewReader(importAnimeCSV), models.MediaTypeAnime, dto.ImportOptions{}, ImportCallbacks{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if txErr != nil || result.Transaction != dto.ImportTransactionRow || result.RolledBack {
+		t.Errorf("Expected committed row mode, got %+v", result)
+	}
+	if result.Imported != 2 || result.Failed != 1 || result.Errors[0].Line != 3 {
+		t.Errorf("Expected 2 imported and line 3 failed, got %+v", result)
+	}
+	if len(result.Rows) != 2 || result.Rows[1].ItemID != 2 || result.Rows[1].Action != dto.ImportActionCreate {
+		t.Errorf("Unexpected rows: %+v", result.Rows)
+	}
+}
+
+func TestImportItemsFromCSV_FileModeRollsBack(t *testing.T) {
+	var created []string
+	var txErr error
+	service := setupImportService(&created, &txErr)
+
+	result, err := service.ImportItemsFromCSV(context.Background(), strings.NewReader(importAnimeCSV), models.MediaTypeAnime, dto.ImportOptions{Transaction: dto.ImportTransactionFile}, ImportCallbacks{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !errors.Is(txErr, errImportRollback) {
+		t.Errorf("Expected transaction to be rolled back, got %v", txErr)
+	}
+	if !result.RolledBack || result.Success || result.Imported != 0 || result.Failed != 1 {
+		t.Errorf("Expected rolled back result, got %+v", result)
+	}
+	for _, row := range result.Rows {
+		if row.ItemID != 0 {
+			t.Errorf("Expected no item IDs after rollback, got %+v", row)
+		}
+	}
+}
+
+func TestImportItemsFromCSV_DryRunRollsBack(t *testing.T) {
+	var created []string
+	var txErr error
+	service := setupImportService(&created, &txErr)
+
+	result, err := service.ImportItemsFromCSV(context.Background(), strings.NewReader(importAnimeCSV), models.MediaTypeAnime, dto.ImportOptions{DryRun: true}, ImportCallbacks{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !errors.Is(txErr, errImportRollback) {
+		t.Errorf("Expected dry run to roll back, got %v", txErr)
+	}
+	if !result.DryRun || result.RolledBack || result.Imported != 2 {
+		t.Errorf("Expected preview of 2 items, got %+v", result)
+	}
+	if result.Rows[0].Title != "Frieren" || result.Rows[0].ItemID != 0 {
+		t.Errorf("Expected preview rows without IDs, got %+v", result.Rows)
+	}
+}
+
+func TestImportItemsFromCSV_UpsertMatchesExistingItems(t *testing.T) {
+	releaseDate := time.Date(2023, 9, 29, 0, 0, 0, 0, time.UTC)
+	frieren := &models.Item{
+		ID:               7,
+		Title:            "Frieren",
+		Type:             models.MediaTypeAnime,
+		ReleaseDate:      &releaseDate,
+		ExternalMetadata: models.JSONB{"mal": "52991"},
+		AnimeData:        &models.AnimeData{Episodes: 28, Studio: "Madhouse"},
+		Tags:             []models.Tag{{Name: "fantasy"}},
+	}
+	dandadan := &models.Item{
+		ID:        8,
+		Title:     "Dandadan",
+		Type:      models.MediaTypeAnime,
+		AnimeData: &models.AnimeData{Episodes: 12, Studio: "Science SARU"},
+	}
+
+	var updated, created []string
+	mockRepo := &testutil.MockItemRepository{
+		FindByExternalIDsFunc: func(ctx context.Context, mediaType models.MediaType, source string, externalIDs []string) ([]models.Item, error) {
+			if source == "mal" && len(externalIDs) == 1 && externalIDs[0] == "52991" {
+				return []models.Item{*frieren}, nil
+			}
+			return nil, nil
+		},
+		FindByNormalizedTitlesFunc: func(ctx context.Context, mediaType models.MediaType, titles []string) ([]models.Item, error) {
+			if len(titles) != 3 {
+				t.Errorf("Expected one title lookup for the batch, got %v", titles)
+			}
+			return []models.Item{*dandadan}, nil
+		},
+		GetByIDFunc: func(ctx context.Context, id uint) (*models.Item, error) {
+			if id == frieren.ID {
+				return frieren, nil
+			}
+			return dandadan, nil
+		},
+		UpdateFunc: func(ctx context.Context, item *models.Item) error {
+			updated = append(updated, item.Title)
+			return nil
+		},
+		CreateBatchFunc: func(ctx context.Context, items []*models.Item) error {
+			for _, item := range items {
+				created = append(created, item.Title)
+				item.ID = 9
+			}
+			return nil
+		},
+	}
+	mockTagRepo := &testutil.MockTagRepository{}
+	uow := &testutil.MockUnitOfWork{Items: mockRepo, Tags: mockTagRepo}
+	service := NewItemService(mockRepo, mockTagRepo, uow, nil)
+
+	csv := `title,episodes,studio,tags,external_metadata
+Sousou no Frieren,28,Madhouse,fantasy,mal:52991
+Dandadan,12,Science SARU,,
+Kaiju No. 8,12,Production I.G,,
+`
+	result, err := service.ImportItemsFromCSV(context.Background(), strings.NewReader(csv), models.MediaTypeAnime, dto.ImportOptions{Mode: dto.ImportModeUpsert}, ImportCallbacks{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.Updated != 1 || result.Unchanged != 1 || result.Created != 1 || result.Failed != 0 {
+		t.Fatalf("Expected 1 updated, 1 unchanged and 1 created, got %+v", result)
+	}
+	if frieren.Title != "Sousou no Frieren" || len(updated) != 1 {
+		t.Errorf("Expected Frieren retitled by external ID match, got %q (%v)", frieren.Title, updated)
+	}
+	if result.Rows[1].Action != dto.ImportActionUnchanged || result.Rows[1].ItemID != dandadan.ID {
+		t.Errorf("Expected Dandadan matched by normalized title, got %+v", result.Rows[1])
+	}
+	if len(created) != 1 || created[0] != "Kaiju No. 8" || result.Rows[2].ItemID != 9 {
+		t.Errorf("Expected only Kaiju No. 8 created, got %v", created)
+	}
+}
+
+func TestImportItemsFromCSV_UpsertRejectsAmbiguousTitles(t *testing.T) {
+	first, second := time.Date(2003, 10, 4, 0, 0, 0, 0, time.UTC), time.Date(2009, 4, 5, 0, 0, 0, 0, time.UTC)
+	mockRepo := &testutil.MockItemRepository{
+		FindByNormalizedTitlesFunc: func(ctx context.Context, mediaType models.MediaType, titles []string) ([]models.Item, error) {
+			return []models.Item{
+				{ID: 1, Title: "Fullmetal Alchemist", ReleaseDate: &first},
+				{ID: 2, Title: "Fullmetal Alchemist", ReleaseDate: &second},
+			}, nil
+		},
+		GetByIDFunc: func(ctx context.Context, id uint) (*models.Item, error) {
+			return &models.Item{ID: id, Title: "Fullmetal Alchemist", Type: models.MediaTypeAnime}, nil
+		},
+	}
+	uow := &testutil.MockUnitOfWork{Items: mockRepo, Tags: &testutil.MockTagRepository{}}
+	service := NewItemService(mockRepo, &testutil.MockTagRepository{}, uow, nil)
+
+	csv := `title,episodes,studio,release_date
+Fullmetal Alchemist,51,Bones,
+Fullmetal Alchemist,64,Bones,2009-04-05
+`
+	result, err := service.ImportItemsFromCSV(context.Background(), strings.NewReader(csv), models.MediaTypeAnime, dto.ImportOptions{Mode: dto.ImportModeUpsert}, ImportCallbacks{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.Failed != 1 || result.Errors[0].Line != 2 {
+		t.Errorf("Expected the row without year to be ambiguous, got %+v", result.Errors)
+	}
+	if len(result.Rows) != 1 || result.Rows[0].ItemID != 2 {
+		t.Errorf("Expected the row with year to match item 2, got %+v", result.Rows)
+	}
+}
+
+func TestImportItemsFromCSV_BatchesAndIsolatesFailedRows(t *testing.T) {
+	var b strings.Builder
+	b.WriteString("title,episodes,studio,tags\n")
+	for i := 1; i <= importBatchSize+2; i++ {
+		fmt.Fprintf(&b, "Anime %d,12,Studio,action|drama\n", i)
+	}
+
+	var batchSizes []int
+	var created []string
+	mockRepo := &testutil.MockItemRepository{
+		CreateBatchFunc: func(ctx context.Context, items []*models.Item) error {
+			batchSizes = append(batchSizes, len(items))
+			for _, item := range items {
+				// Falha de gravação em uma linha desfaz o lote, que é regravado linha a linha
+				if item.Title == "Anime 3" {
+					return errors.New("constraint violation")
+				}
+			}
+			return recordCreatedItems(&created)(ctx, items)
+		},
+	}
+	tagLookups := 0
+	mockTagRepo := &testutil.MockTagRepository{
+		FindOrCreateFunc: func(ctx context.Context, tag *models.Tag) error {
+			tagLookups++
+			tag.ID = uint(tagLookups)
+			return nil
+		},
+	}
+	uow := &testutil.MockUnitOfWork{Items: mockRepo, Tags: mockTagRepo}
+	service := NewItemService(mockRepo, mockTagRepo, uow, nil)
+
+	var progress []dto.ImportProgress
+	result, err := service.ImportItemsFromCSV(context.Background(), strings.NewReader(b.String()), models.MediaTypeAnime, dto.ImportOptions{}, ImportCallbacks{
+		OnProgress: func(p dto.ImportProgress) { progress = append(progress, p) },
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if result.TotalLines != importBatchSize+2 || result.Created != importBatchSize+1 || result.Failed != 1 || result.Errors[0].Line != 4 {
+		t.Errorf("Expected only line 4 to fail, got created=%d failed=%d errors=%+v", result.Created, result.Failed, result.Errors)
+	}
+	if batchSizes[0] != importBatchSize || batchSizes[len(batchSizes)-1] != 2 {
+		t.Errorf("Expected a full batch, row-by-row retries and a final batch of 2, got %v", batchSizes)
+	}
+	// Tags do lote desfeito são resolvidas de novo; depois disso vêm do cache
+	if tagLookups > 4 {
+		t.Errorf("Expected tags to be cached per import, got %d lookups", tagLookups)
+	}
+	if len(progress) != 2 || progress[1].Lines != importBatchSize+2 || progress[1].Failed != 1 {
+		t.Errorf("Expected progress after each batch, got %+v", progress)
+	}
+}
+
+func TestImportItemsFromCSV_CapsErrorList(t *testing.T) {
+	var b strings.Builder
+	b.WriteString("title,episodes,studio\n")
+	for i := 1; i <= maxImportErrors+5; i++ {
+		fmt.Fprintf(&b, "Broken %d,abc,Studio\n", i)
+	}
+
+	var created []string
+	var txErr error
+	service := setupImportService(&created, &txErr)
+
+	var reported int
+	result, err := service.ImportItemsFromCSV(context.Background(), strings.NewReader(b.String()), models.MediaTypeAnime, dto.ImportOptions{}, ImportCallbacks{
+		OnError: func(dto.ImportError) { reported++ },
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.Failed != maxImportErrors+5 || len(result.Errors) != maxImportErrors || !result.ErrorsTruncated {
+		t.Errorf("Expected %d errors reported out of %d, got %d (truncated=%v)", maxImportErrors, result.Failed, len(result.Errors), result.ErrorsTruncated)
+	}
+	// O callback recebe todos os erros, inclusive os que não cabem no resultado
+	if reported != result.Failed {
+		t.Errorf("Expected OnError for all %d failed lines, got %d", result.Failed, reported)
+	}
+}
+
+func TestImportItemsFromCSV_CreateRejectsExistingTitle(t *testing.T) {
+	var created []string
+	var txErr error
+	service := setupImportService(&created, &txErr)
+	service.itemRepo.(*testutil.MockItemRepository).FindByNormalizedTitlesFunc = func(ctx context.Context, mediaType models.MediaType, titles []string) ([]models.Item, error) {
+		return []models.Item{{ID: 9, Title: "  FRIEREN ", Type: mediaType}}, nil
+	}
+
+	result, err := service.ImportItemsFromCSV(context.Background(), strings.NewReader(importAnimeCSV), models.MediaTypeAnime, dto.ImportOptions{}, ImportCallbacks{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.Created != 1 || len(created) != 1 || created[0] != "Dandadan" {
+		t.Errorf("Expected only Dandadan to be created, got %v", created)
+	}
+	// Erros de parse são reportados na leitura; os de gravação, quando o lote é gravado
+	if result.Failed != 2 || result.Errors[1].Line != 2 || !strings.Contains(result.Errors[1].Error, "already exists with ID 9") {
+		t.Errorf("Expected existing title to be rejected, got %+v", result.Errors)
+	}
+}
+
+// setupMixedImportService cria o serviço de import registrando os items criados e os tipos consultados
+func setupMixedImportService(created *[]*models.Item, lookedUp *[]models.MediaType) *ItemService {
+	mockRepo := &testutil.MockItemRepository{
+		CreateBatchFunc: func(ctx context.Context, items []*models.Item) error {
+			for _, item := range items {
+				*created = append(*created, item)
+				item.ID = uint(len(*created))
+			}
+			return nil
+		},
+		FindByNormalizedTitlesFunc: func(ctx context.Context, mediaType models.MediaType, titles []string) ([]models.Item, error) {
+			*lookedUp = append(*lookedUp, mediaType)
+			return []models.Item{}, nil
+		},
+	}
+	mockTagRepo := &testutil.MockTagRepository{}
+	uow := &testutil.MockUnitOfWork{Items: mockRepo, Tags: mockTagRepo}
+	return NewItemService(mockRepo, mockTagRepo, uow, nil)
+}
+
+func TestImportItems_JSONArray(t *testing.T) {
+	var created []*models.Item
+	var lookedUp []models.MediaType
+	service := setupMixedImportService(&created, &lookedUp)
+
+	input := `[
+		{"type": "anime", "title": "Frieren", "tags": ["fantasy", "a|b"], "external_metadata": {"MAL": 52991},
+		 "specific_data": {"episodes": 28, "studio": "Madhouse"}},
+		{"type": "movie", "title": "Alien", "release_date": "1979-05-25", "specific_data": {"runtime": "117", "director": "Ridley Scott"}},
+		{"type": "anime", "title": "Half", "specific_data": {"episodes": 12.5, "studio": "X"}},
+		{"type": "anime", "title": 42},
+		{"type": "podcast", "title": "Unknown"}
+	]`
+	result, err := service.ImportItems(context.Background(), strings.NewReader(input), "", dto.ImportOptions{Format: dto.ImportFormatJSON}, ImportCallbacks{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if result.Format != dto.ImportFormatJSON || result.MediaType != "" || result.TotalLines != 5 || result.Created != 2 || result.Failed != 3 {
+		t.Fatalf("Expected 2 created and 3 failed items, got %+v", result)
+	}
+	if len(created) != 2 || created[0].AnimeData == nil || created[0].AnimeData.Episodes != 28 || created[1].MovieData == nil || created[1].MovieData.Runtime != 117 {
+		t.Errorf("Expected type-specific data from specific_data, got %+v", created)
+	}
+	if created[0].ExternalMetadata["mal"] != "52991" {
+		t.Errorf("Expected external IDs normalized like CSV, got %v", created[0].ExternalMetadata)
+	}
+
+	// Mesmas validações do CSV, com a posição do item no array
+	expected := map[int]string{3: "invalid episodes value: 12.5", 4: "invalid value for title", 5: "invalid or missing type: 'podcast'"}
+	for _, importErr := range result.Errors {
+		if expected[importErr.Line] != importErr.Error {
+			t.Errorf("Unexpected error at item %d: %s", importErr.Line, importErr.Error)
+		}
+	}
+
+	// Um lote misto consulta os títulos de cada tipo separadamente
+	if len(lookedUp) != 2 {
+		t.Errorf("Expected one title lookup per type, got %v", lookedUp)
+	}
+}
+
+func TestImportItems_JSONRequiresArray(t *testing.T) {
+	service := NewItemService(&testutil.MockItemRepository{}, &testutil.MockTagRepository{}, nil, nil)
+
+	_, err := service.ImportItems(context.Background(), strings.NewReader(`{"type": "anime"}`), "", dto.ImportOptions{Format: dto.ImportFormatJSON}, ImportCallbacks{})
+	if err == nil || !strings.Contains(err.Error(), "must be an array") {
+		t.Errorf("Expected array error, got %v", err)
+	}
+}
+
+func TestImportItems_NDJSON(t *testing.T) {
+	var created []*models.Item
+	var lookedUp []models.MediaType
+	service := setupMixedImportService(&created, &lookedUp)
+
+	input := `{"type": "game", "title": "Hades", "specific_data": {"platform": "PC", "developer": "Supergiant"}}
+
+{"type": "book", "title": "Dune", "specific_data": {"pages": 412}
+{"type": "book", "title": "Dune", "specific_data": {"pages": 412, "author": "Frank Herbert"}}
+`
+	result, err := service.ImportItems(context.Background(), strings.NewReader(input), "", dto.ImportOptions{Format: dto.ImportFormatNDJSON}, ImportCallbacks{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// A linha em branco é ignorada; JSON inválido invalida apenas a própria linha
+	if result.TotalLines != 3 || result.Created != 2 || result.Failed != 1 || result.Errors[0].Line != 3 {
+		t.Errorf("Expected line 3 to fail and 2 items created, got %+v", result)
+	}
+	if len(created) != 2 || created[1].BookData == nil || created[1].BookData.Author != "Frank Herbert" {
+		t.Errorf("Unexpected created items: %+v", created)
+	}
+}
+
+func TestImportItems_MixedCSV(t *testing.T) {
+	var created []*models.Item
+	var lookedUp []models.MediaType
+	service := setupMixedImportService(&created, &lookedUp)
+
+	input := `type,title,episodes,studio,runtime,director
+anime,Frieren,28,Madhouse,,
+Movie,Alien,,,117,Ridley Scott
+,Untyped,12,Studio,,
+movie,No Runtime,,,,Someone
+`
+	result, err := service.ImportItems(context.Background(), strings.NewReader(input), "", dto.ImportOptions{}, ImportCallbacks{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if result.Created != 2 || created[1].Type != models.MediaTypeMovie {
+		t.Errorf("Expected anime and movie created, got %+v", created)
+	}
+	if result.Failed != 2 || result.Errors[0].Line != 4 || result.Errors[1].Error != "runtime is required" {
+		t.Errorf("Expected missing type and missing runtime to fail, got %+v", result.Errors)
+	}
+
+	// Sem a coluna type, o CSV misto é rejeitado antes de qualquer linha
+	_, err = service.ImportItems(context.Background(), strings.NewReader("title,episodes\nFrieren,28\n"), "", dto.ImportOptions{}, ImportCallbacks{})
+	if err == nil || !strings.Contains(err.Error(), "missing required column 'type'") {
+		t.Errorf("Expected missing type column error, got %v", err)
+	}
+}
+
+// exportFixtureItems retorna items de tipos variados, com dados específicos, tags e IDs externos numéricos
+func exportFixtureItems() []models.Item {
+	release := time.Date(2023, 9, 29, 0, 0, 0, 0, time.UTC)
+	return []models.Item{
+		{
+			ID: 1, Type: models.MediaTypeAnime, Title: "Frieren", Description: `Elf mage, "after" the journey`, ReleaseDate: &release,
+			Tags:             []models.Tag{{Name: "adventure"}, {Name: "fantasy"}},
+			ExternalMetadata: models.JSONB{"mal": float64(52991), "anilist": "154587"},
+			AnimeData:        &models.AnimeData{Episodes: 28, Studio: "Madhouse"},
+		},
+		{
+			ID: 2, Type: models.MediaTypeComic, Title: "One Piece",
+			BookData: &models.BookData{Chapters: 1100, Volumes: 108, Author: "Eiichiro Oda", Format: "manga"},
+		},
+		{
+			ID: 5, Type: models.MediaTypeGame, Title: "Elden Ring",
+			ExternalMetadata: models.JSONB{"steam": float64(1245620)},
+			GameData:         &models.GameData{Platform: "PC", Developer: "FromSoftware", AveragePlaytime: 60},
+		},
+		{
+			ID: 9, Type: models.MediaTypeBook, Title: "The Hobbit",
+			BookData: &models.BookData{Pages: 310, Author: "J.R.R. Tolkien", Publisher: "Allen & Unwin"},
+		},
+	}
+}
+
+func TestExportItems_RoundTripsThroughImport(t *testing.T) {
+	for _, format := range []string{dto.ImportFormatCSV, dto.ImportFormatJSON, dto.ImportFormatNDJSON} {
+		t.Run(format, func(t *testing.T) {
+			exporter := NewItemService(&testutil.MockItemRepository{
+				ListAfterIDFunc: func(ctx context.Context, mediaType models.MediaType, afterID uint, limit int) ([]models.Item, error) {
+					return exportFixtureItems(), nil
+				},
+			}, &testutil.MockTagRepository{}, nil, nil)
+
+			var out strings.Builder
+			if err := exporter.ExportItems(context.Background(), &out, "", format); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			var created []*models.Item
+			var lookedUp []models.MediaType
+			importer := setupMixedImportService(&created, &lookedUp)
+			result, err := importer.ImportItems(context.Background(), strings.NewReader(out.String()), "", dto.ImportOptions{Format: format}, ImportCallbacks{})
+			if err != nil || result.Created != 4 || result.Failed != 0 {
+				t.Fatalf("Expected every exported item to import, got %+v (%v)\n%s", result, err, out.String())
+			}
+
+			anime, comic, game, book := created[0], created[1], created[2], created[3]
+			if anime.Description != `Elf mage, "after" the journey` || anime.ReleaseDate == nil || anime.ReleaseDate.Format("2006-01-02") != "2023-09-29" {
+				t.Errorf("Expected common fields preserved, got %+v", anime)
+			}
+			if anime.AnimeData.Episodes != 28 || anime.ExternalMetadata["mal"] != "52991" || anime.ExternalMetadata["anilist"] != "154587" {
+				t.Errorf("Expected anime data and external IDs preserved, got %+v %v", anime.AnimeData, anime.ExternalMetadata)
+			}
+			if comic.BookData.Chapters != 1100 || comic.BookData.Volumes != 108 || comic.BookData.Format != "manga" {
+				t.Errorf("Expected comic data preserved, got %+v", comic.BookData)
+			}
+			if game.ExternalMetadata["steam"] != "1245620" {
+				t.Errorf("Expected numeric external ID without exponent, got %v", game.ExternalMetadata)
+			}
+			if book.BookData.Pages != 310 || book.BookData.Publisher != "Allen & Unwin" {
+				t.Errorf("Expected book data preserved, got %+v", book.BookData)
+			}
+		})
+	}
+}
+
+func TestExportItems_TypedCSVMatchesTypeImport(t *testing.T) {
+	for _, mediaType := range models.ValidMediaTypes {
+		headers := exportHeadersForType(mediaType)
+		if err := validateHeadersForType(headers, mediaType); err != nil {
+			t.Errorf("Expected export headers for %s to be accepted by its import, got %v", mediaType, err)
+		}
+	}
+
+	exporter := NewItemService(&testutil.MockItemRepository{
+		ListAfterIDFunc: func(ctx context.Context, mediaType models.MediaType, afterID uint, limit int) ([]models.Item, error) {
+			return exportFixtureItems()[:1], nil
+		},
+	}, &testutil.MockTagRepository{}, nil, nil)
+
+	var out strings.Builder
+	if err := exporter.ExportItems(context.Background(), &out, models.MediaTypeAnime, dto.ImportFormatCSV); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	expected := "title,description,release_date,cover_url,tags,episodes,studio,external_metadata\n" +
+		`Frieren,"Elf mage, ""after"" the journey",2023-09-29,,adventure|fantasy,28,Madhouse,anilist:154587|mal:52991` + "\n"
+	if out.String() != expected {
+		t.Errorf("Expected anime CSV\n%s\ngot\n%s", expected, out.String())
+	}
+
+	var created []string
+	var txErr error
+	result, err := setupImportService(&created, &txErr).ImportItemsFromCSV(context.Background(), strings.NewReader(out.String()), models.MediaTypeAnime, dto.ImportOptions{}, ImportCallbacks{})
+	if err != nil || result.Created != 1 {
+		t.Errorf("Expected exported CSV to import, got %+v (%v)", result, err)
+	}
+}
+
+func TestExportItems_ReadsInBatches(t *testing.T) {
+	var afterIDs []uint
+	service := NewItemService(&testutil.MockItemRepository{
+		ListAfterIDFunc: func(ctx context.Context, mediaType models.MediaType, afterID uint, limit int) ([]models.Item, error) {
+			afterIDs = append(afterIDs, afterID)
+			if afterID > 0 {
+				return []models.Item{}, nil
+			}
+			items := make([]models.Item, limit)
+			for i := range items {
+				items[i] = models.Item{ID: uint(i + 1), Type: models.MediaTypeMovie, Title: fmt.Sprintf("Movie %d", i+1)}
+			}
+			return items, nil
+		},
+	}, &testutil.MockTagRepository{}, nil, nil)
+
+	var out strings.Builder
+	if err := service.ExportItems(context.Background(), &out, models.MediaTypeMovie, dto.ImportFormatJSON); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(afterIDs) != 2 || afterIDs[1] != exportBatchSize {
+		t.Errorf("Expected a second batch after ID %d, got %v", exportBatchSize, afterIDs)
+	}
+	if !strings.HasPrefix(out.String(), "[\n") || !strings.HasSuffix(out.String(), "\n]\n") {
+		t.Errorf("Expected a JSON array, got %q...", out.String()[:20])
+	}
+
+	if err := service.ExportItems(context.Background(), &out, "podcast", dto.ImportFormatCSV); !errors.Is(err, models.ErrInvalidMediaType) {
+		t.Errorf("Expected ErrInvalidMediaType, got %v", err)
+	}
+}
@@ -0,0 +1,151 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/logger"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/repositories"
+)
+
+type ItemStatsService struct {
+	statsRepo repositories.ItemStatsRepositoryInterface
+}
+
+// NewItemStatsService cria uma nova instância do serviço de agregados da comunidade
+func NewItemStatsService(statsRepo repositories.ItemStatsRepositoryInterface) *ItemStatsService {
+	return &ItemStatsService{statsRepo: statsRepo}
+}
+
+// RefreshAll recalcula as médias por tipo de mídia e os agregados de todos os items
+// Necessário periodicamente: o score bayesiano depende da média global, que muda com qualquer nota
+func (s *ItemStatsService) RefreshAll(ctx context.Context) error {
+	if err := computeItemStats(ctx, s.statsRepo, nil); err != nil {
+		return err
+	}
+
+	if err := s.statsRepo.DeleteStale(ctx); err != nil {
+		return fmt.Errorf("failed to delete stale item stats: %w", err)
+	}
+	return nil
+}
+
+// RunPeriodicRefresh executa RefreshAll imediatamente e depois a cada interval, até o contexto ser cancelado
+func (s *ItemStatsService) RunPeriodicRefresh(ctx context.Context, interval time.Duration) {
+	runPeriodically(ctx, interval, "item stats refresh", s.RefreshAll)
+}
+
+// computeItemStats recalcula e grava os agregados dos items informados (nil = todos)
+// Items informados que não estão em nenhuma lista ficam com os agregados zerados
+// Só o recálculo completo refaz as médias por tipo; o incremental usa as gravadas no último
+func computeItemStats(ctx context.Context, statsRepo repositories.ItemStatsRepositoryInterface, itemIDs []uint) error {
+	aggregates, err := statsRepo.Aggregate(ctx, itemIDs)
+	if err != nil {
+		return fmt.Errorf("failed to aggregate item stats: %w", err)
+	}
+
+	means, err := meanRatings(ctx, statsRepo, aggregates, itemIDs == nil)
+	if err != nil {
+		return err
+	}
+
+	stats := buildItemStats(aggregates, means, itemIDs, time.Now())
+	if err := statsRepo.Upsert(ctx, stats); err != nil {
+		return fmt.Errorf("failed to save item stats: %w", err)
+	}
+	return nil
+}
+
+// meanRatings retorna as médias por tipo de mídia, recalculando-as (e gravando) quando recompute é true
+// ou quando as médias gravadas não cobrem o tipo de algum item avaliado
+func meanRatings(ctx context.Context, statsRepo repositories.ItemStatsRepositoryInterface, aggregates []dto.ItemStatsAggregate, recompute bool) ([]dto.MediaTypeMean, error) {
+	if !recompute {
+		means, err := statsRepo.GetMeanRatings(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get stored mean ratings: %w", err)
+		}
+		if coversRatedTypes(means, aggregates) {
+			return means, nil
+		}
+	}
+
+	means, err := statsRepo.MeanRatingByType(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mean rating by type: %w", err)
+	}
+	if err := statsRepo.SaveMeanRatings(ctx, means); err != nil {
+		return nil, fmt.Errorf("failed to save mean ratings: %w", err)
+	}
+	return means, nil
+}
+
+// coversRatedTypes indica se há média gravada para o tipo de todo item com avaliações
+// Um tipo ausente (ex.: primeira avaliação de um tipo novo) zeraria a média global do score bayesiano
+func coversRatedTypes(means []dto.MediaTypeMean, aggregates []dto.ItemStatsAggregate) bool {
+	if len(means) == 0 {
+		return false
+	}
+	known := make(map[string]bool, len(means))
+	for _, mean := range means {
+		known[mean.MediaType] = true
+	}
+	for _, agg := range aggregates {
+		if agg.RatingCount > 0 && !known[agg.MediaType] {
+			return false
+		}
+	}
+	return true
+}
+
+// buildItemStats monta os agregados com o score bayesiano a partir das linhas agregadas
+// A média global usada para cada item é a do seu tipo de mídia
+func buildItemStats(aggregates []dto.ItemStatsAggregate, means []dto.MediaTypeMean, itemIDs []uint, now time.Time) []models.ItemStats {
+	meanByType := make(map[string]float64, len(means))
+	for _, mean := range means {
+		meanByType[mean.MediaType] = mean.Mean
+	}
+
+	stats := make([]models.ItemStats, 0, len(aggregates)+len(itemIDs))
+	seen := make(map[uint]bool, len(aggregates))
+	for _, agg := range aggregates {
+		seen[agg.ItemID] = true
+		stats = append(stats, models.ItemStats{
+			ItemID:         agg.ItemID,
+			MeanScore:      agg.MeanScore,
+			WeightedScore:  models.BayesianScore(agg.MeanScore, agg.RatingCount, meanByType[agg.MediaType]),
+			RatingCount:    agg.RatingCount,
+			Members:        agg.Members,
+			CompletedCount: agg.CompletedCount,
+			DroppedCount:   agg.DroppedCount,
+			FavoriteCount:  agg.FavoriteCount,
+			UpdatedAt:      now,
+		})
+	}
+
+	for _, itemID := range itemIDs {
+		if !seen[itemID] {
+			seen[itemID] = true
+			stats = append(stats, models.ItemStats{ItemID: itemID, UpdatedAt: now})
+		}
+	}
+
+	return stats
+}
+
+// refreshItemStats atualiza os agregados dos items alterados sem interromper a operação principal
+// Falhas são apenas logadas: o job periódico corrige qualquer divergência
+func refreshItemStats(ctx context.Context, statsRepo repositories.ItemStatsRepositoryInterface, itemIDs ...uint) {
+	if statsRepo == nil || len(itemIDs) == 0 {
+		return
+	}
+
+	if err := computeItemStats(ctx, statsRepo, itemIDs); err != nil {
+		logger.Warn().
+			Err(err).
+			Interface("item_ids", itemIDs).
+			Msg("Failed to refresh item stats")
+	}
+}
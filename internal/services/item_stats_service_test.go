@@ -0,0 +1,311 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/testutil"
+)
+
+func TestBuildItemStats_UsesMediaTypeMean(t *testing.T) {
+	now := time.Now()
+	aggregates := []dto.ItemStatsAggregate{
+		{ItemID: 1, MediaType: "anime", MeanScore: 9, RatingCount: 10, Members: 20, CompletedCount: 8, DroppedCount: 1, FavoriteCount: 3},
+		{ItemID: 2, MediaType: "game", MeanScore: 9, RatingCount: 10, Members: 15},
+	}
+	means := []dto.MediaTypeMean{
+		{MediaType: "anime", Mean: 7},
+		{MediaType: "game", Mean: 5},
+	}
+
+	stats := buildItemStats(aggregates, means, nil, now)
+
+	if len(stats) != 2 {
+		t.Fatalf("Expected 2 stats, got %d", len(stats))
+	}
+	if stats[0].WeightedScore != 8 {
+		t.Errorf("Expected anime weighted score 8, got %f", stats[0].WeightedScore)
+	}
+	if stats[1].WeightedScore != 7 {
+		t.Errorf("Expected game weighted score 7, got %f", stats[1].WeightedScore)
+	}
+	if stats[0].Members != 20 || stats[0].CompletedCount != 8 || stats[0].DroppedCount != 1 || stats[0].FavoriteCount != 3 {
+		t.Errorf("Expected counters to be copied, got %+v", stats[0])
+	}
+}
+
+func TestBuildItemStats_ZeroesItemsWithoutEntries(t *testing.T) {
+	aggregates := []dto.ItemStatsAggregate{{ItemID: 1, MediaType: "anime", MeanScore: 8, RatingCount: 1, Members: 1}}
+
+	stats := buildItemStats(aggregates, nil, []uint{1, 2}, time.Now())
+
+	if len(stats) != 2 {
+		t.Fatalf("Expected 2 stats, got %d", len(stats))
+	}
+	if stats[1].ItemID != 2 || stats[1].Members != 0 || stats[1].WeightedScore != 0 {
+		t.Errorf("Expected zeroed stats for item 2, got %+v", stats[1])
+	}
+}
+
+func TestItemStatsService_RefreshAll(t *testing.T) {
+	ctx := context.Background()
+	var upserted []models.ItemStats
+	staleDeleted := false
+
+	mockRepo := &testutil.MockItemStatsRepository{
+		AggregateFunc: func(ctx context.Context, itemIDs []uint) ([]dto.ItemStatsAggregate, error) {
+			if itemIDs != nil {
+				t.Errorf("Expected full refresh (nil item IDs), got %v", itemIDs)
+			}
+			return []dto.ItemStatsAggregate{{ItemID: 1, MediaType: "anime", MeanScore: 8, RatingCount: 2, Members: 3}}, nil
+		},
+		UpsertFunc: func(ctx context.Context, stats []models.ItemStats) error {
+			upserted = stats
+			return nil
+		},
+		DeleteStaleFunc: func(ctx context.Context) error {
+			staleDeleted = true
+			return nil
+		},
+	}
+
+	service := NewItemStatsService(mockRepo)
+	if err := service.RefreshAll(ctx); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(upserted) != 1 || upserted[0].ItemID != 1 {
+		t.Errorf("Expected stats for item 1 to be saved, got %+v", upserted)
+	}
+	if !staleDeleted {
+		t.Error("Expected stale stats to be deleted")
+	}
+}
+
+func TestItemStatsService_RefreshAll_RecomputesMeans(t *testing.T) {
+	var saved []dto.MediaTypeMean
+	mockRepo := &testutil.MockItemStatsRepository{
+		GetMeanRatingsFunc: func(ctx context.Context) ([]dto.MediaTypeMean, error) {
+			t.Error("Full refresh should not use stored means")
+			return nil, nil
+		},
+		MeanRatingByTypeFunc: func(ctx context.Context) ([]dto.MediaTypeMean, error) {
+			return []dto.MediaTypeMean{{MediaType: "anime", Mean: 7}}, nil
+		},
+		SaveMeanRatingsFunc: func(ctx context.Context, means []dto.MediaTypeMean) error {
+			saved = means
+			return nil
+		},
+	}
+
+	if err := NewItemStatsService(mockRepo).RefreshAll(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(saved) != 1 || saved[0].Mean != 7 {
+		t.Errorf("Expected recomputed means to be saved, got %+v", saved)
+	}
+}
+
+func TestComputeItemStats_IncrementalUsesStoredMeans(t *testing.T) {
+	var upserted []models.ItemStats
+	mockRepo := &testutil.MockItemStatsRepository{
+		AggregateFunc: func(ctx context.Context, itemIDs []uint) ([]dto.ItemStatsAggregate, error) {
+			return []dto.ItemStatsAggregate{{ItemID: 4, MediaType: "game", MeanScore: 9, RatingCount: 10}}, nil
+		},
+		GetMeanRatingsFunc: func(ctx context.Context) ([]dto.MediaTypeMean, error) {
+			return []dto.MediaTypeMean{{MediaType: "game", Mean: 5}}, nil
+		},
+		MeanRatingByTypeFunc: func(ctx context.Context) ([]dto.MediaTypeMean, error) {
+			t.Error("Incremental refresh should not recompute the means")
+			return nil, nil
+		},
+		UpsertFunc: func(ctx context.Context, stats []models.ItemStats) error {
+			upserted = stats
+			return nil
+		},
+	}
+
+	if err := computeItemStats(context.Background(), mockRepo, []uint{4}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(upserted) != 1 || upserted[0].WeightedScore != 7 {
+		t.Errorf("Expected weighted score from the stored game mean, got %+v", upserted)
+	}
+}
+
+func TestComputeItemStats_IncrementalWithoutStoredMeans(t *testing.T) {
+	recomputed, saved := false, false
+	mockRepo := &testutil.MockItemStatsRepository{
+		MeanRatingByTypeFunc: func(ctx context.Context) ([]dto.MediaTypeMean, error) {
+			recomputed = true
+			return []dto.MediaTypeMean{{MediaType: "anime", Mean: 7}}, nil
+		},
+		SaveMeanRatingsFunc: func(ctx context.Context, means []dto.MediaTypeMean) error {
+			saved = true
+			return nil
+		},
+	}
+
+	if err := computeItemStats(context.Background(), mockRepo, []uint{1}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !recomputed || !saved {
+		t.Errorf("Expected means computed and saved before the first full refresh (recomputed=%v, saved=%v)", recomputed, saved)
+	}
+}
+
+func TestComputeItemStats_IncrementalRecomputesMissingTypeMean(t *testing.T) {
+	var upserted []models.ItemStats
+	saved := false
+	mockRepo := &testutil.MockItemStatsRepository{
+		AggregateFunc: func(ctx context.Context, itemIDs []uint) ([]dto.ItemStatsAggregate, error) {
+			return []dto.ItemStatsAggregate{{ItemID: 4, MediaType: "game", MeanScore: 9, RatingCount: 10}}, nil
+		},
+		GetMeanRatingsFunc: func(ctx context.Context) ([]dto.MediaTypeMean, error) {
+			return []dto.MediaTypeMean{{MediaType: "anime", Mean: 7}}, nil
+		},
+		MeanRatingByTypeFunc: func(ctx context.Context) ([]dto.MediaTypeMean, error) {
+			return []dto.MediaTypeMean{{MediaType: "anime", Mean: 7}, {MediaType: "game", Mean: 5}}, nil
+		},
+		SaveMeanRatingsFunc: func(ctx context.Context, means []dto.MediaTypeMean) error {
+			saved = true
+			return nil
+		},
+		UpsertFunc: func(ctx context.Context, stats []models.ItemStats) error {
+			upserted = stats
+			return nil
+		},
+	}
+
+	if err := computeItemStats(context.Background(), mockRepo, []uint{4}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !saved {
+		t.Error("Expected the recomputed means to be saved")
+	}
+	if len(upserted) != 1 || upserted[0].WeightedScore != 7 {
+		t.Errorf("Expected weighted score from the recomputed game mean, got %+v", upserted)
+	}
+}
+
+func TestItemStatsService_RefreshAll_AggregateError(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := &testutil.MockItemStatsRepository{
+		AggregateFunc: func(ctx context.Context, itemIDs []uint) ([]dto.ItemStatsAggregate, error) {
+			return nil, errors.New("database error")
+		},
+		UpsertFunc: func(ctx context.Context, stats []models.ItemStats) error {
+			t.Error("Upsert should not be called when aggregation fails")
+			return nil
+		},
+	}
+
+	service := NewItemStatsService(mockRepo)
+	if err := service.RefreshAll(ctx); err == nil {
+		t.Error("Expected error, got nil")
+	}
+}
+
+func TestAddToList_RefreshesItemStats(t *testing.T) {
+	ctx := context.Background()
+	item := &models.Item{Title: "Test Item", Type: models.MediaTypeAnime}
+	item.ID = 7
+
+	mockItemRepo := &testutil.MockItemRepository{
+		GetByIDFunc: func(ctx context.Context, id uint) (*models.Item, error) {
+			return item, nil
+		},
+	}
+	mockUserItemRepo := &testutil.MockUserItemRepository{
+		ExistsFunc: func(ctx context.Context, userID, itemID uint) (bool, error) {
+			return false, nil
+		},
+	}
+
+	var refreshedIDs []uint
+	mockStatsRepo := &testutil.MockItemStatsRepository{
+		AggregateFunc: func(ctx context.Context, itemIDs []uint) ([]dto.ItemStatsAggregate, error) {
+			refreshedIDs = itemIDs
+			return nil, nil
+		},
+	}
+
+	service := NewUserItemService(mockUserItemRepo, mockItemRepo, nil, nil, mockStatsRepo)
+	if _, err := service.AddToList(ctx, 1, 7, models.StatusPlanned); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(refreshedIDs) != 1 || refreshedIDs[0] != 7 {
+		t.Errorf("Expected stats refresh for item 7, got %v", refreshedIDs)
+	}
+}
+
+func TestSeasonRange(t *testing.T) {
+	now := time.Date(2024, time.August, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		season       string
+		year         int
+		expectedFrom time.Time
+	}{
+		{name: "winter_explicit_year", season: dto.SeasonWinter, year: 2020, expectedFrom: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "spring_default_year", season: dto.SeasonSpring, expectedFrom: time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "fall", season: dto.SeasonFall, year: 2023, expectedFrom: time.Date(2023, time.October, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "current", season: dto.SeasonCurrent, expectedFrom: time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to := seasonRange(tt.season, tt.year, now)
+			if !from.Equal(tt.expectedFrom) {
+				t.Errorf("Expected from %v, got %v", tt.expectedFrom, from)
+			}
+			if !to.Equal(tt.expectedFrom.AddDate(0, 3, 0)) {
+				t.Errorf("Expected to %v, got %v", tt.expectedFrom.AddDate(0, 3, 0), to)
+			}
+		})
+	}
+}
+
+func TestGetRankings_AssignsRankFromOffset(t *testing.T) {
+	ctx := context.Background()
+	var receivedFilter dto.ItemRankingFilter
+
+	mockItemRepo := &testutil.MockItemRepository{
+		GetRankedFunc: func(ctx context.Context, filter dto.ItemRankingFilter, params dto.PaginationParams) ([]models.Item, int64, error) {
+			receivedFilter = filter
+			return []models.Item{{Title: "A", Type: models.MediaTypeAnime}, {Title: "B", Type: models.MediaTypeAnime}}, 42, nil
+		},
+	}
+
+	service := NewItemService(mockItemRepo, &testutil.MockTagRepository{}, nil, nil)
+	filter := dto.ItemRankingFilter{Type: "anime", SortBy: dto.ItemSortScore, Season: dto.SeasonSummer, Year: 2023}
+	rankings, total, err := service.GetRankings(ctx, filter, dto.PaginationParams{Page: 3, Limit: 10})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if total != 42 {
+		t.Errorf("Expected total 42, got %d", total)
+	}
+	if len(rankings) != 2 || rankings[0].Rank != 21 || rankings[1].Rank != 22 {
+		t.Errorf("Expected ranks 21 and 22, got %+v", rankings)
+	}
+	if receivedFilter.ReleasedFrom == nil || !receivedFilter.ReleasedFrom.Equal(time.Date(2023, time.July, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected season to resolve to July 2023, got %v", receivedFilter.ReleasedFrom)
+	}
+}
+
+func TestGetRankings_InvalidMediaType(t *testing.T) {
+	service := NewItemService(&testutil.MockItemRepository{}, &testutil.MockTagRepository{}, nil, nil)
+
+	_, _, err := service.GetRankings(context.Background(), dto.ItemRankingFilter{Type: "invalid"}, dto.PaginationParams{})
+	if !errors.Is(err, models.ErrInvalidMediaType) {
+		t.Errorf("Expected ErrInvalidMediaType, got %v", err)
+	}
+}
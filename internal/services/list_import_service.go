@@ -0,0 +1,498 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/repositories"
+	"gorm.io/gorm"
+)
+
+// listImportLookupSize limita o número de IDs ou títulos por consulta ao catálogo
+const listImportLookupSize = 1000
+
+type ListImportService struct {
+	listImportRepo repositories.ListImportRepositoryInterface
+	itemRepo       repositories.ItemRepositoryInterface
+	uow            repositories.UnitOfWorkInterface
+	statsRepo      repositories.ItemStatsRepositoryInterface // Opcional: nil desativa a atualização incremental dos agregados
+}
+
+// NewListImportService cria uma nova instância do serviço de imports de lista pessoal
+func NewListImportService(listImportRepo repositories.ListImportRepositoryInterface, itemRepo repositories.ItemRepositoryInterface, uow repositories.UnitOfWorkInterface, statsRepo repositories.ItemStatsRepositoryInterface) *ListImportService {
+	return &ListImportService{
+		listImportRepo: listImportRepo,
+		itemRepo:       itemRepo,
+		uow:            uow,
+		statsRepo:      statsRepo,
+	}
+}
+
+// Import lê o export de outro serviço e adiciona à lista do usuário as entradas encontradas no catálogo
+// Cada entrada é procurada pelos IDs externos (external_metadata) e depois pelo título, desempatado pelo ano
+// Entradas não encontradas ficam guardadas como unmatched para revisão; items que já estão na lista não são alterados
+// A importação não gera eventos de atividade: o history recebe as datas do export
+func (s *ListImportService) Import(ctx context.Context, userID uint, source models.ListImportSource, fileName string, reader io.Reader) (*dto.ListImportDTO, error) {
+	if !source.IsValid() {
+		return nil, models.ErrInvalidListImportSource
+	}
+
+	records, err := parseListExport(source, fileName, reader)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidListImportFile, err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("%w: the export has no entries", models.ErrInvalidListImportFile)
+	}
+
+	matches, err := s.matchRecords(ctx, records)
+	if err != nil {
+		return nil, err
+	}
+
+	listImport := &models.ListImport{
+		UserID:   userID,
+		Source:   source,
+		FileName: fileName,
+		Total:    len(records),
+		Entries:  make([]models.ListImportEntry, len(records)),
+	}
+
+	var importedItemIDs []uint
+	err = s.uow.Do(ctx, func(tx *repositories.Repositories) error {
+		importedItemIDs = importedItemIDs[:0]
+
+		for i, record := range records {
+			entry := record.entry
+			match := matches[i]
+
+			if match.item == nil {
+				entry.Status = models.ListImportEntryUnmatched
+				entry.Reason = match.reason
+				listImport.Entries[i] = entry
+				continue
+			}
+			entry.ItemID = &match.item.ID
+
+			// Savepoint: uma entrada que falha não invalida as demais
+			var userItem *models.UserItem
+			addErr := tx.UnitOfWork.Do(ctx, func(sp *repositories.Repositories) error {
+				var err error
+				userItem, err = addListImportEntry(ctx, sp.UserItems, userID, &entry, match.item)
+				return err
+			})
+
+			switch {
+			case addErr == nil:
+				entry.Status = models.ListImportEntryImported
+				importedItemIDs = append(importedItemIDs, match.item.ID)
+			case errors.Is(addErr, models.ErrDuplicateEntry):
+				entry.Status = models.ListImportEntrySkipped
+				entry.Reason = "already in your list"
+			default:
+				entry.Status = models.ListImportEntryFailed
+				entry.Reason = addErr.Error()
+			}
+			if userItem != nil {
+				entry.UserItemID = &userItem.ID
+			}
+			listImport.Entries[i] = entry
+		}
+
+		return tx.ListImports.Create(ctx, listImport)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to import list: %w", err)
+	}
+
+	refreshItemStats(ctx, s.statsRepo, importedItemIDs...)
+
+	counts := make(map[string]int)
+	for _, entry := range listImport.Entries {
+		counts[string(entry.Status)]++
+	}
+	return dto.ListImportToDTO(listImport, counts), nil
+}
+
+// GetImports retorna os imports de lista do usuário com os totais por status
+func (s *ListImportService) GetImports(ctx context.Context, userID uint, params dto.PaginationParams) ([]dto.ListImportDTO, int64, error) {
+	imports, total, err := s.listImportRepo.GetByUserID(ctx, userID, params)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get list imports: %w", err)
+	}
+
+	ids := make([]uint, len(imports))
+	for i, listImport := range imports {
+		ids[i] = listImport.ID
+	}
+	counts, err := s.countEntries(ctx, ids...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	result := make([]dto.ListImportDTO, len(imports))
+	for i := range imports {
+		result[i] = *dto.ListImportToDTO(&imports[i], counts[imports[i].ID])
+	}
+	return result, total, nil
+}
+
+// GetImport retorna um import de lista do usuário com os totais por status
+func (s *ListImportService) GetImport(ctx context.Context, userID, id uint) (*dto.ListImportDTO, error) {
+	listImport, err := s.listImportRepo.GetByIDAndUser(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := s.countEntries(ctx, listImport.ID)
+	if err != nil {
+		return nil, err
+	}
+	return dto.ListImportToDTO(listImport, counts[listImport.ID]), nil
+}
+
+// GetEntries retorna as entradas de um import do usuário, opcionalmente filtradas por status (ex: unmatched)
+func (s *ListImportService) GetEntries(ctx context.Context, userID, id uint, filter dto.ListImportEntryFilter, params dto.PaginationParams) ([]models.ListImportEntry, int64, error) {
+	if _, err := s.listImportRepo.GetByIDAndUser(ctx, id, userID); err != nil {
+		return nil, 0, err
+	}
+	return s.listImportRepo.GetEntries(ctx, id, models.ListImportEntryStatus(filter.Status), params)
+}
+
+// ResolveEntry adiciona à lista uma entrada não encontrada, com o item do catálogo escolhido pelo usuário
+func (s *ListImportService) ResolveEntry(ctx context.Context, userID, id, entryID, itemID uint) (*models.ListImportEntry, error) {
+	entry, err := s.getUnmatchedEntry(ctx, userID, id, entryID)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.itemRepo.GetByID(ctx, itemID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrItemNotFound
+		}
+		return nil, fmt.Errorf("failed to get item: %w", err)
+	}
+
+	err = s.uow.Do(ctx, func(tx *repositories.Repositories) error {
+		userItem, err := addListImportEntry(ctx, tx.UserItems, userID, entry, item)
+		if err != nil {
+			return err
+		}
+
+		entry.Status = models.ListImportEntryResolved
+		entry.Reason = ""
+		entry.ItemID = &item.ID
+		entry.UserItemID = &userItem.ID
+		return tx.ListImports.UpdateEntry(ctx, entry)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	refreshItemStats(ctx, s.statsRepo, item.ID)
+	return entry, nil
+}
+
+// DismissEntry descarta uma entrada não encontrada (ela sai da revisão sem ser adicionada à lista)
+func (s *ListImportService) DismissEntry(ctx context.Context, userID, id, entryID uint) (*models.ListImportEntry, error) {
+	entry, err := s.getUnmatchedEntry(ctx, userID, id, entryID)
+	if err != nil {
+		return nil, err
+	}
+
+	entry.Status = models.ListImportEntryDismissed
+	if err := s.listImportRepo.UpdateEntry(ctx, entry); err != nil {
+		return nil, fmt.Errorf("failed to dismiss entry: %w", err)
+	}
+	return entry, nil
+}
+
+// getUnmatchedEntry busca uma entrada de um import do usuário que ainda aguarda revisão
+func (s *ListImportService) getUnmatchedEntry(ctx context.Context, userID, id, entryID uint) (*models.ListImportEntry, error) {
+	if _, err := s.listImportRepo.GetByIDAndUser(ctx, id, userID); err != nil {
+		return nil, err
+	}
+
+	entry, err := s.listImportRepo.GetEntry(ctx, id, entryID)
+	if err != nil {
+		return nil, err
+	}
+	if entry.Status != models.ListImportEntryUnmatched {
+		return nil, models.ErrListImportEntryReviewed
+	}
+	return entry, nil
+}
+
+// countEntries retorna os totais por status das entradas de cada import
+func (s *ListImportService) countEntries(ctx context.Context, ids ...uint) (map[uint]map[string]int, error) {
+	rows, err := s.listImportRepo.CountEntriesByStatus(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count list import entries: %w", err)
+	}
+
+	counts := make(map[uint]map[string]int, len(ids))
+	for _, row := range rows {
+		if counts[row.ListImportID] == nil {
+			counts[row.ListImportID] = make(map[string]int)
+		}
+		counts[row.ListImportID][row.Status] = row.Count
+	}
+	return counts, nil
+}
+
+// addListImportEntry cria a entrada da lista a partir dos dados convertidos do export
+// Se o item já estiver na lista, retorna a entrada existente (sem alterá-la) e ErrDuplicateEntry
+func addListImportEntry(ctx context.Context, userItemRepo repositories.UserItemRepositoryInterface, userID uint, entry *models.ListImportEntry, item *models.Item) (*models.UserItem, error) {
+	existing, err := userItemRepo.GetByUserAndItem(ctx, userID, item.ID)
+	if err == nil {
+		return existing, models.ErrDuplicateEntry
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("failed to check if item exists in user list: %w", err)
+	}
+
+	userItem := importedUserItem(userID, entry, item)
+	if err := userItem.Validate(); err != nil {
+		return nil, err
+	}
+	if err := userItemRepo.Create(ctx, userItem); err != nil {
+		return nil, fmt.Errorf("failed to add item to list: %w", err)
+	}
+	return userItem, nil
+}
+
+// importedUserItem converte a entrada do export para a lista, com o progresso no formato do tipo do item
+func importedUserItem(userID uint, entry *models.ListImportEntry, item *models.Item) *models.UserItem {
+	userItem := &models.UserItem{
+		UserID:          userID,
+		ItemID:          item.ID,
+		Status:          entry.ListStatus,
+		Rating:          entry.Rating,
+		Notes:           entry.Notes,
+		ProgressType:    models.GetDefaultProgressType(item.Type),
+		ProgressData:    models.JSONB{},
+		CompletionCount: entry.CompletionCount,
+	}
+	if userItem.Status == "" {
+		userItem.Status = models.StatusPlanned
+	}
+	if userItem.Status == models.StatusCompleted && userItem.CompletionCount == 0 {
+		userItem.CompletionCount = 1
+	}
+
+	switch userItem.ProgressType {
+	case models.ProgressTypeEpisodic:
+		if entry.Progress > 0 {
+			userItem.SetEpisodicProgress(1, entry.Progress)
+		}
+	case models.ProgressTypeReading:
+		var chapter, volume *int
+		if entry.Progress > 0 {
+			chapter = &entry.Progress
+		}
+		if entry.ProgressVolumes > 0 {
+			volume = &entry.ProgressVolumes
+		}
+		if chapter != nil || volume != nil {
+			userItem.SetReadingProgress(chapter, volume, nil)
+		}
+	case models.ProgressTypePercent:
+		if entry.Progress > 0 {
+			percent := 0
+			if userItem.Status == models.StatusCompleted {
+				percent = 100
+			}
+			userItem.SetPercentProgress(percent, entry.Progress/60, nil)
+		}
+	}
+
+	// Uma visualização concluída precisa da data de término; sem ela, só o status e o contador de conclusões são importados
+	if entry.FinishedAt != nil || (entry.StartedAt != nil && userItem.Status != models.StatusCompleted) {
+		userItem.AddView(entry.StartedAt, entry.FinishedAt)
+	}
+
+	return userItem
+}
+
+// listImportMatch é o item do catálogo encontrado para uma entrada, ou o motivo de não ter sido encontrado
+type listImportMatch struct {
+	item   *models.Item
+	reason string
+}
+
+// matchRecords procura as entradas no catálogo: primeiro pelos IDs externos, depois pela chave de título
+// As consultas são agrupadas por tipo e fonte, então o número de idas ao banco não depende do tamanho do export
+func (s *ListImportService) matchRecords(ctx context.Context, records []listImportRecord) ([]listImportMatch, error) {
+	matches := make([]listImportMatch, len(records))
+
+	// 1. IDs externos
+	wantedIDs := make(map[models.MediaType]map[string][]string)
+	for _, record := range records {
+		for _, mediaType := range record.mediaTypes {
+			for _, externalID := range record.externalIDs {
+				if wantedIDs[mediaType] == nil {
+					wantedIDs[mediaType] = make(map[string][]string)
+				}
+				wantedIDs[mediaType][externalID.source] = append(wantedIDs[mediaType][externalID.source], externalID.id)
+			}
+		}
+	}
+
+	byExternal := make(map[string]*models.Item)
+	for mediaType, sources := range wantedIDs {
+		for source, ids := range sources {
+			for _, chunk := range chunkStrings(uniqueStrings(ids), listImportLookupSize) {
+				items, err := s.itemRepo.FindByExternalIDs(ctx, mediaType, source, chunk)
+				if err != nil {
+					return nil, fmt.Errorf("failed to look up items by external IDs: %w", err)
+				}
+				for i := range items {
+					key := externalKey(mediaType, source) + ":" + exportScalar(items[i].ExternalMetadata[source])
+					if _, ok := byExternal[key]; !ok {
+						byExternal[key] = &items[i]
+					}
+				}
+			}
+		}
+	}
+
+	wantedTitles := make(map[models.MediaType][]string)
+	for i, record := range records {
+	search:
+		for _, externalID := range record.externalIDs {
+			for _, mediaType := range record.mediaTypes {
+				if item, ok := byExternal[externalKey(mediaType, externalID.source)+":"+externalID.id]; ok {
+					matches[i].item = item
+					break search
+				}
+			}
+		}
+
+		if matches[i].item == nil {
+			for _, mediaType := range record.mediaTypes {
+				for _, title := range record.titles {
+					if key := titleMatchKey(title); key != "" {
+						wantedTitles[mediaType] = append(wantedTitles[mediaType], key)
+					}
+				}
+			}
+		}
+	}
+
+	// 2. Título sem pontuação, desempatado pelo ano de lançamento
+	byTitle := make(map[string][]models.Item)
+	for mediaType, keys := range wantedTitles {
+		for _, chunk := range chunkStrings(uniqueStrings(keys), listImportLookupSize) {
+			items, err := s.itemRepo.FindByTitleKeys(ctx, mediaType, chunk)
+			if err != nil {
+				return nil, fmt.Errorf("failed to look up items by title: %w", err)
+			}
+			for _, item := range items {
+				key := titleKey(mediaType, titleMatchKey(item.Title))
+				byTitle[key] = append(byTitle[key], item)
+			}
+		}
+	}
+
+	for i, record := range records {
+		if matches[i].item != nil {
+			continue
+		}
+
+		var candidates []models.Item
+		seen := make(map[uint]bool)
+		for _, mediaType := range record.mediaTypes {
+			for _, title := range record.titles {
+				for _, item := range byTitle[titleKey(mediaType, titleMatchKey(title))] {
+					if !seen[item.ID] {
+						seen[item.ID] = true
+						candidates = append(candidates, item)
+					}
+				}
+			}
+		}
+		matches[i] = matchByTitle(candidates, record.entry.Year)
+	}
+
+	return matches, nil
+}
+
+// matchByTitle escolhe entre os items com o mesmo título usando o ano de lançamento
+// Items sem data e anos com 1 de diferença (estreia em outro país, por exemplo) continuam candidatos
+func matchByTitle(candidates []models.Item, year int) listImportMatch {
+	if len(candidates) == 0 {
+		return listImportMatch{reason: "no catalog item matches this entry"}
+	}
+
+	compatible := candidates
+	var sameYear []models.Item
+	if year > 0 {
+		compatible = nil
+		for _, item := range candidates {
+			if item.ReleaseDate == nil {
+				compatible = append(compatible, item)
+				continue
+			}
+			diff := item.ReleaseDate.Year() - year
+			if diff >= -1 && diff <= 1 {
+				compatible = append(compatible, item)
+			}
+			if diff == 0 {
+				sameYear = append(sameYear, item)
+			}
+		}
+	}
+
+	switch {
+	case len(compatible) == 1:
+		return listImportMatch{item: &compatible[0]}
+	case len(sameYear) == 1:
+		return listImportMatch{item: &sameYear[0]}
+	case len(compatible) == 0:
+		return listImportMatch{reason: fmt.Sprintf("catalog items with this title were released in other years (not %d)", year)}
+	default:
+		return listImportMatch{reason: fmt.Sprintf("%d catalog items match this title", len(compatible))}
+	}
+}
+
+// titleMatchKey normaliza o título para a comparação aproximada: minúsculas, apenas letras e números
+// Deve corresponder à chave calculada no banco por FindByTitleKeys
+func titleMatchKey(title string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, title)
+}
+
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	unique := make([]string, 0, len(values))
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
+
+func chunkStrings(values []string, size int) [][]string {
+	var chunks [][]string
+	for start := 0; start < len(values); start += size {
+		end := start + size
+		if end > len(values) {
+			end = len(values)
+		}
+		chunks = append(chunks, values[start:end])
+	}
+	return chunks
+}
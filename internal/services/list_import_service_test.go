@@ -0,0 +1,486 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/testutil"
+	"gorm.io/gorm"
+)
+
+func TestParseListExport_MAL(t *testing.T) {
+	xml := `<?xml version="1.0" encoding="UTF-8"?>
+<myanimelist>
+	<myinfo><user_name>someone</user_name></myinfo>
+	<anime>
+		<series_animedb_id>5114</series_animedb_id>
+		<series_title><![CDATA[Fullmetal Alchemist: Brotherhood]]></series_title>
+		<my_watched_episodes>64</my_watched_episodes>
+		<my_start_date>2024-01-05</my_start_date>
+		<my_finish_date>2024-03-10</my_finish_date>
+		<my_score>10</my_score>
+		<my_status>Completed</my_status>
+		<my_times_watched>0</my_times_watched>
+	</anime>
+	<manga>
+		<manga_mangadb_id>2</manga_mangadb_id>
+		<manga_title><![CDATA[Berserk]]></manga_title>
+		<my_read_volumes>12</my_read_volumes>
+		<my_read_chapters>120</my_read_chapters>
+		<my_start_date>0000-00-00</my_start_date>
+		<my_finish_date>0000-00-00</my_finish_date>
+		<my_score>0</my_score>
+		<my_status>Reading</my_status>
+	</manga>
+</myanimelist>`
+
+	records, err := parseListExport(models.ListImportSourceMAL, "animelist.xml", strings.NewReader(xml))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("Expected 2 records, got %d", len(records))
+	}
+
+	anime := records[0].entry
+	if anime.ExternalIDs != "mal:5114" || anime.ListStatus != models.StatusCompleted || anime.Rating != 10 || anime.Progress != 64 || anime.CompletionCount != 1 {
+		t.Errorf("Unexpected anime entry: %+v", anime)
+	}
+	if anime.StartedAt == nil || anime.FinishedAt == nil || anime.FinishedAt.Format("2006-01-02") != "2024-03-10" {
+		t.Errorf("Expected start and finish dates, got %v and %v", anime.StartedAt, anime.FinishedAt)
+	}
+
+	manga := records[1].entry
+	if manga.ListStatus != models.StatusInProgress || manga.Progress != 120 || manga.ProgressVolumes != 12 {
+		t.Errorf("Unexpected manga entry: %+v", manga)
+	}
+	if manga.StartedAt != nil || manga.FinishedAt != nil {
+		t.Errorf("Expected 0000-00-00 dates to be empty, got %v and %v", manga.StartedAt, manga.FinishedAt)
+	}
+	if len(records[1].mediaTypes) != 2 {
+		t.Errorf("Expected MAL manga to match comics and novels, got %v", records[1].mediaTypes)
+	}
+}
+
+func TestParseListExport_AniListRescalesScores(t *testing.T) {
+	data := `{"data":{"MediaListCollection":{
+		"user":{"mediaListOptions":{"scoreFormat":"POINT_100"}},
+		"lists":[
+			{"isCustomList":false,"entries":[
+				{"status":"CURRENT","score":85,"progress":3,"startedAt":{"year":2025,"month":2,"day":1},
+				 "media":{"id":21,"idMal":21,"type":"ANIME","format":"TV","title":{"romaji":"One Piece","english":"One Piece"},"startDate":{"year":1999}}},
+				{"status":"COMPLETED","score":70,"repeat":1,"completedAt":{"year":2023,"month":6},
+				 "media":{"id":30,"type":"MANGA","format":"NOVEL","title":{"romaji":"Koukaku no Regios","english":"Chrome Shelled Regios"}}}
+			]},
+			{"isCustomList":true,"entries":[
+				{"status":"CURRENT","score":85,"media":{"id":21,"type":"ANIME","title":{"romaji":"One Piece"}}}
+			]}
+		]
+	}}}`
+
+	records, err := parseListExport(models.ListImportSourceAniList, "anilist.json", strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("Expected custom lists to be skipped (2 records), got %d", len(records))
+	}
+
+	anime := records[0].entry
+	if anime.Rating != 8.5 || anime.ListStatus != models.StatusInProgress || anime.Year != 1999 {
+		t.Errorf("Unexpected anime entry: %+v", anime)
+	}
+	if anime.ExternalIDs != "anilist:21|mal:21" {
+		t.Errorf("Expected anilist and mal IDs, got %q", anime.ExternalIDs)
+	}
+
+	novel := records[1].entry
+	if novel.MediaType != models.MediaTypeNovel || novel.Rating != 7 || novel.CompletionCount != 2 {
+		t.Errorf("Unexpected novel entry: %+v", novel)
+	}
+	if novel.FinishedAt == nil || novel.FinishedAt.Format("2006-01") != "2023-06" {
+		t.Errorf("Expected partial completion date, got %v", novel.FinishedAt)
+	}
+}
+
+func TestAniListRating(t *testing.T) {
+	tests := []struct {
+		score  float64
+		format string
+		want   float64
+	}{
+		{85, "POINT_100", 8.5},
+		{4, "POINT_5", 8},
+		{3, "POINT_3", 10},
+		{1, "POINT_3", 3},
+		{7.5, "POINT_10_DECIMAL", 7.5},
+	}
+
+	for _, tt := range tests {
+		if got := aniListRating(tt.score, tt.format); got != tt.want {
+			t.Errorf("aniListRating(%v, %s) = %v, want %v", tt.score, tt.format, got, tt.want)
+		}
+	}
+}
+
+func TestParseListExport_LetterboxdMergesDiaryRewatches(t *testing.T) {
+	csv := "Date,Name,Year,Letterboxd URI,Rating,Rewatch,Tags,Watched Date\n" +
+		"2024-01-02,Heat,1995,https://boxd.it/aaa,4,,,2024-01-01\n" +
+		"2025-05-02,Heat,1995,https://boxd.it/aaa,4.5,Yes,,2025-05-01\n" +
+		"2025-06-02,Alien,1979,https://boxd.it/bbb,,,,2025-06-01\n"
+
+	records, err := parseListExport(models.ListImportSourceLetterboxd, "diary.csv", strings.NewReader(csv))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("Expected rewatches to be merged (2 records), got %d", len(records))
+	}
+
+	heat := records[0].entry
+	if heat.Rating != 9 || heat.CompletionCount != 2 || heat.Year != 1995 || heat.ListStatus != models.StatusCompleted {
+		t.Errorf("Unexpected entry: %+v", heat)
+	}
+	if heat.FinishedAt == nil || heat.FinishedAt.Format("2006-01-02") != "2025-05-01" {
+		t.Errorf("Expected latest watched date, got %v", heat.FinishedAt)
+	}
+
+	watchlist, err := parseListExport(models.ListImportSourceLetterboxd, "watchlist.csv",
+		strings.NewReader("Date,Name,Year,Letterboxd URI\n2025-01-01,Ran,1985,https://boxd.it/ccc\n"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if watchlist[0].entry.ListStatus != models.StatusPlanned {
+		t.Errorf("Expected watchlist entries to be planned, got %s", watchlist[0].entry.ListStatus)
+	}
+}
+
+func TestParseListExport_Goodreads(t *testing.T) {
+	csv := "Book Id,Title,Author,ISBN,ISBN13,My Rating,Year Published,Original Publication Year,Date Read,Exclusive Shelf,Private Notes,Read Count\n" +
+		`5907,"The Hobbit (Middle-earth Universe, #0)",J.R.R. Tolkien,"=""0618260307""","=""9780618260300""",5,2002,1937,2024/08/15,read,Reread soon,2` + "\n" +
+		`1234,Some Book,Someone,"=""""","=""""",0,2020,,,currently-reading,,0` + "\n"
+
+	records, err := parseListExport(models.ListImportSourceGoodreads, "goodreads_library_export.csv", strings.NewReader(csv))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	hobbit := records[0]
+	if hobbit.entry.ExternalIDs != "isbn:9780618260300|isbn:0618260307|goodreads:5907" {
+		t.Errorf("Unexpected external IDs: %q", hobbit.entry.ExternalIDs)
+	}
+	if hobbit.entry.Rating != 10 || hobbit.entry.Year != 1937 || hobbit.entry.CompletionCount != 2 || hobbit.entry.Notes != "Reread soon" {
+		t.Errorf("Unexpected entry: %+v", hobbit.entry)
+	}
+	if len(hobbit.titles) != 2 || hobbit.titles[1] != "The Hobbit" {
+		t.Errorf("Expected title without series suffix as alternate, got %v", hobbit.titles)
+	}
+
+	reading := records[1].entry
+	if reading.ListStatus != models.StatusInProgress || reading.Rating != 0 || reading.ExternalIDs != "goodreads:1234" {
+		t.Errorf("Unexpected entry: %+v", reading)
+	}
+}
+
+func TestParseListExport_Steam(t *testing.T) {
+	data := `{"response":{"game_count":2,"games":[
+		{"appid":620,"name":"Portal 2","playtime_forever":750},
+		{"appid":400,"name":"Portal","playtime_forever":0}
+	]}}`
+
+	records, err := parseListExport(models.ListImportSourceSteam, "owned_games.json", strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if records[0].entry.ListStatus != models.StatusInProgress || records[0].entry.Progress != 750 || records[0].entry.ExternalIDs != "steam:620" {
+		t.Errorf("Unexpected entry: %+v", records[0].entry)
+	}
+	if records[1].entry.ListStatus != models.StatusPlanned {
+		t.Errorf("Expected unplayed game to be planned, got %s", records[1].entry.ListStatus)
+	}
+}
+
+func TestParseListExport_InvalidFile(t *testing.T) {
+	_, err := parseListExport(models.ListImportSourceLetterboxd, "diary.csv", strings.NewReader("Title,Rating\nHeat,4\n"))
+	if err == nil {
+		t.Error("Expected error for missing Letterboxd columns, got nil")
+	}
+}
+
+// listImportTestService monta o serviço com o catálogo em memória e registra os items adicionados à lista
+func listImportTestService(catalog []models.Item, created *[]*models.UserItem, saved **models.ListImport) *ListImportService {
+	itemRepo := &testutil.MockItemRepository{
+		FindByExternalIDsFunc: func(ctx context.Context, mediaType models.MediaType, source string, externalIDs []string) ([]models.Item, error) {
+			var items []models.Item
+			for _, item := range catalog {
+				for _, id := range externalIDs {
+					if item.Type == mediaType && exportScalar(item.ExternalMetadata[source]) == id {
+						items = append(items, item)
+					}
+				}
+			}
+			return items, nil
+		},
+		FindByTitleKeysFunc: func(ctx context.Context, mediaType models.MediaType, keys []string) ([]models.Item, error) {
+			var items []models.Item
+			for _, item := range catalog {
+				for _, key := range keys {
+					if item.Type == mediaType && titleMatchKey(item.Title) == key {
+						items = append(items, item)
+					}
+				}
+			}
+			return items, nil
+		},
+	}
+
+	userItemRepo := &testutil.MockUserItemRepository{
+		GetByUserAndItemFunc: func(ctx context.Context, userID, itemID uint) (*models.UserItem, error) {
+			for _, userItem := range *created {
+				if userItem.ItemID == itemID {
+					return userItem, nil
+				}
+			}
+			return nil, gorm.ErrRecordNotFound
+		},
+		CreateFunc: func(ctx context.Context, userItem *models.UserItem) error {
+			userItem.ID = uint(len(*created) + 1)
+			*created = append(*created, userItem)
+			return nil
+		},
+	}
+
+	listImportRepo := &testutil.MockListImportRepository{
+		CreateFunc: func(ctx context.Context, listImport *models.ListImport) error {
+			listImport.ID = 1
+			*saved = listImport
+			return nil
+		},
+	}
+
+	uow := &testutil.MockUnitOfWork{UserItems: userItemRepo, ListImports: listImportRepo}
+	return NewListImportService(listImportRepo, itemRepo, uow, nil)
+}
+
+func TestListImport_MatchesByExternalIDThenTitle(t *testing.T) {
+	ctx := context.Background()
+	released := func(year int) *time.Time {
+		date := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+		return &date
+	}
+
+	catalog := []models.Item{
+		{ID: 1, Type: models.MediaTypeAnime, Title: "Fullmetal Alchemist: Brotherhood", ExternalMetadata: models.JSONB{"mal": float64(5114)}},
+		{ID: 2, Type: models.MediaTypeAnime, Title: "Cowboy Bebop", ReleaseDate: released(1998)},
+		{ID: 3, Type: models.MediaTypeAnime, Title: "Hunter x Hunter", ReleaseDate: released(1999)},
+		{ID: 4, Type: models.MediaTypeAnime, Title: "Hunter x Hunter", ReleaseDate: released(2011)},
+		{ID: 5, Type: models.MediaTypeAnime, Title: "Trigun", ReleaseDate: released(1998)},
+		{ID: 6, Type: models.MediaTypeAnime, Title: "Trigun", ReleaseDate: released(1999)},
+	}
+
+	data := `{"MediaListCollection":{"lists":[{"entries":[
+		{"status":"COMPLETED","score":9,"completedAt":{"year":2024,"month":3,"day":10},
+		 "media":{"id":5114,"idMal":5114,"type":"ANIME","title":{"romaji":"Hagane no Renkinjutsushi: Fullmetal Alchemist"}}},
+		{"status":"CURRENT","score":8,"progress":5,"media":{"id":1,"type":"ANIME","title":{"romaji":"Cowboy Bebop!"},"startDate":{"year":1998}}},
+		{"status":"PLANNING","media":{"id":11061,"type":"ANIME","title":{"romaji":"HUNTER×HUNTER","english":"Hunter x Hunter"},"startDate":{"year":2011}}},
+		{"status":"PLANNING","media":{"id":6,"type":"ANIME","title":{"romaji":"Trigun"}}},
+		{"status":"PLANNING","media":{"id":99999,"type":"ANIME","title":{"romaji":"Unknown Show"}}}
+	]}]}}`
+
+	var created []*models.UserItem
+	var saved *models.ListImport
+	service := listImportTestService(catalog, &created, &saved)
+
+	result, err := service.Import(ctx, 7, models.ListImportSourceAniList, "anilist.json", strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.Total != 5 || result.Imported != 3 || result.Unmatched != 2 {
+		t.Errorf("Expected 3 imported and 2 unmatched of 5, got %+v", result)
+	}
+	if result.ReviewURL == "" {
+		t.Error("Expected review URL for unmatched entries")
+	}
+
+	wantItems := []uint{1, 2, 4}
+	if len(created) != len(wantItems) {
+		t.Fatalf("Expected %d list items, got %d", len(wantItems), len(created))
+	}
+	for i, want := range wantItems {
+		if created[i].ItemID != want || created[i].UserID != 7 {
+			t.Errorf("Expected list item %d for item %d, got %+v", i, want, created[i])
+		}
+	}
+
+	fma := created[0]
+	if fma.Status != models.StatusCompleted || fma.Rating != 9 || fma.CompletionCount != 1 {
+		t.Errorf("Unexpected completed entry: %+v", fma)
+	}
+	if len(fma.GetAllViews()) != 1 {
+		t.Errorf("Expected completion date in view history, got %v", fma.ProgressData)
+	}
+	if created[1].Status != models.StatusInProgress || created[1].ProgressData["episode"] == nil {
+		t.Errorf("Expected episodic progress, got %+v", created[1])
+	}
+
+	entries := saved.Entries
+	if entries[3].Status != models.ListImportEntryUnmatched || !strings.Contains(entries[3].Reason, "2 catalog items") {
+		t.Errorf("Expected ambiguous title to be unmatched, got %+v", entries[3])
+	}
+	if entries[4].Status != models.ListImportEntryUnmatched || entries[4].ItemID != nil {
+		t.Errorf("Expected unknown title to be unmatched, got %+v", entries[4])
+	}
+}
+
+func TestListImport_SkipsItemsAlreadyInList(t *testing.T) {
+	ctx := context.Background()
+	catalog := []models.Item{{ID: 1, Type: models.MediaTypeGame, Title: "Portal 2", ExternalMetadata: models.JSONB{"steam": "620"}}}
+
+	created := []*models.UserItem{{ID: 9, ItemID: 1, Status: models.StatusCompleted}}
+	var saved *models.ListImport
+	service := listImportTestService(catalog, &created, &saved)
+
+	data := `{"games":[{"appid":620,"name":"Portal 2","playtime_forever":750}]}`
+	result, err := service.Import(ctx, 1, models.ListImportSourceSteam, "games.json", strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.Skipped != 1 || len(created) != 1 {
+		t.Errorf("Expected entry to be skipped without changes, got %+v", result)
+	}
+	if entry := saved.Entries[0]; entry.UserItemID == nil || *entry.UserItemID != 9 {
+		t.Errorf("Expected skipped entry to point to the existing list item, got %+v", entry)
+	}
+}
+
+func TestListImport_InvalidInput(t *testing.T) {
+	ctx := context.Background()
+	service := NewListImportService(&testutil.MockListImportRepository{}, &testutil.MockItemRepository{}, &testutil.MockUnitOfWork{}, nil)
+
+	if _, err := service.Import(ctx, 1, "trakt", "list.json", strings.NewReader("{}")); !errors.Is(err, models.ErrInvalidListImportSource) {
+		t.Errorf("Expected ErrInvalidListImportSource, got %v", err)
+	}
+	if _, err := service.Import(ctx, 1, models.ListImportSourceMAL, "list.xml", strings.NewReader("not xml")); !errors.Is(err, models.ErrInvalidListImportFile) {
+		t.Errorf("Expected ErrInvalidListImportFile for unreadable file, got %v", err)
+	}
+	if _, err := service.Import(ctx, 1, models.ListImportSourceSteam, "games.json", strings.NewReader(`{"games":[]}`)); !errors.Is(err, models.ErrInvalidListImportFile) {
+		t.Errorf("Expected ErrInvalidListImportFile for empty export, got %v", err)
+	}
+}
+
+// listImportReviewService monta o serviço para a revisão de uma entrada do import 1 do usuário 1
+func listImportReviewService(entry *models.ListImportEntry, created *[]*models.UserItem, updated **models.ListImportEntry) *ListImportService {
+	listImportRepo := &testutil.MockListImportRepository{
+		GetByIDAndUserFunc: func(ctx context.Context, id, userID uint) (*models.ListImport, error) {
+			if id != 1 || userID != 1 {
+				return nil, models.ErrListImportNotFound
+			}
+			return &models.ListImport{ID: 1, UserID: 1}, nil
+		},
+		GetEntryFunc: func(ctx context.Context, listImportID, entryID uint) (*models.ListImportEntry, error) {
+			return entry, nil
+		},
+		UpdateEntryFunc: func(ctx context.Context, entry *models.ListImportEntry) error {
+			*updated = entry
+			return nil
+		},
+	}
+	itemRepo := &testutil.MockItemRepository{
+		GetByIDFunc: func(ctx context.Context, id uint) (*models.Item, error) {
+			if id != 3 {
+				return nil, gorm.ErrRecordNotFound
+			}
+			return &models.Item{ID: 3, Type: models.MediaTypeComic, Title: "Berserk"}, nil
+		},
+	}
+	userItemRepo := &testutil.MockUserItemRepository{
+		GetByUserAndItemFunc: func(ctx context.Context, userID, itemID uint) (*models.UserItem, error) {
+			return nil, gorm.ErrRecordNotFound
+		},
+		CreateFunc: func(ctx context.Context, userItem *models.UserItem) error {
+			userItem.ID = 12
+			*created = append(*created, userItem)
+			return nil
+		},
+	}
+
+	uow := &testutil.MockUnitOfWork{UserItems: userItemRepo, ListImports: listImportRepo}
+	return NewListImportService(listImportRepo, itemRepo, uow, nil)
+}
+
+func TestResolveEntry_AddsChosenItem(t *testing.T) {
+	ctx := context.Background()
+	entry := &models.ListImportEntry{
+		ID: 5, ListImportID: 1, Status: models.ListImportEntryUnmatched, Reason: "no catalog item matches this entry",
+		ListStatus: models.StatusInProgress, Rating: 8, Progress: 120, ProgressVolumes: 12,
+	}
+
+	var created []*models.UserItem
+	var updated *models.ListImportEntry
+	service := listImportReviewService(entry, &created, &updated)
+
+	resolved, err := service.ResolveEntry(ctx, 1, 1, 5, 3)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if resolved.Status != models.ListImportEntryResolved || resolved.Reason != "" || updated == nil {
+		t.Errorf("Expected entry to be saved as resolved, got %+v", resolved)
+	}
+	if resolved.UserItemID == nil || *resolved.UserItemID != 12 || resolved.ItemID == nil || *resolved.ItemID != 3 {
+		t.Errorf("Expected entry to point to the new list item, got %+v", resolved)
+	}
+	if len(created) != 1 || created[0].Rating != 8 || created[0].ProgressData["chapter"] == nil {
+		t.Errorf("Expected list item with reading progress, got %+v", created)
+	}
+}
+
+func TestResolveEntry_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	var created []*models.UserItem
+	var updated *models.ListImportEntry
+	unmatched := &models.ListImportEntry{ID: 5, ListImportID: 1, Status: models.ListImportEntryUnmatched}
+	service := listImportReviewService(unmatched, &created, &updated)
+
+	if _, err := service.ResolveEntry(ctx, 2, 1, 5, 3); !errors.Is(err, models.ErrListImportNotFound) {
+		t.Errorf("Expected ErrListImportNotFound for another user's import, got %v", err)
+	}
+	if _, err := service.ResolveEntry(ctx, 1, 1, 5, 99); !errors.Is(err, models.ErrItemNotFound) {
+		t.Errorf("Expected ErrItemNotFound, got %v", err)
+	}
+
+	imported := &models.ListImportEntry{ID: 5, ListImportID: 1, Status: models.ListImportEntryImported}
+	service = listImportReviewService(imported, &created, &updated)
+	if _, err := service.ResolveEntry(ctx, 1, 1, 5, 3); !errors.Is(err, models.ErrListImportEntryReviewed) {
+		t.Errorf("Expected ErrListImportEntryReviewed, got %v", err)
+	}
+	if len(created) != 0 {
+		t.Errorf("Expected no list items to be created, got %d", len(created))
+	}
+}
+
+func TestDismissEntry(t *testing.T) {
+	ctx := context.Background()
+	entry := &models.ListImportEntry{ID: 5, ListImportID: 1, Status: models.ListImportEntryUnmatched}
+
+	var created []*models.UserItem
+	var updated *models.ListImportEntry
+	service := listImportReviewService(entry, &created, &updated)
+
+	dismissed, err := service.DismissEntry(ctx, 1, 1, 5)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if dismissed.Status != models.ListImportEntryDismissed || updated == nil || len(created) != 0 {
+		t.Errorf("Expected entry to be dismissed without adding it to the list, got %+v", dismissed)
+	}
+
+	if _, err := service.DismissEntry(ctx, 1, 1, 5); !errors.Is(err, models.ErrListImportEntryReviewed) {
+		t.Errorf("Expected ErrListImportEntryReviewed for a dismissed entry, got %v", err)
+	}
+}
@@ -0,0 +1,575 @@
+package services
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+)
+
+// listImportRecord é uma entrada do export já convertida para os campos da lista,
+// com as chaves usadas para encontrá-la no catálogo
+type listImportRecord struct {
+	entry       models.ListImportEntry
+	externalIDs []listImportExternalID // Em ordem de preferência
+	mediaTypes  []models.MediaType     // Tipos em que a entrada pode estar no catálogo
+	titles      []string               // Título principal e alternativos
+}
+
+// listImportExternalID é um ID da entrada em uma fonte de external_metadata (mal, anilist, isbn...)
+type listImportExternalID struct {
+	source string
+	id     string
+}
+
+// newListImportRecord monta a entrada com os IDs externos no formato source:id|source:id
+func newListImportRecord(line int, title string, year int, mediaTypes []models.MediaType, externalIDs []listImportExternalID) listImportRecord {
+	var valid []listImportExternalID
+	var pairs []string
+	for _, externalID := range externalIDs {
+		if externalID.id != "" && externalID.id != "0" {
+			valid = append(valid, externalID)
+			pairs = append(pairs, externalID.source+":"+externalID.id)
+		}
+	}
+
+	return listImportRecord{
+		entry: models.ListImportEntry{
+			Line:        line,
+			Title:       strings.TrimSpace(title),
+			Year:        year,
+			MediaType:   mediaTypes[0],
+			ExternalIDs: strings.Join(pairs, "|"),
+		},
+		externalIDs: valid,
+		mediaTypes:  mediaTypes,
+		titles:      []string{strings.TrimSpace(title)},
+	}
+}
+
+// parseListExport lê o export de uma das origens suportadas
+// fileName é usado pelo Letterboxd, cujo export separa a watchlist em outro arquivo
+func parseListExport(source models.ListImportSource, fileName string, reader io.Reader) ([]listImportRecord, error) {
+	switch source {
+	case models.ListImportSourceMAL:
+		return parseMALExport(reader)
+	case models.ListImportSourceAniList:
+		return parseAniListExport(reader)
+	case models.ListImportSourceLetterboxd:
+		return parseLetterboxdExport(reader, fileName)
+	case models.ListImportSourceGoodreads:
+		return parseGoodreadsExport(reader)
+	case models.ListImportSourceSteam:
+		return parseSteamExport(reader)
+	default:
+		return nil, models.ErrInvalidListImportSource
+	}
+}
+
+// ========================================
+// MyAnimeList (XML)
+// ========================================
+
+type malExport struct {
+	XMLName xml.Name   `xml:"myanimelist"`
+	Anime   []malEntry `xml:"anime"`
+	Manga   []malEntry `xml:"manga"`
+}
+
+type malEntry struct {
+	AnimeID         string `xml:"series_animedb_id"`
+	AnimeTitle      string `xml:"series_title"`
+	MangaID         string `xml:"manga_mangadb_id"`
+	MangaTitle      string `xml:"manga_title"`
+	WatchedEpisodes int    `xml:"my_watched_episodes"`
+	ReadVolumes     int    `xml:"my_read_volumes"`
+	ReadChapters    int    `xml:"my_read_chapters"`
+	StartDate       string `xml:"my_start_date"`
+	FinishDate      string `xml:"my_finish_date"`
+	Score           int    `xml:"my_score"`
+	Status          string `xml:"my_status"`
+	TimesWatched    int    `xml:"my_times_watched"`
+	TimesRead       int    `xml:"my_times_read"`
+	Comments        string `xml:"my_comments"`
+}
+
+// parseMALExport lê o XML do export de lista do MyAnimeList (animelist ou mangalist)
+// Mangás do MAL incluem light novels, então podem estar no catálogo como comic ou novel
+func parseMALExport(reader io.Reader) ([]listImportRecord, error) {
+	var export malExport
+	if err := xml.NewDecoder(reader).Decode(&export); err != nil {
+		return nil, fmt.Errorf("failed to parse MAL XML: %w", err)
+	}
+
+	records := make([]listImportRecord, 0, len(export.Anime)+len(export.Manga))
+	for i, anime := range export.Anime {
+		record := newListImportRecord(i+1, anime.AnimeTitle, 0, []models.MediaType{models.MediaTypeAnime},
+			[]listImportExternalID{{"mal", strings.TrimSpace(anime.AnimeID)}})
+		record.entry.Progress = anime.WatchedEpisodes
+		record.entry.CompletionCount = anime.TimesWatched
+		records = append(records, withMALListData(record, anime))
+	}
+	for i, manga := range export.Manga {
+		record := newListImportRecord(len(export.Anime)+i+1, manga.MangaTitle, 0, []models.MediaType{models.MediaTypeComic, models.MediaTypeNovel},
+			[]listImportExternalID{{"mal", strings.TrimSpace(manga.MangaID)}})
+		record.entry.Progress = manga.ReadChapters
+		record.entry.ProgressVolumes = manga.ReadVolumes
+		record.entry.CompletionCount = manga.TimesRead
+		records = append(records, withMALListData(record, manga))
+	}
+
+	return records, nil
+}
+
+// withMALListData preenche os campos comuns a anime e mangá (status, nota 0-10, datas e comentários)
+func withMALListData(record listImportRecord, entry malEntry) listImportRecord {
+	record.entry.ListStatus = malStatus(entry.Status)
+	if record.entry.ListStatus == models.StatusCompleted {
+		record.entry.CompletionCount++ // my_times_watched/my_times_read contam apenas as revisitas
+	}
+	record.entry.Rating = clampRating(float64(entry.Score))
+	record.entry.StartedAt = parseListDate(entry.StartDate, "2006-01-02")
+	record.entry.FinishedAt = parseListDate(entry.FinishDate, "2006-01-02")
+	record.entry.Notes = strings.TrimSpace(entry.Comments)
+	return record
+}
+
+// malStatus converte o status do MAL (texto ou o código numérico de exports antigos)
+func malStatus(status string) models.MediaStatus {
+	switch strings.ToLower(strings.TrimSpace(status)) {
+	case "watching", "reading", "1":
+		return models.StatusInProgress
+	case "completed", "2":
+		return models.StatusCompleted
+	case "on-hold", "3":
+		return models.StatusPaused
+	case "dropped", "4":
+		return models.StatusDropped
+	default:
+		return models.StatusPlanned
+	}
+}
+
+// ========================================
+// AniList (JSON)
+// ========================================
+
+type aniListExport struct {
+	Data *struct {
+		MediaListCollection *aniListCollection `json:"MediaListCollection"`
+	} `json:"data"`
+	MediaListCollection *aniListCollection `json:"MediaListCollection"`
+	aniListCollection
+}
+
+type aniListCollection struct {
+	User *struct {
+		MediaListOptions struct {
+			ScoreFormat string `json:"scoreFormat"`
+		} `json:"mediaListOptions"`
+	} `json:"user"`
+	Lists []struct {
+		IsCustomList bool           `json:"isCustomList"`
+		Entries      []aniListEntry `json:"entries"`
+	} `json:"lists"`
+}
+
+type aniListEntry struct {
+	Status          string      `json:"status"`
+	Score           float64     `json:"score"`
+	Progress        int         `json:"progress"`
+	ProgressVolumes int         `json:"progressVolumes"`
+	Repeat          int         `json:"repeat"`
+	Notes           string      `json:"notes"`
+	StartedAt       aniListDate `json:"startedAt"`
+	CompletedAt     aniListDate `json:"completedAt"`
+	Media           struct {
+		ID     int    `json:"id"`
+		IDMal  int    `json:"idMal"`
+		Type   string `json:"type"`   // ANIME ou MANGA
+		Format string `json:"format"` // TV, MOVIE, MANGA, NOVEL, ONE_SHOT...
+		Title  struct {
+			Romaji  string `json:"romaji"`
+			English string `json:"english"`
+			Native  string `json:"native"`
+		} `json:"title"`
+		StartDate aniListDate `json:"startDate"`
+	} `json:"media"`
+}
+
+type aniListDate struct {
+	Year  int `json:"year"`
+	Month int `json:"month"`
+	Day   int `json:"day"`
+}
+
+// time retorna a data (nil sem ano); mês e dia ausentes viram 1
+func (d aniListDate) time() *time.Time {
+	if d.Year == 0 {
+		return nil
+	}
+	month, day := d.Month, d.Day
+	if month == 0 {
+		month = 1
+	}
+	if day == 0 {
+		day = 1
+	}
+	date := time.Date(d.Year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	return &date
+}
+
+// parseAniListExport lê a MediaListCollection do AniList (resposta da API GraphQL, com ou sem o envelope data)
+// Listas personalizadas repetem entradas das listas de status e são ignoradas
+func parseAniListExport(reader io.Reader) ([]listImportRecord, error) {
+	var export aniListExport
+	if err := json.NewDecoder(reader).Decode(&export); err != nil {
+		return nil, fmt.Errorf("failed to parse AniList JSON: %w", err)
+	}
+
+	collection := &export.aniListCollection
+	if export.Data != nil && export.Data.MediaListCollection != nil {
+		collection = export.Data.MediaListCollection
+	} else if export.MediaListCollection != nil {
+		collection = export.MediaListCollection
+	}
+
+	var entries []aniListEntry
+	for _, list := range collection.Lists {
+		if !list.IsCustomList {
+			entries = append(entries, list.Entries...)
+		}
+	}
+
+	scoreFormat := ""
+	if collection.User != nil {
+		scoreFormat = collection.User.MediaListOptions.ScoreFormat
+	}
+	if scoreFormat == "" {
+		// Sem o formato, notas acima de 10 só podem ser da escala de 100
+		scoreFormat = "POINT_10_DECIMAL"
+		for _, entry := range entries {
+			if entry.Score > 10 {
+				scoreFormat = "POINT_100"
+				break
+			}
+		}
+	}
+
+	records := make([]listImportRecord, 0, len(entries))
+	for i, entry := range entries {
+		mediaType := models.MediaTypeAnime
+		if entry.Media.Type == "MANGA" {
+			mediaType = models.MediaTypeComic
+			if entry.Media.Format == "NOVEL" {
+				mediaType = models.MediaTypeNovel
+			}
+		}
+
+		title := entry.Media.Title
+		primary := firstNonEmpty(title.English, title.Romaji, title.Native)
+		record := newListImportRecord(i+1, primary, entry.Media.StartDate.Year, []models.MediaType{mediaType}, []listImportExternalID{
+			{"anilist", strconv.Itoa(entry.Media.ID)},
+			{"mal", strconv.Itoa(entry.Media.IDMal)},
+		})
+		for _, alternative := range []string{title.Romaji, title.Native} {
+			if alternative = strings.TrimSpace(alternative); alternative != "" && alternative != record.entry.Title {
+				record.titles = append(record.titles, alternative)
+			}
+		}
+
+		record.entry.ListStatus = aniListStatus(entry.Status)
+		record.entry.Rating = aniListRating(entry.Score, scoreFormat)
+		record.entry.Progress = entry.Progress
+		record.entry.ProgressVolumes = entry.ProgressVolumes
+		record.entry.CompletionCount = entry.Repeat // Repeat conta apenas as revisitas
+		if record.entry.ListStatus == models.StatusCompleted {
+			record.entry.CompletionCount++
+		}
+		record.entry.StartedAt = entry.StartedAt.time()
+		record.entry.FinishedAt = entry.CompletedAt.time()
+		record.entry.Notes = strings.TrimSpace(entry.Notes)
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+func aniListStatus(status string) models.MediaStatus {
+	switch status {
+	case "CURRENT", "REPEATING":
+		return models.StatusInProgress
+	case "COMPLETED":
+		return models.StatusCompleted
+	case "PAUSED":
+		return models.StatusPaused
+	case "DROPPED":
+		return models.StatusDropped
+	default:
+		return models.StatusPlanned
+	}
+}
+
+// aniListRating converte a nota do formato escolhido pelo usuário no AniList para 0-10
+func aniListRating(score float64, format string) float64 {
+	switch format {
+	case "POINT_100":
+		return clampRating(score / 10)
+	case "POINT_5":
+		return clampRating(score * 2)
+	case "POINT_3":
+		// Carinhas: 1 (ruim), 2 (ok), 3 (bom)
+		return clampRating(math.Round(score * 10 / 3))
+	default: // POINT_10 e POINT_10_DECIMAL
+		return clampRating(score)
+	}
+}
+
+// ========================================
+// Letterboxd (CSV)
+// ========================================
+
+// parseLetterboxdExport lê um CSV do export do Letterboxd: diary, ratings, watched ou watchlist (pelo nome do arquivo)
+// Filmes da watchlist entram como planejados e os demais como completos; no diary, cada rewatch
+// é uma linha e as linhas do mesmo filme são somadas em uma entrada
+func parseLetterboxdExport(reader io.Reader, fileName string) ([]listImportRecord, error) {
+	rows, err := readListExportCSV(reader, []string{"name", "letterboxd uri"}, "Letterboxd exports")
+	if err != nil {
+		return nil, err
+	}
+
+	status := models.StatusCompleted
+	if strings.Contains(strings.ToLower(fileName), "watchlist") {
+		status = models.StatusPlanned
+	}
+
+	var records []listImportRecord
+	byURI := make(map[string]int)
+	for _, row := range rows {
+		uri := row.field("letterboxd uri")
+		index, seen := byURI[uri]
+		if !seen {
+			year, _ := strconv.Atoi(row.field("year"))
+			record := newListImportRecord(row.line, row.field("name"), year, []models.MediaType{models.MediaTypeMovie},
+				[]listImportExternalID{{"letterboxd", uri}})
+			record.entry.ListStatus = status
+			records = append(records, record)
+			index = len(records) - 1
+			if uri != "" {
+				byURI[uri] = index
+			}
+		}
+		entry := &records[index].entry
+
+		// Rating de 0.5 a 5 estrelas; a linha mais recente do diary prevalece
+		if rating, err := strconv.ParseFloat(row.field("rating"), 64); err == nil && rating > 0 {
+			entry.Rating = clampRating(rating * 2)
+		}
+		if watched := parseListDate(row.field("watched date"), "2006-01-02"); watched != nil {
+			if entry.FinishedAt == nil || watched.After(*entry.FinishedAt) {
+				entry.FinishedAt = watched
+			}
+			entry.CompletionCount++
+		}
+	}
+
+	return records, nil
+}
+
+// ========================================
+// Goodreads (CSV)
+// ========================================
+
+// goodreadsSeriesSuffix captura o sufixo de série do título (ex: "The Hobbit (Middle-earth, #0)")
+var goodreadsSeriesSuffix = regexp.MustCompile(`\s*\([^()]*#[^()]*\)\s*$`)
+
+// parseGoodreadsExport lê o CSV "Export Library" do Goodreads
+// O status vem da estante exclusiva (read, currently-reading, to-read); o export não traz a data de início
+func parseGoodreadsExport(reader io.Reader) ([]listImportRecord, error) {
+	rows, err := readListExportCSV(reader, []string{"book id", "title", "exclusive shelf"}, "Goodreads exports")
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]listImportRecord, 0, len(rows))
+	for _, row := range rows {
+		year, _ := strconv.Atoi(row.field("original publication year"))
+		if year == 0 {
+			year, _ = strconv.Atoi(row.field("year published"))
+		}
+
+		title := row.field("title")
+		record := newListImportRecord(row.line, title, year,
+			[]models.MediaType{models.MediaTypeBook, models.MediaTypeNovel, models.MediaTypeComic},
+			[]listImportExternalID{
+				{"isbn", goodreadsISBN(row.field("isbn13"))},
+				{"isbn", goodreadsISBN(row.field("isbn"))},
+				{"goodreads", row.field("book id")},
+			})
+		if short := goodreadsSeriesSuffix.ReplaceAllString(title, ""); short != title && short != "" {
+			record.titles = append(record.titles, short)
+		}
+
+		record.entry.ListStatus = goodreadsStatus(row.field("exclusive shelf"))
+		if rating, err := strconv.Atoi(row.field("my rating")); err == nil {
+			record.entry.Rating = clampRating(float64(rating * 2))
+		}
+		record.entry.FinishedAt = parseListDate(row.field("date read"), "2006/01/02", "2006-01-02")
+		record.entry.CompletionCount, _ = strconv.Atoi(row.field("read count"))
+		record.entry.Notes = row.field("private notes")
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// goodreadsISBN remove a formatação de fórmula do CSV do Goodreads (="9780547928227")
+func goodreadsISBN(value string) string {
+	return strings.Trim(value, `="`)
+}
+
+func goodreadsStatus(shelf string) models.MediaStatus {
+	shelf = strings.ToLower(strings.TrimSpace(shelf))
+	switch {
+	case shelf == "read":
+		return models.StatusCompleted
+	case shelf == "currently-reading":
+		return models.StatusInProgress
+	case strings.Contains(shelf, "dnf"), strings.Contains(shelf, "did-not-finish"), strings.Contains(shelf, "abandon"):
+		return models.StatusDropped
+	default:
+		return models.StatusPlanned
+	}
+}
+
+// ========================================
+// Steam (JSON)
+// ========================================
+
+type steamExport struct {
+	Response *struct {
+		Games []steamGame `json:"games"`
+	} `json:"response"`
+	Games []steamGame `json:"games"`
+}
+
+type steamGame struct {
+	AppID           int    `json:"appid"`
+	Name            string `json:"name"`
+	PlaytimeForever int    `json:"playtime_forever"` // Minutos
+	LastPlayed      int64  `json:"rtime_last_played"`
+}
+
+// parseSteamExport lê a biblioteca Steam (resposta de IPlayerService/GetOwnedGames, com ou sem o envelope response)
+// A Steam não informa se o jogo foi terminado: jogos com tempo de jogo entram em andamento e os demais como planejados
+func parseSteamExport(reader io.Reader) ([]listImportRecord, error) {
+	var export steamExport
+	if err := json.NewDecoder(reader).Decode(&export); err != nil {
+		return nil, fmt.Errorf("failed to parse Steam JSON: %w", err)
+	}
+
+	games := export.Games
+	if export.Response != nil {
+		games = export.Response.Games
+	}
+
+	records := make([]listImportRecord, 0, len(games))
+	for i, game := range games {
+		record := newListImportRecord(i+1, game.Name, 0, []models.MediaType{models.MediaTypeGame},
+			[]listImportExternalID{{"steam", strconv.Itoa(game.AppID)}})
+
+		record.entry.ListStatus = models.StatusPlanned
+		if game.PlaytimeForever > 0 {
+			record.entry.ListStatus = models.StatusInProgress
+			record.entry.Progress = game.PlaytimeForever
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// ========================================
+// Helpers
+// ========================================
+
+// listExportRow é uma linha de um CSV de export, com acesso às colunas pelo nome
+type listExportRow struct {
+	line    int
+	headers []string
+	values  []string
+}
+
+func (r listExportRow) field(name string) string {
+	return getFieldValue(r.headers, r.values, name)
+}
+
+// readListExportCSV lê um CSV de export exigindo as colunas informadas (o context aparece na mensagem de erro)
+func readListExportCSV(reader io.Reader, required []string, context string) ([]listExportRow, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+
+	headers, err := csvReader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errImportEmpty
+		}
+		return nil, fmt.Errorf("failed to parse CSV: %w", err)
+	}
+	headers[0] = strings.TrimPrefix(headers[0], "\ufeff")
+	if err := validateHeaders(headers, required, context); err != nil {
+		return nil, err
+	}
+
+	var rows []listExportRow
+	for line := 2; ; line++ {
+		values, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse CSV: %w", err)
+		}
+		rows = append(rows, listExportRow{line: line, headers: headers, values: values})
+	}
+}
+
+// parseListDate converte uma data do export (nil quando vazia ou zerada, como o 0000-00-00 do MAL)
+// Dia ou mês zerados (datas parciais do MAL) viram 1
+func parseListDate(value string, layouts ...string) *time.Time {
+	value = strings.TrimSpace(value)
+	if value == "" || strings.HasPrefix(value, "0000") {
+		return nil
+	}
+	value = strings.Replace(strings.Replace(value, "-00", "-01", 2), "/00", "/01", 2)
+
+	for _, layout := range layouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			return &date
+		}
+	}
+	return nil
+}
+
+// clampRating arredonda a nota para uma casa decimal e a mantém em 0-10
+func clampRating(rating float64) float64 {
+	return math.Max(0, math.Min(10, math.Round(rating*10)/10))
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+	return ""
+}
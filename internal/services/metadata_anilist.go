@@ -0,0 +1,239 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+)
+
+// AniListAPIURL é o endpoint GraphQL público do AniList
+const AniListAPIURL = "https://graphql.anilist.co"
+
+// aniListMinTagRank é o rank mínimo (0-100) para uma tag do AniList virar tag do item
+const aniListMinTagRank = 60
+
+const aniListMediaQuery = `query ($id: Int, $idMal: Int, $search: String, $type: MediaType, $format: MediaFormat) {
+  Media(id: $id, idMal: $idMal, search: $search, type: $type, format: $format) {
+    id
+    idMal
+    title { romaji english }
+    description(asHtml: false)
+    startDate { year month day }
+    coverImage { extraLarge large }
+    genres
+    tags { name rank isMediaSpoiler }
+    format
+    countryOfOrigin
+    episodes
+    chapters
+    volumes
+    studios(isMain: true) { nodes { name } }
+    staff(perPage: 10) { edges { role node { name { full } } } }
+  }
+}`
+
+// AniListProvider busca animes, mangás e light novels no AniList
+// Também cobre o MyAnimeList: o AniList resolve IDs do MAL (idMal) e devolve os dois IDs
+type AniListProvider struct {
+	http metadataClient
+}
+
+// NewAniListProvider cria o provider do AniList; baseURL vazio usa AniListAPIURL
+func NewAniListProvider(client *http.Client, baseURL string) *AniListProvider {
+	return &AniListProvider{http: newMetadataClient("anilist", client, baseURL, AniListAPIURL)}
+}
+
+// Name identifica o provider
+func (p *AniListProvider) Name() string {
+	return "anilist"
+}
+
+// Supports indica se o provider cobre o tipo de mídia
+func (p *AniListProvider) Supports(mediaType models.MediaType) bool {
+	return mediaType == models.MediaTypeAnime || mediaType == models.MediaTypeComic || mediaType == models.MediaTypeNovel
+}
+
+type aniListMedia struct {
+	ID    int `json:"id"`
+	IDMal int `json:"idMal"`
+	Title struct {
+		Romaji  string `json:"romaji"`
+		English string `json:"english"`
+	} `json:"title"`
+	Description string `json:"description"`
+	StartDate   struct {
+		Year  int `json:"year"`
+		Month int `json:"month"`
+		Day   int `json:"day"`
+	} `json:"startDate"`
+	CoverImage struct {
+		ExtraLarge string `json:"extraLarge"`
+		Large      string `json:"large"`
+	} `json:"coverImage"`
+	Genres []string `json:"genres"`
+	Tags   []struct {
+		Name           string `json:"name"`
+		Rank           int    `json:"rank"`
+		IsMediaSpoiler bool   `json:"isMediaSpoiler"`
+	} `json:"tags"`
+	Format          string `json:"format"`
+	CountryOfOrigin string `json:"countryOfOrigin"`
+	Episodes        int    `json:"episodes"`
+	Chapters        int    `json:"chapters"`
+	Volumes         int    `json:"volumes"`
+	Studios         struct {
+		Nodes []struct {
+			Name string `json:"name"`
+		} `json:"nodes"`
+	} `json:"studios"`
+	Staff struct {
+		Edges []struct {
+			Role string `json:"role"`
+			Node struct {
+				Name struct {
+					Full string `json:"full"`
+				} `json:"name"`
+			} `json:"node"`
+		} `json:"edges"`
+	} `json:"staff"`
+}
+
+// Fetch busca pelo ID do AniList, depois pelo ID do MAL e, sem IDs, pelo título
+func (p *AniListProvider) Fetch(ctx context.Context, query MetadataQuery) (*ItemMetadata, error) {
+	variables := map[string]interface{}{"type": "MANGA"}
+	if query.MediaType == models.MediaTypeAnime {
+		variables["type"] = "ANIME"
+	}
+
+	// IDs do MAL colidem entre animes e mangás; o type da query desambigua
+	switch source, id := query.externalID("anilist", "mal"); {
+	case source != "":
+		numericID := aniListIntID(id)
+		if numericID == 0 {
+			return nil, models.ErrMetadataNotFound
+		}
+		if source == "mal" {
+			variables["idMal"] = numericID
+		} else {
+			variables["id"] = numericID
+		}
+	case query.Title != "":
+		variables["search"] = query.Title
+		if query.MediaType == models.MediaTypeNovel {
+			variables["format"] = "NOVEL"
+		}
+	default:
+		return nil, models.ErrMetadataNotFound
+	}
+
+	body, err := json.Marshal(map[string]interface{}{"query": aniListMediaQuery, "variables": variables})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode anilist query: %w", err)
+	}
+	req, err := p.http.newRequest(ctx, http.MethodPost, "", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	var resp struct {
+		Data struct {
+			Media *aniListMedia `json:"Media"`
+		} `json:"data"`
+	}
+	if err := p.http.doJSON(req, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Data.Media == nil {
+		return nil, models.ErrMetadataNotFound
+	}
+	return aniListMetadata(query.MediaType, resp.Data.Media), nil
+}
+
+// aniListIntID converte o ID externo; IDs inválidos viram 0 e não encontram nada
+func aniListIntID(id string) int {
+	value, err := strconv.Atoi(id)
+	if err != nil || value <= 0 {
+		return 0
+	}
+	return value
+}
+
+func aniListMetadata(mediaType models.MediaType, media *aniListMedia) *ItemMetadata {
+	metadata := &ItemMetadata{
+		Source:      "anilist",
+		ExternalIDs: map[string]string{"anilist": strconv.Itoa(media.ID)},
+		Title:       media.Title.English,
+		Description: plainMetadataText(media.Description),
+		ReleaseDate: metadataDateFromParts(media.StartDate.Year, media.StartDate.Month, media.StartDate.Day),
+		CoverURL:    media.CoverImage.ExtraLarge,
+		Tags:        append([]string{}, media.Genres...),
+	}
+	if metadata.Title == "" {
+		metadata.Title = media.Title.Romaji
+	}
+	if metadata.CoverURL == "" {
+		metadata.CoverURL = media.CoverImage.Large
+	}
+	if media.IDMal > 0 {
+		metadata.ExternalIDs["mal"] = strconv.Itoa(media.IDMal)
+	}
+	for _, tag := range media.Tags {
+		if tag.Rank >= aniListMinTagRank && !tag.IsMediaSpoiler {
+			metadata.Tags = append(metadata.Tags, tag.Name)
+		}
+	}
+
+	if mediaType == models.MediaTypeAnime {
+		data := &models.AnimeData{Episodes: media.Episodes}
+		if len(media.Studios.Nodes) > 0 {
+			data.Studio = media.Studios.Nodes[0].Name
+		}
+		metadata.SpecificData = data
+		return metadata
+	}
+
+	metadata.SpecificData = &models.BookData{
+		Author:   aniListAuthor(media),
+		Volumes:  media.Volumes,
+		Chapters: media.Chapters,
+		Format:   aniListBookFormat(media.Format, media.CountryOfOrigin),
+	}
+	return metadata
+}
+
+// aniListAuthor retorna o primeiro membro da equipe creditado pela história (ou criação original)
+func aniListAuthor(media *aniListMedia) string {
+	for _, edge := range media.Staff.Edges {
+		role := strings.ToLower(edge.Role)
+		if strings.Contains(role, "story") || strings.Contains(role, "original creator") {
+			return edge.Node.Name.Full
+		}
+	}
+	return ""
+}
+
+// aniListBookFormat converte o formato do AniList para o BookData.Format usado no catálogo
+func aniListBookFormat(format, country string) string {
+	switch format {
+	case "NOVEL":
+		return "light_novel"
+	case "ONE_SHOT":
+		return "one_shot"
+	case "MANGA":
+		switch country {
+		case "KR":
+			return "manhwa"
+		case "CN", "TW":
+			return "manhua"
+		}
+		return "manga"
+	}
+	return ""
+}
@@ -0,0 +1,171 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+)
+
+const (
+	// IGDBAPIURL é a URL base da API do IGDB
+	IGDBAPIURL = "https://api.igdb.com"
+	// igdbImageURL é o formato das capas do IGDB a partir do image_id
+	igdbImageURL = "https://images.igdb.com/igdb/image/upload/t_cover_big/%s.jpg"
+	// igdbSteamSource é o external_game_source da Steam no IGDB
+	igdbSteamSource = 1
+)
+
+const igdbGameFields = "fields name,summary,first_release_date,cover.image_id,genres.name,themes.name,platforms.name,involved_companies.developer,involved_companies.company.name;"
+
+// IGDBProvider busca jogos no IGDB, também a partir de IDs da Steam
+type IGDBProvider struct {
+	http        metadataClient
+	clientID    string
+	accessToken string // Token de app da Twitch
+}
+
+// NewIGDBProvider cria o provider do IGDB; baseURL vazio usa IGDBAPIURL
+func NewIGDBProvider(client *http.Client, baseURL, clientID, accessToken string) *IGDBProvider {
+	return &IGDBProvider{
+		http:        newMetadataClient("igdb", client, baseURL, IGDBAPIURL),
+		clientID:    clientID,
+		accessToken: accessToken,
+	}
+}
+
+// Name identifica o provider
+func (p *IGDBProvider) Name() string {
+	return "igdb"
+}
+
+// Supports indica se o provider cobre o tipo de mídia
+func (p *IGDBProvider) Supports(mediaType models.MediaType) bool {
+	return mediaType == models.MediaTypeGame
+}
+
+type igdbNamed struct {
+	Name string `json:"name"`
+}
+
+type igdbGame struct {
+	ID               int    `json:"id"`
+	Name             string `json:"name"`
+	Summary          string `json:"summary"`
+	FirstReleaseDate int64  `json:"first_release_date"`
+	Cover            *struct {
+		ImageID string `json:"image_id"`
+	} `json:"cover"`
+	Genres            []igdbNamed `json:"genres"`
+	Themes            []igdbNamed `json:"themes"`
+	Platforms         []igdbNamed `json:"platforms"`
+	InvolvedCompanies []struct {
+		Developer bool      `json:"developer"`
+		Company   igdbNamed `json:"company"`
+	} `json:"involved_companies"`
+}
+
+// Fetch busca pelo ID do IGDB, depois pelo ID da Steam e, sem IDs, pelo título
+func (p *IGDBProvider) Fetch(ctx context.Context, query MetadataQuery) (*ItemMetadata, error) {
+	var body string
+	switch source, id := query.externalID("igdb", "steam"); {
+	case source == "igdb":
+		gameID, err := strconv.Atoi(id)
+		if err != nil {
+			return nil, models.ErrMetadataNotFound
+		}
+		body = fmt.Sprintf("%s where id = %d;", igdbGameFields, gameID)
+	case source == "steam":
+		gameID, err := p.steamGameID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		body = fmt.Sprintf("%s where id = %d;", igdbGameFields, gameID)
+	case query.Title != "":
+		body = fmt.Sprintf("search %s; %s limit 1;", igdbString(query.Title), igdbGameFields)
+	default:
+		return nil, models.ErrMetadataNotFound
+	}
+
+	var games []igdbGame
+	if err := p.post(ctx, "/v4/games", body, &games); err != nil {
+		return nil, err
+	}
+	if len(games) == 0 {
+		return nil, models.ErrMetadataNotFound
+	}
+	return igdbMetadata(&games[0]), nil
+}
+
+// steamGameID resolve o ID do IGDB a partir do app ID da Steam
+func (p *IGDBProvider) steamGameID(ctx context.Context, appID string) (int, error) {
+	body := fmt.Sprintf("fields game; where external_game_source = %d & uid = %s;", igdbSteamSource, igdbString(appID))
+	var externalGames []struct {
+		Game int `json:"game"`
+	}
+	if err := p.post(ctx, "/v4/external_games", body, &externalGames); err != nil {
+		return 0, err
+	}
+	if len(externalGames) == 0 || externalGames[0].Game == 0 {
+		return 0, models.ErrMetadataNotFound
+	}
+	return externalGames[0].Game, nil
+}
+
+// post envia uma query Apicalypse para o endpoint
+func (p *IGDBProvider) post(ctx context.Context, path, body string, out interface{}) error {
+	req, err := p.http.newRequest(ctx, http.MethodPost, path, strings.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "text/plain")
+	req.Header.Set("Client-ID", p.clientID)
+	req.Header.Set("Authorization", "Bearer "+p.accessToken)
+	return p.http.doJSON(req, out)
+}
+
+// igdbString escapa um valor como string literal do Apicalypse
+func igdbString(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
+
+func igdbMetadata(game *igdbGame) *ItemMetadata {
+	metadata := &ItemMetadata{
+		Source:      "igdb",
+		ExternalIDs: map[string]string{"igdb": strconv.Itoa(game.ID)},
+		Title:       game.Name,
+		Description: game.Summary,
+		Tags:        make([]string, 0, len(game.Genres)+len(game.Themes)),
+	}
+	if game.FirstReleaseDate > 0 {
+		date := time.Unix(game.FirstReleaseDate, 0).UTC()
+		metadata.ReleaseDate = &date
+	}
+	if game.Cover != nil && game.Cover.ImageID != "" {
+		metadata.CoverURL = fmt.Sprintf(igdbImageURL, game.Cover.ImageID)
+	}
+	for _, named := range game.Genres {
+		metadata.Tags = append(metadata.Tags, named.Name)
+	}
+	for _, named := range game.Themes {
+		metadata.Tags = append(metadata.Tags, named.Name)
+	}
+
+	platforms := make([]string, len(game.Platforms))
+	for i, platform := range game.Platforms {
+		platforms[i] = platform.Name
+	}
+	data := &models.GameData{Platform: strings.Join(platforms, ", ")}
+	for _, involved := range game.InvolvedCompanies {
+		if involved.Developer {
+			data.Developer = involved.Company.Name
+			break
+		}
+	}
+	metadata.SpecificData = data
+	return metadata
+}
@@ -0,0 +1,208 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+)
+
+const (
+	// OpenLibraryAPIURL é a URL base da API do Open Library
+	OpenLibraryAPIURL = "https://openlibrary.org"
+	// openLibraryCoverURL é o formato das capas do Open Library a partir do ID da capa
+	openLibraryCoverURL = "https://covers.openlibrary.org/b/id/%d-L.jpg"
+)
+
+// OpenLibraryProvider busca livros no Open Library, pelo ISBN, pelo ID da obra ou pelo título
+type OpenLibraryProvider struct {
+	http metadataClient
+}
+
+// NewOpenLibraryProvider cria o provider do Open Library; baseURL vazio usa OpenLibraryAPIURL
+func NewOpenLibraryProvider(client *http.Client, baseURL string) *OpenLibraryProvider {
+	return &OpenLibraryProvider{http: newMetadataClient("openlibrary", client, baseURL, OpenLibraryAPIURL)}
+}
+
+// Name identifica o provider
+func (p *OpenLibraryProvider) Name() string {
+	return "openlibrary"
+}
+
+// Supports indica se o provider cobre o tipo de mídia
+// Novels também são buscadas aqui, depois do AniList, para romances ocidentais
+func (p *OpenLibraryProvider) Supports(mediaType models.MediaType) bool {
+	return mediaType == models.MediaTypeBook || mediaType == models.MediaTypeNovel
+}
+
+type openLibraryKey struct {
+	Key string `json:"key"`
+}
+
+// openLibraryText aceita os dois formatos de texto da API: string ou {"type": ..., "value": ...}
+type openLibraryText string
+
+func (t *openLibraryText) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err == nil {
+		*t = openLibraryText(value)
+		return nil
+	}
+	var typed struct {
+		Value string `json:"value"`
+	}
+	if err := json.Unmarshal(data, &typed); err != nil {
+		return err
+	}
+	*t = openLibraryText(typed.Value)
+	return nil
+}
+
+type openLibraryEdition struct {
+	Title         string           `json:"title"`
+	PublishDate   string           `json:"publish_date"`
+	NumberOfPages int              `json:"number_of_pages"`
+	Publishers    []string         `json:"publishers"`
+	Covers        []int            `json:"covers"`
+	Works         []openLibraryKey `json:"works"`
+	Authors       []openLibraryKey `json:"authors"`
+}
+
+type openLibraryWork struct {
+	Key              string          `json:"key"`
+	Title            string          `json:"title"`
+	Description      openLibraryText `json:"description"`
+	FirstPublishDate string          `json:"first_publish_date"`
+	Subjects         []string        `json:"subjects"`
+	Covers           []int           `json:"covers"`
+	Authors          []struct {
+		Author openLibraryKey `json:"author"`
+	} `json:"authors"`
+}
+
+// Fetch busca pelo ISBN (edição e obra), depois pelo ID da obra e, sem IDs, pelo título
+func (p *OpenLibraryProvider) Fetch(ctx context.Context, query MetadataQuery) (*ItemMetadata, error) {
+	var edition *openLibraryEdition
+	var workKey string
+
+	switch source, id := query.externalID("isbn", "openlibrary"); {
+	case source == "isbn":
+		edition = &openLibraryEdition{}
+		isbn := strings.ReplaceAll(id, "-", "")
+		if err := p.get(ctx, "/isbn/"+url.PathEscape(isbn)+".json", edition); err != nil {
+			return nil, err
+		}
+		if len(edition.Works) == 0 {
+			return nil, models.ErrMetadataNotFound
+		}
+		workKey = edition.Works[0].Key
+	case source == "openlibrary":
+		workKey = "/works/" + url.PathEscape(strings.TrimPrefix(id, "/works/"))
+	case query.Title != "":
+		key, err := p.search(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		workKey = key
+	default:
+		return nil, models.ErrMetadataNotFound
+	}
+
+	var work openLibraryWork
+	if err := p.get(ctx, workKey+".json", &work); err != nil {
+		return nil, err
+	}
+
+	metadata := openLibraryMetadata(edition, &work)
+	if authorKey := openLibraryAuthorKey(edition, &work); authorKey != "" {
+		var author struct {
+			Name string `json:"name"`
+		}
+		if err := p.get(ctx, authorKey+".json", &author); err != nil {
+			return nil, err
+		}
+		metadata.SpecificData.(*models.BookData).Author = author.Name
+	}
+	return metadata, nil
+}
+
+// search retorna a chave da obra mais relevante para o título (e ano, se conhecido)
+func (p *OpenLibraryProvider) search(ctx context.Context, query MetadataQuery) (string, error) {
+	params := url.Values{"title": {query.Title}, "limit": {"1"}, "fields": {"key"}}
+	if query.Year > 0 {
+		params.Set("first_publish_year", strconv.Itoa(query.Year))
+	}
+	var found struct {
+		Docs []openLibraryKey `json:"docs"`
+	}
+	if err := p.get(ctx, "/search.json?"+params.Encode(), &found); err != nil {
+		return "", err
+	}
+	if len(found.Docs) == 0 || found.Docs[0].Key == "" {
+		return "", models.ErrMetadataNotFound
+	}
+	return found.Docs[0].Key, nil
+}
+
+func (p *OpenLibraryProvider) get(ctx context.Context, path string, out interface{}) error {
+	req, err := p.http.newRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return err
+	}
+	return p.http.doJSON(req, out)
+}
+
+// openLibraryAuthorKey prefere o autor da edição ao da obra
+func openLibraryAuthorKey(edition *openLibraryEdition, work *openLibraryWork) string {
+	if edition != nil && len(edition.Authors) > 0 {
+		return edition.Authors[0].Key
+	}
+	if len(work.Authors) > 0 {
+		return work.Authors[0].Author.Key
+	}
+	return ""
+}
+
+// openLibraryMetadata junta a obra com a edição (quando buscada pelo ISBN), que tem páginas, editora e data da edição
+func openLibraryMetadata(edition *openLibraryEdition, work *openLibraryWork) *ItemMetadata {
+	metadata := &ItemMetadata{
+		Source:      "openlibrary",
+		ExternalIDs: map[string]string{"openlibrary": strings.TrimPrefix(work.Key, "/works/")},
+		Title:       work.Title,
+		Description: strings.TrimSpace(string(work.Description)),
+		ReleaseDate: parseMetadataDate(work.FirstPublishDate),
+	}
+	covers := work.Covers
+	data := &models.BookData{}
+
+	if edition != nil {
+		if metadata.ReleaseDate == nil {
+			metadata.ReleaseDate = parseMetadataDate(edition.PublishDate)
+		}
+		if len(edition.Covers) > 0 {
+			covers = edition.Covers
+		}
+		if len(edition.Publishers) > 0 {
+			data.Publisher = edition.Publishers[0]
+		}
+		data.Pages = edition.NumberOfPages
+	}
+	// IDs de capa negativos indicam capas removidas
+	if len(covers) > 0 && covers[0] > 0 {
+		metadata.CoverURL = fmt.Sprintf(openLibraryCoverURL, covers[0])
+	}
+	// Assuntos com prefixo (nyt:..., series:...) são classificações internas, não gêneros
+	for _, subject := range work.Subjects {
+		if !strings.Contains(subject, ":") {
+			metadata.Tags = append(metadata.Tags, subject)
+		}
+	}
+
+	metadata.SpecificData = data
+	return metadata
+}
@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+)
+
+// maxMetadataResponseSize limita o corpo lido das respostas das APIs de metadados
+const maxMetadataResponseSize = 4 << 20
+
+// MetadataProvider busca metadados de um item do catálogo em uma API externa
+type MetadataProvider interface {
+	// Name identifica o provider (query param provider e fonte em ExternalMetadata)
+	Name() string
+	// Supports indica se o provider cobre o tipo de mídia
+	Supports(mediaType models.MediaType) bool
+	// Fetch busca pelos IDs externos que o provider reconhece ou, sem eles, pelo título e ano
+	// Retorna models.ErrMetadataNotFound quando nada corresponde
+	Fetch(ctx context.Context, query MetadataQuery) (*ItemMetadata, error)
+}
+
+// MetadataQuery identifica o item a buscar
+type MetadataQuery struct {
+	MediaType   models.MediaType
+	ExternalIDs models.JSONB // ExternalMetadata do item (mal, anilist, imdb, tmdb, igdb, steam, isbn, openlibrary...)
+	Title       string
+	Year        int // 0 = desconhecido
+}
+
+// externalID retorna a primeira fonte (na ordem informada) com ID no item
+func (q MetadataQuery) externalID(sources ...string) (string, string) {
+	for _, source := range sources {
+		if id := strings.TrimSpace(exportScalar(q.ExternalIDs[source])); id != "" {
+			return source, id
+		}
+	}
+	return "", ""
+}
+
+// ItemMetadata são os dados encontrados por um provider, prontos para mesclar no Item
+type ItemMetadata struct {
+	Source       string
+	ExternalIDs  map[string]string // IDs descobertos, incluindo o do próprio provider
+	Title        string
+	Description  string
+	ReleaseDate  *time.Time
+	CoverURL     string
+	Tags         []string
+	SpecificData interface{} // *models.AnimeData, *models.MovieData, *models.SeriesData, *models.GameData ou *models.BookData
+}
+
+// metadataClient faz as requisições HTTP de um provider
+// O http.Client e a URL base são injetáveis, para testes contra servidores httptest
+type metadataClient struct {
+	name    string
+	client  *http.Client
+	baseURL string
+}
+
+func newMetadataClient(name string, client *http.Client, baseURL, defaultURL string) metadataClient {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if baseURL == "" {
+		baseURL = defaultURL
+	}
+	return metadataClient{name: name, client: client, baseURL: strings.TrimSuffix(baseURL, "/")}
+}
+
+// newRequest cria uma requisição para path (relativo à URL base)
+func (c metadataClient) newRequest(ctx context.Context, method, path string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", models.ErrMetadataProviderFailed, c.name, err)
+	}
+	req.Header.Set("Accept", "application/json")
+	return req, nil
+}
+
+// doJSON executa a requisição e decodifica a resposta JSON em out
+// 404 vira ErrMetadataNotFound; outras falhas viram ErrMetadataProviderFailed
+func (c metadataClient) doJSON(req *http.Request, out interface{}) error {
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %v", models.ErrMetadataProviderFailed, c.name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return models.ErrMetadataNotFound
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%w: %s returned status %d", models.ErrMetadataProviderFailed, c.name, resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxMetadataResponseSize)).Decode(out); err != nil {
+		return fmt.Errorf("%w: %s: invalid response: %v", models.ErrMetadataProviderFailed, c.name, err)
+	}
+	return nil
+}
+
+// metadataDateLayouts são os formatos de data aceitos das APIs (do mais ao menos preciso)
+var metadataDateLayouts = []string{"2006-01-02", "January 2, 2006", "Jan 2, 2006", "2 January 2006", "January 2006", "Jan 2006", "2006-01", "2006"}
+
+// parseMetadataDate interpreta uma data das APIs de metadados; valores vazios ou desconhecidos retornam nil
+func parseMetadataDate(value string) *time.Time {
+	value = strings.TrimSpace(value)
+	for _, layout := range metadataDateLayouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			return &date
+		}
+	}
+	return nil
+}
+
+// metadataDateFromParts monta a data a partir de ano, mês e dia (mês e dia opcionais)
+func metadataDateFromParts(year, month, day int) *time.Time {
+	if year == 0 {
+		return nil
+	}
+	if month == 0 {
+		month = 1
+	}
+	if day == 0 {
+		day = 1
+	}
+	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	return &date
+}
+
+var metadataHTMLTag = regexp.MustCompile(`<[^>]*>`)
+
+// plainMetadataText remove HTML (sinopses do AniList e do MAL) e colapsa linhas em branco
+func plainMetadataText(value string) string {
+	value = strings.ReplaceAll(value, "<br>", "\n")
+	value = html.UnescapeString(metadataHTMLTag.ReplaceAllString(value, ""))
+
+	lines := strings.Split(strings.ReplaceAll(value, "\r\n", "\n"), "\n")
+	kept := make([]string, 0, len(lines))
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" && (len(kept) == 0 || kept[len(kept)-1] == "") {
+			continue
+		}
+		kept = append(kept, line)
+	}
+	return strings.TrimSpace(strings.Join(kept, "\n"))
+}
@@ -0,0 +1,341 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+)
+
+func TestAniListProvider_FetchByMALID(t *testing.T) {
+	var variables map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Variables map[string]interface{} `json:"variables"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		variables = body.Variables
+		io.WriteString(w, `{"data":{"Media":{
+			"id":5114,"idMal":5114,
+			"title":{"romaji":"Hagane no Renkinjutsushi: FULLMETAL ALCHEMIST","english":"Fullmetal Alchemist: Brotherhood"},
+			"description":"Two brothers search for the Philosopher's Stone.<br><br>\n<i>(Source: Funimation)</i>",
+			"startDate":{"year":2009,"month":4,"day":5},
+			"coverImage":{"extraLarge":"https://img.anilist.co/fmab.jpg"},
+			"genres":["Action","Adventure"],
+			"tags":[{"name":"Alchemy","rank":95,"isMediaSpoiler":false},{"name":"Twins","rank":20,"isMediaSpoiler":false},{"name":"Tragedy","rank":80,"isMediaSpoiler":true}],
+			"format":"TV","episodes":64,
+			"studios":{"nodes":[{"name":"Bones"}]}
+		}}}`)
+	}))
+	defer server.Close()
+
+	provider := NewAniListProvider(server.Client(), server.URL)
+	metadata, err := provider.Fetch(context.Background(), MetadataQuery{
+		MediaType:   models.MediaTypeAnime,
+		ExternalIDs: models.JSONB{"mal": float64(5114)},
+		Title:       "FMA Brotherhood",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if variables["idMal"] != float64(5114) || variables["type"] != "ANIME" || variables["search"] != nil {
+		t.Errorf("Expected lookup by MAL ID, got variables %v", variables)
+	}
+	if metadata.Title != "Fullmetal Alchemist: Brotherhood" || metadata.ExternalIDs["anilist"] != "5114" || metadata.ExternalIDs["mal"] != "5114" {
+		t.Errorf("Unexpected metadata: %+v", metadata)
+	}
+	if metadata.Description != "Two brothers search for the Philosopher's Stone.\n\n(Source: Funimation)" {
+		t.Errorf("Expected HTML stripped from description, got %q", metadata.Description)
+	}
+	if metadata.ReleaseDate == nil || metadata.ReleaseDate.Format("2006-01-02") != "2009-04-05" {
+		t.Errorf("Expected release date 2009-04-05, got %v", metadata.ReleaseDate)
+	}
+	if strings.Join(metadata.Tags, ",") != "Action,Adventure,Alchemy" {
+		t.Errorf("Expected genres and relevant non-spoiler tags, got %v", metadata.Tags)
+	}
+	anime, ok := metadata.SpecificData.(*models.AnimeData)
+	if !ok || anime.Episodes != 64 || anime.Studio != "Bones" {
+		t.Errorf("Unexpected anime data: %+v", metadata.SpecificData)
+	}
+}
+
+func TestAniListProvider_FetchNovelByTitle(t *testing.T) {
+	var variables map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Variables map[string]interface{} `json:"variables"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		variables = body.Variables
+		io.WriteString(w, `{"data":{"Media":{
+			"id":86300,"title":{"romaji":"Koukaku no Regios"},
+			"format":"NOVEL","countryOfOrigin":"JP","volumes":24,
+			"staff":{"edges":[{"role":"Illustration","node":{"name":{"full":"Miyuu"}}},{"role":"Story","node":{"name":{"full":"Shuusuke Amagi"}}}]}
+		}}}`)
+	}))
+	defer server.Close()
+
+	provider := NewAniListProvider(server.Client(), server.URL)
+	metadata, err := provider.Fetch(context.Background(), MetadataQuery{MediaType: models.MediaTypeNovel, Title: "Chrome Shelled Regios"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if variables["search"] != "Chrome Shelled Regios" || variables["type"] != "MANGA" || variables["format"] != "NOVEL" {
+		t.Errorf("Expected novel search by title, got variables %v", variables)
+	}
+	if metadata.Title != "Koukaku no Regios" || metadata.ExternalIDs["mal"] != "" {
+		t.Errorf("Expected romaji title without MAL ID, got %+v", metadata)
+	}
+	book, ok := metadata.SpecificData.(*models.BookData)
+	if !ok || book.Author != "Shuusuke Amagi" || book.Volumes != 24 || book.Format != "light_novel" {
+		t.Errorf("Unexpected book data: %+v", metadata.SpecificData)
+	}
+}
+
+func TestAniListProvider_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		io.WriteString(w, `{"errors":[{"message":"Not Found.","status":404}],"data":{"Media":null}}`)
+	}))
+	defer server.Close()
+
+	provider := NewAniListProvider(server.Client(), server.URL)
+	_, err := provider.Fetch(context.Background(), MetadataQuery{MediaType: models.MediaTypeAnime, Title: "Nothing"})
+	if !errors.Is(err, models.ErrMetadataNotFound) {
+		t.Errorf("Expected ErrMetadataNotFound, got %v", err)
+	}
+
+	_, err = provider.Fetch(context.Background(), MetadataQuery{MediaType: models.MediaTypeAnime})
+	if !errors.Is(err, models.ErrMetadataNotFound) {
+		t.Errorf("Expected ErrMetadataNotFound without IDs or title, got %v", err)
+	}
+}
+
+func TestTMDBProvider_FetchMovieByIMDbID(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		paths = append(paths, r.URL.Path)
+		switch r.URL.Path {
+		case "/3/find/tt0133093":
+			io.WriteString(w, `{"movie_results":[{"id":603}],"tv_results":[]}`)
+		case "/3/movie/603":
+			io.WriteString(w, `{"id":603,"title":"The Matrix","overview":"A hacker learns the truth.","release_date":"1999-03-30",
+				"poster_path":"/matrix.jpg","genres":[{"name":"Action"},{"name":"Science Fiction"}],"runtime":136,"imdb_id":"tt0133093",
+				"credits":{"crew":[{"job":"Producer","name":"Joel Silver"},{"job":"Director","name":"Lana Wachowski"}]}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	provider := NewTMDBProvider(server.Client(), server.URL, "secret")
+	metadata, err := provider.Fetch(context.Background(), MetadataQuery{MediaType: models.MediaTypeMovie, ExternalIDs: models.JSONB{"imdb": "tt0133093"}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if strings.Join(paths, " ") != "/3/find/tt0133093 /3/movie/603" {
+		t.Errorf("Expected find then details, got %v", paths)
+	}
+	if metadata.Title != "The Matrix" || metadata.CoverURL != "https://image.tmdb.org/t/p/w500/matrix.jpg" || metadata.ExternalIDs["tmdb"] != "603" {
+		t.Errorf("Unexpected metadata: %+v", metadata)
+	}
+	movie, ok := metadata.SpecificData.(*models.MovieData)
+	if !ok || movie.Director != "Lana Wachowski" || movie.Runtime != 136 {
+		t.Errorf("Unexpected movie data: %+v", metadata.SpecificData)
+	}
+}
+
+func TestTMDBProvider_SearchSeries(t *testing.T) {
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/3/search/tv":
+			query = r.URL.RawQuery
+			io.WriteString(w, `{"results":[{"id":1396}]}`)
+		case "/3/tv/1396":
+			io.WriteString(w, `{"id":1396,"name":"Breaking Bad","first_air_date":"2008-01-20","number_of_seasons":5,"number_of_episodes":62,
+				"external_ids":{"imdb_id":"tt0903747"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	provider := NewTMDBProvider(server.Client(), server.URL, "secret")
+	metadata, err := provider.Fetch(context.Background(), MetadataQuery{MediaType: models.MediaTypeSeries, Title: "Breaking Bad", Year: 2008})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if query != "first_air_date_year=2008&query=Breaking+Bad" {
+		t.Errorf("Expected search by title and first air year, got %q", query)
+	}
+	if metadata.Title != "Breaking Bad" || metadata.ExternalIDs["imdb"] != "tt0903747" || metadata.ReleaseDate == nil {
+		t.Errorf("Unexpected metadata: %+v", metadata)
+	}
+	series, ok := metadata.SpecificData.(*models.SeriesData)
+	if !ok || series.Seasons != 5 || series.Episodes != 62 {
+		t.Errorf("Unexpected series data: %+v", metadata.SpecificData)
+	}
+}
+
+func TestTMDBProvider_ServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	provider := NewTMDBProvider(server.Client(), server.URL, "secret")
+	_, err := provider.Fetch(context.Background(), MetadataQuery{MediaType: models.MediaTypeMovie, ExternalIDs: models.JSONB{"tmdb": "603"}})
+	if !errors.Is(err, models.ErrMetadataProviderFailed) {
+		t.Errorf("Expected ErrMetadataProviderFailed, got %v", err)
+	}
+}
+
+func TestIGDBProvider_FetchBySteamID(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Client-ID") != "client" || r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		switch r.URL.Path {
+		case "/v4/external_games":
+			io.WriteString(w, `[{"id":9,"game":1942}]`)
+		case "/v4/games":
+			io.WriteString(w, `[{"id":1942,"name":"The Witcher 3: Wild Hunt","summary":"Geralt hunts monsters.","first_release_date":1431993600,
+				"cover":{"image_id":"co1wyy"},"genres":[{"name":"Role-playing (RPG)"}],"themes":[{"name":"Fantasy"}],
+				"platforms":[{"name":"PC (Microsoft Windows)"},{"name":"PlayStation 4"}],
+				"involved_companies":[{"developer":false,"company":{"name":"Bandai Namco"}},{"developer":true,"company":{"name":"CD Projekt RED"}}]}]`)
+		}
+	}))
+	defer server.Close()
+
+	provider := NewIGDBProvider(server.Client(), server.URL, "client", "token")
+	metadata, err := provider.Fetch(context.Background(), MetadataQuery{MediaType: models.MediaTypeGame, ExternalIDs: models.JSONB{"steam": "292030"}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(bodies) != 2 || !strings.Contains(bodies[0], `uid = "292030"`) || !strings.Contains(bodies[1], "where id = 1942;") {
+		t.Errorf("Expected Steam lookup then game by ID, got %v", bodies)
+	}
+	if metadata.CoverURL != "https://images.igdb.com/igdb/image/upload/t_cover_big/co1wyy.jpg" || metadata.ReleaseDate.Format("2006-01-02") != "2015-05-19" {
+		t.Errorf("Unexpected metadata: %+v", metadata)
+	}
+	if strings.Join(metadata.Tags, ",") != "Role-playing (RPG),Fantasy" {
+		t.Errorf("Expected genres and themes as tags, got %v", metadata.Tags)
+	}
+	game, ok := metadata.SpecificData.(*models.GameData)
+	if !ok || game.Developer != "CD Projekt RED" || game.Platform != "PC (Microsoft Windows), PlayStation 4" {
+		t.Errorf("Unexpected game data: %+v", metadata.SpecificData)
+	}
+}
+
+func TestIGDBProvider_SearchEscapesTitle(t *testing.T) {
+	var body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		body = string(data)
+		io.WriteString(w, `[]`)
+	}))
+	defer server.Close()
+
+	provider := NewIGDBProvider(server.Client(), server.URL, "client", "token")
+	_, err := provider.Fetch(context.Background(), MetadataQuery{MediaType: models.MediaTypeGame, Title: `Say "Hi"`})
+	if !errors.Is(err, models.ErrMetadataNotFound) {
+		t.Errorf("Expected ErrMetadataNotFound for empty results, got %v", err)
+	}
+	if !strings.HasPrefix(body, `search "Say \"Hi\"";`) {
+		t.Errorf("Expected escaped search term, got %q", body)
+	}
+}
+
+func TestOpenLibraryProvider_FetchByISBN(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/isbn/9780441172719.json":
+			io.WriteString(w, `{"title":"Dune","publish_date":"August 1990","number_of_pages":535,"publishers":["Ace Books"],
+				"covers":[12345],"works":[{"key":"/works/OL893415W"}],"authors":[{"key":"/authors/OL79034A"}]}`)
+		case "/works/OL893415W.json":
+			io.WriteString(w, `{"key":"/works/OL893415W","title":"Dune","first_publish_date":"1965",
+				"description":{"type":"/type/text","value":"Set on the desert planet Arrakis."},
+				"subjects":["Science fiction","nyt:mass-market-paperback=2021-10-03","Ecology"],"covers":[999]}`)
+		case "/authors/OL79034A.json":
+			io.WriteString(w, `{"name":"Frank Herbert"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	provider := NewOpenLibraryProvider(server.Client(), server.URL)
+	metadata, err := provider.Fetch(context.Background(), MetadataQuery{MediaType: models.MediaTypeBook, ExternalIDs: models.JSONB{"isbn": "978-0-441-17271-9"}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if metadata.ExternalIDs["openlibrary"] != "OL893415W" || metadata.Description != "Set on the desert planet Arrakis." {
+		t.Errorf("Unexpected metadata: %+v", metadata)
+	}
+	if metadata.ReleaseDate == nil || metadata.ReleaseDate.Year() != 1965 {
+		t.Errorf("Expected first publish year 1965, got %v", metadata.ReleaseDate)
+	}
+	if metadata.CoverURL != "https://covers.openlibrary.org/b/id/12345-L.jpg" {
+		t.Errorf("Expected edition cover, got %q", metadata.CoverURL)
+	}
+	if strings.Join(metadata.Tags, ",") != "Science fiction,Ecology" {
+		t.Errorf("Expected subjects without classification prefixes, got %v", metadata.Tags)
+	}
+	book, ok := metadata.SpecificData.(*models.BookData)
+	if !ok || book.Author != "Frank Herbert" || book.Pages != 535 || book.Publisher != "Ace Books" {
+		t.Errorf("Unexpected book data: %+v", metadata.SpecificData)
+	}
+}
+
+func TestOpenLibraryProvider_SearchByTitle(t *testing.T) {
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/search.json":
+			query = r.URL.Query().Get("title")
+			io.WriteString(w, `{"docs":[{"key":"/works/OL27448W"}]}`)
+		case "/works/OL27448W.json":
+			io.WriteString(w, `{"key":"/works/OL27448W","title":"The Lord of the Rings","description":"One ring to rule them all.",
+				"authors":[{"author":{"key":"/authors/OL26320A"}}]}`)
+		case "/authors/OL26320A.json":
+			io.WriteString(w, `{"name":"J.R.R. Tolkien"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	provider := NewOpenLibraryProvider(server.Client(), server.URL)
+	metadata, err := provider.Fetch(context.Background(), MetadataQuery{MediaType: models.MediaTypeBook, Title: "The Lord of the Rings"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if query != "The Lord of the Rings" || metadata.Description != "One ring to rule them all." {
+		t.Errorf("Unexpected search %q or metadata %+v", query, metadata)
+	}
+	if book := metadata.SpecificData.(*models.BookData); book.Author != "J.R.R. Tolkien" {
+		t.Errorf("Expected work author, got %q", book.Author)
+	}
+}
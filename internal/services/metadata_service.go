@@ -0,0 +1,363 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/repositories"
+	"gorm.io/gorm"
+)
+
+// maxEnrichTags limita as tags adicionadas por enriquecimento (o Open Library retorna dezenas de assuntos)
+const maxEnrichTags = 15
+
+type MetadataService struct {
+	itemRepo     repositories.ItemRepositoryInterface
+	uow          repositories.UnitOfWorkInterface
+	similarCache *SimilarItemsCache // Opcional: invalidado quando tags ou dados do catálogo mudam
+	providers    []MetadataProvider // Em ordem de prioridade para cada tipo
+}
+
+// NewMetadataService cria uma nova instância do serviço de enriquecimento de items
+func NewMetadataService(itemRepo repositories.ItemRepositoryInterface, uow repositories.UnitOfWorkInterface, similarCache *SimilarItemsCache, providers ...MetadataProvider) *MetadataService {
+	return &MetadataService{
+		itemRepo:     itemRepo,
+		uow:          uow,
+		similarCache: similarCache,
+		providers:    providers,
+	}
+}
+
+// EnrichItem busca o item nos providers externos e mescla o resultado no catálogo
+// Sem overwrite apenas campos vazios são preenchidos; IDs externos e tags encontrados são sempre somados aos atuais
+func (s *MetadataService) EnrichItem(ctx context.Context, itemID uint, params dto.EnrichItemParams) (*dto.EnrichItemResult, error) {
+	item, err := s.itemRepo.GetByID(ctx, itemID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrItemNotFound
+		}
+		return nil, fmt.Errorf("failed to get item: %w", err)
+	}
+
+	providers, err := s.providersFor(item.Type, params.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	query := MetadataQuery{MediaType: item.Type, ExternalIDs: item.ExternalMetadata, Title: item.Title}
+	if item.ReleaseDate != nil {
+		query.Year = item.ReleaseDate.Year()
+	}
+	metadata, err := fetchMetadata(ctx, providers, query)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := mergeItemMetadata(item, metadata, params.Overwrite)
+	specificData := mergeSpecificData(item, metadata.SpecificData, params.Overwrite)
+	tagNames := missingTagNames(item.Tags, metadata.Tags)
+
+	err = s.uow.Do(ctx, func(tx *repositories.Repositories) error {
+		if len(updated) > 0 {
+			if err := tx.Items.Update(ctx, item); err != nil {
+				return fmt.Errorf("failed to update item: %w", err)
+			}
+		}
+		if specificData != nil {
+			if err := tx.Items.SaveSpecificData(ctx, item.ID, item.Type, specificData); err != nil {
+				return fmt.Errorf("failed to update specific data: %w", err)
+			}
+			updated = append(updated, specificDataField(item.Type))
+		}
+		if len(tagNames) > 0 {
+			added, err := associateMetadataTags(ctx, tx, item, tagNames)
+			if err != nil {
+				return err
+			}
+			if added {
+				updated = append(updated, "tags")
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(updated) > 0 {
+		s.similarCache.Invalidate()
+		if item, err = s.itemRepo.GetByID(ctx, itemID); err != nil {
+			return nil, fmt.Errorf("failed to get item: %w", err)
+		}
+	}
+
+	return &dto.EnrichItemResult{
+		Item:       dto.ItemToDTO(item),
+		Provider:   metadata.Source,
+		ExternalID: metadata.ExternalIDs[metadata.Source],
+		Updated:    nonNil(updated),
+	}, nil
+}
+
+// providersFor retorna os providers a consultar para o tipo; name restringe a um provider específico
+func (s *MetadataService) providersFor(mediaType models.MediaType, name string) ([]MetadataProvider, error) {
+	if name != "" {
+		for _, provider := range s.providers {
+			if provider.Name() != name {
+				continue
+			}
+			if !provider.Supports(mediaType) {
+				return nil, models.ErrMetadataNotSupported
+			}
+			return []MetadataProvider{provider}, nil
+		}
+		return nil, models.ErrUnknownMetadataProvider
+	}
+
+	var providers []MetadataProvider
+	for _, provider := range s.providers {
+		if provider.Supports(mediaType) {
+			providers = append(providers, provider)
+		}
+	}
+	if len(providers) == 0 {
+		return nil, models.ErrMetadataNotSupported
+	}
+	return providers, nil
+}
+
+// fetchMetadata consulta os providers em ordem até um encontrar o item
+// Se nenhum encontrar e algum falhou, a falha é retornada no lugar de ErrMetadataNotFound
+func fetchMetadata(ctx context.Context, providers []MetadataProvider, query MetadataQuery) (*ItemMetadata, error) {
+	var failure error
+	for _, provider := range providers {
+		metadata, err := provider.Fetch(ctx, query)
+		if err == nil {
+			if metadata.Source == "" {
+				metadata.Source = provider.Name()
+			}
+			return metadata, nil
+		}
+		if !errors.Is(err, models.ErrMetadataNotFound) {
+			failure = err
+		}
+	}
+	if failure != nil {
+		return nil, failure
+	}
+	return nil, models.ErrMetadataNotFound
+}
+
+// mergeItemMetadata aplica os campos base encontrados sobre o item e retorna os nomes dos campos alterados
+// O título só é trocado com overwrite, já que o item sempre tem um
+func mergeItemMetadata(item *models.Item, metadata *ItemMetadata, overwrite bool) []string {
+	var updated []string
+	if overwrite && metadata.Title != "" && item.Title != metadata.Title {
+		item.Title = metadata.Title
+		updated = append(updated, "title")
+	}
+	if value := mergeString(item.Description, metadata.Description, overwrite); value != item.Description {
+		item.Description = value
+		updated = append(updated, "description")
+	}
+	if value := mergeString(item.CoverURL, metadata.CoverURL, overwrite); value != item.CoverURL {
+		item.CoverURL = value
+		updated = append(updated, "cover_url")
+	}
+	if metadata.ReleaseDate != nil && (item.ReleaseDate == nil || (overwrite && !item.ReleaseDate.Equal(*metadata.ReleaseDate))) {
+		item.ReleaseDate = metadata.ReleaseDate
+		updated = append(updated, "release_date")
+	}
+
+	externalChanged := false
+	for source, id := range metadata.ExternalIDs {
+		if id == "" {
+			continue
+		}
+		if item.ExternalMetadata == nil {
+			item.ExternalMetadata = models.JSONB{}
+		}
+		current := exportScalar(item.ExternalMetadata[source])
+		if current == id || (current != "" && !overwrite) {
+			continue
+		}
+		item.ExternalMetadata[source] = id
+		externalChanged = true
+	}
+	if externalChanged {
+		updated = append(updated, "external_metadata")
+	}
+	return updated
+}
+
+// mergeSpecificData combina os dados específicos atuais com os encontrados
+// Retorna os dados a salvar ou nil quando nada mudou (ou o provider retornou dados de outro tipo)
+func mergeSpecificData(item *models.Item, fetched interface{}, overwrite bool) interface{} {
+	// Sem dados específicos atuais compara com o valor zero, para não gravar um registro vazio
+	existing := *item
+	var merged interface{}
+	switch d := fetched.(type) {
+	case *models.AnimeData:
+		if item.Type != models.MediaTypeAnime {
+			return nil
+		}
+		current := item.AnimeData
+		if current == nil {
+			current = &models.AnimeData{}
+		}
+		existing.AnimeData = current
+		merged = &models.AnimeData{
+			Episodes: mergeInt(current.Episodes, d.Episodes, overwrite),
+			Studio:   mergeString(current.Studio, d.Studio, overwrite),
+		}
+	case *models.MovieData:
+		if item.Type != models.MediaTypeMovie {
+			return nil
+		}
+		current := item.MovieData
+		if current == nil {
+			current = &models.MovieData{}
+		}
+		existing.MovieData = current
+		merged = &models.MovieData{
+			Director: mergeString(current.Director, d.Director, overwrite),
+			Runtime:  mergeInt(current.Runtime, d.Runtime, overwrite),
+		}
+	case *models.SeriesData:
+		if item.Type != models.MediaTypeSeries {
+			return nil
+		}
+		current := item.SeriesData
+		if current == nil {
+			current = &models.SeriesData{}
+		}
+		existing.SeriesData = current
+		merged = &models.SeriesData{
+			Seasons:  mergeInt(current.Seasons, d.Seasons, overwrite),
+			Episodes: mergeInt(current.Episodes, d.Episodes, overwrite),
+		}
+	case *models.GameData:
+		if item.Type != models.MediaTypeGame {
+			return nil
+		}
+		current := item.GameData
+		if current == nil {
+			current = &models.GameData{}
+		}
+		existing.GameData = current
+		merged = &models.GameData{
+			Platform:        mergeString(current.Platform, d.Platform, overwrite),
+			Developer:       mergeString(current.Developer, d.Developer, overwrite),
+			AveragePlaytime: mergeInt(current.AveragePlaytime, d.AveragePlaytime, overwrite),
+		}
+	case *models.BookData:
+		if item.Type != models.MediaTypeBook && item.Type != models.MediaTypeComic && item.Type != models.MediaTypeNovel {
+			return nil
+		}
+		current := item.BookData
+		if current == nil {
+			current = &models.BookData{}
+		}
+		existing.BookData = current
+		merged = &models.BookData{
+			Author:    mergeString(current.Author, d.Author, overwrite),
+			Volumes:   mergeInt(current.Volumes, d.Volumes, overwrite),
+			Chapters:  mergeInt(current.Chapters, d.Chapters, overwrite),
+			Pages:     mergeInt(current.Pages, d.Pages, overwrite),
+			Format:    mergeString(current.Format, d.Format, overwrite),
+			Publisher: mergeString(current.Publisher, d.Publisher, overwrite),
+		}
+	default:
+		return nil
+	}
+
+	if !specificDataChanged(&existing, merged) {
+		return nil
+	}
+	return merged
+}
+
+// mergeString usa o valor encontrado quando o atual está vazio (ou sempre, com overwrite)
+func mergeString(current, fetched string, overwrite bool) string {
+	if fetched != "" && (current == "" || overwrite) {
+		return fetched
+	}
+	return current
+}
+
+// mergeInt usa o valor encontrado quando o atual é zero (ou sempre, com overwrite)
+func mergeInt(current, fetched int, overwrite bool) int {
+	if fetched != 0 && (current == 0 || overwrite) {
+		return fetched
+	}
+	return current
+}
+
+// specificDataField é o nome do campo JSON dos dados específicos do tipo
+func specificDataField(mediaType models.MediaType) string {
+	switch mediaType {
+	case models.MediaTypeAnime:
+		return "anime_data"
+	case models.MediaTypeMovie:
+		return "movie_data"
+	case models.MediaTypeSeries:
+		return "series_data"
+	case models.MediaTypeGame:
+		return "game_data"
+	}
+	return "book_data"
+}
+
+// missingTagNames normaliza as tags encontradas e retorna as que o item ainda não tem, até maxEnrichTags
+func missingTagNames(current []models.Tag, fetched []string) []string {
+	seen := make(map[string]bool, len(current)+len(fetched))
+	for _, tag := range current {
+		seen[tag.Name] = true
+	}
+
+	var names []string
+	for _, name := range fetched {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+		if len(names) == maxEnrichTags {
+			break
+		}
+	}
+	return names
+}
+
+// associateMetadataTags soma as tags encontradas às atuais do item e informa se alguma foi adicionada
+// Aliases resolvem para a tag canônica, que o item pode já ter
+func associateMetadataTags(ctx context.Context, tx *repositories.Repositories, item *models.Item, names []string) (bool, error) {
+	seen := make(map[uint]bool, len(item.Tags)+len(names))
+	tagIDs := make([]uint, 0, len(item.Tags)+len(names))
+	for _, tag := range item.Tags {
+		seen[tag.ID] = true
+		tagIDs = append(tagIDs, tag.ID)
+	}
+	for _, name := range names {
+		tag := &models.Tag{Name: name}
+		if err := tx.Tags.FindOrCreate(ctx, tag); err != nil {
+			return false, fmt.Errorf("failed to find/create tag '%s': %w", name, err)
+		}
+		if !seen[tag.ID] {
+			seen[tag.ID] = true
+			tagIDs = append(tagIDs, tag.ID)
+		}
+	}
+	if len(tagIDs) == len(item.Tags) {
+		return false, nil
+	}
+	if err := tx.Items.AssociateTags(ctx, item.ID, tagIDs); err != nil {
+		return false, fmt.Errorf("failed to associate tags: %w", err)
+	}
+	return true, nil
+}
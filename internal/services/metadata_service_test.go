@@ -0,0 +1,252 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/repositories"
+	"github.com/rafaelc-rb/geekery-api/internal/testutil"
+	"gorm.io/gorm"
+)
+
+// fakeMetadataProvider é um MetadataProvider de teste que retorna um resultado fixo
+type fakeMetadataProvider struct {
+	name     string
+	types    []models.MediaType
+	metadata *ItemMetadata
+	err      error
+	queries  []MetadataQuery
+}
+
+func (p *fakeMetadataProvider) Name() string {
+	return p.name
+}
+
+func (p *fakeMetadataProvider) Supports(mediaType models.MediaType) bool {
+	for _, supported := range p.types {
+		if supported == mediaType {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *fakeMetadataProvider) Fetch(ctx context.Context, query MetadataQuery) (*ItemMetadata, error) {
+	p.queries = append(p.queries, query)
+	return p.metadata, p.err
+}
+
+func setupMetadataService(item *models.Item, providers ...MetadataProvider) (*MetadataService, *testutil.MockItemRepository, *testutil.MockTagRepository) {
+	itemRepo := &testutil.MockItemRepository{
+		GetByIDFunc: func(ctx context.Context, id uint) (*models.Item, error) {
+			if id != item.ID {
+				return nil, gorm.ErrRecordNotFound
+			}
+			copied := *item
+			return &copied, nil
+		},
+	}
+	tagRepo := &testutil.MockTagRepository{}
+	uow := &testutil.MockUnitOfWork{Items: itemRepo, Tags: tagRepo}
+	return NewMetadataService(itemRepo, uow, nil, providers...), itemRepo, tagRepo
+}
+
+func TestMetadataService_EnrichItemFillsEmptyFields(t *testing.T) {
+	release := time.Date(2009, 4, 5, 0, 0, 0, 0, time.UTC)
+	item := &models.Item{
+		ID:               1,
+		Title:            "FMA Brotherhood",
+		Type:             models.MediaTypeAnime,
+		CoverURL:         "https://example.com/own-cover.jpg",
+		ExternalMetadata: models.JSONB{"mal": "5114"},
+		Tags:             []models.Tag{{ID: 7, Name: "action"}},
+		AnimeData:        &models.AnimeData{ID: 3, ItemID: 1, Studio: "Bones"},
+	}
+	provider := &fakeMetadataProvider{
+		name:  "anilist",
+		types: []models.MediaType{models.MediaTypeAnime},
+		metadata: &ItemMetadata{
+			Source:       "anilist",
+			ExternalIDs:  map[string]string{"anilist": "5114", "mal": "9999"},
+			Title:        "Fullmetal Alchemist: Brotherhood",
+			Description:  "Two brothers search for the Philosopher's Stone.",
+			ReleaseDate:  &release,
+			CoverURL:     "https://img.anilist.co/fmab.jpg",
+			Tags:         []string{"Action", "Adventure", " adventure "},
+			SpecificData: &models.AnimeData{Episodes: 64, Studio: "Studio Bones"},
+		},
+	}
+	service, itemRepo, tagRepo := setupMetadataService(item, provider)
+
+	var saved *models.Item
+	itemRepo.UpdateFunc = func(ctx context.Context, item *models.Item) error {
+		saved = item
+		return nil
+	}
+	var savedData interface{}
+	itemRepo.SaveSpecificDataFunc = func(ctx context.Context, itemID uint, mediaType models.MediaType, data interface{}) error {
+		savedData = data
+		return nil
+	}
+	var associated []uint
+	itemRepo.AssociateTagsFunc = func(ctx context.Context, itemID uint, tagIDs []uint) error {
+		associated = tagIDs
+		return nil
+	}
+	tagRepo.FindOrCreateFunc = func(ctx context.Context, tag *models.Tag) error {
+		tag.ID = 8
+		return nil
+	}
+
+	result, err := service.EnrichItem(context.Background(), 1, dto.EnrichItemParams{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if query := provider.queries[0]; query.Title != "FMA Brotherhood" || exportScalar(query.ExternalIDs["mal"]) != "5114" || query.Year != 0 {
+		t.Errorf("Unexpected query: %+v", query)
+	}
+	if saved == nil || saved.Title != "FMA Brotherhood" || saved.CoverURL != "https://example.com/own-cover.jpg" {
+		t.Fatalf("Expected title and existing cover to be kept, got %+v", saved)
+	}
+	if saved.Description == "" || saved.ReleaseDate == nil {
+		t.Errorf("Expected empty description and release date to be filled, got %+v", saved)
+	}
+	if saved.ExternalMetadata["anilist"] != "5114" || saved.ExternalMetadata["mal"] != "5114" {
+		t.Errorf("Expected AniList ID added and MAL ID kept, got %v", saved.ExternalMetadata)
+	}
+	anime, ok := savedData.(*models.AnimeData)
+	if !ok || anime.Episodes != 64 || anime.Studio != "Bones" || anime.ID != 0 {
+		t.Errorf("Expected episodes filled and studio kept, got %+v", savedData)
+	}
+	if fmt.Sprint(associated) != "[7 8]" {
+		t.Errorf("Expected new tag added to existing ones, got %v", associated)
+	}
+	if fmt.Sprint(result.Updated) != "[description release_date external_metadata anime_data tags]" {
+		t.Errorf("Unexpected updated fields: %v", result.Updated)
+	}
+	if result.Provider != "anilist" || result.ExternalID != "5114" {
+		t.Errorf("Unexpected provider %q or external ID %q", result.Provider, result.ExternalID)
+	}
+}
+
+func TestMetadataService_EnrichItemOverwrite(t *testing.T) {
+	item := &models.Item{ID: 2, Title: "Matrix", Type: models.MediaTypeMovie, Description: "Old", MovieData: &models.MovieData{Director: "Unknown", Runtime: 136}}
+	provider := &fakeMetadataProvider{
+		name:  "tmdb",
+		types: []models.MediaType{models.MediaTypeMovie},
+		metadata: &ItemMetadata{
+			Source:       "tmdb",
+			ExternalIDs:  map[string]string{"tmdb": "603"},
+			Title:        "The Matrix",
+			Description:  "A hacker learns the truth.",
+			SpecificData: &models.MovieData{Director: "Lana Wachowski", Runtime: 136},
+		},
+	}
+	service, itemRepo, _ := setupMetadataService(item, provider)
+
+	var saved *models.Item
+	itemRepo.UpdateFunc = func(ctx context.Context, item *models.Item) error {
+		saved = item
+		return nil
+	}
+	var savedData interface{}
+	itemRepo.SaveSpecificDataFunc = func(ctx context.Context, itemID uint, mediaType models.MediaType, data interface{}) error {
+		savedData = data
+		return nil
+	}
+
+	result, err := service.EnrichItem(context.Background(), 2, dto.EnrichItemParams{Overwrite: true})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if saved == nil || saved.Title != "The Matrix" || saved.Description != "A hacker learns the truth." {
+		t.Errorf("Expected title and description overwritten, got %+v", saved)
+	}
+	if movie, ok := savedData.(*models.MovieData); !ok || movie.Director != "Lana Wachowski" {
+		t.Errorf("Expected director overwritten, got %+v", savedData)
+	}
+	if fmt.Sprint(result.Updated) != "[title description external_metadata movie_data]" {
+		t.Errorf("Unexpected updated fields: %v", result.Updated)
+	}
+}
+
+func TestMetadataService_EnrichItemFallsBackToNextProvider(t *testing.T) {
+	item := &models.Item{ID: 3, Title: "Dune", Type: models.MediaTypeNovel, Description: "Set on Arrakis."}
+	anilist := &fakeMetadataProvider{name: "anilist", types: []models.MediaType{models.MediaTypeNovel}, err: models.ErrMetadataNotFound}
+	openLibrary := &fakeMetadataProvider{
+		name:     "openlibrary",
+		types:    []models.MediaType{models.MediaTypeBook, models.MediaTypeNovel},
+		metadata: &ItemMetadata{Source: "openlibrary", ExternalIDs: map[string]string{"openlibrary": "OL893415W"}, Description: "Other synopsis"},
+	}
+	service, itemRepo, _ := setupMetadataService(item, anilist, openLibrary)
+	itemRepo.UpdateFunc = func(ctx context.Context, item *models.Item) error { return nil }
+
+	result, err := service.EnrichItem(context.Background(), 3, dto.EnrichItemParams{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(anilist.queries) != 1 || result.Provider != "openlibrary" {
+		t.Errorf("Expected fallback to openlibrary, got %q", result.Provider)
+	}
+	if fmt.Sprint(result.Updated) != "[external_metadata]" {
+		t.Errorf("Expected only the external ID to change, got %v", result.Updated)
+	}
+}
+
+func TestMetadataService_EnrichItemNothingChanged(t *testing.T) {
+	item := &models.Item{ID: 4, Title: "Dune", Type: models.MediaTypeBook, ExternalMetadata: models.JSONB{"openlibrary": "OL893415W"}}
+	provider := &fakeMetadataProvider{
+		name:     "openlibrary",
+		types:    []models.MediaType{models.MediaTypeBook},
+		metadata: &ItemMetadata{Source: "openlibrary", ExternalIDs: map[string]string{"openlibrary": "OL893415W"}, SpecificData: &models.BookData{}},
+	}
+	service, _, _ := setupMetadataService(item, provider)
+	service.uow = &testutil.MockUnitOfWork{DoFunc: func(ctx context.Context, fn func(tx *repositories.Repositories) error) error {
+		return fn(&repositories.Repositories{})
+	}}
+
+	result, err := service.EnrichItem(context.Background(), 4, dto.EnrichItemParams{})
+	if err != nil {
+		t.Fatalf("Expected no writes and no error, got %v", err)
+	}
+	if len(result.Updated) != 0 || result.Updated == nil {
+		t.Errorf("Expected empty updated list, got %v", result.Updated)
+	}
+}
+
+func TestMetadataService_EnrichItemErrors(t *testing.T) {
+	item := &models.Item{ID: 5, Title: "Hades", Type: models.MediaTypeGame}
+	failing := &fakeMetadataProvider{name: "igdb", types: []models.MediaType{models.MediaTypeGame}, err: fmt.Errorf("%w: igdb returned status 500", models.ErrMetadataProviderFailed)}
+	service, _, _ := setupMetadataService(item, failing)
+
+	tests := []struct {
+		name     string
+		itemID   uint
+		provider string
+		want     error
+	}{
+		{"item not found", 99, "", models.ErrItemNotFound},
+		{"provider not configured", 5, "tmdb", models.ErrUnknownMetadataProvider},
+		{"provider failure", 5, "", models.ErrMetadataProviderFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.EnrichItem(context.Background(), tt.itemID, dto.EnrichItemParams{Provider: tt.provider})
+			if !errors.Is(err, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+
+	unsupported, _, _ := setupMetadataService(&models.Item{ID: 6, Title: "Dune", Type: models.MediaTypeBook}, failing)
+	if _, err := unsupported.EnrichItem(context.Background(), 6, dto.EnrichItemParams{}); !errors.Is(err, models.ErrMetadataNotSupported) {
+		t.Errorf("Expected ErrMetadataNotSupported, got %v", err)
+	}
+}
@@ -0,0 +1,180 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+)
+
+const (
+	// TMDBAPIURL é a URL base da API do The Movie Database
+	TMDBAPIURL = "https://api.themoviedb.org"
+	// tmdbImageURL é o prefixo das capas do TMDB (poster_path é relativo)
+	tmdbImageURL = "https://image.tmdb.org/t/p/w500"
+)
+
+// TMDBProvider busca filmes e séries no TMDB, também a partir de IDs do IMDb
+type TMDBProvider struct {
+	http  metadataClient
+	token string // API Read Access Token (Bearer)
+}
+
+// NewTMDBProvider cria o provider do TMDB; baseURL vazio usa TMDBAPIURL
+func NewTMDBProvider(client *http.Client, baseURL, token string) *TMDBProvider {
+	return &TMDBProvider{http: newMetadataClient("tmdb", client, baseURL, TMDBAPIURL), token: token}
+}
+
+// Name identifica o provider
+func (p *TMDBProvider) Name() string {
+	return "tmdb"
+}
+
+// Supports indica se o provider cobre o tipo de mídia
+func (p *TMDBProvider) Supports(mediaType models.MediaType) bool {
+	return mediaType == models.MediaTypeMovie || mediaType == models.MediaTypeSeries
+}
+
+type tmdbGenre struct {
+	Name string `json:"name"`
+}
+
+// tmdbTitle reúne os campos de filmes (title, release_date, runtime) e séries (name, first_air_date, temporadas)
+type tmdbTitle struct {
+	ID               int         `json:"id"`
+	Title            string      `json:"title"`
+	Name             string      `json:"name"`
+	Overview         string      `json:"overview"`
+	ReleaseDate      string      `json:"release_date"`
+	FirstAirDate     string      `json:"first_air_date"`
+	PosterPath       string      `json:"poster_path"`
+	Genres           []tmdbGenre `json:"genres"`
+	Runtime          int         `json:"runtime"`
+	NumberOfSeasons  int         `json:"number_of_seasons"`
+	NumberOfEpisodes int         `json:"number_of_episodes"`
+	IMDbID           string      `json:"imdb_id"`
+	ExternalIDs      struct {
+		IMDbID string `json:"imdb_id"`
+	} `json:"external_ids"`
+	Credits struct {
+		Crew []struct {
+			Job  string `json:"job"`
+			Name string `json:"name"`
+		} `json:"crew"`
+	} `json:"credits"`
+}
+
+// Fetch busca pelo ID do TMDB, depois pelo ID do IMDb e, sem IDs, pelo título (e ano, se conhecido)
+func (p *TMDBProvider) Fetch(ctx context.Context, query MetadataQuery) (*ItemMetadata, error) {
+	kind := "movie"
+	if query.MediaType == models.MediaTypeSeries {
+		kind = "tv"
+	}
+
+	id, err := p.resolveID(ctx, kind, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var title tmdbTitle
+	path := fmt.Sprintf("/3/%s/%s?append_to_response=credits,external_ids", kind, url.PathEscape(id))
+	if err := p.get(ctx, path, &title); err != nil {
+		return nil, err
+	}
+	return tmdbMetadata(query.MediaType, &title), nil
+}
+
+// resolveID encontra o ID do TMDB do item
+func (p *TMDBProvider) resolveID(ctx context.Context, kind string, query MetadataQuery) (string, error) {
+	source, id := query.externalID("tmdb", "imdb")
+	switch {
+	case source == "tmdb":
+		return id, nil
+	case source == "imdb":
+		var found struct {
+			MovieResults []tmdbTitle `json:"movie_results"`
+			TVResults    []tmdbTitle `json:"tv_results"`
+		}
+		if err := p.get(ctx, "/3/find/"+url.PathEscape(id)+"?external_source=imdb_id", &found); err != nil {
+			return "", err
+		}
+		results := found.MovieResults
+		if kind == "tv" {
+			results = found.TVResults
+		}
+		return tmdbFirstID(results)
+	case query.Title != "":
+		params := url.Values{"query": {query.Title}}
+		if query.Year > 0 {
+			yearParam := "year"
+			if kind == "tv" {
+				yearParam = "first_air_date_year"
+			}
+			params.Set(yearParam, strconv.Itoa(query.Year))
+		}
+		var found struct {
+			Results []tmdbTitle `json:"results"`
+		}
+		if err := p.get(ctx, "/3/search/"+kind+"?"+params.Encode(), &found); err != nil {
+			return "", err
+		}
+		return tmdbFirstID(found.Results)
+	}
+	return "", models.ErrMetadataNotFound
+}
+
+func tmdbFirstID(results []tmdbTitle) (string, error) {
+	if len(results) == 0 {
+		return "", models.ErrMetadataNotFound
+	}
+	return strconv.Itoa(results[0].ID), nil
+}
+
+func (p *TMDBProvider) get(ctx context.Context, path string, out interface{}) error {
+	req, err := p.http.newRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+p.token)
+	return p.http.doJSON(req, out)
+}
+
+func tmdbMetadata(mediaType models.MediaType, title *tmdbTitle) *ItemMetadata {
+	metadata := &ItemMetadata{
+		Source:      "tmdb",
+		ExternalIDs: map[string]string{"tmdb": strconv.Itoa(title.ID)},
+		Title:       title.Title,
+		Description: title.Overview,
+		ReleaseDate: parseMetadataDate(title.ReleaseDate),
+		Tags:        make([]string, len(title.Genres)),
+	}
+	if title.PosterPath != "" {
+		metadata.CoverURL = tmdbImageURL + title.PosterPath
+	}
+	for i, genre := range title.Genres {
+		metadata.Tags[i] = genre.Name
+	}
+	if imdbID := firstNonEmpty(title.IMDbID, title.ExternalIDs.IMDbID); imdbID != "" {
+		metadata.ExternalIDs["imdb"] = imdbID
+	}
+
+	if mediaType == models.MediaTypeSeries {
+		metadata.Title = title.Name
+		metadata.ReleaseDate = parseMetadataDate(title.FirstAirDate)
+		metadata.SpecificData = &models.SeriesData{Seasons: title.NumberOfSeasons, Episodes: title.NumberOfEpisodes}
+		return metadata
+	}
+
+	data := &models.MovieData{Runtime: title.Runtime}
+	for _, member := range title.Credits.Crew {
+		if member.Job == "Director" {
+			data.Director = member.Name
+			break
+		}
+	}
+	metadata.SpecificData = data
+	return metadata
+}
@@ -0,0 +1,30 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/rafaelc-rb/geekery-api/internal/logger"
+)
+
+// runPeriodically executa job imediatamente e depois a cada interval, até o contexto ser cancelado
+// Falhas são logadas e não interrompem as próximas execuções
+func runPeriodically(ctx context.Context, interval time.Duration, name string, job func(ctx context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		start := time.Now()
+		if err := job(ctx); err != nil {
+			logger.Error().Err(err).Str("job", name).Msg("Background job failed")
+		} else {
+			logger.Info().Str("job", name).Dur("duration", time.Since(start)).Msg("Background job finished")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
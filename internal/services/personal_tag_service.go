@@ -0,0 +1,190 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/repositories"
+	"gorm.io/gorm"
+)
+
+type PersonalTagService struct {
+	personalTagRepo repositories.PersonalTagRepositoryInterface
+	userItemRepo    repositories.UserItemRepositoryInterface
+}
+
+// NewPersonalTagService cria uma nova instância do serviço de rótulos pessoais
+func NewPersonalTagService(personalTagRepo repositories.PersonalTagRepositoryInterface, userItemRepo repositories.UserItemRepositoryInterface) *PersonalTagService {
+	return &PersonalTagService{
+		personalTagRepo: personalTagRepo,
+		userItemRepo:    userItemRepo,
+	}
+}
+
+// CreateTag cria um rótulo pessoal para o usuário
+func (s *PersonalTagService) CreateTag(ctx context.Context, userID uint, req dto.PersonalTagRequest) (*models.PersonalTag, error) {
+	tag := &models.PersonalTag{
+		UserID: userID,
+		Name:   normalizeTagName(req.Name),
+		Color:  strings.ToLower(req.Color),
+	}
+
+	if err := tag.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.ensureUniqueName(ctx, userID, tag.Name, 0); err != nil {
+		return nil, err
+	}
+
+	if err := s.personalTagRepo.Create(ctx, tag); err != nil {
+		return nil, fmt.Errorf("failed to create personal tag: %w", err)
+	}
+
+	return tag, nil
+}
+
+// GetTags retorna os rótulos pessoais do usuário com o número de usos
+func (s *PersonalTagService) GetTags(ctx context.Context, userID uint) ([]models.PersonalTag, error) {
+	tags, err := s.personalTagRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get personal tags: %w", err)
+	}
+	return tags, nil
+}
+
+// UpdateTag renomeia ou altera a cor de um rótulo do usuário
+func (s *PersonalTagService) UpdateTag(ctx context.Context, id, userID uint, req dto.PersonalTagRequest) (*models.PersonalTag, error) {
+	tag, err := s.getOwnedTag(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	tag.Name = normalizeTagName(req.Name)
+	tag.Color = strings.ToLower(req.Color)
+
+	if err := tag.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.ensureUniqueName(ctx, userID, tag.Name, tag.ID); err != nil {
+		return nil, err
+	}
+
+	if err := s.personalTagRepo.Update(ctx, tag); err != nil {
+		return nil, fmt.Errorf("failed to update personal tag: %w", err)
+	}
+
+	return tag, nil
+}
+
+// DeleteTag remove um rótulo do usuário (e suas associações com a lista)
+func (s *PersonalTagService) DeleteTag(ctx context.Context, id, userID uint) error {
+	if _, err := s.getOwnedTag(ctx, id, userID); err != nil {
+		return err
+	}
+
+	if err := s.personalTagRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete personal tag: %w", err)
+	}
+	return nil
+}
+
+// SetUserItemTags substitui os rótulos pessoais de uma entrada da lista do usuário
+func (s *PersonalTagService) SetUserItemTags(ctx context.Context, userItemID, userID uint, tagIDs []uint) (*models.UserItem, error) {
+	userItem, err := s.userItemRepo.GetByIDAndUser(ctx, userItemID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := setPersonalTags(ctx, s.personalTagRepo, userItem, tagIDs); err != nil {
+		return nil, err
+	}
+
+	return userItem, nil
+}
+
+// RemoveUserItemTag remove um rótulo pessoal de uma entrada da lista do usuário
+func (s *PersonalTagService) RemoveUserItemTag(ctx context.Context, userItemID, userID, tagID uint) error {
+	if _, err := s.userItemRepo.GetByIDAndUser(ctx, userItemID, userID); err != nil {
+		return err
+	}
+
+	if _, err := s.getOwnedTag(ctx, tagID, userID); err != nil {
+		return err
+	}
+
+	if err := s.personalTagRepo.RemoveFromUserItem(ctx, userItemID, tagID); err != nil {
+		return fmt.Errorf("failed to remove personal tag: %w", err)
+	}
+	return nil
+}
+
+// getOwnedTag busca um rótulo do usuário, retornando ErrPersonalTagNotFound se não existir
+func (s *PersonalTagService) getOwnedTag(ctx context.Context, id, userID uint) (*models.PersonalTag, error) {
+	tag, err := s.personalTagRepo.GetByIDAndUser(ctx, id, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrPersonalTagNotFound
+		}
+		return nil, fmt.Errorf("failed to find personal tag: %w", err)
+	}
+	return tag, nil
+}
+
+// ensureUniqueName verifica se o usuário já possui outro rótulo com o mesmo nome
+func (s *PersonalTagService) ensureUniqueName(ctx context.Context, userID uint, name string, currentID uint) error {
+	existing, err := s.personalTagRepo.GetByName(ctx, userID, name)
+	if err == nil && existing != nil && existing.ID != currentID {
+		return models.ErrDuplicatePersonalTag
+	}
+	return nil
+}
+
+// setPersonalTags resolve os IDs informados entre os rótulos do usuário e os aplica à entrada
+// Usado tanto pelo endpoint da entrada quanto pela operação de bulk
+func setPersonalTags(ctx context.Context, personalTagRepo repositories.PersonalTagRepositoryInterface, userItem *models.UserItem, tagIDs []uint) error {
+	unique := make([]uint, 0, len(tagIDs))
+	seen := make(map[uint]bool, len(tagIDs))
+	for _, id := range tagIDs {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	tags, err := personalTagRepo.GetByIDsAndUser(ctx, unique, userItem.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to find personal tags: %w", err)
+	}
+	if len(tags) != len(unique) {
+		return models.ErrPersonalTagNotFound
+	}
+
+	if err := personalTagRepo.ReplaceForUserItem(ctx, userItem, tags); err != nil {
+		return fmt.Errorf("failed to set personal tags: %w", err)
+	}
+
+	userItem.PersonalTags = tags
+	return nil
+}
+
+// normalizeTagName normaliza o nome de uma tag (lowercase e trim)
+func normalizeTagName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// normalizeTagNames normaliza uma lista de nomes de tags, descartando os vazios
+func normalizeTagNames(names []string) []string {
+	normalized := make([]string, 0, len(names))
+	for _, name := range names {
+		if name = normalizeTagName(name); name != "" {
+			normalized = append(normalized, name)
+		}
+	}
+	return normalized
+}
@@ -0,0 +1,102 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/testutil"
+)
+
+func TestCreatePersonalTag_NormalizesName(t *testing.T) {
+	var created *models.PersonalTag
+	mockPersonalTagRepo := &testutil.MockPersonalTagRepository{
+		CreateFunc: func(ctx context.Context, tag *models.PersonalTag) error {
+			created = tag
+			return nil
+		},
+	}
+
+	service := NewPersonalTagService(mockPersonalTagRepo, &testutil.MockUserItemRepository{})
+	_, err := service.CreateTag(context.Background(), 1, dto.PersonalTagRequest{Name: "  Watch With Partner ", Color: "#FF8800"})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if created.Name != "watch with partner" || created.Color != "#ff8800" || created.UserID != 1 {
+		t.Errorf("Unexpected personal tag: %+v", created)
+	}
+}
+
+func TestCreatePersonalTag_Duplicate(t *testing.T) {
+	mockPersonalTagRepo := &testutil.MockPersonalTagRepository{
+		GetByNameFunc: func(ctx context.Context, userID uint, name string) (*models.PersonalTag, error) {
+			return &models.PersonalTag{ID: 3, UserID: userID, Name: name}, nil
+		},
+	}
+
+	service := NewPersonalTagService(mockPersonalTagRepo, &testutil.MockUserItemRepository{})
+	_, err := service.CreateTag(context.Background(), 1, dto.PersonalTagRequest{Name: "comfort rewatch"})
+
+	if !errors.Is(err, models.ErrDuplicatePersonalTag) {
+		t.Errorf("Expected ErrDuplicatePersonalTag, got %v", err)
+	}
+}
+
+func TestSetUserItemTags_ForeignTag(t *testing.T) {
+	mockUserItemRepo := &testutil.MockUserItemRepository{
+		GetByIDAndUserFunc: func(ctx context.Context, id, userID uint) (*models.UserItem, error) {
+			return &models.UserItem{ID: id, UserID: userID, ItemID: 10}, nil
+		},
+	}
+	mockPersonalTagRepo := &testutil.MockPersonalTagRepository{
+		GetByIDsAndUserFunc: func(ctx context.Context, ids []uint, userID uint) ([]models.PersonalTag, error) {
+			// Apenas o rótulo 1 pertence ao usuário
+			return []models.PersonalTag{{ID: 1, UserID: userID, Name: "mine"}}, nil
+		},
+		ReplaceForUserItemFunc: func(ctx context.Context, userItem *models.UserItem, tags []models.PersonalTag) error {
+			t.Error("ReplaceForUserItem should not be called with foreign tags")
+			return nil
+		},
+	}
+
+	service := NewPersonalTagService(mockPersonalTagRepo, mockUserItemRepo)
+	_, err := service.SetUserItemTags(context.Background(), 5, 1, []uint{1, 2})
+
+	if !errors.Is(err, models.ErrPersonalTagNotFound) {
+		t.Errorf("Expected ErrPersonalTagNotFound, got %v", err)
+	}
+}
+
+func TestSetUserItemTags_DeduplicatesIDs(t *testing.T) {
+	var replaced []models.PersonalTag
+	mockUserItemRepo := &testutil.MockUserItemRepository{
+		GetByIDAndUserFunc: func(ctx context.Context, id, userID uint) (*models.UserItem, error) {
+			return &models.UserItem{ID: id, UserID: userID, ItemID: 10}, nil
+		},
+	}
+	mockPersonalTagRepo := &testutil.MockPersonalTagRepository{
+		GetByIDsAndUserFunc: func(ctx context.Context, ids []uint, userID uint) ([]models.PersonalTag, error) {
+			if len(ids) != 1 {
+				t.Errorf("Expected deduplicated IDs, got %v", ids)
+			}
+			return []models.PersonalTag{{ID: 1, UserID: userID, Name: "mine"}}, nil
+		},
+		ReplaceForUserItemFunc: func(ctx context.Context, userItem *models.UserItem, tags []models.PersonalTag) error {
+			replaced = tags
+			return nil
+		},
+	}
+
+	service := NewPersonalTagService(mockPersonalTagRepo, mockUserItemRepo)
+	userItem, err := service.SetUserItemTags(context.Background(), 5, 1, []uint{1, 1})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(replaced) != 1 || len(userItem.PersonalTags) != 1 {
+		t.Errorf("Expected one personal tag, got %v", replaced)
+	}
+}
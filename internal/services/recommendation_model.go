@@ -0,0 +1,271 @@
+package services
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+)
+
+// Parâmetros do modelo de similaridade entre items
+const (
+	similarityMinScore     = 0.05 // Pares abaixo desse score não são guardados
+	collaborativeShrinkage = 5.0  // Co-avaliações necessárias para confiar na similaridade colaborativa
+	contentMaxGroupSize    = 500  // Tags/criadores com mais items que isso não geram pares candidatos
+
+	// Pesos da similaridade por conteúdo (somam 1)
+	contentTagWeight     = 0.6
+	contentCreatorWeight = 0.25
+	contentTypeWeight    = 0.15
+)
+
+// itemPair identifica um par não ordenado de items (a < b)
+type itemPair struct {
+	a, b uint
+}
+
+func newItemPair(x, y uint) itemPair {
+	if x > y {
+		x, y = y, x
+	}
+	return itemPair{a: x, b: y}
+}
+
+// signalPreference converte uma interação do usuário em preferência na escala 0-10
+// Sem nota, o status é usado como sinal implícito; favorito soma um ponto
+func signalPreference(signal dto.UserItemSignal) float64 {
+	preference := signal.Rating
+	if preference <= 0 {
+		switch models.MediaStatus(signal.Status) {
+		case models.StatusCompleted:
+			preference = 7
+		case models.StatusInProgress:
+			preference = 6.5
+		case models.StatusPlanned:
+			preference = 5.5
+		case models.StatusDropped:
+			preference = 2
+		default:
+			preference = 5
+		}
+	}
+	if signal.Favorite {
+		preference = math.Min(preference+1, 10)
+	}
+	return preference
+}
+
+// buildSimilarities calcula o modelo de similaridade combinando conteúdo e filtragem colaborativa item-item
+// Cada item guarda no máximo models.SimilarityNeighbors vizinhos
+// Relações entre items (sequências, adaptações) ainda não existem no catálogo e não entram no cálculo
+func buildSimilarities(features []dto.ItemFeatures, signals []dto.UserItemSignal, now time.Time) []models.ItemSimilarity {
+	content := contentSimilarities(features)
+	collaborative := collaborativeSimilarities(signals)
+
+	pairs := make(map[itemPair]struct{}, len(content)+len(collaborative))
+	for pair := range content {
+		pairs[pair] = struct{}{}
+	}
+	for pair := range collaborative {
+		pairs[pair] = struct{}{}
+	}
+
+	neighbors := make(map[uint][]models.ItemSimilarity)
+	for pair := range pairs {
+		contentScore := content[pair]
+		collaborativeScore := collaborative[pair]
+		score := models.SimilarityContentWeight*contentScore + models.SimilarityCollaborativeWeight*collaborativeScore
+		if score < similarityMinScore {
+			continue
+		}
+
+		for _, dir := range [][2]uint{{pair.a, pair.b}, {pair.b, pair.a}} {
+			neighbors[dir[0]] = append(neighbors[dir[0]], models.ItemSimilarity{
+				ItemID:             dir[0],
+				SimilarItemID:      dir[1],
+				Score:              score,
+				ContentScore:       contentScore,
+				CollaborativeScore: collaborativeScore,
+				UpdatedAt:          now,
+			})
+		}
+	}
+
+	itemIDs := make([]uint, 0, len(neighbors))
+	for itemID := range neighbors {
+		itemIDs = append(itemIDs, itemID)
+	}
+	sort.Slice(itemIDs, func(i, j int) bool { return itemIDs[i] < itemIDs[j] })
+
+	var similarities []models.ItemSimilarity
+	for _, itemID := range itemIDs {
+		list := neighbors[itemID]
+		sort.Slice(list, func(i, j int) bool {
+			if list[i].Score != list[j].Score {
+				return list[i].Score > list[j].Score
+			}
+			return list[i].SimilarItemID < list[j].SimilarItemID
+		})
+		if len(list) > models.SimilarityNeighbors {
+			list = list[:models.SimilarityNeighbors]
+		}
+		similarities = append(similarities, list...)
+	}
+
+	return similarities
+}
+
+// contentSimilarities calcula a similaridade por conteúdo dos pares que compartilham uma tag ou criador
+func contentSimilarities(features []dto.ItemFeatures) map[itemPair]float64 {
+	byID := make(map[uint]*dto.ItemFeatures, len(features))
+	byTag := make(map[uint][]uint)
+	byCreator := make(map[string][]uint)
+	for i := range features {
+		f := &features[i]
+		byID[f.ItemID] = f
+		for _, tagID := range f.TagIDs {
+			byTag[tagID] = append(byTag[tagID], f.ItemID)
+		}
+		if creator := normalizeCreator(f.Creator); creator != "" {
+			byCreator[creator] = append(byCreator[creator], f.ItemID)
+		}
+	}
+
+	groups := make([][]uint, 0, len(byTag)+len(byCreator))
+	for _, group := range byTag {
+		groups = append(groups, group)
+	}
+	for _, group := range byCreator {
+		groups = append(groups, group)
+	}
+
+	scores := make(map[itemPair]float64)
+	for _, group := range groups {
+		if len(group) < 2 || len(group) > contentMaxGroupSize {
+			continue
+		}
+		for i := 0; i < len(group); i++ {
+			for j := i + 1; j < len(group); j++ {
+				pair := newItemPair(group[i], group[j])
+				if _, done := scores[pair]; done || pair.a == pair.b {
+					continue
+				}
+				scores[pair] = contentScore(byID[pair.a], byID[pair.b])
+			}
+		}
+	}
+
+	return scores
+}
+
+// contentScore combina tags em comum (Jaccard), mesmo criador e mesmo tipo de mídia
+func contentScore(a, b *dto.ItemFeatures) float64 {
+	score := contentTagWeight * jaccard(a.TagIDs, b.TagIDs)
+
+	if creator := normalizeCreator(a.Creator); creator != "" && creator == normalizeCreator(b.Creator) {
+		score += contentCreatorWeight
+	}
+	if a.MediaType == b.MediaType {
+		score += contentTypeWeight
+	}
+	return score
+}
+
+// collaborativeSimilarities calcula o cosseno ajustado (preferências centradas na média do usuário) entre items
+// O resultado é reduzido para pares com poucas co-avaliações e pares com correlação negativa são descartados
+func collaborativeSimilarities(signals []dto.UserItemSignal) map[itemPair]float64 {
+	byUser := make(map[uint][]dto.UserItemSignal)
+	for _, signal := range signals {
+		byUser[signal.UserID] = append(byUser[signal.UserID], signal)
+	}
+
+	type centered struct {
+		itemID uint
+		value  float64
+	}
+
+	dots := make(map[itemPair]float64)
+	counts := make(map[itemPair]int)
+	norms := make(map[uint]float64)
+
+	for _, userSignals := range byUser {
+		if len(userSignals) < 2 {
+			continue
+		}
+
+		var sum float64
+		for _, signal := range userSignals {
+			sum += signalPreference(signal)
+		}
+		mean := sum / float64(len(userSignals))
+
+		values := make([]centered, len(userSignals))
+		for i, signal := range userSignals {
+			v := signalPreference(signal) - mean
+			values[i] = centered{itemID: signal.ItemID, value: v}
+			norms[signal.ItemID] += v * v
+		}
+
+		for i := 0; i < len(values); i++ {
+			for j := i + 1; j < len(values); j++ {
+				if values[i].itemID == values[j].itemID {
+					continue
+				}
+				pair := newItemPair(values[i].itemID, values[j].itemID)
+				dots[pair] += values[i].value * values[j].value
+				counts[pair]++
+			}
+		}
+	}
+
+	scores := make(map[itemPair]float64, len(dots))
+	for pair, dot := range dots {
+		if dot <= 0 {
+			continue
+		}
+		denominator := math.Sqrt(norms[pair.a] * norms[pair.b])
+		if denominator == 0 {
+			continue
+		}
+		n := float64(counts[pair])
+		scores[pair] = dot / denominator * n / (n + collaborativeShrinkage)
+	}
+
+	return scores
+}
+
+// jaccard calcula |A ∩ B| / |A ∪ B| entre dois conjuntos de IDs
+func jaccard(a, b []uint) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	set := make(map[uint]bool, len(a))
+	for _, id := range a {
+		set[id] = true
+	}
+
+	intersection := 0
+	union := len(set)
+	seen := make(map[uint]bool, len(b))
+	for _, id := range b {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if set[id] {
+			intersection++
+		} else {
+			union++
+		}
+	}
+	return float64(intersection) / float64(union)
+}
+
+// normalizeCreator normaliza o nome do criador para comparação
+func normalizeCreator(creator string) string {
+	return strings.ToLower(strings.TrimSpace(creator))
+}
@@ -0,0 +1,261 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/repositories"
+)
+
+const (
+	recommendationDefaultLimit      = 20
+	recommendationMaxReasons        = 3
+	recommendationNeutralPreference = 5.0 // Preferência (0-10) abaixo da qual um item não origina recomendações
+	recommendationFallbackPool      = 100 // Items do ranking considerados quando não há recomendações personalizadas
+)
+
+type RecommendationService struct {
+	recommendationRepo repositories.RecommendationRepositoryInterface
+	itemRepo           repositories.ItemRepositoryInterface
+	similarCache       *SimilarItemsCache // Opcional: nil calcula os items similares a cada requisição
+}
+
+// NewRecommendationService cria uma nova instância do serviço de recomendações
+func NewRecommendationService(recommendationRepo repositories.RecommendationRepositoryInterface, itemRepo repositories.ItemRepositoryInterface, similarCache *SimilarItemsCache) *RecommendationService {
+	return &RecommendationService{
+		recommendationRepo: recommendationRepo,
+		itemRepo:           itemRepo,
+		similarCache:       similarCache,
+	}
+}
+
+// RebuildModel recalcula a similaridade entre todos os items a partir do catálogo e das listas de todos os usuários
+func (s *RecommendationService) RebuildModel(ctx context.Context) error {
+	features, err := s.recommendationRepo.GetItemFeatures(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get item features: %w", err)
+	}
+
+	signals, err := s.recommendationRepo.GetSignals(ctx, 0)
+	if err != nil {
+		return fmt.Errorf("failed to get list signals: %w", err)
+	}
+
+	similarities := buildSimilarities(features, signals, time.Now())
+	if err := s.recommendationRepo.ReplaceSimilarities(ctx, similarities); err != nil {
+		return fmt.Errorf("failed to save item similarities: %w", err)
+	}
+	return nil
+}
+
+// RunPeriodicRebuild executa RebuildModel imediatamente e depois a cada interval, até o contexto ser cancelado
+func (s *RecommendationService) RunPeriodicRebuild(ctx context.Context, interval time.Duration) {
+	runPeriodically(ctx, interval, "recommendation model rebuild", s.RebuildModel)
+}
+
+// recommendationSeed é um item da lista do usuário que origina recomendações
+type recommendationSeed struct {
+	signal dto.UserItemSignal
+	weight float64 // Preferência normalizada (0-1]
+}
+
+// recommendationContribution é a parcela do score de um candidato vinda de um item da lista
+type recommendationContribution struct {
+	seedItemID uint
+	value      float64
+}
+
+// recommendationCandidate é um item ainda não adicionado pelo usuário com seu score acumulado
+type recommendationCandidate struct {
+	itemID        uint
+	score         float64
+	contributions []recommendationContribution
+}
+
+// GetRecommendations sugere items do catálogo que o usuário ainda não adicionou à lista
+// O score soma a similaridade com cada item de que o usuário gostou, ponderada pela preferência
+// Sem histórico suficiente, retorna os items mais bem avaliados pela comunidade
+func (s *RecommendationService) GetRecommendations(ctx context.Context, userID uint, filter dto.RecommendationFilter) ([]dto.RecommendationDTO, error) {
+	mediaType := models.MediaType(filter.Type)
+	if mediaType != "" && !mediaType.IsValid() {
+		return nil, models.ErrInvalidMediaType
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = recommendationDefaultLimit
+	}
+
+	signals, err := s.recommendationRepo.GetSignals(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get list signals: %w", err)
+	}
+
+	listed := make(map[uint]bool, len(signals))
+	seeds := make(map[uint]recommendationSeed)
+	seedIDs := make([]uint, 0, len(signals))
+	for _, signal := range signals {
+		listed[signal.ItemID] = true
+		weight := (signalPreference(signal) - recommendationNeutralPreference) / recommendationNeutralPreference
+		if weight > 0 {
+			seeds[signal.ItemID] = recommendationSeed{signal: signal, weight: weight}
+			seedIDs = append(seedIDs, signal.ItemID)
+		}
+	}
+
+	similarities, err := s.recommendationRepo.GetSimilarities(ctx, seedIDs, mediaType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get item similarities: %w", err)
+	}
+
+	candidates := scoreCandidates(seeds, listed, similarities)
+	if len(candidates) == 0 {
+		return s.popularRecommendations(ctx, listed, filter.Type, limit)
+	}
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+
+	// Carregar candidatos e items da lista citados nas explicações
+	ids := make([]uint, 0, len(candidates)*(recommendationMaxReasons+1))
+	for _, candidate := range candidates {
+		ids = append(ids, candidate.itemID)
+		for _, contribution := range candidate.contributions {
+			ids = append(ids, contribution.seedItemID)
+		}
+	}
+	items, err := s.recommendationRepo.GetItemsByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recommended items: %w", err)
+	}
+	itemsByID := make(map[uint]*models.Item, len(items))
+	for i := range items {
+		itemsByID[items[i].ID] = &items[i]
+	}
+
+	recommendations := make([]dto.RecommendationDTO, 0, len(candidates))
+	for _, candidate := range candidates {
+		item, ok := itemsByID[candidate.itemID]
+		if !ok {
+			continue
+		}
+
+		reasons := make([]dto.RecommendationReasonDTO, 0, len(candidate.contributions))
+		for _, contribution := range candidate.contributions {
+			var title string
+			if seedItem, ok := itemsByID[contribution.seedItemID]; ok {
+				title = seedItem.Title
+			}
+			reasons = append(reasons, recommendationReason(seeds[contribution.seedItemID].signal, title))
+		}
+
+		recommendations = append(recommendations, dto.RecommendationDTO{
+			Item:    dto.ItemToDTO(item),
+			Score:   candidate.score,
+			Reasons: reasons,
+		})
+	}
+
+	return recommendations, nil
+}
+
+// scoreCandidates acumula o score dos items similares aos items da lista, ignorando os já adicionados
+// Retorna os candidatos em ordem decrescente de score, cada um com as maiores contribuições
+func scoreCandidates(seeds map[uint]recommendationSeed, listed map[uint]bool, similarities []models.ItemSimilarity) []recommendationCandidate {
+	byItem := make(map[uint]*recommendationCandidate)
+	for _, similarity := range similarities {
+		seed, ok := seeds[similarity.ItemID]
+		if !ok || listed[similarity.SimilarItemID] {
+			continue
+		}
+
+		candidate, ok := byItem[similarity.SimilarItemID]
+		if !ok {
+			candidate = &recommendationCandidate{itemID: similarity.SimilarItemID}
+			byItem[similarity.SimilarItemID] = candidate
+		}
+
+		value := seed.weight * similarity.Score
+		candidate.score += value
+		candidate.contributions = append(candidate.contributions, recommendationContribution{
+			seedItemID: similarity.ItemID,
+			value:      value,
+		})
+	}
+
+	candidates := make([]recommendationCandidate, 0, len(byItem))
+	for _, candidate := range byItem {
+		sort.Slice(candidate.contributions, func(i, j int) bool {
+			if candidate.contributions[i].value != candidate.contributions[j].value {
+				return candidate.contributions[i].value > candidate.contributions[j].value
+			}
+			return candidate.contributions[i].seedItemID < candidate.contributions[j].seedItemID
+		})
+		if len(candidate.contributions) > recommendationMaxReasons {
+			candidate.contributions = candidate.contributions[:recommendationMaxReasons]
+		}
+		candidates = append(candidates, *candidate)
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score > candidates[j].score
+		}
+		return candidates[i].itemID < candidates[j].itemID
+	})
+	return candidates
+}
+
+// recommendationReason monta a explicação a partir da interação do usuário com o item da lista
+func recommendationReason(signal dto.UserItemSignal, title string) dto.RecommendationReasonDTO {
+	reason := dto.RecommendationReasonDTO{
+		ItemID: signal.ItemID,
+		Title:  title,
+		Rating: signal.Rating,
+		Status: signal.Status,
+	}
+
+	switch {
+	case signal.Rating > 0:
+		reason.Message = fmt.Sprintf("because you rated %s %g/10", title, signal.Rating)
+	case signal.Favorite:
+		reason.Message = fmt.Sprintf("because you favorited %s", title)
+	case models.MediaStatus(signal.Status) == models.StatusCompleted:
+		reason.Message = fmt.Sprintf("because you completed %s", title)
+	case models.MediaStatus(signal.Status) == models.StatusInProgress:
+		reason.Message = fmt.Sprintf("because you are enjoying %s", title)
+	default:
+		reason.Message = fmt.Sprintf("because you added %s to your list", title)
+	}
+	return reason
+}
+
+// popularRecommendations recomenda os items mais bem avaliados pela comunidade que o usuário ainda não adicionou
+func (s *RecommendationService) popularRecommendations(ctx context.Context, listed map[uint]bool, mediaType string, limit int) ([]dto.RecommendationDTO, error) {
+	filter := dto.ItemRankingFilter{Type: mediaType, SortBy: dto.ItemSortScore}
+	items, _, err := s.itemRepo.GetRanked(ctx, filter, dto.PaginationParams{Page: 1, Limit: recommendationFallbackPool})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get popular items: %w", err)
+	}
+
+	recommendations := make([]dto.RecommendationDTO, 0, limit)
+	for i := range items {
+		if len(recommendations) == limit {
+			break
+		}
+		if listed[items[i].ID] || items[i].Stats == nil || items[i].Stats.RatingCount == 0 {
+			continue
+		}
+
+		recommendations = append(recommendations, dto.RecommendationDTO{
+			Item:    dto.ItemToDTO(&items[i]),
+			Score:   items[i].Stats.WeightedScore / 10,
+			Reasons: []dto.RecommendationReasonDTO{{Message: "popular with the community"}},
+		})
+	}
+	return recommendations, nil
+}
@@ -0,0 +1,222 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/testutil"
+)
+
+func findSimilarity(similarities []models.ItemSimilarity, itemID, similarItemID uint) *models.ItemSimilarity {
+	for i := range similarities {
+		if similarities[i].ItemID == itemID && similarities[i].SimilarItemID == similarItemID {
+			return &similarities[i]
+		}
+	}
+	return nil
+}
+
+func TestBuildSimilarities_ContentBased(t *testing.T) {
+	features := []dto.ItemFeatures{
+		{ItemID: 1, MediaType: "anime", Creator: "Madhouse", TagIDs: []uint{10, 11}},
+		{ItemID: 2, MediaType: "anime", Creator: " madhouse ", TagIDs: []uint{10, 11}},
+		{ItemID: 3, MediaType: "game", Creator: "FromSoftware", TagIDs: []uint{12}},
+	}
+
+	similarities := buildSimilarities(features, nil, time.Now())
+
+	sim := findSimilarity(similarities, 1, 2)
+	if sim == nil {
+		t.Fatal("Expected items 1 and 2 to be similar")
+	}
+	if sim.ContentScore != 1 {
+		t.Errorf("Expected content score 1 (same tags, creator and type), got %f", sim.ContentScore)
+	}
+	if findSimilarity(similarities, 2, 1) == nil {
+		t.Error("Expected similarity to be stored in both directions")
+	}
+	if findSimilarity(similarities, 1, 3) != nil {
+		t.Error("Expected no similarity between items without shared tags or creator")
+	}
+}
+
+func TestBuildSimilarities_Collaborative(t *testing.T) {
+	// Usuários que gostam de 1 também gostam de 2 e não gostam de 3
+	signals := []dto.UserItemSignal{
+		{UserID: 1, ItemID: 1, Rating: 9}, {UserID: 1, ItemID: 2, Rating: 9}, {UserID: 1, ItemID: 3, Rating: 3},
+		{UserID: 2, ItemID: 1, Rating: 8}, {UserID: 2, ItemID: 2, Rating: 9}, {UserID: 2, ItemID: 3, Rating: 2},
+		{UserID: 3, ItemID: 1, Rating: 10}, {UserID: 3, ItemID: 2, Rating: 8}, {UserID: 3, ItemID: 3, Rating: 4},
+	}
+
+	similarities := buildSimilarities(nil, signals, time.Now())
+
+	sim := findSimilarity(similarities, 1, 2)
+	if sim == nil || sim.CollaborativeScore <= 0 {
+		t.Fatalf("Expected positive collaborative similarity between 1 and 2, got %+v", sim)
+	}
+	if findSimilarity(similarities, 1, 3) != nil {
+		t.Error("Expected negatively correlated items not to be similar")
+	}
+}
+
+func TestBuildSimilarities_LimitsNeighbors(t *testing.T) {
+	features := make([]dto.ItemFeatures, models.SimilarityNeighbors+10)
+	for i := range features {
+		features[i] = dto.ItemFeatures{ItemID: uint(i + 1), MediaType: "anime", TagIDs: []uint{1}}
+	}
+
+	similarities := buildSimilarities(features, nil, time.Now())
+
+	count := 0
+	for _, sim := range similarities {
+		if sim.ItemID == 1 {
+			count++
+		}
+	}
+	if count != models.SimilarityNeighbors {
+		t.Errorf("Expected %d neighbors, got %d", models.SimilarityNeighbors, count)
+	}
+}
+
+func TestSignalPreference(t *testing.T) {
+	tests := []struct {
+		name     string
+		signal   dto.UserItemSignal
+		expected float64
+	}{
+		{name: "rating", signal: dto.UserItemSignal{Rating: 8, Status: "dropped"}, expected: 8},
+		{name: "completed_without_rating", signal: dto.UserItemSignal{Status: "completed"}, expected: 7},
+		{name: "dropped_without_rating", signal: dto.UserItemSignal{Status: "dropped"}, expected: 2},
+		{name: "favorite_capped", signal: dto.UserItemSignal{Rating: 10, Favorite: true}, expected: 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := signalPreference(tt.signal); got != tt.expected {
+				t.Errorf("Expected %f, got %f", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestRebuildModel_ReplacesSimilarities(t *testing.T) {
+	ctx := context.Background()
+	var saved []models.ItemSimilarity
+	mockRepo := &testutil.MockRecommendationRepository{
+		GetItemFeaturesFunc: func(ctx context.Context, itemIDs []uint) ([]dto.ItemFeatures, error) {
+			return []dto.ItemFeatures{
+				{ItemID: 1, MediaType: "anime", TagIDs: []uint{1}},
+				{ItemID: 2, MediaType: "anime", TagIDs: []uint{1}},
+			}, nil
+		},
+		GetSignalsFunc: func(ctx context.Context, userID uint) ([]dto.UserItemSignal, error) {
+			if userID != 0 {
+				t.Errorf("Expected signals of all users, got user %d", userID)
+			}
+			return nil, nil
+		},
+		ReplaceSimilaritiesFunc: func(ctx context.Context, similarities []models.ItemSimilarity) error {
+			saved = similarities
+			return nil
+		},
+	}
+
+	service := NewRecommendationService(mockRepo, &testutil.MockItemRepository{}, nil)
+	if err := service.RebuildModel(ctx); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(saved) != 2 {
+		t.Errorf("Expected 2 similarity rows, got %d", len(saved))
+	}
+}
+
+func TestGetRecommendations_ExplainsAndExcludesListed(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := &testutil.MockRecommendationRepository{
+		GetSignalsFunc: func(ctx context.Context, userID uint) ([]dto.UserItemSignal, error) {
+			return []dto.UserItemSignal{
+				{UserID: userID, ItemID: 1, Rating: 9, Status: "completed"},
+				{UserID: userID, ItemID: 2, Status: "dropped"},
+			}, nil
+		},
+		GetSimilaritiesFunc: func(ctx context.Context, itemIDs []uint, mediaType models.MediaType) ([]models.ItemSimilarity, error) {
+			if len(itemIDs) != 1 || itemIDs[0] != 1 {
+				t.Errorf("Expected only liked item 1 as seed, got %v", itemIDs)
+			}
+			return []models.ItemSimilarity{
+				{ItemID: 1, SimilarItemID: 2, Score: 0.9}, // Já está na lista
+				{ItemID: 1, SimilarItemID: 3, Score: 0.8},
+				{ItemID: 1, SimilarItemID: 4, Score: 0.4},
+			}, nil
+		},
+		GetItemsByIDsFunc: func(ctx context.Context, ids []uint) ([]models.Item, error) {
+			items := []models.Item{
+				{ID: 1, Title: "Steins;Gate", Type: models.MediaTypeAnime},
+				{ID: 3, Title: "Erased", Type: models.MediaTypeAnime},
+				{ID: 4, Title: "Paprika", Type: models.MediaTypeMovie},
+			}
+			return items, nil
+		},
+	}
+
+	service := NewRecommendationService(mockRepo, &testutil.MockItemRepository{}, nil)
+	recommendations, err := service.GetRecommendations(ctx, 1, dto.RecommendationFilter{})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(recommendations) != 2 {
+		t.Fatalf("Expected 2 recommendations, got %d", len(recommendations))
+	}
+	if recommendations[0].Item.ID != 3 || recommendations[1].Item.ID != 4 {
+		t.Errorf("Expected items 3 and 4 in score order, got %d and %d", recommendations[0].Item.ID, recommendations[1].Item.ID)
+	}
+	if len(recommendations[0].Reasons) != 1 || !strings.Contains(recommendations[0].Reasons[0].Message, "rated Steins;Gate 9/10") {
+		t.Errorf("Expected explanation based on Steins;Gate rating, got %+v", recommendations[0].Reasons)
+	}
+}
+
+func TestGetRecommendations_FallsBackToPopular(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := &testutil.MockRecommendationRepository{
+		GetSignalsFunc: func(ctx context.Context, userID uint) ([]dto.UserItemSignal, error) {
+			return []dto.UserItemSignal{{UserID: userID, ItemID: 1, Status: "planned"}}, nil
+		},
+	}
+	mockItemRepo := &testutil.MockItemRepository{
+		GetRankedFunc: func(ctx context.Context, filter dto.ItemRankingFilter, params dto.PaginationParams) ([]models.Item, int64, error) {
+			return []models.Item{
+				{ID: 1, Title: "Listed", Stats: &models.ItemStats{WeightedScore: 9, RatingCount: 10}},
+				{ID: 2, Title: "Popular", Stats: &models.ItemStats{WeightedScore: 8, RatingCount: 10}},
+				{ID: 3, Title: "Unrated"},
+			}, 3, nil
+		},
+	}
+
+	service := NewRecommendationService(mockRepo, mockItemRepo, nil)
+	recommendations, err := service.GetRecommendations(ctx, 1, dto.RecommendationFilter{})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(recommendations) != 1 || recommendations[0].Item.ID != 2 {
+		t.Fatalf("Expected only the popular unlisted item, got %+v", recommendations)
+	}
+	if recommendations[0].Reasons[0].Message != "popular with the community" {
+		t.Errorf("Expected popularity explanation, got %q", recommendations[0].Reasons[0].Message)
+	}
+}
+
+func TestGetRecommendations_InvalidMediaType(t *testing.T) {
+	service := NewRecommendationService(&testutil.MockRecommendationRepository{}, &testutil.MockItemRepository{}, nil)
+
+	_, err := service.GetRecommendations(context.Background(), 1, dto.RecommendationFilter{Type: "invalid"})
+	if !errors.Is(err, models.ErrInvalidMediaType) {
+		t.Errorf("Expected ErrInvalidMediaType, got %v", err)
+	}
+}
@@ -0,0 +1,270 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/repositories"
+	"gorm.io/gorm"
+)
+
+type ReviewService struct {
+	reviewRepo   repositories.ReviewRepositoryInterface
+	itemRepo     repositories.ItemRepositoryInterface
+	userItemRepo repositories.UserItemRepositoryInterface
+	activityRepo repositories.ActivityRepositoryInterface // Opcional: nil desativa o registro no feed
+}
+
+// NewReviewService cria uma nova instância do serviço de resenhas
+func NewReviewService(reviewRepo repositories.ReviewRepositoryInterface, itemRepo repositories.ItemRepositoryInterface, userItemRepo repositories.UserItemRepositoryInterface, activityRepo repositories.ActivityRepositoryInterface) *ReviewService {
+	return &ReviewService{
+		reviewRepo:   reviewRepo,
+		itemRepo:     itemRepo,
+		userItemRepo: userItemRepo,
+		activityRepo: activityRepo,
+	}
+}
+
+// CreateReview publica a resenha do usuário sobre um item (uma por item)
+func (s *ReviewService) CreateReview(ctx context.Context, userID, itemID uint, req dto.ReviewRequest) (*dto.ReviewDTO, error) {
+	item, err := s.getItem(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := s.reviewRepo.Exists(ctx, userID, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check existing review: %w", err)
+	}
+	if exists {
+		return nil, models.ErrDuplicateReview
+	}
+
+	rating, err := s.ratingSnapshot(ctx, userID, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	review := &models.Review{
+		UserID:      userID,
+		ItemID:      itemID,
+		Title:       req.Title,
+		Body:        req.Body,
+		Spoiler:     req.Spoiler,
+		Recommended: *req.Recommended,
+		Rating:      rating,
+	}
+	if err := review.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.reviewRepo.Create(ctx, review); err != nil {
+		return nil, fmt.Errorf("failed to create review: %w", err)
+	}
+
+	recordActivity(ctx, s.activityRepo, []models.ActivityEvent{{
+		UserID:     userID,
+		ItemID:     itemID,
+		MediaType:  item.Type,
+		Kind:       models.ActivityReviewed,
+		Rating:     rating,
+		OccurredAt: time.Now(),
+	}})
+
+	return s.GetReview(ctx, review.ID, userID)
+}
+
+// GetItemReviews retorna as resenhas públicas de um item
+// viewerID (0 = anônimo) é usado para indicar em quais o usuário já votou
+func (s *ReviewService) GetItemReviews(ctx context.Context, itemID, viewerID uint, filter dto.ReviewFilter, params dto.PaginationParams) ([]dto.ReviewDTO, int64, error) {
+	if _, err := s.getItem(ctx, itemID); err != nil {
+		return nil, 0, err
+	}
+
+	reviews, total, err := s.reviewRepo.GetByItemID(ctx, itemID, filter, params)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get reviews: %w", err)
+	}
+
+	result, err := s.withViewerVotes(ctx, reviews, viewerID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, total, nil
+}
+
+// GetReview retorna uma resenha
+func (s *ReviewService) GetReview(ctx context.Context, id, viewerID uint) (*dto.ReviewDTO, error) {
+	review, err := s.reviewRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.withViewerVotes(ctx, []models.Review{*review}, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	return &result[0], nil
+}
+
+// UpdateReview edita a resenha do autor, guardando a versão anterior no histórico
+// A nota é atualizada a partir da lista do usuário
+func (s *ReviewService) UpdateReview(ctx context.Context, id, userID uint, req dto.ReviewRequest) (*dto.ReviewDTO, error) {
+	review, err := s.getOwnedReview(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := &models.ReviewRevision{
+		ReviewID:    review.ID,
+		Title:       review.Title,
+		Body:        review.Body,
+		Spoiler:     review.Spoiler,
+		Recommended: review.Recommended,
+		Rating:      review.Rating,
+	}
+
+	rating, err := s.ratingSnapshot(ctx, userID, review.ItemID)
+	if err != nil {
+		return nil, err
+	}
+
+	review.Title = req.Title
+	review.Body = req.Body
+	review.Spoiler = req.Spoiler
+	review.Recommended = *req.Recommended
+	review.Rating = rating
+	if err := review.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.reviewRepo.Update(ctx, review, previous); err != nil {
+		return nil, fmt.Errorf("failed to update review: %w", err)
+	}
+
+	return s.GetReview(ctx, id, userID)
+}
+
+// DeleteReview remove a resenha do autor
+func (s *ReviewService) DeleteReview(ctx context.Context, id, userID uint) error {
+	if _, err := s.getOwnedReview(ctx, id, userID); err != nil {
+		return err
+	}
+
+	if err := s.reviewRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete review: %w", err)
+	}
+	return nil
+}
+
+// GetReviewHistory retorna as versões anteriores da resenha, mais recentes primeiro
+func (s *ReviewService) GetReviewHistory(ctx context.Context, id uint) ([]dto.ReviewRevisionDTO, error) {
+	review, err := s.reviewRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.reviewRepo.GetRevisions(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get review history: %w", err)
+	}
+	return dto.ReviewRevisionsToDTOs(revisions, review.RatingHidden), nil
+}
+
+// VoteHelpful marca a resenha como útil para o usuário (o autor não pode votar)
+func (s *ReviewService) VoteHelpful(ctx context.Context, id, userID uint) (*dto.ReviewDTO, error) {
+	review, err := s.reviewRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if review.UserID == userID {
+		return nil, models.ErrCannotVoteOwnReview
+	}
+
+	if err := s.reviewRepo.AddVote(ctx, &models.ReviewVote{ReviewID: id, UserID: userID}); err != nil {
+		return nil, fmt.Errorf("failed to vote on review: %w", err)
+	}
+
+	return s.GetReview(ctx, id, userID)
+}
+
+// RemoveHelpfulVote remove o voto útil do usuário
+func (s *ReviewService) RemoveHelpfulVote(ctx context.Context, id, userID uint) (*dto.ReviewDTO, error) {
+	if _, err := s.reviewRepo.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if err := s.reviewRepo.RemoveVote(ctx, id, userID); err != nil {
+		return nil, fmt.Errorf("failed to remove review vote: %w", err)
+	}
+
+	return s.GetReview(ctx, id, userID)
+}
+
+// getOwnedReview busca uma resenha garantindo que pertence ao usuário
+func (s *ReviewService) getOwnedReview(ctx context.Context, id, userID uint) (*models.Review, error) {
+	review, err := s.reviewRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if review.UserID != userID {
+		return nil, models.ErrNotReviewAuthor
+	}
+	return review, nil
+}
+
+// getItem retorna o item do catálogo, verificando se ele existe
+func (s *ReviewService) getItem(ctx context.Context, itemID uint) (*models.Item, error) {
+	item, err := s.itemRepo.GetByID(ctx, itemID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrItemNotFound
+		}
+		return nil, fmt.Errorf("failed to verify item existence: %w", err)
+	}
+	return item, nil
+}
+
+// ratingSnapshot retorna a nota atual do item na lista do usuário (0 se não está na lista)
+func (s *ReviewService) ratingSnapshot(ctx context.Context, userID, itemID uint) (float64, error) {
+	userItem, err := s.userItemRepo.GetByUserAndItem(ctx, userID, itemID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get list rating: %w", err)
+	}
+	return userItem.Rating, nil
+}
+
+// withViewerVotes converte as resenhas para DTO marcando as votadas pelo usuário
+func (s *ReviewService) withViewerVotes(ctx context.Context, reviews []models.Review, viewerID uint) ([]dto.ReviewDTO, error) {
+	voted := make(map[uint]bool)
+	if viewerID != 0 && len(reviews) > 0 {
+		ids := make([]uint, len(reviews))
+		for i, review := range reviews {
+			ids[i] = review.ID
+		}
+
+		votedIDs, err := s.reviewRepo.GetVotedReviewIDs(ctx, viewerID, ids)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get review votes: %w", err)
+		}
+		for _, id := range votedIDs {
+			voted[id] = true
+		}
+	}
+
+	result := make([]dto.ReviewDTO, 0, len(reviews))
+	for i := range reviews {
+		reviewDTO := dto.ReviewToDTO(&reviews[i])
+		reviewDTO.VotedHelpful = voted[reviews[i].ID]
+		result = append(result, *reviewDTO)
+	}
+	return result, nil
+}
@@ -0,0 +1,223 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/testutil"
+	"gorm.io/gorm"
+)
+
+func TestCreateReview_SnapshotsListRating(t *testing.T) {
+	var created *models.Review
+	mockReviewRepo := &testutil.MockReviewRepository{
+		CreateFunc: func(ctx context.Context, review *models.Review) error {
+			review.ID = 10
+			created = review
+			return nil
+		},
+	}
+	mockUserItemRepo := &testutil.MockUserItemRepository{
+		GetByUserAndItemFunc: func(ctx context.Context, userID, itemID uint) (*models.UserItem, error) {
+			return &models.UserItem{UserID: userID, ItemID: itemID, Rating: 8.5, Notes: "private"}, nil
+		},
+	}
+
+	service := NewReviewService(mockReviewRepo, &testutil.MockItemRepository{}, mockUserItemRepo, nil)
+	recommended := true
+	_, err := service.CreateReview(context.Background(), 1, 5, dto.ReviewRequest{Title: "Great", Body: "**Loved it**", Recommended: &recommended})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if created.Rating != 8.5 || !created.Recommended || created.Body != "**Loved it**" {
+		t.Errorf("Unexpected review: %+v", created)
+	}
+}
+
+func TestCreateReview_RecordsFeedActivity(t *testing.T) {
+	mockItemRepo := &testutil.MockItemRepository{
+		GetByIDFunc: func(ctx context.Context, id uint) (*models.Item, error) {
+			return &models.Item{ID: id, Type: models.MediaTypeBook}, nil
+		},
+	}
+	mockActivityRepo := &testutil.MockActivityRepository{}
+
+	service := NewReviewService(&testutil.MockReviewRepository{}, mockItemRepo, &testutil.MockUserItemRepository{}, mockActivityRepo)
+	recommended := false
+	if _, err := service.CreateReview(context.Background(), 1, 5, dto.ReviewRequest{Title: "Meh", Body: "Not for me", Recommended: &recommended}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(mockActivityRepo.Created) != 1 {
+		t.Fatalf("Expected one activity event, got %+v", mockActivityRepo.Created)
+	}
+	event := mockActivityRepo.Created[0]
+	if event.Kind != models.ActivityReviewed || event.ItemID != 5 || event.MediaType != models.MediaTypeBook {
+		t.Errorf("Unexpected reviewed event: %+v", event)
+	}
+}
+
+func TestCreateReview_NotInListHasNoRating(t *testing.T) {
+	var created *models.Review
+	mockReviewRepo := &testutil.MockReviewRepository{
+		CreateFunc: func(ctx context.Context, review *models.Review) error {
+			created = review
+			return nil
+		},
+	}
+	mockUserItemRepo := &testutil.MockUserItemRepository{
+		GetByUserAndItemFunc: func(ctx context.Context, userID, itemID uint) (*models.UserItem, error) {
+			return nil, gorm.ErrRecordNotFound
+		},
+	}
+
+	service := NewReviewService(mockReviewRepo, &testutil.MockItemRepository{}, mockUserItemRepo, nil)
+	recommended := false
+	_, err := service.CreateReview(context.Background(), 1, 5, dto.ReviewRequest{Title: "Meh", Body: "Not for me", Recommended: &recommended})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if created.Rating != 0 {
+		t.Errorf("Expected rating 0, got %v", created.Rating)
+	}
+}
+
+func TestCreateReview_Duplicate(t *testing.T) {
+	mockReviewRepo := &testutil.MockReviewRepository{
+		ExistsFunc: func(ctx context.Context, userID, itemID uint) (bool, error) {
+			return true, nil
+		},
+	}
+
+	service := NewReviewService(mockReviewRepo, &testutil.MockItemRepository{}, &testutil.MockUserItemRepository{}, nil)
+	recommended := true
+	_, err := service.CreateReview(context.Background(), 1, 5, dto.ReviewRequest{Title: "Again", Body: "Again", Recommended: &recommended})
+
+	if !errors.Is(err, models.ErrDuplicateReview) {
+		t.Errorf("Expected ErrDuplicateReview, got %v", err)
+	}
+}
+
+func TestUpdateReview_StoresPreviousVersion(t *testing.T) {
+	var previous *models.ReviewRevision
+	mockReviewRepo := &testutil.MockReviewRepository{
+		GetByIDFunc: func(ctx context.Context, id uint) (*models.Review, error) {
+			return &models.Review{ID: id, UserID: 1, ItemID: 5, Title: "Old", Body: "Old body", Rating: 6}, nil
+		},
+		UpdateFunc: func(ctx context.Context, review *models.Review, prev *models.ReviewRevision) error {
+			previous = prev
+			return nil
+		},
+	}
+	mockUserItemRepo := &testutil.MockUserItemRepository{
+		GetByUserAndItemFunc: func(ctx context.Context, userID, itemID uint) (*models.UserItem, error) {
+			return &models.UserItem{Rating: 9}, nil
+		},
+	}
+
+	service := NewReviewService(mockReviewRepo, &testutil.MockItemRepository{}, mockUserItemRepo, nil)
+	recommended := true
+	_, err := service.UpdateReview(context.Background(), 3, 1, dto.ReviewRequest{Title: "New", Body: "New body", Recommended: &recommended})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if previous == nil || previous.ReviewID != 3 || previous.Title != "Old" || previous.Rating != 6 {
+		t.Errorf("Expected previous version to be stored, got %+v", previous)
+	}
+}
+
+func TestUpdateReview_NotAuthor(t *testing.T) {
+	mockReviewRepo := &testutil.MockReviewRepository{
+		GetByIDFunc: func(ctx context.Context, id uint) (*models.Review, error) {
+			return &models.Review{ID: id, UserID: 2}, nil
+		},
+		UpdateFunc: func(ctx context.Context, review *models.Review, prev *models.ReviewRevision) error {
+			t.Error("Update should not be called")
+			return nil
+		},
+	}
+
+	service := NewReviewService(mockReviewRepo, &testutil.MockItemRepository{}, &testutil.MockUserItemRepository{}, nil)
+	recommended := true
+	_, err := service.UpdateReview(context.Background(), 3, 1, dto.ReviewRequest{Title: "x", Body: "y", Recommended: &recommended})
+
+	if !errors.Is(err, models.ErrNotReviewAuthor) {
+		t.Errorf("Expected ErrNotReviewAuthor, got %v", err)
+	}
+}
+
+func TestVoteHelpful_OwnReview(t *testing.T) {
+	mockReviewRepo := &testutil.MockReviewRepository{
+		GetByIDFunc: func(ctx context.Context, id uint) (*models.Review, error) {
+			return &models.Review{ID: id, UserID: 1}, nil
+		},
+	}
+
+	service := NewReviewService(mockReviewRepo, &testutil.MockItemRepository{}, &testutil.MockUserItemRepository{}, nil)
+	_, err := service.VoteHelpful(context.Background(), 3, 1)
+
+	if !errors.Is(err, models.ErrCannotVoteOwnReview) {
+		t.Errorf("Expected ErrCannotVoteOwnReview, got %v", err)
+	}
+}
+
+func TestGetItemReviews_MarksViewerVotes(t *testing.T) {
+	mockReviewRepo := &testutil.MockReviewRepository{
+		GetByItemIDFunc: func(ctx context.Context, itemID uint, filter dto.ReviewFilter, params dto.PaginationParams) ([]models.Review, int64, error) {
+			return []models.Review{{ID: 1, UserID: 2}, {ID: 2, UserID: 3}}, 2, nil
+		},
+		GetVotedReviewIDsFunc: func(ctx context.Context, userID uint, reviewIDs []uint) ([]uint, error) {
+			return []uint{2}, nil
+		},
+	}
+
+	service := NewReviewService(mockReviewRepo, &testutil.MockItemRepository{}, &testutil.MockUserItemRepository{}, nil)
+	reviews, total, err := service.GetItemReviews(context.Background(), 5, 1, dto.ReviewFilter{}, dto.PaginationParams{})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if total != 2 || reviews[0].VotedHelpful || !reviews[1].VotedHelpful {
+		t.Errorf("Unexpected votes: %+v", reviews)
+	}
+}
+
+func TestGetItemReviews_HidesPrivateRatings(t *testing.T) {
+	mockReviewRepo := &testutil.MockReviewRepository{
+		GetByItemIDFunc: func(ctx context.Context, itemID uint, filter dto.ReviewFilter, params dto.PaginationParams) ([]models.Review, int64, error) {
+			return []models.Review{{ID: 1, UserID: 2, Rating: 9}, {ID: 2, UserID: 3, Rating: 7, RatingHidden: true}}, 2, nil
+		},
+		GetByIDFunc: func(ctx context.Context, id uint) (*models.Review, error) {
+			return &models.Review{ID: id, UserID: 3, Rating: 7, RatingHidden: true}, nil
+		},
+		GetRevisionsFunc: func(ctx context.Context, reviewID uint) ([]models.ReviewRevision, error) {
+			return []models.ReviewRevision{{ReviewID: reviewID, Title: "Old", Rating: 6}}, nil
+		},
+	}
+
+	service := NewReviewService(mockReviewRepo, &testutil.MockItemRepository{}, &testutil.MockUserItemRepository{}, nil)
+	reviews, _, err := service.GetItemReviews(context.Background(), 5, 0, dto.ReviewFilter{}, dto.PaginationParams{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if reviews[0].Rating != 9 || reviews[1].Rating != 0 {
+		t.Errorf("Expected only the hidden rating to be zeroed, got %v and %v", reviews[0].Rating, reviews[1].Rating)
+	}
+
+	review, err := service.GetReview(context.Background(), 2, 0)
+	if err != nil || review.Rating != 0 {
+		t.Errorf("Expected hidden rating zeroed, got %+v (%v)", review, err)
+	}
+
+	// As versões anteriores também não revelam a nota
+	history, err := service.GetReviewHistory(context.Background(), 2)
+	if err != nil || len(history) != 1 || history[0].Rating != 0 || history[0].Title != "Old" {
+		t.Errorf("Expected revision rating zeroed, got %+v (%v)", history, err)
+	}
+}
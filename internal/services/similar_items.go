@@ -0,0 +1,327 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+)
+
+// Parâmetros de "mais como este"
+const (
+	similarItemsDefaultLimit    = 10
+	similarItemsMaxLimit        = 50  // Resultados calculados (e guardados em cache) por item
+	similarItemsCandidatePool   = 500 // Candidatos considerados por fonte (tags, criador, listas)
+	similarItemsCacheMaxEntries = 5000
+
+	// Pesos da similaridade (somam 1)
+	similarTagWeight          = 0.5
+	similarCreatorWeight      = 0.2
+	similarCoOccurrenceWeight = 0.3
+)
+
+// SimilarItemsCacheTTL é o tempo de vida padrão do cache de items similares
+// As co-ocorrências em listas mudam aos poucos; mudanças de tags invalidam o cache na hora
+const SimilarItemsCacheTTL = time.Hour
+
+// SimilarItemsCache guarda em memória os items similares já calculados
+// É invalidado por completo quando tags, criadores ou items do catálogo mudam; um cache nil desativa o cache
+type SimilarItemsCache struct {
+	mu      sync.RWMutex
+	ttl     time.Duration
+	entries map[string]similarItemsCacheEntry
+}
+
+type similarItemsCacheEntry struct {
+	items     []dto.SimilarItemDTO
+	expiresAt time.Time
+}
+
+// NewSimilarItemsCache cria um cache de items similares com o tempo de vida informado
+func NewSimilarItemsCache(ttl time.Duration) *SimilarItemsCache {
+	return &SimilarItemsCache{
+		ttl:     ttl,
+		entries: make(map[string]similarItemsCacheEntry),
+	}
+}
+
+// Invalidate descarta todos os resultados em cache
+func (c *SimilarItemsCache) Invalidate() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	c.entries = make(map[string]similarItemsCacheEntry)
+	c.mu.Unlock()
+}
+
+func (c *SimilarItemsCache) get(key string) ([]dto.SimilarItemDTO, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.items, true
+}
+
+func (c *SimilarItemsCache) set(key string, items []dto.SimilarItemDTO) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Limite simples de memória: recomeça o cache quando cheio
+	if len(c.entries) >= similarItemsCacheMaxEntries {
+		c.entries = make(map[string]similarItemsCacheEntry)
+	}
+	c.entries[key] = similarItemsCacheEntry{items: items, expiresAt: time.Now().Add(c.ttl)}
+}
+
+// GetSimilarItems retorna os items mais parecidos com o item informado ("mais como este")
+// Combina tags em comum (Jaccard ponderado por IDF), mesmo criador e co-ocorrência nas listas dos usuários
+func (s *RecommendationService) GetSimilarItems(ctx context.Context, itemID uint, filter dto.SimilarItemsFilter) ([]dto.SimilarItemDTO, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = similarItemsDefaultLimit
+	}
+
+	features, err := s.recommendationRepo.GetItemFeatures(ctx, []uint{itemID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get item features: %w", err)
+	}
+	if len(features) == 0 {
+		return nil, models.ErrItemNotFound
+	}
+	target := features[0]
+
+	mediaType := models.MediaType(filter.Type)
+	if filter.SameType {
+		mediaType = models.MediaType(target.MediaType)
+	}
+	if mediaType != "" && !mediaType.IsValid() {
+		return nil, models.ErrInvalidMediaType
+	}
+
+	key := fmt.Sprintf("%d:%s", itemID, mediaType)
+	similar, ok := s.similarCache.get(key)
+	if !ok {
+		similar, err = s.computeSimilarItems(ctx, target, mediaType)
+		if err != nil {
+			return nil, err
+		}
+		s.similarCache.set(key, similar)
+	}
+
+	if len(similar) > limit {
+		similar = similar[:limit]
+	}
+	return similar, nil
+}
+
+// similarCandidate é um item candidato a similar com os componentes do score
+type similarCandidate struct {
+	features      dto.ItemFeatures
+	score         float64
+	sameCreator   bool
+	sharedMembers int64
+}
+
+// computeSimilarItems calcula os similares de um item a partir dos candidatos que compartilham tags, criador ou listas
+func (s *RecommendationService) computeSimilarItems(ctx context.Context, target dto.ItemFeatures, mediaType models.MediaType) ([]dto.SimilarItemDTO, error) {
+	candidateIDs, err := s.recommendationRepo.GetItemIDsSharingTags(ctx, target.ItemID, similarItemsCandidatePool)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get items sharing tags: %w", err)
+	}
+
+	if target.Creator != "" {
+		creatorIDs, err := s.recommendationRepo.GetItemIDsByCreator(ctx, target.Creator, similarItemsCandidatePool)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get items by creator: %w", err)
+		}
+		candidateIDs = append(candidateIDs, creatorIDs...)
+	}
+
+	coOccurrences, members, err := s.recommendationRepo.GetCoOccurrences(ctx, target.ItemID, similarItemsCandidatePool)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get list co-occurrences: %w", err)
+	}
+	coOccurrenceByID := make(map[uint]dto.ItemCoOccurrence, len(coOccurrences))
+	for _, co := range coOccurrences {
+		coOccurrenceByID[co.ItemID] = co
+		candidateIDs = append(candidateIDs, co.ItemID)
+	}
+
+	candidateIDs = uniqueIDs(candidateIDs, target.ItemID)
+	if len(candidateIDs) == 0 {
+		return []dto.SimilarItemDTO{}, nil
+	}
+
+	candidateFeatures, err := s.recommendationRepo.GetItemFeatures(ctx, candidateIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get candidate features: %w", err)
+	}
+
+	tagIDs := append([]uint{}, target.TagIDs...)
+	for _, f := range candidateFeatures {
+		tagIDs = append(tagIDs, f.TagIDs...)
+	}
+	frequencies, totalItems, err := s.recommendationRepo.GetTagFrequencies(ctx, uniqueIDs(tagIDs, 0))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tag frequencies: %w", err)
+	}
+	idf := tagIDF(frequencies, totalItems)
+
+	candidates := make([]similarCandidate, 0, len(candidateFeatures))
+	for _, f := range candidateFeatures {
+		if mediaType != "" && f.MediaType != string(mediaType) {
+			continue
+		}
+
+		candidate := similarCandidate{features: f}
+		score := similarTagWeight * weightedJaccard(target.TagIDs, f.TagIDs, idf)
+		if creator := normalizeCreator(target.Creator); creator != "" && creator == normalizeCreator(f.Creator) {
+			candidate.sameCreator = true
+			score += similarCreatorWeight
+		}
+		if co, ok := coOccurrenceByID[f.ItemID]; ok && members > 0 && co.Members > 0 {
+			candidate.sharedMembers = co.Shared
+			score += similarCoOccurrenceWeight * float64(co.Shared) / math.Sqrt(float64(members)*float64(co.Members))
+		}
+		if score <= 0 {
+			continue
+		}
+		candidate.score = score
+		candidates = append(candidates, candidate)
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score > candidates[j].score
+		}
+		return candidates[i].features.ItemID < candidates[j].features.ItemID
+	})
+	if len(candidates) > similarItemsMaxLimit {
+		candidates = candidates[:similarItemsMaxLimit]
+	}
+
+	ids := make([]uint, len(candidates))
+	for i, candidate := range candidates {
+		ids[i] = candidate.features.ItemID
+	}
+	items, err := s.recommendationRepo.GetItemsByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get similar items: %w", err)
+	}
+	itemsByID := make(map[uint]*models.Item, len(items))
+	for i := range items {
+		itemsByID[items[i].ID] = &items[i]
+	}
+
+	targetTags := make(map[uint]bool, len(target.TagIDs))
+	for _, tagID := range target.TagIDs {
+		targetTags[tagID] = true
+	}
+
+	similar := make([]dto.SimilarItemDTO, 0, len(candidates))
+	for _, candidate := range candidates {
+		item, ok := itemsByID[candidate.features.ItemID]
+		if !ok {
+			continue
+		}
+
+		sharedTags := []string{}
+		for _, tag := range item.Tags {
+			if targetTags[tag.ID] {
+				sharedTags = append(sharedTags, tag.Name)
+			}
+		}
+
+		similar = append(similar, dto.SimilarItemDTO{
+			Item:          dto.ItemToDTO(item),
+			Score:         candidate.score,
+			SharedTags:    sharedTags,
+			SameCreator:   candidate.sameCreator,
+			SharedMembers: candidate.sharedMembers,
+		})
+	}
+
+	return similar, nil
+}
+
+// tagIDF calcula o peso IDF de cada tag: tags raras pesam mais que tags presentes em muitos items
+func tagIDF(frequencies []dto.TagFrequency, totalItems int64) map[uint]float64 {
+	idf := make(map[uint]float64, len(frequencies))
+	for _, f := range frequencies {
+		if f.ItemCount > 0 {
+			idf[f.TagID] = math.Log(1 + float64(totalItems)/float64(f.ItemCount))
+		}
+	}
+	return idf
+}
+
+// weightedJaccard calcula Σ idf(A ∩ B) / Σ idf(A ∪ B); tags sem frequência conhecida pesam 1
+func weightedJaccard(a, b []uint, idf map[uint]float64) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	weight := func(tagID uint) float64 {
+		if w, ok := idf[tagID]; ok {
+			return w
+		}
+		return 1
+	}
+
+	inA := make(map[uint]bool, len(a))
+	var union float64
+	for _, tagID := range a {
+		if !inA[tagID] {
+			inA[tagID] = true
+			union += weight(tagID)
+		}
+	}
+
+	var intersection float64
+	seen := make(map[uint]bool, len(b))
+	for _, tagID := range b {
+		if seen[tagID] {
+			continue
+		}
+		seen[tagID] = true
+		if inA[tagID] {
+			intersection += weight(tagID)
+		} else {
+			union += weight(tagID)
+		}
+	}
+
+	if union == 0 {
+		return 0
+	}
+	return intersection / union
+}
+
+// uniqueIDs remove IDs repetidos e o ID excluído (0 = nenhum), preservando a ordem
+func uniqueIDs(ids []uint, exclude uint) []uint {
+	seen := make(map[uint]bool, len(ids))
+	unique := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		if id == exclude || seen[id] {
+			continue
+		}
+		seen[id] = true
+		unique = append(unique, id)
+	}
+	return unique
+}
@@ -0,0 +1,169 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/testutil"
+)
+
+// newSimilarItemsRepo monta um catálogo pequeno: 1 (alvo, anime), 2 (anime com as mesmas tags),
+// 3 (filme do mesmo estúdio) e 4 (jogo que só aparece nas mesmas listas)
+func newSimilarItemsRepo() *testutil.MockRecommendationRepository {
+	features := map[uint]dto.ItemFeatures{
+		1: {ItemID: 1, MediaType: "anime", Creator: "Studio Ghibli", TagIDs: []uint{10, 11}},
+		2: {ItemID: 2, MediaType: "anime", Creator: "Madhouse", TagIDs: []uint{10, 11}},
+		3: {ItemID: 3, MediaType: "movie", Creator: "studio ghibli", TagIDs: []uint{12}},
+		4: {ItemID: 4, MediaType: "game", TagIDs: []uint{13}},
+	}
+
+	return &testutil.MockRecommendationRepository{
+		GetItemFeaturesFunc: func(ctx context.Context, itemIDs []uint) ([]dto.ItemFeatures, error) {
+			var result []dto.ItemFeatures
+			for _, id := range itemIDs {
+				if f, ok := features[id]; ok {
+					result = append(result, f)
+				}
+			}
+			return result, nil
+		},
+		GetItemIDsSharingTagsFunc: func(ctx context.Context, itemID uint, limit int) ([]uint, error) {
+			return []uint{2}, nil
+		},
+		GetItemIDsByCreatorFunc: func(ctx context.Context, creator string, limit int) ([]uint, error) {
+			return []uint{1, 3}, nil
+		},
+		GetCoOccurrencesFunc: func(ctx context.Context, itemID uint, limit int) ([]dto.ItemCoOccurrence, int64, error) {
+			return []dto.ItemCoOccurrence{{ItemID: 4, Shared: 2, Members: 4}}, 4, nil
+		},
+		GetTagFrequenciesFunc: func(ctx context.Context, tagIDs []uint) ([]dto.TagFrequency, int64, error) {
+			return []dto.TagFrequency{{TagID: 10, ItemCount: 2}, {TagID: 11, ItemCount: 2}}, 4, nil
+		},
+		GetItemsByIDsFunc: func(ctx context.Context, ids []uint) ([]models.Item, error) {
+			items := make([]models.Item, 0, len(ids))
+			for _, id := range ids {
+				item := models.Item{ID: id, Type: models.MediaType(features[id].MediaType)}
+				if id == 2 {
+					item.Tags = []models.Tag{{ID: 10, Name: "psychological"}, {ID: 11, Name: "thriller"}}
+				}
+				items = append(items, item)
+			}
+			return items, nil
+		},
+	}
+}
+
+func TestGetSimilarItems_CombinesSignals(t *testing.T) {
+	service := NewRecommendationService(newSimilarItemsRepo(), &testutil.MockItemRepository{}, nil)
+
+	similar, err := service.GetSimilarItems(context.Background(), 1, dto.SimilarItemsFilter{})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(similar) != 3 {
+		t.Fatalf("Expected 3 similar items, got %d", len(similar))
+	}
+
+	byID := make(map[uint]dto.SimilarItemDTO)
+	for _, s := range similar {
+		byID[s.Item.ID] = s
+	}
+	if similar[0].Item.ID != 2 || len(byID[2].SharedTags) != 2 {
+		t.Errorf("Expected item with the same tags first with shared tags, got %+v", similar[0])
+	}
+	if !byID[3].SameCreator {
+		t.Error("Expected item 3 to be flagged as same creator")
+	}
+	if byID[4].SharedMembers != 2 || math.Abs(byID[4].Score-similarCoOccurrenceWeight*0.5) > 1e-9 {
+		t.Errorf("Expected co-occurrence score for item 4, got %+v", byID[4])
+	}
+}
+
+func TestGetSimilarItems_SameType(t *testing.T) {
+	service := NewRecommendationService(newSimilarItemsRepo(), &testutil.MockItemRepository{}, nil)
+
+	similar, err := service.GetSimilarItems(context.Background(), 1, dto.SimilarItemsFilter{SameType: true, Type: "game"})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(similar) != 1 || similar[0].Item.ID != 2 {
+		t.Errorf("Expected only the anime item, got %+v", similar)
+	}
+}
+
+func TestGetSimilarItems_CacheAndInvalidate(t *testing.T) {
+	ctx := context.Background()
+	computations := 0
+	mockRepo := newSimilarItemsRepo()
+	sharingTags := mockRepo.GetItemIDsSharingTagsFunc
+	mockRepo.GetItemIDsSharingTagsFunc = func(ctx context.Context, itemID uint, limit int) ([]uint, error) {
+		computations++
+		return sharingTags(ctx, itemID, limit)
+	}
+
+	cache := NewSimilarItemsCache(SimilarItemsCacheTTL)
+	service := NewRecommendationService(mockRepo, &testutil.MockItemRepository{}, cache)
+
+	if _, err := service.GetSimilarItems(ctx, 1, dto.SimilarItemsFilter{}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	limited, err := service.GetSimilarItems(ctx, 1, dto.SimilarItemsFilter{Limit: 1})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if computations != 1 {
+		t.Errorf("Expected second request to hit the cache, got %d computations", computations)
+	}
+	if len(limited) != 1 {
+		t.Errorf("Expected cached result to respect limit, got %d", len(limited))
+	}
+
+	// Alterar tags de um item invalida o cache
+	itemService := NewItemService(&testutil.MockItemRepository{
+		GetByIDFunc: func(ctx context.Context, id uint) (*models.Item, error) {
+			return &models.Item{ID: id}, nil
+		},
+	}, &testutil.MockTagRepository{}, nil, cache)
+	if err := itemService.AssociateTags(ctx, 2, []uint{12}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if _, err := service.GetSimilarItems(ctx, 1, dto.SimilarItemsFilter{}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if computations != 2 {
+		t.Errorf("Expected recomputation after tag change, got %d computations", computations)
+	}
+}
+
+func TestGetSimilarItems_ItemNotFound(t *testing.T) {
+	service := NewRecommendationService(&testutil.MockRecommendationRepository{}, &testutil.MockItemRepository{}, nil)
+
+	_, err := service.GetSimilarItems(context.Background(), 99, dto.SimilarItemsFilter{})
+	if !errors.Is(err, models.ErrItemNotFound) {
+		t.Errorf("Expected ErrItemNotFound, got %v", err)
+	}
+}
+
+func TestWeightedJaccard(t *testing.T) {
+	idf := map[uint]float64{1: 0.5, 2: 2}
+
+	// Compartilhar a tag rara (2) pesa mais que compartilhar a tag comum (1)
+	rare := weightedJaccard([]uint{1, 2}, []uint{2}, idf)
+	common := weightedJaccard([]uint{1, 2}, []uint{1}, idf)
+	if rare <= common {
+		t.Errorf("Expected rare tag overlap (%f) to score higher than common tag overlap (%f)", rare, common)
+	}
+	if got := weightedJaccard([]uint{1, 2}, []uint{2, 1}, idf); got != 1 {
+		t.Errorf("Expected identical tag sets to score 1, got %f", got)
+	}
+	if got := weightedJaccard(nil, []uint{1}, idf); got != 0 {
+		t.Errorf("Expected empty tag set to score 0, got %f", got)
+	}
+}
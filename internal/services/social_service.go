@@ -0,0 +1,405 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/repositories"
+	"gorm.io/gorm"
+)
+
+type SocialService struct {
+	userRepo     repositories.UserRepositoryInterface
+	followRepo   repositories.FollowRepositoryInterface
+	userItemRepo repositories.UserItemRepositoryInterface
+	activityRepo repositories.ActivityRepositoryInterface
+}
+
+// NewSocialService cria uma nova instância do serviço social (seguidores, perfis e feed)
+func NewSocialService(userRepo repositories.UserRepositoryInterface, followRepo repositories.FollowRepositoryInterface, userItemRepo repositories.UserItemRepositoryInterface, activityRepo repositories.ActivityRepositoryInterface) *SocialService {
+	return &SocialService{
+		userRepo:     userRepo,
+		followRepo:   followRepo,
+		userItemRepo: userItemRepo,
+		activityRepo: activityRepo,
+	}
+}
+
+// Follow faz o usuário seguir outro pelo username e retorna o status da relação
+// Perfis públicos são seguidos na hora; os demais recebem uma solicitação pendente de aprovação
+// Seguir de novo não tem efeito e retorna o status atual
+func (s *SocialService) Follow(ctx context.Context, followerID uint, username string) (models.FollowStatus, error) {
+	target, err := s.getUser(ctx, username)
+	if err != nil {
+		return "", err
+	}
+	if target.ID == followerID {
+		return "", models.ErrCannotFollowSelf
+	}
+
+	current, err := s.followRepo.GetStatus(ctx, followerID, target.ID)
+	if err != nil {
+		return "", fmt.Errorf("failed to check follow: %w", err)
+	}
+	if current != "" {
+		return current, nil
+	}
+
+	status := models.FollowAccepted
+	if target.ProfileVisibility != models.ProfilePublic {
+		status = models.FollowPending
+	}
+	if err := s.followRepo.Follow(ctx, followerID, target.ID, status); err != nil {
+		return "", fmt.Errorf("failed to follow user: %w", err)
+	}
+	return status, nil
+}
+
+// Unfollow faz o usuário deixar de seguir outro pelo username (ou cancela a solicitação pendente)
+func (s *SocialService) Unfollow(ctx context.Context, followerID uint, username string) error {
+	target, err := s.getUser(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	if err := s.followRepo.Unfollow(ctx, followerID, target.ID); err != nil {
+		return fmt.Errorf("failed to unfollow user: %w", err)
+	}
+	return nil
+}
+
+// GetProfile retorna o perfil de um usuário visto por viewerID (0 = anônimo)
+// Perfis não visíveis retornam apenas os dados básicos, com Restricted = true
+func (s *SocialService) GetProfile(ctx context.Context, viewerID uint, username string) (*dto.ProfileDTO, error) {
+	user, err := s.getUser(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	isFollowing, err := s.isFollowing(ctx, viewerID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	followsYou, err := s.isFollowing(ctx, user.ID, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	requested, err := s.hasPendingRequest(ctx, viewerID, user.ID, isFollowing)
+	if err != nil {
+		return nil, err
+	}
+
+	followers, err := s.followRepo.CountFollowers(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count followers: %w", err)
+	}
+	following, err := s.followRepo.CountFollowing(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count following: %w", err)
+	}
+
+	profile := &dto.ProfileDTO{
+		ID:                user.ID,
+		Username:          user.Username,
+		Name:              user.Name,
+		ProfileVisibility: string(user.ProfileVisibility),
+		FollowersCount:    followers,
+		FollowingCount:    following,
+		IsFollowing:       isFollowing,
+		FollowsYou:        followsYou,
+		FollowRequested:   requested,
+		CreatedAt:         user.CreatedAt,
+	}
+
+	if !user.CanBeViewedBy(viewerID, isFollowing) {
+		profile.Restricted = true
+		return profile, nil
+	}
+
+	aggregates, err := s.userItemRepo.GetPublicStatistics(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get statistics: %w", err)
+	}
+	profile.Stats = dto.StatsToDTO(aggregates, nil)
+
+	return profile, nil
+}
+
+// GetUserList retorna as entradas públicas da lista de um usuário
+func (s *SocialService) GetUserList(ctx context.Context, viewerID uint, username string, filter dto.UserItemFilter, params dto.PaginationParams) ([]models.UserItem, int64, error) {
+	user, err := s.getVisibleUser(ctx, viewerID, username)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	filter, err = normalizeListFilter(filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// O repositório aplica as configurações de privacidade do dono (entradas privadas, status ocultos, notas)
+	userItems, total, err := s.userItemRepo.SearchPublic(ctx, user.ID, filter, params)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get user list: %w", err)
+	}
+	return userItems, total, nil
+}
+
+// GetFollowers retorna os seguidores de um usuário
+func (s *SocialService) GetFollowers(ctx context.Context, viewerID uint, username string, params dto.PaginationParams) ([]dto.UserSummaryDTO, int64, error) {
+	user, err := s.getVisibleUser(ctx, viewerID, username)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	users, total, err := s.followRepo.GetFollowers(ctx, user.ID, params)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get followers: %w", err)
+	}
+	return userSummaries(users), total, nil
+}
+
+// GetFollowing retorna os usuários seguidos por um usuário
+func (s *SocialService) GetFollowing(ctx context.Context, viewerID uint, username string, params dto.PaginationParams) ([]dto.UserSummaryDTO, int64, error) {
+	user, err := s.getVisibleUser(ctx, viewerID, username)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	users, total, err := s.followRepo.GetFollowing(ctx, user.ID, params)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get following: %w", err)
+	}
+	return userSummaries(users), total, nil
+}
+
+// GetFollowRequests retorna as solicitações pendentes para seguir o usuário
+func (s *SocialService) GetFollowRequests(ctx context.Context, userID uint, params dto.PaginationParams) ([]dto.UserSummaryDTO, int64, error) {
+	users, total, err := s.followRepo.GetFollowRequests(ctx, userID, params)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get follow requests: %w", err)
+	}
+	return userSummaries(users), total, nil
+}
+
+// AcceptFollowRequest aprova a solicitação de username para seguir o usuário
+func (s *SocialService) AcceptFollowRequest(ctx context.Context, userID uint, username string) error {
+	follower, err := s.getUser(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	accepted, err := s.followRepo.AcceptRequest(ctx, follower.ID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to accept follow request: %w", err)
+	}
+	if !accepted {
+		return models.ErrFollowRequestNotFound
+	}
+	return nil
+}
+
+// RejectFollowRequest recusa a solicitação de username para seguir o usuário
+func (s *SocialService) RejectFollowRequest(ctx context.Context, userID uint, username string) error {
+	follower, err := s.getUser(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := s.followRepo.DeleteRequest(ctx, follower.ID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to reject follow request: %w", err)
+	}
+	if !deleted {
+		return models.ErrFollowRequestNotFound
+	}
+	return nil
+}
+
+// GetFeed retorna a atividade recente dos usuários seguidos por viewerID
+func (s *SocialService) GetFeed(ctx context.Context, viewerID uint, params dto.PaginationParams) ([]dto.FeedEventDTO, int64, error) {
+	rows, total, err := s.activityRepo.GetFeed(ctx, viewerID, params)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get feed: %w", err)
+	}
+
+	events := make([]dto.FeedEventDTO, len(rows))
+	for i, row := range rows {
+		events[i] = feedEvent(row)
+	}
+	return events, total, nil
+}
+
+// feedEvent converte uma linha do feed, traduzindo transições para in_progress em "started"
+func feedEvent(row dto.FeedEventRow) dto.FeedEventDTO {
+	kind := row.Kind
+	if models.ActivityKind(kind) == models.ActivityStatusChanged {
+		kind = dto.FeedEventStarted
+	}
+
+	return dto.FeedEventDTO{
+		ID:   row.ID,
+		Kind: kind,
+		User: dto.UserSummaryDTO{
+			ID:       row.UserID,
+			Username: row.Username,
+			Name:     row.Name,
+		},
+		Item: dto.FeedItemDTO{
+			ID:       row.ItemID,
+			Title:    row.Title,
+			Type:     row.MediaType,
+			CoverURL: row.CoverURL,
+		},
+		Status:      row.Status,
+		Rating:      row.Rating,
+		Completions: row.Completions,
+		OccurredAt:  row.OccurredAt,
+	}
+}
+
+// GetPrivacySettings retorna as configurações de privacidade do usuário
+func (s *SocialService) GetPrivacySettings(ctx context.Context, userID uint) (*dto.PrivacySettingsDTO, error) {
+	user, err := s.getUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return privacySettingsDTO(user), nil
+}
+
+// UpdatePrivacySettings altera as configurações de privacidade do usuário
+func (s *SocialService) UpdatePrivacySettings(ctx context.Context, userID uint, req dto.UpdatePrivacySettingsRequest) (*dto.PrivacySettingsDTO, error) {
+	visibility := models.ProfileVisibility(req.ProfileVisibility)
+	if !visibility.IsValid() {
+		return nil, models.ErrInvalidProfileVisibility
+	}
+
+	hidden := models.StatusList{}
+	for _, status := range req.HiddenStatuses {
+		if !models.MediaStatus(status).IsValid() {
+			return nil, models.ErrInvalidStatus
+		}
+		if !hidden.Contains(models.MediaStatus(status)) {
+			hidden = append(hidden, models.MediaStatus(status))
+		}
+	}
+
+	user, err := s.getUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	user.ProfileVisibility = visibility
+	user.HiddenStatuses = hidden
+	user.HideRatings = req.HideRatings
+	user.HideNotes = req.HideNotes
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to update privacy settings: %w", err)
+	}
+
+	// Um perfil público não exige aprovação: as solicitações pendentes passam a valer
+	if visibility == models.ProfilePublic {
+		if err := s.followRepo.AcceptAllRequests(ctx, userID); err != nil {
+			return nil, fmt.Errorf("failed to accept follow requests: %w", err)
+		}
+	}
+	return privacySettingsDTO(user), nil
+}
+
+// privacySettingsDTO extrai as configurações de privacidade do usuário
+func privacySettingsDTO(user *models.User) *dto.PrivacySettingsDTO {
+	hidden := make([]string, len(user.HiddenStatuses))
+	for i, status := range user.HiddenStatuses {
+		hidden[i] = string(status)
+	}
+
+	return &dto.PrivacySettingsDTO{
+		ProfileVisibility: string(user.ProfileVisibility),
+		HiddenStatuses:    hidden,
+		HideRatings:       user.HideRatings,
+		HideNotes:         user.HideNotes,
+	}
+}
+
+// getUser busca um usuário pelo username
+func (s *SocialService) getUser(ctx context.Context, username string) (*models.User, error) {
+	user, err := s.userRepo.GetByUsername(ctx, username)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	return user, nil
+}
+
+// getUserByID busca um usuário pelo ID
+func (s *SocialService) getUserByID(ctx context.Context, userID uint) (*models.User, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	return user, nil
+}
+
+// getVisibleUser busca um usuário cujo perfil pode ser visto por viewerID
+func (s *SocialService) getVisibleUser(ctx context.Context, viewerID uint, username string) (*models.User, error) {
+	user, err := s.getUser(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	isFollowing, err := s.isFollowing(ctx, viewerID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.CanBeViewedBy(viewerID, isFollowing) {
+		return nil, models.ErrProfileNotVisible
+	}
+	return user, nil
+}
+
+// isFollowing verifica se followerID segue followingID (anônimos e o próprio usuário não seguem)
+func (s *SocialService) isFollowing(ctx context.Context, followerID, followingID uint) (bool, error) {
+	if followerID == 0 || followingID == 0 || followerID == followingID {
+		return false, nil
+	}
+
+	following, err := s.followRepo.IsFollowing(ctx, followerID, followingID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check follow: %w", err)
+	}
+	return following, nil
+}
+
+// hasPendingRequest verifica se followerID tem uma solicitação pendente para seguir followingID
+func (s *SocialService) hasPendingRequest(ctx context.Context, followerID, followingID uint, isFollowing bool) (bool, error) {
+	if isFollowing || followerID == 0 || followingID == 0 || followerID == followingID {
+		return false, nil
+	}
+
+	status, err := s.followRepo.GetStatus(ctx, followerID, followingID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check follow request: %w", err)
+	}
+	return status == models.FollowPending, nil
+}
+
+// userSummaries converte usuários para o resumo usado nas listagens sociais
+func userSummaries(users []models.User) []dto.UserSummaryDTO {
+	summaries := make([]dto.UserSummaryDTO, len(users))
+	for i, user := range users {
+		summaries[i] = dto.UserSummaryDTO{
+			ID:       user.ID,
+			Username: user.Username,
+			Name:     user.Name,
+		}
+	}
+	return summaries
+}
@@ -0,0 +1,280 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/testutil"
+	"gorm.io/gorm"
+)
+
+func socialUserRepo(users ...models.User) *testutil.MockUserRepository {
+	return &testutil.MockUserRepository{
+		GetByUsernameFunc: func(ctx context.Context, username string) (*models.User, error) {
+			for i := range users {
+				if users[i].Username == username {
+					user := users[i]
+					return &user, nil
+				}
+			}
+			return nil, gorm.ErrRecordNotFound
+		},
+	}
+}
+
+func TestFollow_RejectsSelfAndUnknownUsers(t *testing.T) {
+	userRepo := socialUserRepo(models.User{ID: 1, Username: "alice"})
+	followRepo := &testutil.MockFollowRepository{
+		FollowFunc: func(ctx context.Context, followerID, followingID uint, status models.FollowStatus) error {
+			t.Fatalf("Follow should not be called")
+			return nil
+		},
+	}
+	service := NewSocialService(userRepo, followRepo, &testutil.MockUserItemRepository{}, &testutil.MockActivityRepository{})
+
+	if _, err := service.Follow(context.Background(), 1, "alice"); !errors.Is(err, models.ErrCannotFollowSelf) {
+		t.Errorf("Expected ErrCannotFollowSelf, got %v", err)
+	}
+	if _, err := service.Follow(context.Background(), 1, "nobody"); !errors.Is(err, models.ErrUserNotFound) {
+		t.Errorf("Expected ErrUserNotFound, got %v", err)
+	}
+}
+
+func TestFollow_RequiresApprovalForNonPublicProfiles(t *testing.T) {
+	userRepo := socialUserRepo(
+		models.User{ID: 2, Username: "bob", ProfileVisibility: models.ProfilePublic},
+		models.User{ID: 3, Username: "carol", ProfileVisibility: models.ProfileFollowers},
+		models.User{ID: 4, Username: "dave", ProfileVisibility: models.ProfilePrivate},
+		models.User{ID: 5, Username: "erin", ProfileVisibility: models.ProfileFollowers},
+	)
+	saved := map[uint]models.FollowStatus{}
+	followRepo := &testutil.MockFollowRepository{
+		GetStatusFunc: func(ctx context.Context, followerID, followingID uint) (models.FollowStatus, error) {
+			if followingID == 5 {
+				return models.FollowAccepted, nil // Já aprovado antes
+			}
+			return "", nil
+		},
+		FollowFunc: func(ctx context.Context, followerID, followingID uint, status models.FollowStatus) error {
+			saved[followingID] = status
+			return nil
+		},
+	}
+	service := NewSocialService(userRepo, followRepo, &testutil.MockUserItemRepository{}, &testutil.MockActivityRepository{})
+
+	tests := []struct {
+		username string
+		want     models.FollowStatus
+	}{
+		{"bob", models.FollowAccepted},
+		{"carol", models.FollowPending},
+		{"dave", models.FollowPending},
+		{"erin", models.FollowAccepted},
+	}
+	for _, tt := range tests {
+		status, err := service.Follow(context.Background(), 1, tt.username)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if status != tt.want {
+			t.Errorf("%s: expected status %s, got %s", tt.username, tt.want, status)
+		}
+	}
+	if len(saved) != 3 || saved[3] != models.FollowPending || saved[4] != models.FollowPending {
+		t.Errorf("Expected only new relations saved, non-public ones pending, got %v", saved)
+	}
+}
+
+func TestFollowRequests_AcceptAndReject(t *testing.T) {
+	userRepo := socialUserRepo(models.User{ID: 1, Username: "alice"}, models.User{ID: 3, Username: "carol"})
+	var accepted, rejected [2]uint
+	followRepo := &testutil.MockFollowRepository{
+		AcceptRequestFunc: func(ctx context.Context, followerID, followingID uint) (bool, error) {
+			accepted = [2]uint{followerID, followingID}
+			return followerID == 1, nil
+		},
+		DeleteRequestFunc: func(ctx context.Context, followerID, followingID uint) (bool, error) {
+			rejected = [2]uint{followerID, followingID}
+			return followerID == 1, nil
+		},
+	}
+	service := NewSocialService(userRepo, followRepo, &testutil.MockUserItemRepository{}, &testutil.MockActivityRepository{})
+
+	if err := service.AcceptFollowRequest(context.Background(), 2, "alice"); err != nil || accepted != [2]uint{1, 2} {
+		t.Errorf("Expected alice's request to user 2 accepted, got %v (%v)", accepted, err)
+	}
+	if err := service.RejectFollowRequest(context.Background(), 2, "alice"); err != nil || rejected != [2]uint{1, 2} {
+		t.Errorf("Expected alice's request to user 2 rejected, got %v (%v)", rejected, err)
+	}
+	if err := service.AcceptFollowRequest(context.Background(), 2, "carol"); !errors.Is(err, models.ErrFollowRequestNotFound) {
+		t.Errorf("Expected ErrFollowRequestNotFound, got %v", err)
+	}
+	if err := service.RejectFollowRequest(context.Background(), 2, "carol"); !errors.Is(err, models.ErrFollowRequestNotFound) {
+		t.Errorf("Expected ErrFollowRequestNotFound, got %v", err)
+	}
+}
+
+func TestGetProfile_RespectsVisibility(t *testing.T) {
+	userRepo := socialUserRepo(
+		models.User{ID: 2, Username: "bob", ProfileVisibility: models.ProfileFollowers},
+		models.User{ID: 3, Username: "carol", ProfileVisibility: models.ProfilePrivate},
+	)
+	followRepo := &testutil.MockFollowRepository{
+		IsFollowingFunc: func(ctx context.Context, followerID, followingID uint) (bool, error) {
+			return followerID == 1 && followingID == 2, nil
+		},
+		GetStatusFunc: func(ctx context.Context, followerID, followingID uint) (models.FollowStatus, error) {
+			if followerID == 1 && followingID == 3 {
+				return models.FollowPending, nil
+			}
+			return "", nil
+		},
+		CountFollowersFunc: func(ctx context.Context, userID uint) (int64, error) { return 1, nil },
+	}
+	statsCalls := 0
+	userItemRepo := &testutil.MockUserItemRepository{
+		GetPublicStatisticsFunc: func(ctx context.Context, userID uint) ([]dto.StatsAggregate, error) {
+			statsCalls++
+			return []dto.StatsAggregate{{MediaType: "anime", Status: "completed", Count: 3}}, nil
+		},
+	}
+	service := NewSocialService(userRepo, followRepo, userItemRepo, &testutil.MockActivityRepository{})
+
+	// Seguidor vê o perfil restrito a seguidores
+	profile, err := service.GetProfile(context.Background(), 1, "bob")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if profile.Restricted || !profile.IsFollowing || profile.Stats == nil || profile.Stats.Completed != 3 {
+		t.Errorf("Expected full profile for follower, got %+v", profile)
+	}
+
+	// Anônimo não vê
+	profile, err = service.GetProfile(context.Background(), 0, "bob")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !profile.Restricted || profile.Stats != nil || profile.FollowersCount != 1 {
+		t.Errorf("Expected restricted profile for anonymous viewer, got %+v", profile)
+	}
+
+	// Perfil privado só é visto pelo dono
+	if profile, _ = service.GetProfile(context.Background(), 1, "carol"); !profile.Restricted || profile.IsFollowing || !profile.FollowRequested {
+		t.Errorf("Expected private profile restricted with a pending request, got %+v", profile)
+	}
+	if profile, _ = service.GetProfile(context.Background(), 3, "carol"); profile.Restricted {
+		t.Errorf("Expected owner to see own private profile, got %+v", profile)
+	}
+
+	if statsCalls != 2 {
+		t.Errorf("Expected statistics only for visible profiles, got %d calls", statsCalls)
+	}
+}
+
+func TestGetUserList_UsesPublicSearch(t *testing.T) {
+	userRepo := socialUserRepo(
+		models.User{ID: 2, Username: "bob"},
+		models.User{ID: 3, Username: "carol", ProfileVisibility: models.ProfilePrivate},
+	)
+	var received dto.UserItemFilter
+	userItemRepo := &testutil.MockUserItemRepository{
+		SearchPublicFunc: func(ctx context.Context, userID uint, filter dto.UserItemFilter, params dto.PaginationParams) ([]models.UserItem, int64, error) {
+			received = filter
+			return []models.UserItem{}, 0, nil
+		},
+	}
+	service := NewSocialService(userRepo, &testutil.MockFollowRepository{}, userItemRepo, &testutil.MockActivityRepository{})
+
+	filter := dto.UserItemFilter{Statuses: []string{"completed"}, Query: "  frieren "}
+	if _, _, err := service.GetUserList(context.Background(), 1, "bob", filter, dto.PaginationParams{}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(received.Statuses) != 1 || received.Query != "frieren" {
+		t.Errorf("Expected normalized filter, got %+v", received)
+	}
+
+	if _, _, err := service.GetUserList(context.Background(), 1, "carol", dto.UserItemFilter{}, dto.PaginationParams{}); !errors.Is(err, models.ErrProfileNotVisible) {
+		t.Errorf("Expected ErrProfileNotVisible, got %v", err)
+	}
+}
+
+func TestGetFeed_MapsStartedEvents(t *testing.T) {
+	activityRepo := &testutil.MockActivityRepository{
+		GetFeedFunc: func(ctx context.Context, viewerID uint, params dto.PaginationParams) ([]dto.FeedEventRow, int64, error) {
+			return []dto.FeedEventRow{
+				{ID: 2, Kind: string(models.ActivityStatusChanged), Status: "in_progress", UserID: 5, Username: "bob", ItemID: 9, Title: "Frieren"},
+				{ID: 1, Kind: string(models.ActivityRated), Rating: 9, UserID: 5, Username: "bob", ItemID: 8},
+			}, 2, nil
+		},
+	}
+	service := NewSocialService(&testutil.MockUserRepository{}, &testutil.MockFollowRepository{}, &testutil.MockUserItemRepository{}, activityRepo)
+
+	events, total, err := service.GetFeed(context.Background(), 1, dto.PaginationParams{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if total != 2 || events[0].Kind != dto.FeedEventStarted || events[0].User.Username != "bob" || events[0].Item.Title != "Frieren" {
+		t.Errorf("Unexpected started event: %+v", events[0])
+	}
+	if events[1].Kind != dto.FeedEventRated || events[1].Rating != 9 {
+		t.Errorf("Unexpected rated event: %+v", events[1])
+	}
+}
+
+func TestUpdatePrivacySettings(t *testing.T) {
+	var saved *models.User
+	userRepo := &testutil.MockUserRepository{
+		GetByIDFunc: func(ctx context.Context, id uint) (*models.User, error) {
+			return &models.User{ID: id, ProfileVisibility: models.ProfilePublic}, nil
+		},
+		UpdateFunc: func(ctx context.Context, user *models.User) error {
+			saved = user
+			return nil
+		},
+	}
+	acceptedAll := 0
+	followRepo := &testutil.MockFollowRepository{
+		AcceptAllRequestsFunc: func(ctx context.Context, userID uint) error {
+			acceptedAll++
+			return nil
+		},
+	}
+	service := NewSocialService(userRepo, followRepo, &testutil.MockUserItemRepository{}, &testutil.MockActivityRepository{})
+
+	settings, err := service.UpdatePrivacySettings(context.Background(), 1, dto.UpdatePrivacySettingsRequest{
+		ProfileVisibility: "followers",
+		HiddenStatuses:    []string{"dropped", "planned", "dropped"},
+		HideRatings:       true,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if settings.ProfileVisibility != "followers" || saved.ProfileVisibility != models.ProfileFollowers {
+		t.Errorf("Expected followers visibility, got %+v", settings)
+	}
+	if len(saved.HiddenStatuses) != 2 || !saved.HiddenStatuses.Contains(models.StatusDropped) || !saved.HiddenStatuses.Contains(models.StatusPlanned) {
+		t.Errorf("Expected deduplicated hidden statuses, got %v", saved.HiddenStatuses)
+	}
+	if !settings.HideRatings || settings.HideNotes {
+		t.Errorf("Unexpected hide flags: %+v", settings)
+	}
+	if acceptedAll != 0 {
+		t.Errorf("Expected pending follow requests kept for followers-only profile")
+	}
+
+	// Tornar o perfil público aprova as solicitações pendentes
+	if _, err := service.UpdatePrivacySettings(context.Background(), 1, dto.UpdatePrivacySettingsRequest{ProfileVisibility: "public"}); err != nil || acceptedAll != 1 {
+		t.Errorf("Expected pending follow requests accepted, got %d calls (%v)", acceptedAll, err)
+	}
+
+	if _, err := service.UpdatePrivacySettings(context.Background(), 1, dto.UpdatePrivacySettingsRequest{ProfileVisibility: "public", HiddenStatuses: []string{"abandoned"}}); !errors.Is(err, models.ErrInvalidStatus) {
+		t.Errorf("Expected ErrInvalidStatus, got %v", err)
+	}
+
+	if _, err := service.UpdatePrivacySettings(context.Background(), 1, dto.UpdatePrivacySettingsRequest{ProfileVisibility: "friends"}); !errors.Is(err, models.ErrInvalidProfileVisibility) {
+		t.Errorf("Expected ErrInvalidProfileVisibility, got %v", err)
+	}
+}
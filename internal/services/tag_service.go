@@ -6,19 +6,28 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
 	"github.com/rafaelc-rb/geekery-api/internal/models"
 	"github.com/rafaelc-rb/geekery-api/internal/repositories"
 	"gorm.io/gorm"
 )
 
+// Limites de sugestões do autocomplete de tags
+const (
+	tagAutocompleteDefaultLimit = 10
+	tagAutocompleteMaxLimit     = 50
+)
+
 type TagService struct {
-	tagRepo repositories.TagRepositoryInterface
+	tagRepo      repositories.TagRepositoryInterface
+	similarCache *SimilarItemsCache // Opcional: invalidado quando tags de items são removidas ou mescladas
 }
 
 // NewTagService cria uma nova instância do serviço de tags
-func NewTagService(tagRepo repositories.TagRepositoryInterface) *TagService {
+func NewTagService(tagRepo repositories.TagRepositoryInterface, similarCache *SimilarItemsCache) *TagService {
 	return &TagService{
-		tagRepo: tagRepo,
+		tagRepo:      tagRepo,
+		similarCache: similarCache,
 	}
 }
 
@@ -38,6 +47,15 @@ func (s *TagService) CreateTag(ctx context.Context, tag *models.Tag) error {
 		return models.ErrDuplicateTag
 	}
 
+	// O nome também não pode ser alias de outra tag
+	if err := s.ensureNotAlias(ctx, tag.Name, 0); err != nil {
+		return err
+	}
+
+	if err := s.validateParent(ctx, 0, tag.ParentID); err != nil {
+		return err
+	}
+
 	// Criar tag
 	if err := s.tagRepo.Create(ctx, tag); err != nil {
 		return fmt.Errorf("failed to create tag: %w", err)
@@ -51,6 +69,128 @@ func (s *TagService) GetAllTags(ctx context.Context) ([]models.Tag, error) {
 	return s.tagRepo.GetAll(ctx)
 }
 
+// SearchTags retorna as tags paginadas com o número de items total e por tipo de mídia
+func (s *TagService) SearchTags(ctx context.Context, filter dto.TagFilter, params dto.PaginationParams) ([]dto.TagUsageDTO, int64, error) {
+	if !models.TagCategory(filter.Category).IsValid() {
+		return nil, 0, models.ErrInvalidTagCategory
+	}
+	filter.Query = strings.TrimSpace(filter.Query)
+
+	tags, total, err := s.tagRepo.Search(ctx, filter, params)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to search tags: %w", err)
+	}
+
+	ids := make([]uint, len(tags))
+	for i, tag := range tags {
+		ids[i] = tag.ID
+	}
+	counts, err := s.tagRepo.CountByMediaType(ctx, ids)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count tag usage: %w", err)
+	}
+
+	byTag := make(map[uint]map[string]int64, len(tags))
+	for _, count := range counts {
+		if byTag[count.TagID] == nil {
+			byTag[count.TagID] = make(map[string]int64)
+		}
+		byTag[count.TagID][count.MediaType] = count.Count
+	}
+
+	result := make([]dto.TagUsageDTO, 0, len(tags))
+	for _, tag := range tags {
+		perType := byTag[tag.ID]
+		if perType == nil {
+			perType = map[string]int64{}
+		}
+		result = append(result, dto.TagUsageDTO{
+			ID:                tag.ID,
+			Name:              tag.Name,
+			Category:          string(tag.Category),
+			ParentID:          tag.ParentID,
+			ItemCount:         tag.ItemCount,
+			CountsByMediaType: perType,
+			CreatedAt:         tag.CreatedAt,
+			UpdatedAt:         tag.UpdatedAt,
+		})
+	}
+
+	return result, total, nil
+}
+
+// AutocompleteTags sugere tags cujo nome ou alias começa com o prefixo
+func (s *TagService) AutocompleteTags(ctx context.Context, prefix string, limit int) ([]dto.TagSuggestionDTO, error) {
+	prefix = s.normalizeName(prefix)
+	if prefix == "" {
+		return []dto.TagSuggestionDTO{}, nil
+	}
+	if limit < 1 {
+		limit = tagAutocompleteDefaultLimit
+	}
+	if limit > tagAutocompleteMaxLimit {
+		limit = tagAutocompleteMaxLimit
+	}
+
+	tags, err := s.tagRepo.Autocomplete(ctx, prefix, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to autocomplete tags: %w", err)
+	}
+
+	result := make([]dto.TagSuggestionDTO, 0, len(tags))
+	for _, tag := range tags {
+		result = append(result, dto.TagSuggestionDTO{
+			ID:        tag.ID,
+			Name:      tag.Name,
+			Category:  string(tag.Category),
+			ItemCount: tag.ItemCount,
+		})
+	}
+	return result, nil
+}
+
+// GetTagItems retorna os items da tag (incluindo os das tags descendentes)
+func (s *TagService) GetTagItems(ctx context.Context, tagID uint, mediaType string, params dto.PaginationParams) ([]models.Item, int64, error) {
+	if mediaType != "" && !models.MediaType(mediaType).IsValid() {
+		return nil, 0, models.ErrInvalidMediaType
+	}
+
+	if _, err := s.getTag(ctx, tagID); err != nil {
+		return nil, 0, err
+	}
+
+	items, total, err := s.tagRepo.GetItems(ctx, tagID, models.MediaType(mediaType), params)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get tag items: %w", err)
+	}
+	return items, total, nil
+}
+
+// CleanupOrphans remove as tags que nenhum item usa
+// Com dryRun, apenas lista as tags que seriam removidas
+func (s *TagService) CleanupOrphans(ctx context.Context, dryRun bool) (*dto.TagCleanupResult, error) {
+	var tags []models.Tag
+	var err error
+	if dryRun {
+		tags, err = s.tagRepo.GetOrphans(ctx)
+	} else {
+		tags, err = s.tagRepo.DeleteOrphans(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to clean up orphan tags: %w", err)
+	}
+
+	result := &dto.TagCleanupResult{
+		DryRun:  dryRun,
+		Deleted: len(tags),
+		Tags:    make([]string, 0, len(tags)),
+	}
+	for _, tag := range tags {
+		result.Tags = append(result.Tags, tag.Name)
+	}
+	return result, nil
+}
+
 // GetTagByID retorna uma tag específica
 func (s *TagService) GetTagByID(ctx context.Context, id uint) (*models.Tag, error) {
 	if id == 0 {
@@ -93,10 +233,18 @@ func (s *TagService) UpdateTag(ctx context.Context, id uint, updatedTag *models.
 		if err == nil && existing != nil && existing.ID != id {
 			return models.ErrDuplicateTag
 		}
+		if err := s.ensureNotAlias(ctx, updatedTag.Name, id); err != nil {
+			return err
+		}
+	}
+
+	if err := s.validateParent(ctx, id, updatedTag.ParentID); err != nil {
+		return err
 	}
 
-	// Manter o ID original
+	// Manter o ID e a data de criação originais
 	updatedTag.ID = id
+	updatedTag.CreatedAt = existingTag.CreatedAt
 
 	// Atualizar tag
 	if err := s.tagRepo.Update(ctx, updatedTag); err != nil {
@@ -120,7 +268,91 @@ func (s *TagService) DeleteTag(ctx context.Context, id uint) error {
 		return fmt.Errorf("failed to find tag: %w", err)
 	}
 
-	return s.tagRepo.Delete(ctx, id)
+	if err := s.tagRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	s.similarCache.Invalidate()
+	return nil
+}
+
+// AddAlias adiciona um nome alternativo que passa a resolver para a tag
+func (s *TagService) AddAlias(ctx context.Context, tagID uint, name string) (*models.TagAlias, error) {
+	if _, err := s.getTag(ctx, tagID); err != nil {
+		return nil, err
+	}
+
+	name = s.normalizeName(name)
+	if name == "" {
+		return nil, models.ErrTagNameEmpty
+	}
+
+	// O alias não pode coincidir com uma tag ou com outro alias
+	if _, err := s.tagRepo.ResolveName(ctx, name); err == nil {
+		return nil, models.ErrDuplicateTag
+	}
+
+	alias := &models.TagAlias{TagID: tagID, Name: name}
+	if err := s.tagRepo.CreateAlias(ctx, alias); err != nil {
+		return nil, fmt.Errorf("failed to create tag alias: %w", err)
+	}
+	return alias, nil
+}
+
+// RemoveAlias remove um alias da tag
+func (s *TagService) RemoveAlias(ctx context.Context, tagID, aliasID uint) error {
+	if err := s.tagRepo.DeleteAlias(ctx, tagID, aliasID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.ErrTagNotFound
+		}
+		return fmt.Errorf("failed to delete tag alias: %w", err)
+	}
+	return nil
+}
+
+// MergeTags funde as tags de origem na tag destino
+// Items, filhos e aliases das origens passam para o destino e os nomes das origens viram aliases
+func (s *TagService) MergeTags(ctx context.Context, targetID uint, sourceIDs []uint) (*models.Tag, error) {
+	sources := make([]uint, 0, len(sourceIDs))
+	isSource := make(map[uint]bool, len(sourceIDs))
+	for _, id := range sourceIDs {
+		if id == targetID {
+			return nil, models.ErrInvalidTagMerge
+		}
+		if !isSource[id] {
+			isSource[id] = true
+			sources = append(sources, id)
+		}
+	}
+
+	target, err := s.getTag(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	found, err := s.tagRepo.GetTagsByIDs(ctx, sources)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tags: %w", err)
+	}
+	if len(found) != len(sources) {
+		return nil, models.ErrTagNotFound
+	}
+
+	// Se o destino descende de uma origem, sobe até o primeiro ancestral que permanece
+	for target.ParentID != nil && isSource[*target.ParentID] {
+		parent, err := s.getTag(ctx, *target.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		target.ParentID = parent.ParentID
+	}
+
+	if err := s.tagRepo.Merge(ctx, target, sources); err != nil {
+		return nil, fmt.Errorf("failed to merge tags: %w", err)
+	}
+	s.similarCache.Invalidate()
+
+	return s.tagRepo.GetByID(ctx, targetID)
 }
 
 // FindOrCreateTags busca ou cria tags pelo nome
@@ -156,9 +388,66 @@ func (s *TagService) validateTag(tag *models.Tag) error {
 		return errors.New("tag name must have at most 50 characters")
 	}
 
+	if !tag.Category.IsValid() {
+		return models.ErrInvalidTagCategory
+	}
+
 	return nil
 }
 
+// validateParent verifica se o pai existe e se não cria um ciclo na hierarquia
+// tagID é 0 para tags novas (que ainda não podem ser ancestrais de ninguém)
+func (s *TagService) validateParent(ctx context.Context, tagID uint, parentID *uint) error {
+	if parentID == nil {
+		return nil
+	}
+
+	if *parentID == tagID {
+		return models.ErrTagCycle
+	}
+
+	if _, err := s.getTag(ctx, *parentID); err != nil {
+		return err
+	}
+
+	if tagID == 0 {
+		return nil
+	}
+
+	ancestors, err := s.tagRepo.GetAncestorIDs(ctx, *parentID)
+	if err != nil {
+		return fmt.Errorf("failed to get tag ancestors: %w", err)
+	}
+	for _, ancestorID := range ancestors {
+		if ancestorID == tagID {
+			return models.ErrTagCycle
+		}
+	}
+
+	return nil
+}
+
+// ensureNotAlias verifica se o nome não é alias de outra tag (currentID é ignorada)
+func (s *TagService) ensureNotAlias(ctx context.Context, name string, currentID uint) error {
+	resolved, err := s.tagRepo.ResolveName(ctx, name)
+	if err == nil && resolved != nil && resolved.ID != currentID {
+		return models.ErrDuplicateTag
+	}
+	return nil
+}
+
+// getTag busca uma tag retornando ErrTagNotFound quando não existe
+func (s *TagService) getTag(ctx context.Context, id uint) (*models.Tag, error) {
+	tag, err := s.tagRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrTagNotFound
+		}
+		return nil, fmt.Errorf("failed to find tag: %w", err)
+	}
+	return tag, nil
+}
+
 // normalizeName normaliza o nome da tag (lowercase e trim)
 func (s *TagService) normalizeName(name string) string {
 	return strings.ToLower(strings.TrimSpace(name))
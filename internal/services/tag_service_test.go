@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
 	"github.com/rafaelc-rb/geekery-api/internal/models"
 	"github.com/rafaelc-rb/geekery-api/internal/testutil"
 	"gorm.io/gorm"
@@ -21,7 +22,7 @@ func TestCreateTag_Success(t *testing.T) {
 		},
 	}
 
-	service := NewTagService(mockRepo)
+	service := NewTagService(mockRepo, nil)
 	tag := &models.Tag{Name: "Action"}
 
 	err := service.CreateTag(ctx, tag)
@@ -37,7 +38,7 @@ func TestCreateTag_Success(t *testing.T) {
 func TestCreateTag_EmptyName(t *testing.T) {
 	ctx := context.Background()
 	mockRepo := &testutil.MockTagRepository{}
-	service := NewTagService(mockRepo)
+	service := NewTagService(mockRepo, nil)
 
 	tag := &models.Tag{Name: ""}
 
@@ -59,7 +60,7 @@ func TestCreateTag_DuplicateName(t *testing.T) {
 		},
 	}
 
-	service := NewTagService(mockRepo)
+	service := NewTagService(mockRepo, nil)
 	tag := &models.Tag{Name: "Action"} // Different case, but should be caught
 
 	err := service.CreateTag(ctx, tag)
@@ -82,7 +83,7 @@ func TestGetAllTags_Success(t *testing.T) {
 		},
 	}
 
-	service := NewTagService(mockRepo)
+	service := NewTagService(mockRepo, nil)
 	tags, err := service.GetAllTags(ctx)
 
 	if err != nil {
@@ -104,7 +105,7 @@ func TestGetTagByID_Success(t *testing.T) {
 		},
 	}
 
-	service := NewTagService(mockRepo)
+	service := NewTagService(mockRepo, nil)
 	tag, err := service.GetTagByID(ctx, 1)
 
 	if err != nil {
@@ -123,7 +124,7 @@ func TestGetTagByID_NotFound(t *testing.T) {
 		},
 	}
 
-	service := NewTagService(mockRepo)
+	service := NewTagService(mockRepo, nil)
 	_, err := service.GetTagByID(ctx, 999)
 
 	if err == nil {
@@ -148,7 +149,7 @@ func TestUpdateTag_Success(t *testing.T) {
 		},
 	}
 
-	service := NewTagService(mockRepo)
+	service := NewTagService(mockRepo, nil)
 
 	updatedTag := &models.Tag{Name: "New Name"}
 
@@ -167,7 +168,7 @@ func TestDeleteTag_Success(t *testing.T) {
 		},
 	}
 
-	service := NewTagService(mockRepo)
+	service := NewTagService(mockRepo, nil)
 	err := service.DeleteTag(ctx, 1)
 
 	if err != nil {
@@ -196,7 +197,7 @@ func TestFindOrCreateTags_AllExist(t *testing.T) {
 		},
 	}
 
-	service := NewTagService(mockRepo)
+	service := NewTagService(mockRepo, nil)
 	tagNames := []string{"Action", "Comedy"}
 
 	tags, err := service.FindOrCreateTags(ctx, tagNames)
@@ -212,7 +213,7 @@ func TestFindOrCreateTags_AllExist(t *testing.T) {
 func TestFindOrCreateTags_EmptyNames(t *testing.T) {
 	ctx := context.Background()
 	mockRepo := &testutil.MockTagRepository{}
-	service := NewTagService(mockRepo)
+	service := NewTagService(mockRepo, nil)
 
 	tagNames := []string{"", "  "}
 
@@ -238,7 +239,7 @@ func TestFindOrCreateTags_CreateNew(t *testing.T) {
 		},
 	}
 
-	service := NewTagService(mockRepo)
+	service := NewTagService(mockRepo, nil)
 	tagNames := []string{"NewTag1", "NewTag2"}
 
 	tags, err := service.FindOrCreateTags(ctx, tagNames)
@@ -253,3 +254,188 @@ func TestFindOrCreateTags_CreateNew(t *testing.T) {
 		t.Errorf("Expected FindOrCreate to be called 2 times, got %d", callCount)
 	}
 }
+
+func TestUpdateTag_ParentCycle(t *testing.T) {
+	ctx := context.Background()
+	parentID := uint(3)
+	mockRepo := &testutil.MockTagRepository{
+		GetByIDFunc: func(ctx context.Context, id uint) (*models.Tag, error) {
+			tag := &models.Tag{Name: "tag"}
+			tag.ID = id
+			return tag, nil
+		},
+		// 3 descende de 1: tornar 3 pai de 1 criaria um ciclo
+		GetAncestorIDsFunc: func(ctx context.Context, id uint) ([]uint, error) {
+			return []uint{2, 1}, nil
+		},
+	}
+
+	service := NewTagService(mockRepo, nil)
+	err := service.UpdateTag(ctx, 1, &models.Tag{Name: "tag", ParentID: &parentID})
+
+	if err != models.ErrTagCycle {
+		t.Errorf("Expected ErrTagCycle, got %v", err)
+	}
+}
+
+func TestCreateTag_InvalidCategory(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := &testutil.MockTagRepository{
+		GetByNameFunc: func(ctx context.Context, name string) (*models.Tag, error) {
+			return nil, gorm.ErrRecordNotFound
+		},
+	}
+
+	service := NewTagService(mockRepo, nil)
+	err := service.CreateTag(ctx, &models.Tag{Name: "isekai", Category: "subgenre"})
+
+	if err != models.ErrInvalidTagCategory {
+		t.Errorf("Expected ErrInvalidTagCategory, got %v", err)
+	}
+}
+
+func TestAddAlias_ConflictsWithExistingName(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := &testutil.MockTagRepository{
+		ResolveNameFunc: func(ctx context.Context, name string) (*models.Tag, error) {
+			return &models.Tag{Name: name}, nil
+		},
+		CreateAliasFunc: func(ctx context.Context, alias *models.TagAlias) error {
+			t.Error("CreateAlias should not be called for a name in use")
+			return nil
+		},
+	}
+
+	service := NewTagService(mockRepo, nil)
+	_, err := service.AddAlias(ctx, 1, " SciFi ")
+
+	if err != models.ErrDuplicateTag {
+		t.Errorf("Expected ErrDuplicateTag, got %v", err)
+	}
+}
+
+func TestMergeTags_TargetDescendsFromSource(t *testing.T) {
+	ctx := context.Background()
+	grandparentID := uint(1)
+	parentID := uint(2)
+	tags := map[uint]*models.Tag{
+		1: {Name: "fiction"},
+		2: {Name: "science fiction", ParentID: &grandparentID},
+		3: {Name: "sci-fi", ParentID: &parentID},
+	}
+	for id, tag := range tags {
+		tag.ID = id
+	}
+
+	var mergedTarget *models.Tag
+	var mergedSources []uint
+	mockRepo := &testutil.MockTagRepository{
+		GetByIDFunc: func(ctx context.Context, id uint) (*models.Tag, error) {
+			tag := *tags[id]
+			return &tag, nil
+		},
+		GetTagsByIDsFunc: func(ctx context.Context, ids []uint) ([]models.Tag, error) {
+			result := make([]models.Tag, 0, len(ids))
+			for _, id := range ids {
+				result = append(result, *tags[id])
+			}
+			return result, nil
+		},
+		MergeFunc: func(ctx context.Context, target *models.Tag, sourceIDs []uint) error {
+			mergedTarget = target
+			mergedSources = sourceIDs
+			return nil
+		},
+	}
+
+	service := NewTagService(mockRepo, nil)
+	_, err := service.MergeTags(ctx, 3, []uint{2, 2})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(mergedSources) != 1 || mergedSources[0] != 2 {
+		t.Errorf("Expected deduplicated sources [2], got %v", mergedSources)
+	}
+	if mergedTarget.ParentID == nil || *mergedTarget.ParentID != 1 {
+		t.Errorf("Expected target to move under the source's parent, got %v", mergedTarget.ParentID)
+	}
+}
+
+func TestMergeTags_IntoItself(t *testing.T) {
+	service := NewTagService(&testutil.MockTagRepository{}, nil)
+
+	_, err := service.MergeTags(context.Background(), 1, []uint{2, 1})
+
+	if err != models.ErrInvalidTagMerge {
+		t.Errorf("Expected ErrInvalidTagMerge, got %v", err)
+	}
+}
+
+func TestSearchTags_CountsByMediaType(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := &testutil.MockTagRepository{
+		SearchFunc: func(ctx context.Context, filter dto.TagFilter, params dto.PaginationParams) ([]models.Tag, int64, error) {
+			return []models.Tag{
+				{ID: 1, Name: "action", ItemCount: 3},
+				{ID: 2, Name: "unused"},
+			}, 2, nil
+		},
+		CountByMediaTypeFunc: func(ctx context.Context, tagIDs []uint) ([]dto.TagMediaTypeCount, error) {
+			return []dto.TagMediaTypeCount{
+				{TagID: 1, MediaType: "anime", Count: 2},
+				{TagID: 1, MediaType: "movie", Count: 1},
+			}, nil
+		},
+	}
+
+	service := NewTagService(mockRepo, nil)
+	tags, total, err := service.SearchTags(ctx, dto.TagFilter{SortBy: dto.TagSortUsage}, dto.PaginationParams{})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if total != 2 || len(tags) != 2 {
+		t.Fatalf("Expected 2 tags, got %d (total %d)", len(tags), total)
+	}
+	if tags[0].ItemCount != 3 || tags[0].CountsByMediaType["anime"] != 2 || tags[0].CountsByMediaType["movie"] != 1 {
+		t.Errorf("Unexpected counts for action: %+v", tags[0])
+	}
+	if tags[1].CountsByMediaType == nil || len(tags[1].CountsByMediaType) != 0 {
+		t.Errorf("Expected empty counts map for unused tag, got %v", tags[1].CountsByMediaType)
+	}
+}
+
+func TestAutocompleteTags_ClampsLimit(t *testing.T) {
+	ctx := context.Background()
+	var gotPrefix string
+	var gotLimit int
+	mockRepo := &testutil.MockTagRepository{
+		AutocompleteFunc: func(ctx context.Context, prefix string, limit int) ([]models.Tag, error) {
+			gotPrefix, gotLimit = prefix, limit
+			return []models.Tag{{ID: 1, Name: "action"}}, nil
+		},
+	}
+
+	service := NewTagService(mockRepo, nil)
+	suggestions, err := service.AutocompleteTags(ctx, "  ACT ", 500)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if gotPrefix != "act" || gotLimit != tagAutocompleteMaxLimit {
+		t.Errorf("Expected prefix 'act' and limit %d, got %q and %d", tagAutocompleteMaxLimit, gotPrefix, gotLimit)
+	}
+	if len(suggestions) != 1 {
+		t.Errorf("Expected 1 suggestion, got %d", len(suggestions))
+	}
+}
+
+func TestGetTagItems_InvalidMediaType(t *testing.T) {
+	service := NewTagService(&testutil.MockTagRepository{}, nil)
+	_, _, err := service.GetTagItems(context.Background(), 1, "podcast", dto.PaginationParams{})
+
+	if err != models.ErrInvalidMediaType {
+		t.Errorf("Expected ErrInvalidMediaType, got %v", err)
+	}
+}
@@ -0,0 +1,188 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/repositories"
+	"gorm.io/gorm"
+)
+
+// errBulkRollback sinaliza que um lote all-or-nothing deve sofrer rollback
+var errBulkRollback = errors.New("bulk operation rolled back")
+
+// BulkOperations executa um lote de operações na lista do usuário em uma única transação
+// No modo all-or-nothing, a primeira falha interrompe o lote e desfaz tudo
+// Caso contrário, cada operação roda em um savepoint e as falhas são reportadas individualmente
+func (s *UserItemService) BulkOperations(ctx context.Context, userID uint, req dto.BulkOperationsRequest) (*dto.BulkResult, error) {
+	result := &dto.BulkResult{
+		AllOrNothing: req.AllOrNothing,
+		Total:        len(req.Operations),
+		Results:      make([]dto.BulkOperationResult, 0, len(req.Operations)),
+	}
+
+	err := s.uow.Do(ctx, func(tx *repositories.Repositories) error {
+		for i, op := range req.Operations {
+			opResult := dto.BulkOperationResult{
+				Index:      i,
+				Op:         op.Op,
+				ItemID:     op.ItemID,
+				UserItemID: op.ID,
+			}
+
+			var opErr error
+			if req.AllOrNothing {
+				opErr = s.applyBulkOperation(ctx, tx, userID, op, &opResult)
+			} else {
+				// Savepoint: um erro de banco não invalida as demais operações
+				opErr = tx.UnitOfWork.Do(ctx, func(sp *repositories.Repositories) error {
+					return s.applyBulkOperation(ctx, sp, userID, op, &opResult)
+				})
+			}
+
+			if opErr != nil {
+				opResult.Error = opErr.Error()
+				result.Failed++
+			} else {
+				opResult.Success = true
+				result.Succeeded++
+			}
+			result.Results = append(result.Results, opResult)
+
+			if opErr != nil && req.AllOrNothing {
+				return errBulkRollback
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		if !errors.Is(err, errBulkRollback) {
+			return nil, fmt.Errorf("bulk operation failed: %w", err)
+		}
+
+		// Nenhuma operação foi persistida
+		for i := range result.Results {
+			if result.Results[i].Success {
+				result.Results[i].Success = false
+				result.Results[i].Error = "rolled back"
+			}
+		}
+		result.RolledBack = true
+		result.Succeeded = 0
+	}
+
+	// Agregados da comunidade dos items alterados (após o commit)
+	if !result.RolledBack {
+		itemIDs := make([]uint, 0, len(result.Results))
+		for _, opResult := range result.Results {
+			if opResult.Success && opResult.ItemID != 0 && bulkOpAffectsStats(opResult.Op) {
+				itemIDs = append(itemIDs, opResult.ItemID)
+			}
+		}
+		refreshItemStats(ctx, s.statsRepo, itemIDs...)
+	}
+
+	result.Success = result.Failed == 0
+	return result, nil
+}
+
+// applyBulkOperation aplica uma operação do lote usando os repositórios transacionais
+func (s *UserItemService) applyBulkOperation(ctx context.Context, tx *repositories.Repositories, userID uint, op dto.BulkOperation, opResult *dto.BulkOperationResult) error {
+	if op.Op == dto.BulkOpAdd {
+		if op.ItemID == 0 {
+			return models.ErrItemIDRequired
+		}
+
+		status := models.MediaStatus(op.Status)
+		if status == "" {
+			status = models.StatusPlanned
+		}
+		if !status.IsValid() {
+			return models.ErrInvalidStatus
+		}
+
+		userItem, err := s.addToList(ctx, tx.UserItems, tx.Items, userID, op.ItemID, status)
+		if err != nil {
+			return err
+		}
+		opResult.UserItemID = userItem.ID
+		return createBulkActivity(ctx, tx, addedEvents(userItem, time.Now()))
+	}
+
+	userItem, err := findBulkTarget(ctx, tx.UserItems, userID, op)
+	if err != nil {
+		return err
+	}
+	opResult.UserItemID = userItem.ID
+	opResult.ItemID = userItem.ItemID
+
+	switch op.Op {
+	case dto.BulkOpUpdateStatus:
+		before := snapshotUserItem(userItem)
+		if err := applyStatusChange(userItem, models.MediaStatus(op.Status)); err != nil {
+			return err
+		}
+		if err := tx.UserItems.Update(ctx, userItem); err != nil {
+			return err
+		}
+		return createBulkActivity(ctx, tx, activityEvents(before, userItem, time.Now()))
+
+	case dto.BulkOpSetFavorite:
+		if op.Favorite == nil {
+			return errors.New("favorite is required")
+		}
+		userItem.Favorite = *op.Favorite
+		return tx.UserItems.Update(ctx, userItem)
+
+	case dto.BulkOpDelete:
+		return tx.UserItems.Delete(ctx, userItem.ID)
+
+	case dto.BulkOpSetPersonal:
+		return setPersonalTags(ctx, tx.PersonalTags, userItem, op.PersonalTagIDs)
+	}
+
+	return fmt.Errorf("unsupported operation: %s", op.Op)
+}
+
+// bulkOpAffectsStats indica se a operação altera os agregados da comunidade do item
+func bulkOpAffectsStats(op string) bool {
+	switch op {
+	case dto.BulkOpAdd, dto.BulkOpUpdateStatus, dto.BulkOpSetFavorite, dto.BulkOpDelete:
+		return true
+	}
+	return false
+}
+
+// createBulkActivity registra a atividade na mesma transação do lote
+// Diferente de recordActivity, a falha desfaz a operação (o savepoint já estaria inválido)
+func createBulkActivity(ctx context.Context, tx *repositories.Repositories, events []models.ActivityEvent) error {
+	if tx.Activities == nil {
+		return nil
+	}
+	return tx.Activities.Create(ctx, events)
+}
+
+// findBulkTarget localiza a entrada da lista referenciada por id ou item_id
+func findBulkTarget(ctx context.Context, userItemRepo repositories.UserItemRepositoryInterface, userID uint, op dto.BulkOperation) (*models.UserItem, error) {
+	if op.ID != 0 {
+		return userItemRepo.GetByIDAndUser(ctx, op.ID, userID)
+	}
+
+	if op.ItemID == 0 {
+		return nil, errors.New("id or item_id is required")
+	}
+
+	userItem, err := userItemRepo.GetByUserAndItem(ctx, userID, op.ItemID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("item not found in user's list")
+		}
+		return nil, err
+	}
+	return userItem, nil
+}
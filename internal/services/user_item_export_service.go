@@ -0,0 +1,571 @@
+package services
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+)
+
+// ExportList escreve a lista do usuário em w no formato pedido, lendo em lotes
+// json e csv são portáteis e incluem tudo (progresso, history, notas e tags pessoais)
+// mal-xml e anilist incluem apenas anime e mangá (comic/novel); o mal-xml só inclui items com ID do MAL,
+// que o import do MyAnimeList exige. Ambos podem ser importados de volta em /my-list/import/{source}
+func (s *UserItemService) ExportList(ctx context.Context, w io.Writer, userID uint, mediaType models.MediaType, format string) error {
+	if mediaType != "" && !mediaType.IsValid() {
+		return models.ErrInvalidMediaType
+	}
+
+	writer, err := newListExportWriter(w, format, mediaType)
+	if err != nil {
+		return err
+	}
+
+	var afterID uint
+	for {
+		userItems, err := s.userItemRepo.ListAfterID(ctx, userID, mediaType, afterID, exportBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list user items: %w", err)
+		}
+
+		for i := range userItems {
+			// Items removidos do catálogo não têm como ser identificados no arquivo
+			if userItems[i].Item.ID == 0 {
+				continue
+			}
+			if err := writer.write(&userItems[i]); err != nil {
+				return err
+			}
+		}
+		if err := writer.flush(); err != nil {
+			return err
+		}
+
+		if len(userItems) < exportBatchSize {
+			return writer.close()
+		}
+		afterID = userItems[len(userItems)-1].ID
+	}
+}
+
+// listExportWriter escreve entradas da lista em um formato de arquivo
+type listExportWriter interface {
+	write(userItem *models.UserItem) error
+	flush() error
+	close() error
+}
+
+func newListExportWriter(w io.Writer, format string, mediaType models.MediaType) (listExportWriter, error) {
+	switch format {
+	case "", dto.ListExportFormatJSON:
+		return &listJSONExportWriter{json: &jsonExportWriter{writer: bufio.NewWriter(w)}}, nil
+	case dto.ListExportFormatCSV:
+		return newListCSVExportWriter(w)
+	case dto.ListExportFormatMALXML:
+		return newMALExportWriter(w, mediaType)
+	case dto.ListExportFormatAniList:
+		return newAniListExportWriter(w)
+	default:
+		return nil, fmt.Errorf("unsupported export format: %s", format)
+	}
+}
+
+// ========================================
+// JSON e CSV (portáteis)
+// ========================================
+
+// listJSONExportWriter escreve um array JSON de dto.UserItemFileRecord
+type listJSONExportWriter struct {
+	json *jsonExportWriter
+}
+
+func (e *listJSONExportWriter) write(userItem *models.UserItem) error {
+	data, err := json.Marshal(userItemToFileRecord(userItem))
+	if err != nil {
+		return fmt.Errorf("failed to encode list item %d: %w", userItem.ID, err)
+	}
+	return e.json.writeRecord(data)
+}
+
+func (e *listJSONExportWriter) flush() error {
+	return e.json.flush()
+}
+
+func (e *listJSONExportWriter) close() error {
+	return e.json.close()
+}
+
+// listExportHeaders são as colunas do CSV da lista; progress e views são JSON, como no export JSON
+var listExportHeaders = []string{
+	"type", "title", "release_date", "external_metadata",
+	"status", "rating", "favorite", "private",
+	"progress_type", "progress", "completion_count", "started_at", "finished_at", "views",
+	"personal_tags", "notes", "added_at", "updated_at",
+}
+
+type listCSVExportWriter struct {
+	writer *csv.Writer
+}
+
+func newListCSVExportWriter(w io.Writer) (*listCSVExportWriter, error) {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(listExportHeaders); err != nil {
+		return nil, fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return &listCSVExportWriter{writer: writer}, nil
+}
+
+func (e *listCSVExportWriter) write(userItem *models.UserItem) error {
+	record := userItemToFileRecord(userItem)
+
+	progress, views := "", ""
+	if record.Progress != nil {
+		data, err := json.Marshal(record.Progress)
+		if err != nil {
+			return fmt.Errorf("failed to encode list item %d: %w", userItem.ID, err)
+		}
+		progress = string(data)
+	}
+	if record.Views != nil {
+		data, err := json.Marshal(record.Views)
+		if err != nil {
+			return fmt.Errorf("failed to encode list item %d: %w", userItem.ID, err)
+		}
+		views = string(data)
+	}
+	startedAt, finishedAt := latestViewDates(userItem)
+
+	row := []string{
+		record.Type,
+		record.Title,
+		record.ReleaseDate,
+		formatExternalMetadata(userItem.Item.ExternalMetadata),
+		record.Status,
+		strconv.FormatFloat(record.Rating, 'f', -1, 64),
+		strconv.FormatBool(record.Favorite),
+		strconv.FormatBool(record.Private),
+		record.ProgressType,
+		progress,
+		strconv.Itoa(record.CompletionCount),
+		formatExportTime(startedAt),
+		formatExportTime(finishedAt),
+		views,
+		strings.Join(record.PersonalTags, "|"),
+		record.Notes,
+		record.AddedAt.Format(time.RFC3339),
+		record.UpdatedAt.Format(time.RFC3339),
+	}
+
+	if err := e.writer.Write(row); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return nil
+}
+
+func (e *listCSVExportWriter) flush() error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+func (e *listCSVExportWriter) close() error {
+	return e.flush()
+}
+
+// userItemToFileRecord converte a entrada da lista para o formato portátil
+func userItemToFileRecord(userItem *models.UserItem) dto.UserItemFileRecord {
+	item := &userItem.Item
+	record := dto.UserItemFileRecord{
+		Type:            string(item.Type),
+		Title:           item.Title,
+		ReleaseDate:     exportReleaseDate(item),
+		Status:          string(userItem.Status),
+		Rating:          userItem.Rating,
+		Favorite:        userItem.Favorite,
+		Private:         userItem.Private,
+		ProgressType:    string(userItem.ProgressType),
+		CompletionCount: userItem.CompletionCount,
+		Notes:           userItem.Notes,
+		AddedAt:         userItem.CreatedAt,
+		UpdatedAt:       userItem.UpdatedAt,
+	}
+
+	if len(item.ExternalMetadata) > 0 {
+		record.ExternalMetadata = item.ExternalMetadata
+	}
+
+	for key, value := range userItem.ProgressData {
+		if key == "history" {
+			continue
+		}
+		if record.Progress == nil {
+			record.Progress = make(map[string]interface{})
+		}
+		record.Progress[key] = value
+	}
+
+	for _, view := range userItem.GetViewPeriods() {
+		record.Views = append(record.Views, dto.UserItemViewRecord{StartedAt: view.StartedAt, FinishedAt: view.FinishedAt})
+	}
+
+	record.PersonalTags = personalTagNames(userItem)
+
+	return record
+}
+
+// latestViewDates retorna o início e o término mais recentes do history (nil quando ausentes)
+// MAL e AniList guardam apenas um par de datas por entrada
+func latestViewDates(userItem *models.UserItem) (startedAt, finishedAt *time.Time) {
+	for _, view := range userItem.GetViewPeriods() {
+		if view.StartedAt != nil {
+			startedAt = view.StartedAt
+		}
+		if view.FinishedAt != nil {
+			finishedAt = view.FinishedAt
+		}
+	}
+	return startedAt, finishedAt
+}
+
+func formatExportTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// rewatchCount converte CompletionCount no número de revisitas usado pelo MAL e pelo AniList
+// (a primeira conclusão não conta), o inverso da conversão feita no import
+func rewatchCount(userItem *models.UserItem) int {
+	if userItem.Status == models.StatusCompleted && userItem.CompletionCount > 0 {
+		return userItem.CompletionCount - 1
+	}
+	return userItem.CompletionCount
+}
+
+// listExportProgress retorna episódios (ou capítulos) e volumes consumidos
+// Items completos sem progresso registrado usam os totais do catálogo
+func listExportProgress(userItem *models.UserItem) (units, volumes int) {
+	item := &userItem.Item
+
+	switch item.Type {
+	case models.MediaTypeAnime:
+		units = userItem.GetProgressInt("episode")
+		if units == 0 && userItem.Status == models.StatusCompleted && item.AnimeData != nil {
+			units = item.AnimeData.Episodes
+		}
+	case models.MediaTypeComic, models.MediaTypeNovel:
+		units = userItem.GetProgressInt("chapter")
+		volumes = userItem.GetProgressInt("volume")
+		if userItem.Status == models.StatusCompleted && item.BookData != nil {
+			if units == 0 {
+				units = item.BookData.Chapters
+			}
+			if volumes == 0 {
+				volumes = item.BookData.Volumes
+			}
+		}
+	}
+
+	return units, volumes
+}
+
+// ========================================
+// MyAnimeList (XML)
+// ========================================
+
+// malText é escrito como CDATA, como no export do próprio MAL
+type malText struct {
+	Value string `xml:",cdata"`
+}
+
+type malAnimeExport struct {
+	ID              string  `xml:"series_animedb_id"`
+	Title           malText `xml:"series_title"`
+	Episodes        int     `xml:"series_episodes"`
+	MyID            int     `xml:"my_id"`
+	WatchedEpisodes int     `xml:"my_watched_episodes"`
+	StartDate       string  `xml:"my_start_date"`
+	FinishDate      string  `xml:"my_finish_date"`
+	Score           int     `xml:"my_score"`
+	Status          string  `xml:"my_status"`
+	Comments        malText `xml:"my_comments"`
+	TimesWatched    int     `xml:"my_times_watched"`
+	Rewatching      int     `xml:"my_rewatching"`
+	Tags            malText `xml:"my_tags"`
+	UpdateOnImport  int     `xml:"update_on_import"`
+}
+
+type malMangaExport struct {
+	ID             string  `xml:"manga_mangadb_id"`
+	Title          malText `xml:"manga_title"`
+	Volumes        int     `xml:"manga_volumes"`
+	Chapters       int     `xml:"manga_chapters"`
+	MyID           int     `xml:"my_id"`
+	ReadVolumes    int     `xml:"my_read_volumes"`
+	ReadChapters   int     `xml:"my_read_chapters"`
+	StartDate      string  `xml:"my_start_date"`
+	FinishDate     string  `xml:"my_finish_date"`
+	Score          int     `xml:"my_score"`
+	Status         string  `xml:"my_status"`
+	Comments       malText `xml:"my_comments"`
+	TimesRead      int     `xml:"my_times_read"`
+	Rereading      int     `xml:"my_rereading"`
+	Tags           malText `xml:"my_tags"`
+	UpdateOnImport int     `xml:"update_on_import"`
+}
+
+// malExportWriter escreve o XML do export do MAL (<myanimelist> com <anime> e <manga>)
+type malExportWriter struct {
+	writer  *bufio.Writer
+	encoder *xml.Encoder
+}
+
+func newMALExportWriter(w io.Writer, mediaType models.MediaType) (*malExportWriter, error) {
+	writer := bufio.NewWriter(w)
+
+	// user_export_type (1 = anime, 2 = mangá) só é conhecido quando o export é filtrado por tipo
+	myInfo := ""
+	switch mediaType {
+	case models.MediaTypeAnime:
+		myInfo = "\t<myinfo>\n\t\t<user_export_type>1</user_export_type>\n\t</myinfo>\n"
+	case models.MediaTypeComic, models.MediaTypeNovel:
+		myInfo = "\t<myinfo>\n\t\t<user_export_type>2</user_export_type>\n\t</myinfo>\n"
+	}
+	if _, err := writer.WriteString(xml.Header + "<myanimelist>\n" + myInfo); err != nil {
+		return nil, err
+	}
+
+	encoder := xml.NewEncoder(writer)
+	encoder.Indent("\t", "\t")
+	return &malExportWriter{writer: writer, encoder: encoder}, nil
+}
+
+func (e *malExportWriter) write(userItem *models.UserItem) error {
+	item := &userItem.Item
+	malID := exportScalar(item.ExternalMetadata["mal"])
+	if malID == "" {
+		return nil
+	}
+
+	startedAt, finishedAt := latestViewDates(userItem)
+	units, volumes := listExportProgress(userItem)
+	score := int(math.Round(userItem.Rating))
+	tags := strings.Join(personalTagNames(userItem), ", ")
+	repeating := 0
+	if userItem.IsRewatching() {
+		repeating = 1
+	}
+
+	var entry interface{}
+	var name string
+	switch item.Type {
+	case models.MediaTypeAnime:
+		anime := malAnimeExport{
+			ID:              malID,
+			Title:           malText{item.Title},
+			WatchedEpisodes: units,
+			StartDate:       malDate(startedAt),
+			FinishDate:      malDate(finishedAt),
+			Score:           score,
+			Status:          malExportStatus(userItem.Status, "Watching", "Plan to Watch"),
+			Comments:        malText{userItem.Notes},
+			TimesWatched:    rewatchCount(userItem),
+			Rewatching:      repeating,
+			Tags:            malText{tags},
+			UpdateOnImport:  1,
+		}
+		if item.AnimeData != nil {
+			anime.Episodes = item.AnimeData.Episodes
+		}
+		entry, name = anime, "anime"
+	case models.MediaTypeComic, models.MediaTypeNovel:
+		manga := malMangaExport{
+			ID:             malID,
+			Title:          malText{item.Title},
+			ReadVolumes:    volumes,
+			ReadChapters:   units,
+			StartDate:      malDate(startedAt),
+			FinishDate:     malDate(finishedAt),
+			Score:          score,
+			Status:         malExportStatus(userItem.Status, "Reading", "Plan to Read"),
+			Comments:       malText{userItem.Notes},
+			TimesRead:      rewatchCount(userItem),
+			Rereading:      repeating,
+			Tags:           malText{tags},
+			UpdateOnImport: 1,
+		}
+		if item.BookData != nil {
+			manga.Volumes = item.BookData.Volumes
+			manga.Chapters = item.BookData.Chapters
+		}
+		entry, name = manga, "manga"
+	default:
+		return nil
+	}
+
+	if err := e.encoder.EncodeElement(entry, xml.StartElement{Name: xml.Name{Local: name}}); err != nil {
+		return fmt.Errorf("failed to encode list item %d: %w", userItem.ID, err)
+	}
+	return nil
+}
+
+func (e *malExportWriter) flush() error {
+	if err := e.encoder.Flush(); err != nil {
+		return err
+	}
+	return e.writer.Flush()
+}
+
+func (e *malExportWriter) close() error {
+	if err := e.encoder.Flush(); err != nil {
+		return err
+	}
+	if _, err := e.writer.WriteString("\n</myanimelist>\n"); err != nil {
+		return err
+	}
+	return e.writer.Flush()
+}
+
+// malExportStatus converte o status para o texto do MAL (os status em andamento e planejado mudam entre anime e mangá)
+func malExportStatus(status models.MediaStatus, inProgress, planned string) string {
+	switch status {
+	case models.StatusInProgress:
+		return inProgress
+	case models.StatusCompleted:
+		return "Completed"
+	case models.StatusPaused:
+		return "On-Hold"
+	case models.StatusDropped:
+		return "Dropped"
+	default:
+		return planned
+	}
+}
+
+// malDate formata a data no padrão do MAL (0000-00-00 quando ausente)
+func malDate(t *time.Time) string {
+	if t == nil {
+		return "0000-00-00"
+	}
+	return t.Format("2006-01-02")
+}
+
+func personalTagNames(userItem *models.UserItem) []string {
+	names := make([]string, 0, len(userItem.PersonalTags))
+	for _, tag := range userItem.PersonalTags {
+		names = append(names, tag.Name)
+	}
+	return names
+}
+
+// ========================================
+// AniList (JSON)
+// ========================================
+
+// aniListExportWriter escreve uma MediaListCollection com uma única lista (cada entrada traz o próprio status)
+// As notas usam a escala POINT_10_DECIMAL, a mesma da lista
+type aniListExportWriter struct {
+	writer *bufio.Writer
+	count  int
+}
+
+func newAniListExportWriter(w io.Writer) (*aniListExportWriter, error) {
+	writer := bufio.NewWriter(w)
+	header := `{"data":{"MediaListCollection":{"user":{"mediaListOptions":{"scoreFormat":"POINT_10_DECIMAL"}},"lists":[{"name":"Geekery","isCustomList":false,"entries":[`
+	if _, err := writer.WriteString(header); err != nil {
+		return nil, err
+	}
+	return &aniListExportWriter{writer: writer}, nil
+}
+
+func (e *aniListExportWriter) write(userItem *models.UserItem) error {
+	item := &userItem.Item
+
+	var entry aniListEntry
+	switch item.Type {
+	case models.MediaTypeAnime:
+		entry.Media.Type = "ANIME"
+	case models.MediaTypeComic:
+		entry.Media.Type = "MANGA"
+		entry.Media.Format = "MANGA"
+	case models.MediaTypeNovel:
+		entry.Media.Type = "MANGA"
+		entry.Media.Format = "NOVEL"
+	default:
+		return nil
+	}
+
+	startedAt, finishedAt := latestViewDates(userItem)
+	entry.Status = aniListExportStatus(userItem)
+	entry.Score = userItem.Rating
+	entry.Progress, entry.ProgressVolumes = listExportProgress(userItem)
+	entry.Repeat = rewatchCount(userItem)
+	entry.Notes = userItem.Notes
+	entry.StartedAt = newAniListDate(startedAt)
+	entry.CompletedAt = newAniListDate(finishedAt)
+	entry.Media.ID, _ = strconv.Atoi(exportScalar(item.ExternalMetadata["anilist"]))
+	entry.Media.IDMal, _ = strconv.Atoi(exportScalar(item.ExternalMetadata["mal"]))
+	entry.Media.Title.English = item.Title
+	entry.Media.StartDate = newAniListDate(item.ReleaseDate)
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode list item %d: %w", userItem.ID, err)
+	}
+
+	if e.count > 0 {
+		if err := e.writer.WriteByte(','); err != nil {
+			return err
+		}
+	}
+	e.count++
+	_, err = e.writer.Write(data)
+	return err
+}
+
+func (e *aniListExportWriter) flush() error {
+	return e.writer.Flush()
+}
+
+func (e *aniListExportWriter) close() error {
+	if _, err := e.writer.WriteString("]}]}}}\n"); err != nil {
+		return err
+	}
+	return e.writer.Flush()
+}
+
+// aniListExportStatus é o inverso de aniListStatus (em andamento com conclusões anteriores é REPEATING)
+func aniListExportStatus(userItem *models.UserItem) string {
+	switch userItem.Status {
+	case models.StatusInProgress:
+		if userItem.IsRewatching() {
+			return "REPEATING"
+		}
+		return "CURRENT"
+	case models.StatusCompleted:
+		return "COMPLETED"
+	case models.StatusPaused:
+		return "PAUSED"
+	case models.StatusDropped:
+		return "DROPPED"
+	default:
+		return "PLANNING"
+	}
+}
+
+func newAniListDate(t *time.Time) aniListDate {
+	if t == nil {
+		return aniListDate{}
+	}
+	return aniListDate{Year: t.Year(), Month: int(t.Month()), Day: t.Day()}
+}
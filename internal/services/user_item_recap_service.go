@@ -0,0 +1,238 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/rafaelc-rb/geekery-api/internal/dto"
+	"github.com/rafaelc-rb/geekery-api/internal/models"
+)
+
+// recapListSize limita as listas de destaque do recap (top rated, top tags)
+const recapListSize = 5
+
+// recapDayLayout é o formato dos dias usados no cálculo de streak
+const recapDayLayout = "2006-01-02"
+
+// GetRecap retorna o resumo anual ("wrapped") da lista do usuário
+// O ano é considerado em UTC, de 1º de janeiro a 31 de dezembro
+func (s *UserItemService) GetRecap(ctx context.Context, userID uint, year int) (*dto.RecapDTO, error) {
+	if year < 1900 || year > time.Now().Year()+5 {
+		return nil, models.ErrInvalidYear
+	}
+
+	from := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(1, 0, 0)
+
+	userItems, err := s.userItemRepo.GetWithActivityBetween(ctx, userID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recap activity: %w", err)
+	}
+
+	return buildRecap(year, userItems), nil
+}
+
+// buildRecap calcula o recap a partir das visualizações do history
+// Cada visualização concluída no ano conta como uma conclusão (re-watches incluídos)
+func buildRecap(year int, userItems []models.UserItem) *dto.RecapDTO {
+	from := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(1, 0, 0)
+	inYear := func(t *time.Time) bool {
+		return t != nil && !t.Before(from) && t.Before(to)
+	}
+
+	recap := &dto.RecapDTO{
+		Year:            year,
+		Completed:       []dto.RecapItemDTO{},
+		TopRated:        []dto.RecapItemDTO{},
+		TopTags:         []dto.TagCountDTO{},
+		TimeByMediaType: make(map[string]dto.TimeSpentDTO),
+	}
+	activeDays := make(map[string]bool)
+	tagCounts := make(map[string]int)
+
+	for i := range userItems {
+		ui := &userItems[i]
+		if ui.Item.ID == 0 {
+			continue // Item removido do catálogo
+		}
+
+		periods := ui.GetViewPeriods()
+		started := false
+		completions := 0
+		var completedAt *time.Time
+
+		for j, view := range periods {
+			if inYear(view.StartedAt) {
+				started = true
+				activeDays[view.StartedAt.UTC().Format(recapDayLayout)] = true
+			}
+			if !inYear(view.FinishedAt) {
+				continue
+			}
+
+			activeDays[view.FinishedAt.UTC().Format(recapDayLayout)] = true
+			completions++
+			addRecapTimeSpent(recap.TimeByMediaType, ui, j == len(periods)-1)
+
+			if completedAt == nil || view.FinishedAt.After(*completedAt) {
+				completedAt = view.FinishedAt
+			}
+			if recap.FirstCompletion == nil || view.FinishedAt.Before(*recap.FirstCompletion.CompletedAt) {
+				first := newRecapItem(ui, view.FinishedAt)
+				recap.FirstCompletion = &first
+			}
+			if recap.LastCompletion == nil || view.FinishedAt.After(*recap.LastCompletion.CompletedAt) {
+				last := newRecapItem(ui, view.FinishedAt)
+				recap.LastCompletion = &last
+			}
+		}
+
+		if started {
+			recap.ItemsStarted++
+		}
+
+		if ui.CompletionCount > 1 && (recap.MostRewatched == nil || ui.CompletionCount > recap.MostRewatched.CompletionCount) {
+			rewatched := newRecapItem(ui, completedAt)
+			recap.MostRewatched = &rewatched
+		}
+
+		if completions == 0 {
+			continue
+		}
+
+		recap.TotalCompletions += completions
+		recap.Completed = append(recap.Completed, newRecapItem(ui, completedAt))
+		for _, tag := range ui.Item.Tags {
+			tagCounts[tag.Name]++
+		}
+	}
+
+	recap.ItemsCompleted = len(recap.Completed)
+	sort.SliceStable(recap.Completed, func(i, j int) bool {
+		return recap.Completed[i].CompletedAt.Before(*recap.Completed[j].CompletedAt)
+	})
+
+	// Top rated: apenas items concluídos no ano e com nota
+	for _, item := range recap.Completed {
+		if item.Rating > 0 {
+			recap.TopRated = append(recap.TopRated, item)
+		}
+	}
+	sort.SliceStable(recap.TopRated, func(i, j int) bool {
+		return recap.TopRated[i].Rating > recap.TopRated[j].Rating
+	})
+	if len(recap.TopRated) > recapListSize {
+		recap.TopRated = recap.TopRated[:recapListSize]
+	}
+
+	for name, count := range tagCounts {
+		recap.TopTags = append(recap.TopTags, dto.TagCountDTO{Name: name, Count: count})
+	}
+	sort.Slice(recap.TopTags, func(i, j int) bool {
+		if recap.TopTags[i].Count != recap.TopTags[j].Count {
+			return recap.TopTags[i].Count > recap.TopTags[j].Count
+		}
+		return recap.TopTags[i].Name < recap.TopTags[j].Name
+	})
+	if len(recap.TopTags) > recapListSize {
+		recap.TopTags = recap.TopTags[:recapListSize]
+	}
+
+	recap.LongestStreak = longestStreak(activeDays)
+	return recap
+}
+
+// newRecapItem converte um UserItem para RecapItemDTO
+func newRecapItem(ui *models.UserItem, completedAt *time.Time) dto.RecapItemDTO {
+	return dto.RecapItemDTO{
+		UserItemID:      ui.ID,
+		ItemID:          ui.ItemID,
+		Title:           ui.Item.Title,
+		Type:            string(ui.Item.Type),
+		CoverURL:        ui.Item.CoverURL,
+		Rating:          ui.Rating,
+		CompletionCount: ui.CompletionCount,
+		CompletedAt:     completedAt,
+	}
+}
+
+// addRecapTimeSpent soma o consumo de uma visualização concluída ao tipo de mídia do item
+// Usa os totais do catálogo; na visualização atual, o progresso registrado cobre totais ausentes
+func addRecapTimeSpent(times map[string]dto.TimeSpentDTO, ui *models.UserItem, currentView bool) {
+	mediaType := string(ui.Item.Type)
+	spent := times[mediaType]
+
+	progress := func(key string, total int) int64 {
+		if total > 0 || !currentView {
+			return int64(total)
+		}
+		return int64(progressValue(ui.ProgressData, key))
+	}
+
+	switch {
+	case ui.Item.AnimeData != nil:
+		spent.EpisodesWatched += progress("episode", ui.Item.AnimeData.Episodes)
+	case ui.Item.SeriesData != nil:
+		spent.EpisodesWatched += progress("episode", ui.Item.SeriesData.Episodes)
+	case ui.Item.MovieData != nil:
+		spent.MinutesWatched += progress("minutes_watched", ui.Item.MovieData.Runtime)
+	case ui.Item.BookData != nil:
+		spent.ChaptersRead += progress("chapter", ui.Item.BookData.Chapters)
+		spent.PagesRead += progress("page", ui.Item.BookData.Pages)
+	case ui.Item.GameData != nil:
+		// As horas registradas valem apenas para a visualização atual
+		if hours := progressValue(ui.ProgressData, "hours"); currentView && hours > 0 {
+			spent.GameHours += hours
+		} else {
+			spent.GameHours += float64(ui.Item.GameData.AveragePlaytime)
+		}
+	}
+
+	times[mediaType] = spent
+}
+
+// progressValue lê um valor numérico do ProgressData
+func progressValue(data models.JSONB, key string) float64 {
+	switch value := data[key].(type) {
+	case float64:
+		return value
+	case int:
+		return float64(value)
+	}
+	return 0
+}
+
+// longestStreak calcula a maior sequência de dias consecutivos com atividade
+func longestStreak(activeDays map[string]bool) dto.StreakDTO {
+	days := make([]string, 0, len(activeDays))
+	for day := range activeDays {
+		days = append(days, day)
+	}
+	sort.Strings(days)
+
+	var best, current dto.StreakDTO
+	var previous time.Time
+	for _, day := range days {
+		date, err := time.Parse(recapDayLayout, day)
+		if err != nil {
+			continue
+		}
+
+		if current.Days > 0 && date.Sub(previous) == 24*time.Hour {
+			current.Days++
+			current.EndDate = day
+		} else {
+			current = dto.StreakDTO{Days: 1, StartDate: day, EndDate: day}
+		}
+		previous = date
+
+		if current.Days > best.Days {
+			best = current
+		}
+	}
+
+	return best
+}
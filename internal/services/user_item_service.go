@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/rafaelc-rb/geekery-api/internal/dto"
 	"github.com/rafaelc-rb/geekery-api/internal/models"
@@ -14,29 +16,60 @@ import (
 type UserItemService struct {
 	userItemRepo repositories.UserItemRepositoryInterface
 	itemRepo     repositories.ItemRepositoryInterface
+	uow          repositories.UnitOfWorkInterface
+	activityRepo repositories.ActivityRepositoryInterface  // Opcional: nil desativa o registro de atividade
+	statsRepo    repositories.ItemStatsRepositoryInterface // Opcional: nil desativa a atualização incremental dos agregados
 }
 
 // NewUserItemService cria uma nova instância do serviço de user items
-func NewUserItemService(userItemRepo repositories.UserItemRepositoryInterface, itemRepo repositories.ItemRepositoryInterface) *UserItemService {
+func NewUserItemService(userItemRepo repositories.UserItemRepositoryInterface, itemRepo repositories.ItemRepositoryInterface, uow repositories.UnitOfWorkInterface, activityRepo repositories.ActivityRepositoryInterface, statsRepo repositories.ItemStatsRepositoryInterface) *UserItemService {
 	return &UserItemService{
 		userItemRepo: userItemRepo,
 		itemRepo:     itemRepo,
+		uow:          uow,
+		activityRepo: activityRepo,
+		statsRepo:    statsRepo,
 	}
 }
 
 // AddToList adiciona um item à lista do usuário
 func (s *UserItemService) AddToList(ctx context.Context, userID uint, itemID uint, status models.MediaStatus) (*models.UserItem, error) {
+	userItem, err := s.addToList(ctx, s.userItemRepo, s.itemRepo, userID, itemID, status)
+	if err != nil {
+		return nil, err
+	}
+
+	recordActivity(ctx, s.activityRepo, addedEvents(userItem, time.Now()))
+	refreshItemStats(ctx, s.statsRepo, userItem.ItemID)
+	return userItem, nil
+}
+
+// addedEvents retorna o evento de atividade de um item recém-adicionado à lista
+func addedEvents(userItem *models.UserItem, now time.Time) []models.ActivityEvent {
+	return []models.ActivityEvent{{
+		UserID:     userItem.UserID,
+		UserItemID: userItem.ID,
+		ItemID:     userItem.ItemID,
+		MediaType:  userItem.Item.Type,
+		Kind:       models.ActivityAdded,
+		Status:     userItem.Status,
+		OccurredAt: now,
+	}}
+}
+
+// addToList contém a lógica de AddToList usando os repositórios fornecidos (normais ou transacionais)
+func (s *UserItemService) addToList(ctx context.Context, userItemRepo repositories.UserItemRepositoryInterface, itemRepo repositories.ItemRepositoryInterface, userID uint, itemID uint, status models.MediaStatus) (*models.UserItem, error) {
 	// Verificar se o item existe no catálogo
-	item, err := s.itemRepo.GetByID(ctx, itemID)
+	item, err := itemRepo.GetByID(ctx, itemID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("item not found in catalog")
+			return nil, models.ErrItemNotFound
 		}
 		return nil, fmt.Errorf("failed to verify item existence: %w", err)
 	}
 
 	// Verificar se o item já está na lista do usuário
-	exists, err := s.userItemRepo.Exists(ctx, userID, itemID)
+	exists, err := userItemRepo.Exists(ctx, userID, itemID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to check if item exists in user list: %w", err)
 	}
@@ -64,7 +97,7 @@ func (s *UserItemService) AddToList(ctx context.Context, userID uint, itemID uin
 	}
 
 	// Criar no banco
-	if err := s.userItemRepo.Create(ctx, userItem); err != nil {
+	if err := userItemRepo.Create(ctx, userItem); err != nil {
 		return nil, fmt.Errorf("failed to add item to list: %w", err)
 	}
 
@@ -92,6 +125,42 @@ func (s *UserItemService) GetMyFavorites(ctx context.Context, userID uint, param
 	return s.userItemRepo.GetFavorites(ctx, userID, params)
 }
 
+// SearchMyList retorna a lista do usuário aplicando filtros combinados, busca e ordenação
+func (s *UserItemService) SearchMyList(ctx context.Context, userID uint, filter dto.UserItemFilter, params dto.PaginationParams) ([]models.UserItem, int64, error) {
+	filter, err := normalizeListFilter(filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return s.userItemRepo.Search(ctx, userID, filter, params)
+}
+
+// normalizeListFilter valida os filtros da lista e normaliza nomes de tags e a busca
+func normalizeListFilter(filter dto.UserItemFilter) (dto.UserItemFilter, error) {
+	for _, status := range filter.Statuses {
+		if !models.MediaStatus(status).IsValid() {
+			return filter, models.ErrInvalidStatus
+		}
+	}
+
+	for _, mediaType := range filter.MediaTypes {
+		if !models.MediaType(mediaType).IsValid() {
+			return filter, models.ErrInvalidMediaType
+		}
+	}
+
+	if filter.MinRating != nil && filter.MaxRating != nil && *filter.MinRating > *filter.MaxRating {
+		return filter, models.ErrInvalidRatingRange
+	}
+
+	// Tags e rótulos pessoais são armazenados normalizados (lowercase, trim)
+	filter.Tags = normalizeTagNames(filter.Tags)
+	filter.PersonalTags = normalizeTagNames(filter.PersonalTags)
+	filter.Query = strings.TrimSpace(filter.Query)
+
+	return filter, nil
+}
+
 // GetMyListItem retorna um item específico da lista do usuário
 func (s *UserItemService) GetMyListItem(ctx context.Context, id uint, userID uint) (*models.UserItem, error) {
 	userItem, err := s.userItemRepo.GetByIDAndUser(ctx, id, userID)
@@ -108,19 +177,13 @@ func (s *UserItemService) UpdateListItem(ctx context.Context, id uint, userID ui
 	if err != nil {
 		return nil, err
 	}
+	before := snapshotUserItem(existingItem)
 
 	// Atualizar campos
 	if updates.Status != "" {
-		if !updates.Status.IsValid() {
-			return nil, models.ErrInvalidStatus
+		if err := applyStatusChange(existingItem, updates.Status); err != nil {
+			return nil, err
 		}
-
-		// Se mudou para "completed", completar visualização atual
-		if updates.Status == models.StatusCompleted && existingItem.Status != models.StatusCompleted {
-			existingItem.CompleteCurrentView()
-		}
-
-		existingItem.Status = updates.Status
 	}
 
 	if updates.Rating >= 0 && updates.Rating <= 10 {
@@ -131,6 +194,7 @@ func (s *UserItemService) UpdateListItem(ctx context.Context, id uint, userID ui
 
 	existingItem.Favorite = updates.Favorite
 	existingItem.Notes = updates.Notes
+	existingItem.Private = updates.Private
 
 	// Atualizar ProgressType se fornecido
 	if updates.ProgressType != "" {
@@ -160,13 +224,30 @@ func (s *UserItemService) UpdateListItem(ctx context.Context, id uint, userID ui
 		return nil, fmt.Errorf("failed to update list item: %w", err)
 	}
 
+	recordActivity(ctx, s.activityRepo, activityEvents(before, existingItem, time.Now()))
+	refreshItemStats(ctx, s.statsRepo, existingItem.ItemID)
 	return existingItem, nil
 }
 
+// applyStatusChange altera o status de um user item respeitando o ciclo de visualizações
+func applyStatusChange(userItem *models.UserItem, status models.MediaStatus) error {
+	if !status.IsValid() {
+		return models.ErrInvalidStatus
+	}
+
+	// Se mudou para "completed", completar visualização atual
+	if status == models.StatusCompleted && userItem.Status != models.StatusCompleted {
+		userItem.CompleteCurrentView()
+	}
+
+	userItem.Status = status
+	return nil
+}
+
 // RemoveFromList remove um item da lista do usuário
 func (s *UserItemService) RemoveFromList(ctx context.Context, id uint, userID uint) error {
 	// Verificar se o item pertence ao usuário
-	_, err := s.userItemRepo.GetByIDAndUser(ctx, id, userID)
+	userItem, err := s.userItemRepo.GetByIDAndUser(ctx, id, userID)
 	if err != nil {
 		return err
 	}
@@ -176,10 +257,22 @@ func (s *UserItemService) RemoveFromList(ctx context.Context, id uint, userID ui
 		return fmt.Errorf("failed to remove item from list: %w", err)
 	}
 
+	refreshItemStats(ctx, s.statsRepo, userItem.ItemID)
 	return nil
 }
 
 // GetStatistics retorna estatísticas da lista do usuário
-func (s *UserItemService) GetStatistics(ctx context.Context, userID uint) (map[string]int64, error) {
-	return s.userItemRepo.GetStatistics(ctx, userID)
+// Inclui matriz de status por tipo de mídia, distribuição de notas, consumo estimado e conclusões por período
+func (s *UserItemService) GetStatistics(ctx context.Context, userID uint) (*dto.UserListStatsDTO, error) {
+	aggregates, err := s.userItemRepo.GetStatistics(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get statistics: %w", err)
+	}
+
+	completions, err := s.userItemRepo.GetCompletionsByMonth(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get completions: %w", err)
+	}
+
+	return dto.StatsToDTO(aggregates, completions), nil
 }
@@ -1,8 +1,12 @@
 package services
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/rafaelc-rb/geekery-api/internal/dto"
 	"github.com/rafaelc-rb/geekery-api/internal/models"
@@ -31,7 +35,7 @@ func TestAddToList_Success(t *testing.T) {
 		},
 	}
 
-	service := NewUserItemService(mockUserItemRepo, mockItemRepo)
+	service := NewUserItemService(mockUserItemRepo, mockItemRepo, nil, nil, nil)
 	userItem, err := service.AddToList(ctx, 1, 1, models.StatusPlanned)
 
 	if err != nil {
@@ -54,7 +58,7 @@ func TestAddToList_ItemNotFound(t *testing.T) {
 	}
 
 	mockUserItemRepo := &testutil.MockUserItemRepository{}
-	service := NewUserItemService(mockUserItemRepo, mockItemRepo)
+	service := NewUserItemService(mockUserItemRepo, mockItemRepo, nil, nil, nil)
 
 	_, err := service.AddToList(ctx, 1, 999, models.StatusPlanned)
 
@@ -80,7 +84,7 @@ func TestAddToList_DuplicateEntry(t *testing.T) {
 		},
 	}
 
-	service := NewUserItemService(mockUserItemRepo, mockItemRepo)
+	service := NewUserItemService(mockUserItemRepo, mockItemRepo, nil, nil, nil)
 	_, err := service.AddToList(ctx, 1, 1, models.StatusPlanned)
 
 	if err != models.ErrDuplicateEntry {
@@ -101,7 +105,7 @@ func TestGetMyList_Success(t *testing.T) {
 		},
 	}
 
-	service := NewUserItemService(mockUserItemRepo, nil)
+	service := NewUserItemService(mockUserItemRepo, nil, nil, nil, nil)
 	params := dto.PaginationParams{Page: 1, Limit: 20}
 	items, total, err := service.GetMyList(ctx, 1, params)
 
@@ -128,7 +132,7 @@ func TestGetMyListByStatus_Success(t *testing.T) {
 		},
 	}
 
-	service := NewUserItemService(mockUserItemRepo, nil)
+	service := NewUserItemService(mockUserItemRepo, nil, nil, nil, nil)
 	params := dto.PaginationParams{Page: 1, Limit: 20}
 	items, total, err := service.GetMyListByStatus(ctx, 1, models.StatusCompleted, params)
 
@@ -163,7 +167,7 @@ func TestUpdateListItem_Success(t *testing.T) {
 		},
 	}
 
-	service := NewUserItemService(mockUserItemRepo, nil)
+	service := NewUserItemService(mockUserItemRepo, nil, nil, nil, nil)
 
 	updates := &models.UserItem{
 		Status: models.StatusCompleted,
@@ -204,7 +208,7 @@ func TestUpdateListItem_CompleteView(t *testing.T) {
 		},
 	}
 
-	service := NewUserItemService(mockUserItemRepo, nil)
+	service := NewUserItemService(mockUserItemRepo, nil, nil, nil, nil)
 
 	updates := &models.UserItem{
 		Status: models.StatusCompleted,
@@ -234,7 +238,7 @@ func TestRemoveFromList_Success(t *testing.T) {
 		},
 	}
 
-	service := NewUserItemService(mockUserItemRepo, nil)
+	service := NewUserItemService(mockUserItemRepo, nil, nil, nil, nil)
 	err := service.RemoveFromList(ctx, 1, 1)
 
 	if err != nil {
@@ -244,27 +248,59 @@ func TestRemoveFromList_Success(t *testing.T) {
 
 func TestGetStatistics_Success(t *testing.T) {
 	ctx := context.Background()
-	expectedStats := map[string]int64{
-		"total":       10,
-		"completed":   5,
-		"in_progress": 3,
-		"planned":     2,
+	aggregates := []dto.StatsAggregate{
+		{MediaType: "anime", Status: "completed", RatingBucket: 8, Count: 3, Favorites: 1, RatingSum: 24.5, EpisodesWatched: 60},
+		{MediaType: "anime", Status: "in_progress", RatingBucket: 0, Count: 2, EpisodesWatched: 10},
+		{MediaType: "book", Status: "completed", RatingBucket: 10, Count: 1, RatingSum: 10, PagesRead: 300},
+		{MediaType: "book", Status: "planned", RatingBucket: 0, Count: 4},
+	}
+	completions := []dto.PeriodCount{
+		{Period: "2025-11", Count: 1},
+		{Period: "2026-01", Count: 2},
+		{Period: "2026-03", Count: 1},
 	}
 
 	mockUserItemRepo := &testutil.MockUserItemRepository{
-		GetStatisticsFunc: func(ctx context.Context, userID uint) (map[string]int64, error) {
-			return expectedStats, nil
+		GetStatisticsFunc: func(ctx context.Context, userID uint) ([]dto.StatsAggregate, error) {
+			return aggregates, nil
+		},
+		GetCompletionsByMonthFunc: func(ctx context.Context, userID uint) ([]dto.PeriodCount, error) {
+			return completions, nil
 		},
 	}
 
-	service := NewUserItemService(mockUserItemRepo, nil)
+	service := NewUserItemService(mockUserItemRepo, nil, nil, nil, nil)
 	stats, err := service.GetStatistics(ctx, 1)
 
 	if err != nil {
-		t.Errorf("Expected no error, got %v", err)
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if stats.Total != 10 {
+		t.Errorf("Expected total 10, got %d", stats.Total)
+	}
+	if stats.Completed != 4 || stats.InProgress != 2 || stats.Planned != 4 {
+		t.Errorf("Unexpected status counts: %+v", stats)
+	}
+	if stats.Ratings.Count != 4 {
+		t.Errorf("Expected 4 rated items, got %d", stats.Ratings.Count)
+	}
+	if stats.Ratings.Mean != 8.63 {
+		t.Errorf("Expected mean 8.63, got %v", stats.Ratings.Mean)
+	}
+	if stats.Ratings.Histogram["8"] != 3 || stats.Ratings.Histogram["10"] != 1 {
+		t.Errorf("Unexpected histogram: %v", stats.Ratings.Histogram)
+	}
+
+	anime := stats.ByMediaType["anime"]
+	if anime == nil || anime.Total != 5 || anime.ByStatus["completed"] != 3 {
+		t.Errorf("Unexpected anime stats: %+v", anime)
 	}
-	if stats["total"] != 10 {
-		t.Errorf("Expected total 10, got %d", stats["total"])
+	if stats.TimeSpent.EpisodesWatched != 70 || stats.TimeSpent.PagesRead != 300 {
+		t.Errorf("Unexpected time spent: %+v", stats.TimeSpent)
+	}
+
+	if len(stats.Completions.ByYear) != 2 || stats.Completions.ByYear[1].Count != 3 {
+		t.Errorf("Unexpected completions by year: %+v", stats.Completions.ByYear)
 	}
 }
 
@@ -281,7 +317,7 @@ func TestGetMyFavorites_Success(t *testing.T) {
 		},
 	}
 
-	service := NewUserItemService(mockUserItemRepo, nil)
+	service := NewUserItemService(mockUserItemRepo, nil, nil, nil, nil)
 	params := dto.PaginationParams{Page: 1, Limit: 20}
 	items, total, err := service.GetMyFavorites(ctx, 1, params)
 
@@ -311,7 +347,7 @@ func TestUpdateListItem_InvalidRating(t *testing.T) {
 		},
 	}
 
-	service := NewUserItemService(mockUserItemRepo, nil)
+	service := NewUserItemService(mockUserItemRepo, nil, nil, nil, nil)
 
 	updates := &models.UserItem{
 		Rating: 11.0, // Invalid rating
@@ -323,3 +359,356 @@ func TestUpdateListItem_InvalidRating(t *testing.T) {
 		t.Errorf("Expected invalid rating error, got %v", err)
 	}
 }
+
+func TestBulkOperations_PartialFailure(t *testing.T) {
+	ctx := context.Background()
+	item := &models.Item{Title: "Test Item", Type: models.MediaTypeAnime}
+	item.ID = 1
+
+	existingItem := &models.UserItem{UserID: 1, ItemID: 2, Status: models.StatusInProgress}
+	existingItem.ID = 10
+
+	mockItemRepo := &testutil.MockItemRepository{
+		GetByIDFunc: func(ctx context.Context, id uint) (*models.Item, error) {
+			return item, nil
+		},
+	}
+	mockUserItemRepo := &testutil.MockUserItemRepository{
+		CreateFunc: func(ctx context.Context, userItem *models.UserItem) error {
+			userItem.ID = 11
+			return nil
+		},
+		GetByUserAndItemFunc: func(ctx context.Context, userID, itemID uint) (*models.UserItem, error) {
+			if itemID == existingItem.ItemID {
+				return existingItem, nil
+			}
+			return nil, gorm.ErrRecordNotFound
+		},
+	}
+	uow := &testutil.MockUnitOfWork{Items: mockItemRepo, UserItems: mockUserItemRepo}
+
+	service := NewUserItemService(mockUserItemRepo, mockItemRepo, uow, nil, nil)
+	result, err := service.BulkOperations(ctx, 1, dto.BulkOperationsRequest{
+		Operations: []dto.BulkOperation{
+			{Op: dto.BulkOpAdd, ItemID: 1},
+			{Op: dto.BulkOpUpdateStatus, ItemID: 2, Status: "completed"},
+			{Op: dto.BulkOpDelete, ItemID: 99},
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.Succeeded != 2 || result.Failed != 1 {
+		t.Errorf("Expected 2 succeeded and 1 failed, got %d/%d", result.Succeeded, result.Failed)
+	}
+	if result.Success {
+		t.Error("Expected Success false when an operation fails")
+	}
+	if result.Results[0].UserItemID != 11 {
+		t.Errorf("Expected created user item ID 11, got %d", result.Results[0].UserItemID)
+	}
+	if existingItem.Status != models.StatusCompleted || existingItem.CompletionCount != 1 {
+		t.Errorf("Expected item completed once, got status %s and count %d", existingItem.Status, existingItem.CompletionCount)
+	}
+}
+
+func TestBulkOperations_AllOrNothingRollsBack(t *testing.T) {
+	ctx := context.Background()
+	existingItem := &models.UserItem{UserID: 1, ItemID: 2, Status: models.StatusPlanned}
+	existingItem.ID = 10
+
+	mockUserItemRepo := &testutil.MockUserItemRepository{
+		GetByIDAndUserFunc: func(ctx context.Context, id, userID uint) (*models.UserItem, error) {
+			return existingItem, nil
+		},
+	}
+	uow := &testutil.MockUnitOfWork{UserItems: mockUserItemRepo}
+
+	service := NewUserItemService(mockUserItemRepo, nil, uow, nil, nil)
+	favorite := true
+	result, err := service.BulkOperations(ctx, 1, dto.BulkOperationsRequest{
+		AllOrNothing: true,
+		Operations: []dto.BulkOperation{
+			{Op: dto.BulkOpSetFavorite, ID: 10, Favorite: &favorite},
+			{Op: dto.BulkOpUpdateStatus, ID: 10, Status: "invalid"},
+			{Op: dto.BulkOpDelete, ID: 10},
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !result.RolledBack {
+		t.Error("Expected batch to be rolled back")
+	}
+	if result.Succeeded != 0 {
+		t.Errorf("Expected 0 succeeded after rollback, got %d", result.Succeeded)
+	}
+	if len(result.Results) != 2 {
+		t.Errorf("Expected processing to stop at the failing operation, got %d results", len(result.Results))
+	}
+}
+
+func TestSearchMyList_InvalidRatingRange(t *testing.T) {
+	ctx := context.Background()
+	mockUserItemRepo := &testutil.MockUserItemRepository{}
+	service := NewUserItemService(mockUserItemRepo, nil, nil, nil, nil)
+
+	minRating, maxRating := 8.0, 5.0
+	_, _, err := service.SearchMyList(ctx, 1, dto.UserItemFilter{MinRating: &minRating, MaxRating: &maxRating}, dto.PaginationParams{})
+
+	if err != models.ErrInvalidRatingRange {
+		t.Errorf("Expected invalid rating range error, got %v", err)
+	}
+}
+
+func TestGetRecap_Success(t *testing.T) {
+	ctx := context.Background()
+
+	anime := models.UserItem{
+		ItemID:          1,
+		Rating:          9,
+		CompletionCount: 2,
+		ProgressType:    models.ProgressTypeEpisodic,
+		ProgressData: models.JSONB{
+			"history": []interface{}{
+				map[string]interface{}{"started_at": "2025-12-30T10:00:00Z", "finished_at": "2026-01-03T20:00:00Z"},
+				map[string]interface{}{"started_at": "2026-01-04T10:00:00Z", "finished_at": "2026-01-05T20:00:00Z"},
+			},
+		},
+		Item: models.Item{
+			Title:     "Frieren",
+			Type:      models.MediaTypeAnime,
+			Tags:      []models.Tag{{Name: "fantasy"}, {Name: "adventure"}},
+			AnimeData: &models.AnimeData{Episodes: 28},
+		},
+	}
+	anime.ID = 10
+	anime.Item.ID = 1
+
+	movie := models.UserItem{
+		ItemID:          2,
+		Rating:          7,
+		CompletionCount: 1,
+		ProgressType:    models.ProgressTypeTime,
+		ProgressData: models.JSONB{
+			"history": []interface{}{
+				map[string]interface{}{"started_at": "2026-06-10T19:00:00Z", "finished_at": "2026-06-10T21:00:00Z"},
+			},
+		},
+		Item: models.Item{
+			Title:     "Dune",
+			Type:      models.MediaTypeMovie,
+			Tags:      []models.Tag{{Name: "fantasy"}},
+			MovieData: &models.MovieData{Runtime: 155},
+		},
+	}
+	movie.ID = 11
+	movie.Item.ID = 2
+
+	mockUserItemRepo := &testutil.MockUserItemRepository{
+		GetWithActivityBetweenFunc: func(ctx context.Context, userID uint, from, to time.Time) ([]models.UserItem, error) {
+			if from.Year() != 2026 || to.Year() != 2027 {
+				t.Errorf("Unexpected period %v - %v", from, to)
+			}
+			return []models.UserItem{anime, movie}, nil
+		},
+	}
+
+	service := NewUserItemService(mockUserItemRepo, nil, nil, nil, nil)
+	recap, err := service.GetRecap(ctx, 1, 2026)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if recap.TotalCompletions != 3 || recap.ItemsCompleted != 2 {
+		t.Errorf("Expected 3 completions of 2 items, got %d of %d", recap.TotalCompletions, recap.ItemsCompleted)
+	}
+	if recap.TimeByMediaType["anime"].EpisodesWatched != 56 {
+		t.Errorf("Expected 56 episodes, got %d", recap.TimeByMediaType["anime"].EpisodesWatched)
+	}
+	if recap.TimeByMediaType["movie"].MinutesWatched != 155 {
+		t.Errorf("Expected 155 minutes, got %d", recap.TimeByMediaType["movie"].MinutesWatched)
+	}
+	if len(recap.TopRated) != 2 || recap.TopRated[0].Title != "Frieren" {
+		t.Errorf("Unexpected top rated: %+v", recap.TopRated)
+	}
+	if len(recap.TopTags) == 0 || recap.TopTags[0].Name != "fantasy" || recap.TopTags[0].Count != 2 {
+		t.Errorf("Unexpected top tags: %+v", recap.TopTags)
+	}
+	if recap.MostRewatched == nil || recap.MostRewatched.Title != "Frieren" {
+		t.Errorf("Expected Frieren as most rewatched, got %+v", recap.MostRewatched)
+	}
+	if recap.FirstCompletion == nil || recap.FirstCompletion.CompletedAt.Day() != 3 {
+		t.Errorf("Unexpected first completion: %+v", recap.FirstCompletion)
+	}
+	if recap.LastCompletion == nil || recap.LastCompletion.Title != "Dune" {
+		t.Errorf("Unexpected last completion: %+v", recap.LastCompletion)
+	}
+	// 2026-01-03, 04 e 05 (o início em 2025 não conta)
+	if recap.LongestStreak.Days != 3 || recap.LongestStreak.StartDate != "2026-01-03" {
+		t.Errorf("Unexpected streak: %+v", recap.LongestStreak)
+	}
+}
+
+func TestGetRecap_InvalidYear(t *testing.T) {
+	service := NewUserItemService(&testutil.MockUserItemRepository{}, nil, nil, nil, nil)
+
+	_, err := service.GetRecap(context.Background(), 1, 1800)
+	if err != models.ErrInvalidYear {
+		t.Errorf("Expected ErrInvalidYear, got %v", err)
+	}
+}
+
+// exportFixtureList monta uma lista com anime (concluído e revisto), mangá em andamento e filme
+func exportFixtureList() []models.UserItem {
+	started := time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)
+	finished := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+	rewatched := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+
+	anime := models.UserItem{
+		ID: 1, Status: models.StatusCompleted, Rating: 9.5, CompletionCount: 2, Notes: "Best <3", Favorite: true,
+		Item: models.Item{ID: 10, Type: models.MediaTypeAnime, Title: "Fullmetal Alchemist: Brotherhood",
+			ExternalMetadata: models.JSONB{"mal": float64(5114), "anilist": float64(5114)}, AnimeData: &models.AnimeData{Episodes: 64}},
+		PersonalTags: []models.PersonalTag{{Name: "comfort"}},
+	}
+	anime.SetEpisodicProgress(1, 64)
+	anime.AddView(&started, &finished)
+	anime.AddView(&finished, &rewatched)
+
+	manga := models.UserItem{
+		ID: 2, Status: models.StatusInProgress, Rating: 8,
+		Item: models.Item{ID: 11, Type: models.MediaTypeComic, Title: "Berserk",
+			ExternalMetadata: models.JSONB{"mal": "2"}, BookData: &models.BookData{Chapters: 374, Volumes: 41}},
+	}
+	chapter, volume := 120, 12
+	manga.SetReadingProgress(&chapter, &volume, nil)
+	manga.AddView(&started, nil)
+
+	movie := models.UserItem{
+		ID: 3, Status: models.StatusPlanned,
+		Item: models.Item{ID: 12, Type: models.MediaTypeMovie, Title: "Heat"},
+	}
+
+	return []models.UserItem{anime, manga, movie}
+}
+
+func exportListService(userItems []models.UserItem) *UserItemService {
+	mockUserItemRepo := &testutil.MockUserItemRepository{
+		ListAfterIDFunc: func(ctx context.Context, userID uint, mediaType models.MediaType, afterID uint, limit int) ([]models.UserItem, error) {
+			if afterID > 0 {
+				return []models.UserItem{}, nil
+			}
+			return userItems, nil
+		},
+	}
+	return NewUserItemService(mockUserItemRepo, &testutil.MockItemRepository{}, nil, nil, nil)
+}
+
+func TestExportList_JSON(t *testing.T) {
+	service := exportListService(exportFixtureList())
+
+	var buf bytes.Buffer
+	if err := service.ExportList(context.Background(), &buf, 1, "", dto.ListExportFormatJSON); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var records []dto.UserItemFileRecord
+	if err := json.Unmarshal(buf.Bytes(), &records); err != nil {
+		t.Fatalf("Expected a JSON array, got %v: %s", err, buf.String())
+	}
+	if len(records) != 3 {
+		t.Fatalf("Expected 3 records, got %d", len(records))
+	}
+
+	anime := records[0]
+	if anime.Status != "completed" || anime.Rating != 9.5 || !anime.Favorite || anime.CompletionCount != 2 || anime.Notes != "Best <3" {
+		t.Errorf("Unexpected record: %+v", anime)
+	}
+	if len(anime.Views) != 2 || anime.Views[1].FinishedAt == nil || anime.Progress["episode"] != float64(64) {
+		t.Errorf("Expected progress and view history, got %+v and %+v", anime.Progress, anime.Views)
+	}
+	if _, ok := anime.Progress["history"]; ok {
+		t.Error("Expected history to be exported as views, not inside progress")
+	}
+	if len(anime.PersonalTags) != 1 || anime.PersonalTags[0] != "comfort" {
+		t.Errorf("Expected personal tags, got %v", anime.PersonalTags)
+	}
+}
+
+func TestExportList_MALXMLRoundTripsThroughImport(t *testing.T) {
+	service := exportListService(exportFixtureList())
+
+	var buf bytes.Buffer
+	if err := service.ExportList(context.Background(), &buf, 1, "", dto.ListExportFormatMALXML); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !strings.Contains(buf.String(), "<my_times_watched>1</my_times_watched>") || !strings.Contains(buf.String(), "<series_episodes>64</series_episodes>") {
+		t.Errorf("Expected MAL rewatch count and episodes, got %s", buf.String())
+	}
+
+	records, err := parseListExport(models.ListImportSourceMAL, "animelist.xml", &buf)
+	if err != nil {
+		t.Fatalf("Expected the export to be a valid MAL XML, got %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("Expected anime and manga only (2 records), got %d", len(records))
+	}
+
+	anime := records[0].entry
+	if anime.ExternalIDs != "mal:5114" || anime.ListStatus != models.StatusCompleted || anime.Rating != 10 ||
+		anime.Progress != 64 || anime.CompletionCount != 2 || anime.Notes != "Best <3" {
+		t.Errorf("Unexpected anime entry after round trip: %+v", anime)
+	}
+	if anime.StartedAt == nil || anime.StartedAt.Format("2006-01-02") != "2024-03-10" || anime.FinishedAt.Format("2006-01-02") != "2025-08-01" {
+		t.Errorf("Expected latest view dates, got %v and %v", anime.StartedAt, anime.FinishedAt)
+	}
+
+	manga := records[1].entry
+	if manga.ExternalIDs != "mal:2" || manga.ListStatus != models.StatusInProgress || manga.Progress != 120 || manga.ProgressVolumes != 12 || manga.FinishedAt != nil {
+		t.Errorf("Unexpected manga entry after round trip: %+v", manga)
+	}
+}
+
+func TestExportList_AniListRoundTripsThroughImport(t *testing.T) {
+	service := exportListService(exportFixtureList())
+
+	var buf bytes.Buffer
+	if err := service.ExportList(context.Background(), &buf, 1, "", dto.ListExportFormatAniList); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	records, err := parseListExport(models.ListImportSourceAniList, "anilist.json", &buf)
+	if err != nil {
+		t.Fatalf("Expected the export to be a valid AniList collection, got %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("Expected anime and manga only (2 records), got %d", len(records))
+	}
+
+	anime := records[0].entry
+	if anime.ExternalIDs != "anilist:5114|mal:5114" || anime.Rating != 9.5 || anime.CompletionCount != 2 || anime.ListStatus != models.StatusCompleted {
+		t.Errorf("Unexpected anime entry after round trip: %+v", anime)
+	}
+	if manga := records[1].entry; manga.MediaType != models.MediaTypeComic || manga.Progress != 120 {
+		t.Errorf("Unexpected manga entry after round trip: %+v", manga)
+	}
+}
+
+func TestExportList_CSV(t *testing.T) {
+	service := exportListService(exportFixtureList()[2:])
+
+	var buf bytes.Buffer
+	if err := service.ExportList(context.Background(), &buf, 1, models.MediaTypeMovie, dto.ListExportFormatCSV); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 || !strings.HasPrefix(lines[0], "type,title,release_date,external_metadata,status") {
+		t.Fatalf("Unexpected CSV: %s", buf.String())
+	}
+	if !strings.HasPrefix(lines[1], "movie,Heat,,,planned,0,false,false") {
+		t.Errorf("Unexpected row: %s", lines[1])
+	}
+}
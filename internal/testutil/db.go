@@ -57,15 +57,37 @@ func TeardownTestDB(t *testing.T, db *gorm.DB) {
 func CleanupTestDB(t *testing.T, db *gorm.DB) {
 	t.Helper()
 
+	// Tabelas de junção sem model próprio
+	if err := db.Exec("DELETE FROM user_item_personal_tags").Error; err != nil {
+		t.Logf("Warning: failed to clean table: %v", err)
+	}
+
 	// Ordem de limpeza respeitando foreign keys
 	tables := []interface{}{
+		&models.DataExport{},
+		&models.ListImportEntry{},
+		&models.ListImport{},
+		&models.ImportJob{},
+		&models.Follow{},
+		&models.ReviewVote{},
+		&models.ReviewRevision{},
+		&models.Review{},
+		&models.CollectionItem{},
+		&models.Collection{},
+		&models.Goal{},
+		&models.ActivityEvent{},
 		&models.UserItem{},
+		&models.PersonalTag{},
 		&models.AnimeData{},
 		&models.MovieData{},
 		&models.SeriesData{},
 		&models.GameData{},
 		&models.BookData{},
+		&models.ItemStats{},
+		&models.MediaTypeStats{},
+		&models.ItemSimilarity{},
 		&models.Item{},
+		&models.TagAlias{},
 		&models.Tag{},
 		&models.User{},
 	}
@@ -82,6 +104,8 @@ func MigrateTestDB(db *gorm.DB) error {
 	return db.AutoMigrate(
 		&models.User{},
 		&models.Tag{},
+		&models.TagAlias{},
+		&models.PersonalTag{},
 		&models.Item{},
 		&models.AnimeData{},
 		&models.MovieData{},
@@ -89,6 +113,21 @@ func MigrateTestDB(db *gorm.DB) error {
 		&models.GameData{},
 		&models.BookData{},
 		&models.UserItem{},
+		&models.ActivityEvent{},
+		&models.Goal{},
+		&models.Collection{},
+		&models.CollectionItem{},
+		&models.Review{},
+		&models.ReviewRevision{},
+		&models.ReviewVote{},
+		&models.ItemStats{},
+		&models.MediaTypeStats{},
+		&models.ItemSimilarity{},
+		&models.Follow{},
+		&models.ImportJob{},
+		&models.ListImport{},
+		&models.ListImportEntry{},
+		&models.DataExport{},
 	)
 }
 
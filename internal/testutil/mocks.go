@@ -2,25 +2,35 @@ package testutil
 
 import (
 	"context"
+	"time"
 
 	"github.com/rafaelc-rb/geekery-api/internal/dto"
 	"github.com/rafaelc-rb/geekery-api/internal/models"
+	"github.com/rafaelc-rb/geekery-api/internal/repositories"
+	"gorm.io/gorm"
 )
 
 // MockItemRepository é um mock do ItemRepository para testes
 type MockItemRepository struct {
-	CreateFunc              func(ctx context.Context, item *models.Item) error
-	GetAllFunc              func(ctx context.Context, params dto.PaginationParams) ([]models.Item, int64, error)
-	GetByIDFunc             func(ctx context.Context, id uint) (*models.Item, error)
-	GetByTypeFunc           func(ctx context.Context, mediaType models.MediaType, params dto.PaginationParams) ([]models.Item, int64, error)
-	UpdateFunc              func(ctx context.Context, item *models.Item) error
-	DeleteFunc              func(ctx context.Context, id uint) error
-	SearchByTitleFunc       func(ctx context.Context, query string, params dto.PaginationParams) ([]models.Item, int64, error)
-	GetByExternalIDFunc     func(ctx context.Context, source, externalID string) (*models.Item, error)
-	GetByYearFunc           func(ctx context.Context, year int) ([]models.Item, error)
-	AssociateTagsFunc       func(ctx context.Context, itemID uint, tagIDs []uint) error
-	RemoveTagFunc           func(ctx context.Context, itemID uint, tagID uint) error
-	CreateSpecificDataFunc  func(ctx context.Context, itemID uint, mediaType models.MediaType, data interface{}) error
+	CreateFunc                 func(ctx context.Context, item *models.Item) error
+	GetAllFunc                 func(ctx context.Context, params dto.PaginationParams) ([]models.Item, int64, error)
+	GetByIDFunc                func(ctx context.Context, id uint) (*models.Item, error)
+	GetByTypeFunc              func(ctx context.Context, mediaType models.MediaType, params dto.PaginationParams) ([]models.Item, int64, error)
+	UpdateFunc                 func(ctx context.Context, item *models.Item) error
+	DeleteFunc                 func(ctx context.Context, id uint) error
+	SearchByTitleFunc          func(ctx context.Context, query string, params dto.PaginationParams) ([]models.Item, int64, error)
+	GetByExternalIDFunc        func(ctx context.Context, mediaType models.MediaType, source, externalID string) (*models.Item, error)
+	FindByNormalizedTitlesFunc func(ctx context.Context, mediaType models.MediaType, titles []string) ([]models.Item, error)
+	FindByExternalIDsFunc      func(ctx context.Context, mediaType models.MediaType, source string, externalIDs []string) ([]models.Item, error)
+	CreateBatchFunc            func(ctx context.Context, items []*models.Item) error
+	GetByYearFunc              func(ctx context.Context, year int) ([]models.Item, error)
+	ListAfterIDFunc            func(ctx context.Context, mediaType models.MediaType, afterID uint, limit int) ([]models.Item, error)
+	FindByTitleKeysFunc        func(ctx context.Context, mediaType models.MediaType, keys []string) ([]models.Item, error)
+	AssociateTagsFunc          func(ctx context.Context, itemID uint, tagIDs []uint) error
+	RemoveTagFunc              func(ctx context.Context, itemID uint, tagID uint) error
+	CreateSpecificDataFunc     func(ctx context.Context, itemID uint, mediaType models.MediaType, data interface{}) error
+	SaveSpecificDataFunc       func(ctx context.Context, itemID uint, mediaType models.MediaType, data interface{}) error
+	GetRankedFunc              func(ctx context.Context, filter dto.ItemRankingFilter, params dto.PaginationParams) ([]models.Item, int64, error)
 }
 
 func (m *MockItemRepository) Create(ctx context.Context, item *models.Item) error {
@@ -72,11 +82,32 @@ func (m *MockItemRepository) SearchByTitle(ctx context.Context, query string, pa
 	return []models.Item{}, 0, nil
 }
 
-func (m *MockItemRepository) GetByExternalID(ctx context.Context, source, externalID string) (*models.Item, error) {
+func (m *MockItemRepository) GetByExternalID(ctx context.Context, mediaType models.MediaType, source, externalID string) (*models.Item, error) {
 	if m.GetByExternalIDFunc != nil {
-		return m.GetByExternalIDFunc(ctx, source, externalID)
+		return m.GetByExternalIDFunc(ctx, mediaType, source, externalID)
 	}
-	return nil, nil
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (m *MockItemRepository) FindByNormalizedTitles(ctx context.Context, mediaType models.MediaType, titles []string) ([]models.Item, error) {
+	if m.FindByNormalizedTitlesFunc != nil {
+		return m.FindByNormalizedTitlesFunc(ctx, mediaType, titles)
+	}
+	return []models.Item{}, nil
+}
+
+func (m *MockItemRepository) FindByExternalIDs(ctx context.Context, mediaType models.MediaType, source string, externalIDs []string) ([]models.Item, error) {
+	if m.FindByExternalIDsFunc != nil {
+		return m.FindByExternalIDsFunc(ctx, mediaType, source, externalIDs)
+	}
+	return []models.Item{}, nil
+}
+
+func (m *MockItemRepository) CreateBatch(ctx context.Context, items []*models.Item) error {
+	if m.CreateBatchFunc != nil {
+		return m.CreateBatchFunc(ctx, items)
+	}
+	return nil
 }
 
 func (m *MockItemRepository) GetByYear(ctx context.Context, year int) ([]models.Item, error) {
@@ -86,6 +117,20 @@ func (m *MockItemRepository) GetByYear(ctx context.Context, year int) ([]models.
 	return []models.Item{}, nil
 }
 
+func (m *MockItemRepository) ListAfterID(ctx context.Context, mediaType models.MediaType, afterID uint, limit int) ([]models.Item, error) {
+	if m.ListAfterIDFunc != nil {
+		return m.ListAfterIDFunc(ctx, mediaType, afterID, limit)
+	}
+	return []models.Item{}, nil
+}
+
+func (m *MockItemRepository) FindByTitleKeys(ctx context.Context, mediaType models.MediaType, keys []string) ([]models.Item, error) {
+	if m.FindByTitleKeysFunc != nil {
+		return m.FindByTitleKeysFunc(ctx, mediaType, keys)
+	}
+	return []models.Item{}, nil
+}
+
 func (m *MockItemRepository) AssociateTags(ctx context.Context, itemID uint, tagIDs []uint) error {
 	if m.AssociateTagsFunc != nil {
 		return m.AssociateTagsFunc(ctx, itemID, tagIDs)
@@ -107,18 +152,39 @@ func (m *MockItemRepository) CreateSpecificData(ctx context.Context, itemID uint
 	return nil
 }
 
+func (m *MockItemRepository) SaveSpecificData(ctx context.Context, itemID uint, mediaType models.MediaType, data interface{}) error {
+	if m.SaveSpecificDataFunc != nil {
+		return m.SaveSpecificDataFunc(ctx, itemID, mediaType, data)
+	}
+	return nil
+}
+
+func (m *MockItemRepository) GetRanked(ctx context.Context, filter dto.ItemRankingFilter, params dto.PaginationParams) ([]models.Item, int64, error) {
+	if m.GetRankedFunc != nil {
+		return m.GetRankedFunc(ctx, filter, params)
+	}
+	return []models.Item{}, 0, nil
+}
+
 // MockUserItemRepository é um mock do UserItemRepository para testes
 type MockUserItemRepository struct {
 	CreateFunc          func(ctx context.Context, userItem *models.UserItem) error
 	GetByUserIDFunc     func(ctx context.Context, userID uint, params dto.PaginationParams) ([]models.UserItem, int64, error)
 	GetByIDFunc         func(ctx context.Context, id uint) (*models.UserItem, error)
 	GetByIDAndUserFunc  func(ctx context.Context, id, userID uint) (*models.UserItem, error)
+	GetByUserAndItemFunc func(ctx context.Context, userID, itemID uint) (*models.UserItem, error)
 	UpdateFunc          func(ctx context.Context, userItem *models.UserItem) error
 	DeleteFunc          func(ctx context.Context, id uint) error
 	ExistsFunc          func(ctx context.Context, userID, itemID uint) (bool, error)
 	GetByStatusFunc     func(ctx context.Context, userID uint, status models.MediaStatus, params dto.PaginationParams) ([]models.UserItem, int64, error)
 	GetFavoritesFunc    func(ctx context.Context, userID uint, params dto.PaginationParams) ([]models.UserItem, int64, error)
-	GetStatisticsFunc   func(ctx context.Context, userID uint) (map[string]int64, error)
+	SearchFunc          func(ctx context.Context, userID uint, filter dto.UserItemFilter, params dto.PaginationParams) ([]models.UserItem, int64, error)
+	SearchPublicFunc func(ctx context.Context, userID uint, filter dto.UserItemFilter, params dto.PaginationParams) ([]models.UserItem, int64, error)
+	GetStatisticsFunc   func(ctx context.Context, userID uint) ([]dto.StatsAggregate, error)
+	GetPublicStatisticsFunc func(ctx context.Context, userID uint) ([]dto.StatsAggregate, error)
+	GetCompletionsByMonthFunc func(ctx context.Context, userID uint) ([]dto.PeriodCount, error)
+	GetWithActivityBetweenFunc func(ctx context.Context, userID uint, from, to time.Time) ([]models.UserItem, error)
+	ListAfterIDFunc     func(ctx context.Context, userID uint, mediaType models.MediaType, afterID uint, limit int) ([]models.UserItem, error)
 }
 
 func (m *MockUserItemRepository) Create(ctx context.Context, userItem *models.UserItem) error {
@@ -184,14 +250,59 @@ func (m *MockUserItemRepository) GetFavorites(ctx context.Context, userID uint,
 	return []models.UserItem{}, 0, nil
 }
 
-func (m *MockUserItemRepository) GetStatistics(ctx context.Context, userID uint) (map[string]int64, error) {
+func (m *MockUserItemRepository) Search(ctx context.Context, userID uint, filter dto.UserItemFilter, params dto.PaginationParams) ([]models.UserItem, int64, error) {
+	if m.SearchFunc != nil {
+		return m.SearchFunc(ctx, userID, filter, params)
+	}
+	return []models.UserItem{}, 0, nil
+}
+
+func (m *MockUserItemRepository) SearchPublic(ctx context.Context, userID uint, filter dto.UserItemFilter, params dto.PaginationParams) ([]models.UserItem, int64, error) {
+	if m.SearchPublicFunc != nil {
+		return m.SearchPublicFunc(ctx, userID, filter, params)
+	}
+	return []models.UserItem{}, 0, nil
+}
+
+func (m *MockUserItemRepository) GetStatistics(ctx context.Context, userID uint) ([]dto.StatsAggregate, error) {
 	if m.GetStatisticsFunc != nil {
 		return m.GetStatisticsFunc(ctx, userID)
 	}
-	return map[string]int64{}, nil
+	return []dto.StatsAggregate{}, nil
+}
+
+func (m *MockUserItemRepository) GetPublicStatistics(ctx context.Context, userID uint) ([]dto.StatsAggregate, error) {
+	if m.GetPublicStatisticsFunc != nil {
+		return m.GetPublicStatisticsFunc(ctx, userID)
+	}
+	return []dto.StatsAggregate{}, nil
+}
+
+func (m *MockUserItemRepository) GetCompletionsByMonth(ctx context.Context, userID uint) ([]dto.PeriodCount, error) {
+	if m.GetCompletionsByMonthFunc != nil {
+		return m.GetCompletionsByMonthFunc(ctx, userID)
+	}
+	return []dto.PeriodCount{}, nil
+}
+
+func (m *MockUserItemRepository) GetWithActivityBetween(ctx context.Context, userID uint, from, to time.Time) ([]models.UserItem, error) {
+	if m.GetWithActivityBetweenFunc != nil {
+		return m.GetWithActivityBetweenFunc(ctx, userID, from, to)
+	}
+	return []models.UserItem{}, nil
+}
+
+func (m *MockUserItemRepository) ListAfterID(ctx context.Context, userID uint, mediaType models.MediaType, afterID uint, limit int) ([]models.UserItem, error) {
+	if m.ListAfterIDFunc != nil {
+		return m.ListAfterIDFunc(ctx, userID, mediaType, afterID, limit)
+	}
+	return []models.UserItem{}, nil
 }
 
 func (m *MockUserItemRepository) GetByUserAndItem(ctx context.Context, userID uint, itemID uint) (*models.UserItem, error) {
+	if m.GetByUserAndItemFunc != nil {
+		return m.GetByUserAndItemFunc(ctx, userID, itemID)
+	}
 	// Redirecionar para GetByIDAndUserFunc se existir
 	if m.GetByIDAndUserFunc != nil {
 		return m.GetByIDAndUserFunc(ctx, 0, userID) // ID não usado neste caso
@@ -201,14 +312,25 @@ func (m *MockUserItemRepository) GetByUserAndItem(ctx context.Context, userID ui
 
 // MockTagRepository é um mock do TagRepository para testes
 type MockTagRepository struct {
-	CreateFunc        func(ctx context.Context, tag *models.Tag) error
-	GetAllFunc        func(ctx context.Context) ([]models.Tag, error)
-	GetByIDFunc       func(ctx context.Context, id uint) (*models.Tag, error)
-	GetByNameFunc     func(ctx context.Context, name string) (*models.Tag, error)
-	UpdateFunc        func(ctx context.Context, tag *models.Tag) error
-	DeleteFunc        func(ctx context.Context, id uint) error
-	FindOrCreateFunc  func(ctx context.Context, tag *models.Tag) error
-	GetTagsByIDsFunc  func(ctx context.Context, ids []uint) ([]models.Tag, error)
+	CreateFunc           func(ctx context.Context, tag *models.Tag) error
+	GetAllFunc           func(ctx context.Context) ([]models.Tag, error)
+	GetByIDFunc          func(ctx context.Context, id uint) (*models.Tag, error)
+	GetByNameFunc        func(ctx context.Context, name string) (*models.Tag, error)
+	UpdateFunc           func(ctx context.Context, tag *models.Tag) error
+	DeleteFunc           func(ctx context.Context, id uint) error
+	FindOrCreateFunc     func(ctx context.Context, tag *models.Tag) error
+	GetTagsByIDsFunc     func(ctx context.Context, ids []uint) ([]models.Tag, error)
+	SearchFunc           func(ctx context.Context, filter dto.TagFilter, params dto.PaginationParams) ([]models.Tag, int64, error)
+	CountByMediaTypeFunc func(ctx context.Context, tagIDs []uint) ([]dto.TagMediaTypeCount, error)
+	AutocompleteFunc     func(ctx context.Context, prefix string, limit int) ([]models.Tag, error)
+	GetItemsFunc         func(ctx context.Context, tagID uint, mediaType models.MediaType, params dto.PaginationParams) ([]models.Item, int64, error)
+	GetOrphansFunc       func(ctx context.Context) ([]models.Tag, error)
+	DeleteOrphansFunc    func(ctx context.Context) ([]models.Tag, error)
+	ResolveNameFunc      func(ctx context.Context, name string) (*models.Tag, error)
+	GetAncestorIDsFunc   func(ctx context.Context, id uint) ([]uint, error)
+	CreateAliasFunc      func(ctx context.Context, alias *models.TagAlias) error
+	DeleteAliasFunc      func(ctx context.Context, tagID, aliasID uint) error
+	MergeFunc            func(ctx context.Context, target *models.Tag, sourceIDs []uint) error
 }
 
 func (m *MockTagRepository) Create(ctx context.Context, tag *models.Tag) error {
@@ -266,3 +388,965 @@ func (m *MockTagRepository) GetTagsByIDs(ctx context.Context, ids []uint) ([]mod
 	}
 	return []models.Tag{}, nil
 }
+
+func (m *MockTagRepository) Search(ctx context.Context, filter dto.TagFilter, params dto.PaginationParams) ([]models.Tag, int64, error) {
+	if m.SearchFunc != nil {
+		return m.SearchFunc(ctx, filter, params)
+	}
+	return []models.Tag{}, 0, nil
+}
+
+func (m *MockTagRepository) CountByMediaType(ctx context.Context, tagIDs []uint) ([]dto.TagMediaTypeCount, error) {
+	if m.CountByMediaTypeFunc != nil {
+		return m.CountByMediaTypeFunc(ctx, tagIDs)
+	}
+	return []dto.TagMediaTypeCount{}, nil
+}
+
+func (m *MockTagRepository) Autocomplete(ctx context.Context, prefix string, limit int) ([]models.Tag, error) {
+	if m.AutocompleteFunc != nil {
+		return m.AutocompleteFunc(ctx, prefix, limit)
+	}
+	return []models.Tag{}, nil
+}
+
+func (m *MockTagRepository) GetItems(ctx context.Context, tagID uint, mediaType models.MediaType, params dto.PaginationParams) ([]models.Item, int64, error) {
+	if m.GetItemsFunc != nil {
+		return m.GetItemsFunc(ctx, tagID, mediaType, params)
+	}
+	return []models.Item{}, 0, nil
+}
+
+func (m *MockTagRepository) GetOrphans(ctx context.Context) ([]models.Tag, error) {
+	if m.GetOrphansFunc != nil {
+		return m.GetOrphansFunc(ctx)
+	}
+	return []models.Tag{}, nil
+}
+
+func (m *MockTagRepository) DeleteOrphans(ctx context.Context) ([]models.Tag, error) {
+	if m.DeleteOrphansFunc != nil {
+		return m.DeleteOrphansFunc(ctx)
+	}
+	return []models.Tag{}, nil
+}
+
+func (m *MockTagRepository) ResolveName(ctx context.Context, name string) (*models.Tag, error) {
+	if m.ResolveNameFunc != nil {
+		return m.ResolveNameFunc(ctx, name)
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (m *MockTagRepository) GetAncestorIDs(ctx context.Context, id uint) ([]uint, error) {
+	if m.GetAncestorIDsFunc != nil {
+		return m.GetAncestorIDsFunc(ctx, id)
+	}
+	return []uint{}, nil
+}
+
+func (m *MockTagRepository) CreateAlias(ctx context.Context, alias *models.TagAlias) error {
+	if m.CreateAliasFunc != nil {
+		return m.CreateAliasFunc(ctx, alias)
+	}
+	return nil
+}
+
+func (m *MockTagRepository) DeleteAlias(ctx context.Context, tagID, aliasID uint) error {
+	if m.DeleteAliasFunc != nil {
+		return m.DeleteAliasFunc(ctx, tagID, aliasID)
+	}
+	return nil
+}
+
+func (m *MockTagRepository) Merge(ctx context.Context, target *models.Tag, sourceIDs []uint) error {
+	if m.MergeFunc != nil {
+		return m.MergeFunc(ctx, target, sourceIDs)
+	}
+	return nil
+}
+
+// MockUnitOfWork é um mock da UnitOfWork para testes
+// Sem DoFunc, executa fn diretamente com os repositórios configurados (sem transação real)
+type MockUnitOfWork struct {
+	DoFunc     func(ctx context.Context, fn func(tx *repositories.Repositories) error) error
+	Items        repositories.ItemRepositoryInterface
+	Tags         repositories.TagRepositoryInterface
+	UserItems    repositories.UserItemRepositoryInterface
+	Activities   repositories.ActivityRepositoryInterface
+	PersonalTags repositories.PersonalTagRepositoryInterface
+	ListImports  repositories.ListImportRepositoryInterface
+}
+
+func (m *MockUnitOfWork) Do(ctx context.Context, fn func(tx *repositories.Repositories) error) error {
+	if m.DoFunc != nil {
+		return m.DoFunc(ctx, fn)
+	}
+	return fn(&repositories.Repositories{
+		Items:        m.Items,
+		Tags:         m.Tags,
+		UserItems:    m.UserItems,
+		Activities:   m.Activities,
+		PersonalTags: m.PersonalTags,
+		ListImports:  m.ListImports,
+		UnitOfWork:   m,
+	})
+}
+
+// MockActivityRepository é um mock do ActivityRepository para testes
+type MockActivityRepository struct {
+	CreateFunc        func(ctx context.Context, events []models.ActivityEvent) error
+	CountByDayFunc    func(ctx context.Context, userID uint, from, to time.Time) ([]dto.DayCount, error)
+	GetActiveDaysFunc func(ctx context.Context, userID uint) ([]string, error)
+	SumMetricFunc     func(ctx context.Context, userID uint, metric models.GoalMetric, mediaType *models.MediaType, from, to time.Time) (float64, error)
+	GetFeedFunc       func(ctx context.Context, viewerID uint, params dto.PaginationParams) ([]dto.FeedEventRow, int64, error)
+
+	Created []models.ActivityEvent // Eventos recebidos por Create (quando CreateFunc não é definido)
+}
+
+func (m *MockActivityRepository) Create(ctx context.Context, events []models.ActivityEvent) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, events)
+	}
+	m.Created = append(m.Created, events...)
+	return nil
+}
+
+func (m *MockActivityRepository) CountByDay(ctx context.Context, userID uint, from, to time.Time) ([]dto.DayCount, error) {
+	if m.CountByDayFunc != nil {
+		return m.CountByDayFunc(ctx, userID, from, to)
+	}
+	return []dto.DayCount{}, nil
+}
+
+func (m *MockActivityRepository) GetActiveDays(ctx context.Context, userID uint) ([]string, error) {
+	if m.GetActiveDaysFunc != nil {
+		return m.GetActiveDaysFunc(ctx, userID)
+	}
+	return []string{}, nil
+}
+
+func (m *MockActivityRepository) SumMetric(ctx context.Context, userID uint, metric models.GoalMetric, mediaType *models.MediaType, from, to time.Time) (float64, error) {
+	if m.SumMetricFunc != nil {
+		return m.SumMetricFunc(ctx, userID, metric, mediaType, from, to)
+	}
+	return 0, nil
+}
+
+func (m *MockActivityRepository) GetFeed(ctx context.Context, viewerID uint, params dto.PaginationParams) ([]dto.FeedEventRow, int64, error) {
+	if m.GetFeedFunc != nil {
+		return m.GetFeedFunc(ctx, viewerID, params)
+	}
+	return []dto.FeedEventRow{}, 0, nil
+}
+
+// MockUserRepository é um mock do UserRepository para testes
+type MockUserRepository struct {
+	CreateFunc        func(ctx context.Context, user *models.User) error
+	GetByIDFunc       func(ctx context.Context, id uint) (*models.User, error)
+	GetByEmailFunc    func(ctx context.Context, email string) (*models.User, error)
+	GetByUsernameFunc func(ctx context.Context, username string) (*models.User, error)
+	UpdateFunc        func(ctx context.Context, user *models.User) error
+}
+
+func (m *MockUserRepository) Create(ctx context.Context, user *models.User) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, user)
+	}
+	return nil
+}
+
+func (m *MockUserRepository) GetByID(ctx context.Context, id uint) (*models.User, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (m *MockUserRepository) GetByEmail(ctx context.Context, email string) (*models.User, error) {
+	if m.GetByEmailFunc != nil {
+		return m.GetByEmailFunc(ctx, email)
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (m *MockUserRepository) GetByUsername(ctx context.Context, username string) (*models.User, error) {
+	if m.GetByUsernameFunc != nil {
+		return m.GetByUsernameFunc(ctx, username)
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (m *MockUserRepository) Update(ctx context.Context, user *models.User) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, user)
+	}
+	return nil
+}
+
+// MockFollowRepository é um mock do FollowRepository para testes
+type MockFollowRepository struct {
+	FollowFunc            func(ctx context.Context, followerID, followingID uint, status models.FollowStatus) error
+	GetStatusFunc         func(ctx context.Context, followerID, followingID uint) (models.FollowStatus, error)
+	UnfollowFunc          func(ctx context.Context, followerID, followingID uint) error
+	IsFollowingFunc       func(ctx context.Context, followerID, followingID uint) (bool, error)
+	CountFollowersFunc    func(ctx context.Context, userID uint) (int64, error)
+	CountFollowingFunc    func(ctx context.Context, userID uint) (int64, error)
+	GetFollowersFunc      func(ctx context.Context, userID uint, params dto.PaginationParams) ([]models.User, int64, error)
+	GetFollowingFunc      func(ctx context.Context, userID uint, params dto.PaginationParams) ([]models.User, int64, error)
+	GetFollowRequestsFunc func(ctx context.Context, userID uint, params dto.PaginationParams) ([]models.User, int64, error)
+	AcceptRequestFunc     func(ctx context.Context, followerID, followingID uint) (bool, error)
+	DeleteRequestFunc     func(ctx context.Context, followerID, followingID uint) (bool, error)
+	AcceptAllRequestsFunc func(ctx context.Context, userID uint) error
+}
+
+func (m *MockFollowRepository) Follow(ctx context.Context, followerID, followingID uint, status models.FollowStatus) error {
+	if m.FollowFunc != nil {
+		return m.FollowFunc(ctx, followerID, followingID, status)
+	}
+	return nil
+}
+
+func (m *MockFollowRepository) GetStatus(ctx context.Context, followerID, followingID uint) (models.FollowStatus, error) {
+	if m.GetStatusFunc != nil {
+		return m.GetStatusFunc(ctx, followerID, followingID)
+	}
+	return "", nil
+}
+
+func (m *MockFollowRepository) Unfollow(ctx context.Context, followerID, followingID uint) error {
+	if m.UnfollowFunc != nil {
+		return m.UnfollowFunc(ctx, followerID, followingID)
+	}
+	return nil
+}
+
+func (m *MockFollowRepository) IsFollowing(ctx context.Context, followerID, followingID uint) (bool, error) {
+	if m.IsFollowingFunc != nil {
+		return m.IsFollowingFunc(ctx, followerID, followingID)
+	}
+	return false, nil
+}
+
+func (m *MockFollowRepository) CountFollowers(ctx context.Context, userID uint) (int64, error) {
+	if m.CountFollowersFunc != nil {
+		return m.CountFollowersFunc(ctx, userID)
+	}
+	return 0, nil
+}
+
+func (m *MockFollowRepository) CountFollowing(ctx context.Context, userID uint) (int64, error) {
+	if m.CountFollowingFunc != nil {
+		return m.CountFollowingFunc(ctx, userID)
+	}
+	return 0, nil
+}
+
+func (m *MockFollowRepository) GetFollowers(ctx context.Context, userID uint, params dto.PaginationParams) ([]models.User, int64, error) {
+	if m.GetFollowersFunc != nil {
+		return m.GetFollowersFunc(ctx, userID, params)
+	}
+	return []models.User{}, 0, nil
+}
+
+func (m *MockFollowRepository) GetFollowing(ctx context.Context, userID uint, params dto.PaginationParams) ([]models.User, int64, error) {
+	if m.GetFollowingFunc != nil {
+		return m.GetFollowingFunc(ctx, userID, params)
+	}
+	return []models.User{}, 0, nil
+}
+
+func (m *MockFollowRepository) GetFollowRequests(ctx context.Context, userID uint, params dto.PaginationParams) ([]models.User, int64, error) {
+	if m.GetFollowRequestsFunc != nil {
+		return m.GetFollowRequestsFunc(ctx, userID, params)
+	}
+	return []models.User{}, 0, nil
+}
+
+func (m *MockFollowRepository) AcceptRequest(ctx context.Context, followerID, followingID uint) (bool, error) {
+	if m.AcceptRequestFunc != nil {
+		return m.AcceptRequestFunc(ctx, followerID, followingID)
+	}
+	return false, nil
+}
+
+func (m *MockFollowRepository) DeleteRequest(ctx context.Context, followerID, followingID uint) (bool, error) {
+	if m.DeleteRequestFunc != nil {
+		return m.DeleteRequestFunc(ctx, followerID, followingID)
+	}
+	return false, nil
+}
+
+func (m *MockFollowRepository) AcceptAllRequests(ctx context.Context, userID uint) error {
+	if m.AcceptAllRequestsFunc != nil {
+		return m.AcceptAllRequestsFunc(ctx, userID)
+	}
+	return nil
+}
+
+// MockGoalRepository é um mock do GoalRepository para testes
+type MockGoalRepository struct {
+	CreateFunc         func(ctx context.Context, goal *models.Goal) error
+	GetByUserIDFunc    func(ctx context.Context, userID uint) ([]models.Goal, error)
+	GetByIDAndUserFunc func(ctx context.Context, id, userID uint) (*models.Goal, error)
+	UpdateFunc         func(ctx context.Context, goal *models.Goal) error
+	DeleteFunc         func(ctx context.Context, id uint) error
+}
+
+func (m *MockGoalRepository) Create(ctx context.Context, goal *models.Goal) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, goal)
+	}
+	return nil
+}
+
+func (m *MockGoalRepository) GetByUserID(ctx context.Context, userID uint) ([]models.Goal, error) {
+	if m.GetByUserIDFunc != nil {
+		return m.GetByUserIDFunc(ctx, userID)
+	}
+	return []models.Goal{}, nil
+}
+
+func (m *MockGoalRepository) GetByIDAndUser(ctx context.Context, id, userID uint) (*models.Goal, error) {
+	if m.GetByIDAndUserFunc != nil {
+		return m.GetByIDAndUserFunc(ctx, id, userID)
+	}
+	return &models.Goal{}, nil
+}
+
+func (m *MockGoalRepository) Update(ctx context.Context, goal *models.Goal) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, goal)
+	}
+	return nil
+}
+
+func (m *MockGoalRepository) Delete(ctx context.Context, id uint) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return nil
+}
+
+// MockCollectionRepository é um mock do CollectionRepository para testes
+type MockCollectionRepository struct {
+	CreateFunc          func(ctx context.Context, collection *models.Collection) error
+	GetByIDFunc         func(ctx context.Context, id uint) (*models.Collection, error)
+	GetByShareTokenFunc func(ctx context.Context, token string) (*models.Collection, error)
+	GetByUserIDFunc     func(ctx context.Context, userID uint, params dto.PaginationParams) ([]models.Collection, int64, error)
+	GetPublicFunc       func(ctx context.Context, params dto.PaginationParams) ([]models.Collection, int64, error)
+	UpdateFunc          func(ctx context.Context, collection *models.Collection) error
+	DeleteFunc          func(ctx context.Context, id uint) error
+	GetItemFunc         func(ctx context.Context, collectionID, itemID uint) (*models.CollectionItem, error)
+	AddItemFunc         func(ctx context.Context, entry *models.CollectionItem) error
+	UpdateItemFunc      func(ctx context.Context, entry *models.CollectionItem) error
+	RemoveItemFunc      func(ctx context.Context, collectionID, itemID uint) error
+	ReorderFunc         func(ctx context.Context, collectionID uint, itemIDs []uint) error
+}
+
+func (m *MockCollectionRepository) Create(ctx context.Context, collection *models.Collection) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, collection)
+	}
+	return nil
+}
+
+func (m *MockCollectionRepository) GetByID(ctx context.Context, id uint) (*models.Collection, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (m *MockCollectionRepository) GetByShareToken(ctx context.Context, token string) (*models.Collection, error) {
+	if m.GetByShareTokenFunc != nil {
+		return m.GetByShareTokenFunc(ctx, token)
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (m *MockCollectionRepository) GetByUserID(ctx context.Context, userID uint, params dto.PaginationParams) ([]models.Collection, int64, error) {
+	if m.GetByUserIDFunc != nil {
+		return m.GetByUserIDFunc(ctx, userID, params)
+	}
+	return []models.Collection{}, 0, nil
+}
+
+func (m *MockCollectionRepository) GetPublic(ctx context.Context, params dto.PaginationParams) ([]models.Collection, int64, error) {
+	if m.GetPublicFunc != nil {
+		return m.GetPublicFunc(ctx, params)
+	}
+	return []models.Collection{}, 0, nil
+}
+
+func (m *MockCollectionRepository) Update(ctx context.Context, collection *models.Collection) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, collection)
+	}
+	return nil
+}
+
+func (m *MockCollectionRepository) Delete(ctx context.Context, id uint) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockCollectionRepository) GetItem(ctx context.Context, collectionID, itemID uint) (*models.CollectionItem, error) {
+	if m.GetItemFunc != nil {
+		return m.GetItemFunc(ctx, collectionID, itemID)
+	}
+	return nil, models.ErrItemNotInCollection
+}
+
+func (m *MockCollectionRepository) AddItem(ctx context.Context, entry *models.CollectionItem) error {
+	if m.AddItemFunc != nil {
+		return m.AddItemFunc(ctx, entry)
+	}
+	return nil
+}
+
+func (m *MockCollectionRepository) UpdateItem(ctx context.Context, entry *models.CollectionItem) error {
+	if m.UpdateItemFunc != nil {
+		return m.UpdateItemFunc(ctx, entry)
+	}
+	return nil
+}
+
+func (m *MockCollectionRepository) RemoveItem(ctx context.Context, collectionID, itemID uint) error {
+	if m.RemoveItemFunc != nil {
+		return m.RemoveItemFunc(ctx, collectionID, itemID)
+	}
+	return nil
+}
+
+func (m *MockCollectionRepository) Reorder(ctx context.Context, collectionID uint, itemIDs []uint) error {
+	if m.ReorderFunc != nil {
+		return m.ReorderFunc(ctx, collectionID, itemIDs)
+	}
+	return nil
+}
+
+// MockPersonalTagRepository é um mock do PersonalTagRepository para testes
+type MockPersonalTagRepository struct {
+	CreateFunc             func(ctx context.Context, tag *models.PersonalTag) error
+	GetByUserIDFunc        func(ctx context.Context, userID uint) ([]models.PersonalTag, error)
+	GetByIDAndUserFunc     func(ctx context.Context, id, userID uint) (*models.PersonalTag, error)
+	GetByNameFunc          func(ctx context.Context, userID uint, name string) (*models.PersonalTag, error)
+	GetByIDsAndUserFunc    func(ctx context.Context, ids []uint, userID uint) ([]models.PersonalTag, error)
+	UpdateFunc             func(ctx context.Context, tag *models.PersonalTag) error
+	DeleteFunc             func(ctx context.Context, id uint) error
+	ReplaceForUserItemFunc func(ctx context.Context, userItem *models.UserItem, tags []models.PersonalTag) error
+	RemoveFromUserItemFunc func(ctx context.Context, userItemID, tagID uint) error
+}
+
+func (m *MockPersonalTagRepository) Create(ctx context.Context, tag *models.PersonalTag) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, tag)
+	}
+	return nil
+}
+
+func (m *MockPersonalTagRepository) GetByUserID(ctx context.Context, userID uint) ([]models.PersonalTag, error) {
+	if m.GetByUserIDFunc != nil {
+		return m.GetByUserIDFunc(ctx, userID)
+	}
+	return []models.PersonalTag{}, nil
+}
+
+func (m *MockPersonalTagRepository) GetByIDAndUser(ctx context.Context, id, userID uint) (*models.PersonalTag, error) {
+	if m.GetByIDAndUserFunc != nil {
+		return m.GetByIDAndUserFunc(ctx, id, userID)
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (m *MockPersonalTagRepository) GetByName(ctx context.Context, userID uint, name string) (*models.PersonalTag, error) {
+	if m.GetByNameFunc != nil {
+		return m.GetByNameFunc(ctx, userID, name)
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (m *MockPersonalTagRepository) GetByIDsAndUser(ctx context.Context, ids []uint, userID uint) ([]models.PersonalTag, error) {
+	if m.GetByIDsAndUserFunc != nil {
+		return m.GetByIDsAndUserFunc(ctx, ids, userID)
+	}
+	return []models.PersonalTag{}, nil
+}
+
+func (m *MockPersonalTagRepository) Update(ctx context.Context, tag *models.PersonalTag) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, tag)
+	}
+	return nil
+}
+
+func (m *MockPersonalTagRepository) Delete(ctx context.Context, id uint) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockPersonalTagRepository) ReplaceForUserItem(ctx context.Context, userItem *models.UserItem, tags []models.PersonalTag) error {
+	if m.ReplaceForUserItemFunc != nil {
+		return m.ReplaceForUserItemFunc(ctx, userItem, tags)
+	}
+	return nil
+}
+
+func (m *MockPersonalTagRepository) RemoveFromUserItem(ctx context.Context, userItemID, tagID uint) error {
+	if m.RemoveFromUserItemFunc != nil {
+		return m.RemoveFromUserItemFunc(ctx, userItemID, tagID)
+	}
+	return nil
+}
+
+// MockReviewRepository é um mock do ReviewRepository para testes
+type MockReviewRepository struct {
+	CreateFunc            func(ctx context.Context, review *models.Review) error
+	GetByIDFunc           func(ctx context.Context, id uint) (*models.Review, error)
+	ExistsFunc            func(ctx context.Context, userID, itemID uint) (bool, error)
+	GetByItemIDFunc       func(ctx context.Context, itemID uint, filter dto.ReviewFilter, params dto.PaginationParams) ([]models.Review, int64, error)
+	UpdateFunc            func(ctx context.Context, review *models.Review, previous *models.ReviewRevision) error
+	DeleteFunc            func(ctx context.Context, id uint) error
+	GetRevisionsFunc      func(ctx context.Context, reviewID uint) ([]models.ReviewRevision, error)
+	AddVoteFunc           func(ctx context.Context, vote *models.ReviewVote) error
+	RemoveVoteFunc        func(ctx context.Context, reviewID, userID uint) error
+	GetVotedReviewIDsFunc func(ctx context.Context, userID uint, reviewIDs []uint) ([]uint, error)
+}
+
+func (m *MockReviewRepository) Create(ctx context.Context, review *models.Review) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, review)
+	}
+	return nil
+}
+
+func (m *MockReviewRepository) GetByID(ctx context.Context, id uint) (*models.Review, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return &models.Review{ID: id}, nil
+}
+
+func (m *MockReviewRepository) Exists(ctx context.Context, userID, itemID uint) (bool, error) {
+	if m.ExistsFunc != nil {
+		return m.ExistsFunc(ctx, userID, itemID)
+	}
+	return false, nil
+}
+
+func (m *MockReviewRepository) GetByItemID(ctx context.Context, itemID uint, filter dto.ReviewFilter, params dto.PaginationParams) ([]models.Review, int64, error) {
+	if m.GetByItemIDFunc != nil {
+		return m.GetByItemIDFunc(ctx, itemID, filter, params)
+	}
+	return []models.Review{}, 0, nil
+}
+
+func (m *MockReviewRepository) Update(ctx context.Context, review *models.Review, previous *models.ReviewRevision) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, review, previous)
+	}
+	return nil
+}
+
+func (m *MockReviewRepository) Delete(ctx context.Context, id uint) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockReviewRepository) GetRevisions(ctx context.Context, reviewID uint) ([]models.ReviewRevision, error) {
+	if m.GetRevisionsFunc != nil {
+		return m.GetRevisionsFunc(ctx, reviewID)
+	}
+	return []models.ReviewRevision{}, nil
+}
+
+func (m *MockReviewRepository) AddVote(ctx context.Context, vote *models.ReviewVote) error {
+	if m.AddVoteFunc != nil {
+		return m.AddVoteFunc(ctx, vote)
+	}
+	return nil
+}
+
+func (m *MockReviewRepository) RemoveVote(ctx context.Context, reviewID, userID uint) error {
+	if m.RemoveVoteFunc != nil {
+		return m.RemoveVoteFunc(ctx, reviewID, userID)
+	}
+	return nil
+}
+
+func (m *MockReviewRepository) GetVotedReviewIDs(ctx context.Context, userID uint, reviewIDs []uint) ([]uint, error) {
+	if m.GetVotedReviewIDsFunc != nil {
+		return m.GetVotedReviewIDsFunc(ctx, userID, reviewIDs)
+	}
+	return []uint{}, nil
+}
+
+// MockItemStatsRepository é um mock do ItemStatsRepository para testes
+type MockItemStatsRepository struct {
+	AggregateFunc        func(ctx context.Context, itemIDs []uint) ([]dto.ItemStatsAggregate, error)
+	MeanRatingByTypeFunc func(ctx context.Context) ([]dto.MediaTypeMean, error)
+	GetMeanRatingsFunc   func(ctx context.Context) ([]dto.MediaTypeMean, error)
+	SaveMeanRatingsFunc  func(ctx context.Context, means []dto.MediaTypeMean) error
+	UpsertFunc           func(ctx context.Context, stats []models.ItemStats) error
+	DeleteStaleFunc      func(ctx context.Context) error
+}
+
+func (m *MockItemStatsRepository) Aggregate(ctx context.Context, itemIDs []uint) ([]dto.ItemStatsAggregate, error) {
+	if m.AggregateFunc != nil {
+		return m.AggregateFunc(ctx, itemIDs)
+	}
+	return []dto.ItemStatsAggregate{}, nil
+}
+
+func (m *MockItemStatsRepository) MeanRatingByType(ctx context.Context) ([]dto.MediaTypeMean, error) {
+	if m.MeanRatingByTypeFunc != nil {
+		return m.MeanRatingByTypeFunc(ctx)
+	}
+	return []dto.MediaTypeMean{}, nil
+}
+
+func (m *MockItemStatsRepository) GetMeanRatings(ctx context.Context) ([]dto.MediaTypeMean, error) {
+	if m.GetMeanRatingsFunc != nil {
+		return m.GetMeanRatingsFunc(ctx)
+	}
+	return []dto.MediaTypeMean{}, nil
+}
+
+func (m *MockItemStatsRepository) SaveMeanRatings(ctx context.Context, means []dto.MediaTypeMean) error {
+	if m.SaveMeanRatingsFunc != nil {
+		return m.SaveMeanRatingsFunc(ctx, means)
+	}
+	return nil
+}
+
+func (m *MockItemStatsRepository) Upsert(ctx context.Context, stats []models.ItemStats) error {
+	if m.UpsertFunc != nil {
+		return m.UpsertFunc(ctx, stats)
+	}
+	return nil
+}
+
+func (m *MockItemStatsRepository) DeleteStale(ctx context.Context) error {
+	if m.DeleteStaleFunc != nil {
+		return m.DeleteStaleFunc(ctx)
+	}
+	return nil
+}
+
+// MockRecommendationRepository é um mock do RecommendationRepository para testes
+type MockRecommendationRepository struct {
+	GetItemFeaturesFunc       func(ctx context.Context, itemIDs []uint) ([]dto.ItemFeatures, error)
+	GetItemIDsSharingTagsFunc func(ctx context.Context, itemID uint, limit int) ([]uint, error)
+	GetItemIDsByCreatorFunc   func(ctx context.Context, creator string, limit int) ([]uint, error)
+	GetTagFrequenciesFunc     func(ctx context.Context, tagIDs []uint) ([]dto.TagFrequency, int64, error)
+	GetCoOccurrencesFunc      func(ctx context.Context, itemID uint, limit int) ([]dto.ItemCoOccurrence, int64, error)
+	GetSignalsFunc            func(ctx context.Context, userID uint) ([]dto.UserItemSignal, error)
+	ReplaceSimilaritiesFunc   func(ctx context.Context, similarities []models.ItemSimilarity) error
+	GetSimilaritiesFunc       func(ctx context.Context, itemIDs []uint, mediaType models.MediaType) ([]models.ItemSimilarity, error)
+	GetItemsByIDsFunc         func(ctx context.Context, ids []uint) ([]models.Item, error)
+}
+
+func (m *MockRecommendationRepository) GetItemFeatures(ctx context.Context, itemIDs []uint) ([]dto.ItemFeatures, error) {
+	if m.GetItemFeaturesFunc != nil {
+		return m.GetItemFeaturesFunc(ctx, itemIDs)
+	}
+	return []dto.ItemFeatures{}, nil
+}
+
+func (m *MockRecommendationRepository) GetItemIDsSharingTags(ctx context.Context, itemID uint, limit int) ([]uint, error) {
+	if m.GetItemIDsSharingTagsFunc != nil {
+		return m.GetItemIDsSharingTagsFunc(ctx, itemID, limit)
+	}
+	return []uint{}, nil
+}
+
+func (m *MockRecommendationRepository) GetItemIDsByCreator(ctx context.Context, creator string, limit int) ([]uint, error) {
+	if m.GetItemIDsByCreatorFunc != nil {
+		return m.GetItemIDsByCreatorFunc(ctx, creator, limit)
+	}
+	return []uint{}, nil
+}
+
+func (m *MockRecommendationRepository) GetTagFrequencies(ctx context.Context, tagIDs []uint) ([]dto.TagFrequency, int64, error) {
+	if m.GetTagFrequenciesFunc != nil {
+		return m.GetTagFrequenciesFunc(ctx, tagIDs)
+	}
+	return []dto.TagFrequency{}, 0, nil
+}
+
+func (m *MockRecommendationRepository) GetCoOccurrences(ctx context.Context, itemID uint, limit int) ([]dto.ItemCoOccurrence, int64, error) {
+	if m.GetCoOccurrencesFunc != nil {
+		return m.GetCoOccurrencesFunc(ctx, itemID, limit)
+	}
+	return []dto.ItemCoOccurrence{}, 0, nil
+}
+
+func (m *MockRecommendationRepository) GetSignals(ctx context.Context, userID uint) ([]dto.UserItemSignal, error) {
+	if m.GetSignalsFunc != nil {
+		return m.GetSignalsFunc(ctx, userID)
+	}
+	return []dto.UserItemSignal{}, nil
+}
+
+func (m *MockRecommendationRepository) ReplaceSimilarities(ctx context.Context, similarities []models.ItemSimilarity) error {
+	if m.ReplaceSimilaritiesFunc != nil {
+		return m.ReplaceSimilaritiesFunc(ctx, similarities)
+	}
+	return nil
+}
+
+func (m *MockRecommendationRepository) GetSimilarities(ctx context.Context, itemIDs []uint, mediaType models.MediaType) ([]models.ItemSimilarity, error) {
+	if m.GetSimilaritiesFunc != nil {
+		return m.GetSimilaritiesFunc(ctx, itemIDs, mediaType)
+	}
+	return []models.ItemSimilarity{}, nil
+}
+
+func (m *MockRecommendationRepository) GetItemsByIDs(ctx context.Context, ids []uint) ([]models.Item, error) {
+	if m.GetItemsByIDsFunc != nil {
+		return m.GetItemsByIDsFunc(ctx, ids)
+	}
+	return []models.Item{}, nil
+}
+
+// MockImportJobRepository é um mock do ImportJobRepository para testes
+type MockImportJobRepository struct {
+	CreateFunc         func(ctx context.Context, job *models.ImportJob) error
+	GetByIDFunc        func(ctx context.Context, id uint) (*models.ImportJob, error)
+	ClaimFunc          func(ctx context.Context, staleBefore time.Time) (*models.ImportJob, error)
+	UpdateProgressFunc func(ctx context.Context, id uint, lines, imported, failed int) (models.ImportJobStatus, error)
+	FinishFunc         func(ctx context.Context, job *models.ImportJob) error
+	RequeueFunc        func(ctx context.Context, id uint) error
+	CancelFunc         func(ctx context.Context, id uint) (bool, error)
+}
+
+func (m *MockImportJobRepository) Create(ctx context.Context, job *models.ImportJob) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, job)
+	}
+	job.ID = 1
+	return nil
+}
+
+func (m *MockImportJobRepository) GetByID(ctx context.Context, id uint) (*models.ImportJob, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return nil, models.ErrImportJobNotFound
+}
+
+func (m *MockImportJobRepository) Claim(ctx context.Context, staleBefore time.Time) (*models.ImportJob, error) {
+	if m.ClaimFunc != nil {
+		return m.ClaimFunc(ctx, staleBefore)
+	}
+	return nil, nil
+}
+
+func (m *MockImportJobRepository) UpdateProgress(ctx context.Context, id uint, lines, imported, failed int) (models.ImportJobStatus, error) {
+	if m.UpdateProgressFunc != nil {
+		return m.UpdateProgressFunc(ctx, id, lines, imported, failed)
+	}
+	return models.ImportJobRunning, nil
+}
+
+func (m *MockImportJobRepository) Finish(ctx context.Context, job *models.ImportJob) error {
+	if m.FinishFunc != nil {
+		return m.FinishFunc(ctx, job)
+	}
+	return nil
+}
+
+func (m *MockImportJobRepository) Requeue(ctx context.Context, id uint) error {
+	if m.RequeueFunc != nil {
+		return m.RequeueFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockImportJobRepository) Cancel(ctx context.Context, id uint) (bool, error) {
+	if m.CancelFunc != nil {
+		return m.CancelFunc(ctx, id)
+	}
+	return true, nil
+}
+
+// MockListImportRepository é um mock do ListImportRepository para testes
+type MockListImportRepository struct {
+	CreateFunc               func(ctx context.Context, listImport *models.ListImport) error
+	GetByIDAndUserFunc       func(ctx context.Context, id, userID uint) (*models.ListImport, error)
+	GetByUserIDFunc          func(ctx context.Context, userID uint, params dto.PaginationParams) ([]models.ListImport, int64, error)
+	CountEntriesByStatusFunc func(ctx context.Context, listImportIDs []uint) ([]dto.ListImportStatusCount, error)
+	GetEntriesFunc           func(ctx context.Context, listImportID uint, status models.ListImportEntryStatus, params dto.PaginationParams) ([]models.ListImportEntry, int64, error)
+	GetEntryFunc             func(ctx context.Context, listImportID, entryID uint) (*models.ListImportEntry, error)
+	UpdateEntryFunc          func(ctx context.Context, entry *models.ListImportEntry) error
+}
+
+func (m *MockListImportRepository) Create(ctx context.Context, listImport *models.ListImport) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, listImport)
+	}
+	listImport.ID = 1
+	return nil
+}
+
+func (m *MockListImportRepository) GetByIDAndUser(ctx context.Context, id, userID uint) (*models.ListImport, error) {
+	if m.GetByIDAndUserFunc != nil {
+		return m.GetByIDAndUserFunc(ctx, id, userID)
+	}
+	return nil, models.ErrListImportNotFound
+}
+
+func (m *MockListImportRepository) GetByUserID(ctx context.Context, userID uint, params dto.PaginationParams) ([]models.ListImport, int64, error) {
+	if m.GetByUserIDFunc != nil {
+		return m.GetByUserIDFunc(ctx, userID, params)
+	}
+	return []models.ListImport{}, 0, nil
+}
+
+func (m *MockListImportRepository) CountEntriesByStatus(ctx context.Context, listImportIDs []uint) ([]dto.ListImportStatusCount, error) {
+	if m.CountEntriesByStatusFunc != nil {
+		return m.CountEntriesByStatusFunc(ctx, listImportIDs)
+	}
+	return []dto.ListImportStatusCount{}, nil
+}
+
+func (m *MockListImportRepository) GetEntries(ctx context.Context, listImportID uint, status models.ListImportEntryStatus, params dto.PaginationParams) ([]models.ListImportEntry, int64, error) {
+	if m.GetEntriesFunc != nil {
+		return m.GetEntriesFunc(ctx, listImportID, status, params)
+	}
+	return []models.ListImportEntry{}, 0, nil
+}
+
+func (m *MockListImportRepository) GetEntry(ctx context.Context, listImportID, entryID uint) (*models.ListImportEntry, error) {
+	if m.GetEntryFunc != nil {
+		return m.GetEntryFunc(ctx, listImportID, entryID)
+	}
+	return nil, models.ErrListImportEntryNotFound
+}
+
+func (m *MockListImportRepository) UpdateEntry(ctx context.Context, entry *models.ListImportEntry) error {
+	if m.UpdateEntryFunc != nil {
+		return m.UpdateEntryFunc(ctx, entry)
+	}
+	return nil
+}
+
+// MockDataExportRepository é um mock do DataExportRepository para testes
+type MockDataExportRepository struct {
+	CreateFunc         func(ctx context.Context, export *models.DataExport) error
+	GetByIDFunc        func(ctx context.Context, id uint) (*models.DataExport, error)
+	GetByIDAndUserFunc func(ctx context.Context, id, userID uint) (*models.DataExport, error)
+	GetByUserIDFunc    func(ctx context.Context, userID uint, params dto.PaginationParams) ([]models.DataExport, int64, error)
+	HasPendingFunc     func(ctx context.Context, userID uint) (bool, error)
+	ClaimFunc          func(ctx context.Context, staleBefore time.Time) (*models.DataExport, error)
+	HeartbeatFunc      func(ctx context.Context, id uint) error
+	FinishFunc         func(ctx context.Context, export *models.DataExport) (bool, error)
+	RequeueFunc        func(ctx context.Context, id uint) error
+	GetExpiredFunc     func(ctx context.Context, now time.Time, limit int) ([]models.DataExport, error)
+	MarkExpiredFunc    func(ctx context.Context, id uint) error
+}
+
+func (m *MockDataExportRepository) Create(ctx context.Context, export *models.DataExport) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, export)
+	}
+	export.ID = 1
+	return nil
+}
+
+func (m *MockDataExportRepository) GetByID(ctx context.Context, id uint) (*models.DataExport, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return nil, models.ErrDataExportNotFound
+}
+
+func (m *MockDataExportRepository) GetByIDAndUser(ctx context.Context, id, userID uint) (*models.DataExport, error) {
+	if m.GetByIDAndUserFunc != nil {
+		return m.GetByIDAndUserFunc(ctx, id, userID)
+	}
+	return nil, models.ErrDataExportNotFound
+}
+
+func (m *MockDataExportRepository) GetByUserID(ctx context.Context, userID uint, params dto.PaginationParams) ([]models.DataExport, int64, error) {
+	if m.GetByUserIDFunc != nil {
+		return m.GetByUserIDFunc(ctx, userID, params)
+	}
+	return []models.DataExport{}, 0, nil
+}
+
+func (m *MockDataExportRepository) HasPending(ctx context.Context, userID uint) (bool, error) {
+	if m.HasPendingFunc != nil {
+		return m.HasPendingFunc(ctx, userID)
+	}
+	return false, nil
+}
+
+func (m *MockDataExportRepository) Claim(ctx context.Context, staleBefore time.Time) (*models.DataExport, error) {
+	if m.ClaimFunc != nil {
+		return m.ClaimFunc(ctx, staleBefore)
+	}
+	return nil, nil
+}
+
+func (m *MockDataExportRepository) Heartbeat(ctx context.Context, id uint) error {
+	if m.HeartbeatFunc != nil {
+		return m.HeartbeatFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockDataExportRepository) Finish(ctx context.Context, export *models.DataExport) (bool, error) {
+	if m.FinishFunc != nil {
+		return m.FinishFunc(ctx, export)
+	}
+	return true, nil
+}
+
+func (m *MockDataExportRepository) Requeue(ctx context.Context, id uint) error {
+	if m.RequeueFunc != nil {
+		return m.RequeueFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockDataExportRepository) GetExpired(ctx context.Context, now time.Time, limit int) ([]models.DataExport, error) {
+	if m.GetExpiredFunc != nil {
+		return m.GetExpiredFunc(ctx, now, limit)
+	}
+	return []models.DataExport{}, nil
+}
+
+func (m *MockDataExportRepository) MarkExpired(ctx context.Context, id uint) error {
+	if m.MarkExpiredFunc != nil {
+		return m.MarkExpiredFunc(ctx, id)
+	}
+	return nil
+}
+
+// MockAccountRepository é um mock do AccountRepository para testes
+type MockAccountRepository struct {
+	GetDataFunc func(ctx context.Context, userID uint) (*dto.AccountData, error)
+	EraseFunc   func(ctx context.Context, userID uint) (*dto.AccountErasureResult, error)
+}
+
+func (m *MockAccountRepository) GetData(ctx context.Context, userID uint) (*dto.AccountData, error) {
+	if m.GetDataFunc != nil {
+		return m.GetDataFunc(ctx, userID)
+	}
+	return &dto.AccountData{}, nil
+}
+
+func (m *MockAccountRepository) Erase(ctx context.Context, userID uint) (*dto.AccountErasureResult, error) {
+	if m.EraseFunc != nil {
+		return m.EraseFunc(ctx, userID)
+	}
+	return &dto.AccountErasureResult{Deleted: map[string]int64{"users": 1}}, nil
+}
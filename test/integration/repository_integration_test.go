@@ -337,6 +337,34 @@ func TestUserItemRepository_Integration(t *testing.T) {
 		}
 	})
 
+	t.Run("Search escapes LIKE wildcards", func(t *testing.T) {
+		testutil.CleanupTestDB(t, db)
+
+		user4 := &models.User{Name: "testuser4", Email: "test4@example.com"}
+		db.Create(user4)
+
+		for _, title := range []string{"100% Orange Juice", "Plain Title", "snake_case"} {
+			searchItem := &models.Item{Title: title, Type: models.MediaTypeGame}
+			if err := itemRepo.Create(ctx, searchItem); err != nil {
+				t.Fatalf("Failed to create item: %v", err)
+			}
+			userItem := &models.UserItem{UserID: user4.ID, ItemID: searchItem.ID, Status: models.StatusPlanned, ProgressType: models.ProgressTypePercent}
+			if err := userItemRepo.Create(ctx, userItem); err != nil {
+				t.Fatalf("Failed to create user item: %v", err)
+			}
+		}
+
+		for query, want := range map[string]string{"%": "100% Orange Juice", "_": "snake_case"} {
+			results, total, err := userItemRepo.Search(ctx, user4.ID, dto.UserItemFilter{Query: query}, dto.PaginationParams{Page: 1, Limit: 20})
+			if err != nil {
+				t.Fatalf("Failed to search: %v", err)
+			}
+			if total != 1 || len(results) != 1 || results[0].Item.Title != want {
+				t.Errorf("Expected only %q for q=%q, got %d results", want, query, total)
+			}
+		}
+	})
+
 	t.Run("Exists", func(t *testing.T) {
 		testutil.CleanupTestDB(t, db)
 
@@ -415,8 +443,73 @@ func TestUserItemRepository_Integration(t *testing.T) {
 			t.Fatalf("Failed to get statistics: %v", err)
 		}
 
-		if stats["total"] != 3 {
-			t.Errorf("Expected total 3, got %d", stats["total"])
+		if total := dto.StatsToDTO(stats, nil).Total; total != 3 {
+			t.Errorf("Expected total 3, got %d", total)
+		}
+	})
+}
+
+func TestReviewRepository_Integration(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	defer testutil.TeardownTestDB(t, db)
+
+	reviewRepo := repositories.NewReviewRepository(db)
+	ctx := context.Background()
+
+	item := &models.Item{Title: "Reviewed Item", Type: models.MediaTypeBook}
+	if err := db.Create(item).Error; err != nil {
+		t.Fatalf("Failed to create item: %v", err)
+	}
+
+	// Autor público, autor que oculta notas e autor com a entrada privada
+	authors := []*models.User{
+		{Name: "open", Username: "open", Email: "open@example.com"},
+		{Name: "hidden", Username: "hidden", Email: "hidden@example.com", HideRatings: true},
+		{Name: "private", Username: "private", Email: "private@example.com"},
+	}
+	ratings := []float64{6, 10, 9}
+	for i, author := range authors {
+		if err := db.Create(author).Error; err != nil {
+			t.Fatalf("Failed to create user: %v", err)
+		}
+		userItem := &models.UserItem{UserID: author.ID, ItemID: item.ID, Status: models.StatusCompleted, Rating: ratings[i], Private: i == 2, ProgressType: models.ProgressTypeReading}
+		if err := db.Create(userItem).Error; err != nil {
+			t.Fatalf("Failed to create user item: %v", err)
+		}
+		review := &models.Review{UserID: author.ID, ItemID: item.ID, Title: author.Name, Body: "Body", Rating: ratings[i]}
+		if err := reviewRepo.Create(ctx, review); err != nil {
+			t.Fatalf("Failed to create review: %v", err)
+		}
+	}
+
+	t.Run("Hidden ratings are flagged and sorted as unrated", func(t *testing.T) {
+		reviews, total, err := reviewRepo.GetByItemID(ctx, item.ID, dto.ReviewFilter{SortBy: dto.ReviewSortRating}, dto.PaginationParams{})
+		if err != nil {
+			t.Fatalf("Failed to get reviews: %v", err)
+		}
+		if total != 3 || len(reviews) != 3 {
+			t.Fatalf("Expected 3 reviews, got %d", total)
+		}
+		if reviews[0].Title != "open" || reviews[0].RatingHidden {
+			t.Errorf("Expected the only visible rating first, got %+v", reviews[0])
+		}
+		for _, review := range reviews[1:] {
+			if !review.RatingHidden || dto.ReviewToDTO(&review).Rating != 0 {
+				t.Errorf("Expected rating of %q hidden, got %+v", review.Title, review)
+			}
+		}
+	})
+
+	t.Run("GetByID flags hidden rating", func(t *testing.T) {
+		reviews, _, _ := reviewRepo.GetByItemID(ctx, item.ID, dto.ReviewFilter{SortBy: dto.ReviewSortRecent}, dto.PaginationParams{})
+		for _, listed := range reviews {
+			review, err := reviewRepo.GetByID(ctx, listed.ID)
+			if err != nil {
+				t.Fatalf("Failed to get review: %v", err)
+			}
+			if review.RatingHidden != (review.Title != "open") || review.User.Username != review.Title {
+				t.Errorf("Unexpected review %+v", review)
+			}
 		}
 	})
 }